@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
@@ -24,9 +25,20 @@ func printInfo() {
 	const sectionFmt = "%-15s "
 
 	printLogo(color.Cyan)
+	if config.K9sProfile != "" {
+		printTuple(sectionFmt, "Profile", config.K9sProfile, color.Cyan)
+	}
 	printTuple(sectionFmt, "Configuration", config.K9sConfigFile, color.Cyan)
+	printTuple(sectionFmt, "Data", config.K9sDataDir, color.Cyan)
 	printTuple(sectionFmt, "Logs", config.K9sLogs, color.Cyan)
-	printTuple(sectionFmt, "Screen Dumps", config.K9sDumpDir, color.Cyan)
+	cfg := config.NewConfig(nil)
+	if err := cfg.Load(config.K9sConfigFile); err != nil {
+		cfg.K9s = config.NewK9s()
+	}
+	printTuple(sectionFmt, "Screen Dumps", cfg.K9s.DumpRoot(), color.Cyan)
+	if pp := config.Profiles(); len(pp) > 0 {
+		printTuple(sectionFmt, "Profiles", strings.Join(pp, ", "), color.Cyan)
+	}
 }
 
 func printLogo(c color.Paint) {
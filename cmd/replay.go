@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func replayCmd() *cobra.Command {
+	var speed float64
+
+	command := cobra.Command{
+		Use:   "replay FILE",
+		Short: "Replay a recorded session",
+		Long:  "Replay a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			return ui.Replay(f, os.Stdout, speed)
+		},
+	}
+
+	command.Flags().Float64VarP(&speed, "speed", "s", 1, "Playback speed multiplier")
+
+	return &command
+}
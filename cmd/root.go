@@ -3,11 +3,13 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime/debug"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -34,12 +36,15 @@ var (
 		Short: shortAppDesc,
 		Long:  longAppDesc,
 		Run:   run,
+		PersistentPreRun: func(*cobra.Command, []string) {
+			config.InitLocations(*k9sFlags.Profile)
+		},
 	}
 )
 
 func init() {
 	const falseFlag = "false"
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), serveCmd(), replayCmd())
 	initK9sFlags()
 	initK8sFlags()
 
@@ -78,13 +83,27 @@ func run(cmd *cobra.Command, args []string) {
 	}()
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+	firstRun := config.IsFirstRun(config.K9sConfigFile)
 	cfg := loadConfiguration()
 	app := view.NewApp(cfg)
+	app.SetFirstRun(firstRun)
 	{
 		defer app.BailOut()
 		if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 			panic(err)
 		}
+		if *k9sFlags.Record != "" {
+			// Recordings capture secrets shown on screen so keep them private.
+			f, err := os.OpenFile(*k9sFlags.Record, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				panic(err)
+			}
+			if err := f.Chmod(0600); err != nil {
+				panic(err)
+			}
+			defer f.Close()
+			app.SetRecorder(ui.NewRecorder(f))
+		}
 		if err := app.Run(); err != nil {
 			panic(err)
 		}
@@ -117,13 +136,22 @@ func loadConfiguration() *config.Config {
 		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	}
 
+	if err := k9sCfg.Refine(k8sFlags); err != nil {
+		log.Panic().Err(err)
+	}
+	if isBoolSet(k9sFlags.Clean) {
+		k9sCfg.ResetState()
+	}
+	if isBoolSet(k9sFlags.Anonymize) {
+		k9sCfg.K9s.OverrideAnonymize(true)
+	}
 	if isBoolSet(k9sFlags.AllNamespaces) && k9sCfg.SetActiveNamespace(client.AllNamespaces) != nil {
 		log.Error().Msg("Setting active namespace")
 	}
 
-	if err := k9sCfg.Refine(k8sFlags); err != nil {
-		log.Panic().Err(err)
-	}
+	k8sCfg.SetRateLimitsFn(func(cluster string) client.RateLimits {
+		return k9sCfg.K9s.RateLimits(cluster)
+	})
 	k9sCfg.SetConnection(client.InitConnectionOrDie(k8sCfg))
 
 	// Try to access server version if that fail. Connectivity issue?
@@ -187,7 +215,31 @@ func initK9sFlags() {
 		k9sFlags.Command,
 		"command", "c",
 		config.DefaultCommand,
-		"Specify the default command to view when the application launches",
+		"Specify the command or deep link to view when the application launches, ie \"pods -n prod /api\"",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Record,
+		"record",
+		"",
+		"Record the session to an asciicast file",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.Clean,
+		"clean",
+		false,
+		"Ignore the last active view, namespace, filters and sorts",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.Anonymize,
+		"anonymize",
+		false,
+		"Mask resource names, namespaces, addresses and image registries in tables, titles and cluster info (not in yaml, descriptions or messages)",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		k9sFlags.Profile,
+		"profile",
+		"",
+		"Use an alternate config profile ie a separate config, skins, plugins and aliases tree",
 	)
 }
 
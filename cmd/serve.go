@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/remote"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func serveCmd() *cobra.Command {
+	cfg := remote.Config{
+		HostKey:        filepath.Join(config.K9sHome, "ssh_host_key"),
+		AuthorizedKeys: filepath.Join(config.K9sHome, "authorized_keys"),
+	}
+	var logLevel string
+
+	command := cobra.Command{
+		Use:   "serve [-- k9s flags]",
+		Short: "Serve K9s over ssh",
+		Long:  "Serve K9s sessions over ssh to users listed in an authorized keys file. Flags after -- are passed to each session",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			zerolog.SetGlobalLevel(parseLevel(logLevel))
+			bin, err := os.Executable()
+			if err != nil {
+				return err
+			}
+			cfg.Command, cfg.Args = bin, args
+			s, err := remote.NewServer(cfg)
+			if err != nil {
+				return err
+			}
+
+			return s.ListenAndServe()
+		},
+	}
+
+	command.Flags().StringVar(&cfg.Address, "address", remote.DefaultSSHAddress, "Address the ssh server listens on")
+	command.Flags().StringVar(&cfg.HostKey, "host-key", cfg.HostKey, "Path to the ssh host private key. Generated if missing")
+	command.Flags().StringVar(&cfg.AuthorizedKeys, "authorized-keys", cfg.AuthorizedKeys, "Path to the public keys allowed to connect")
+	command.Flags().StringVarP(&logLevel, "logLevel", "l", config.DefaultLogLevel, "Specify a log level (info, warn, debug, error, fatal, panic, trace)")
+
+	return &command
+}
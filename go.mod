@@ -45,6 +45,8 @@ require (
 	github.com/sahilm/fuzzy v0.1.0
 	github.com/spf13/cobra v0.0.5
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/crypto v0.0.0-20191028145041-f83a4685e152
+	golang.org/x/sys v0.0.0-20191028164358-195ce5e7f934
 	golang.org/x/text v0.3.2
 	gopkg.in/yaml.v2 v2.2.4
 	helm.sh/helm/v3 v3.0.2
@@ -56,6 +58,7 @@ require (
 	k8s.io/kubectl v0.0.0
 	k8s.io/kubernetes v1.16.3
 	k8s.io/metrics v0.0.0
+	sigs.k8s.io/kustomize v2.0.3+incompatible
 	sigs.k8s.io/yaml v1.1.0
 	vbom.ml/util v0.0.0-20180919145318-efcd4e0f9787
 )
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessError tracks a missing user permission on a resource.
+type AccessError struct {
+	NS   string
+	GVR  GVR
+	Verb string
+}
+
+// NewAccessError returns a new missing permission error.
+func NewAccessError(ns string, gvr GVR, verb string) *AccessError {
+	if IsClusterWide(ns) {
+		ns = AllNamespaces
+	}
+	return &AccessError{NS: ns, GVR: gvr, Verb: verb}
+}
+
+// Error returns the missing permission.
+func (e *AccessError) Error() string {
+	scope := "cluster wide"
+	if e.NS != AllNamespaces {
+		scope = "in ns " + e.NS
+	}
+
+	return fmt.Sprintf("missing %s %s %s", e.Verb, e.resource(), scope)
+}
+
+// Snippet returns a RBAC role granting the missing permission.
+func (e *AccessError) Snippet() string {
+	kind, meta := "ClusterRole", ""
+	if e.NS != AllNamespaces {
+		kind, meta = "Role", "\n  namespace: "+e.NS
+	}
+	name := "k9s-" + strings.Replace(e.resource(), "/", "-", -1) + "-" + e.Verb
+
+	return fmt.Sprintf(`apiVersion: rbac.authorization.k8s.io/v1
+kind: %s
+metadata:
+  name: %s%s
+rules:
+- apiGroups: [%q]
+  resources: [%q]
+  verbs: [%q]`, kind, name, meta, e.GVR.GVR().Group, e.resource(), e.Verb)
+}
+
+func (e *AccessError) resource() string {
+	if sr := e.GVR.SubResource(); sr != "" {
+		return e.GVR.GVR().Resource + "/" + sr
+	}
+
+	return e.GVR.GVR().Resource
+}
+
+// Preflight checks the user may use a verb on a resource before dialing in.
+// A missing permission returns an AccessError.
+func Preflight(a Authorizer, ns string, gvr GVR, verb string) error {
+	auth, err := a.CanI(ns, gvr.String(), []string{verb})
+	if auth {
+		return nil
+	}
+	if _, ok := err.(*AccessError); ok || err == nil {
+		return NewAccessError(ns, gvr, verb)
+	}
+
+	return err
+}
@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessError(t *testing.T) {
+	uu := map[string]struct {
+		ns, gvr, verb string
+		err, snippet  string
+	}{
+		"namespaced": {
+			ns:   "fred",
+			gvr:  "v1/pods:exec",
+			verb: "create",
+			err:  "missing create pods/exec in ns fred",
+			snippet: `apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: k9s-pods-exec-create
+  namespace: fred
+rules:
+- apiGroups: [""]
+  resources: ["pods/exec"]
+  verbs: ["create"]`,
+		},
+		"cluster": {
+			ns:   client.ClusterScope,
+			gvr:  "apps/v1/deployments",
+			verb: "delete",
+			err:  "missing delete deployments cluster wide",
+			snippet: `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: k9s-deployments-delete
+rules:
+- apiGroups: ["apps"]
+  resources: ["deployments"]
+  verbs: ["delete"]`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := client.NewAccessError(u.ns, client.NewGVR(u.gvr), u.verb)
+			assert.Equal(t, u.err, e.Error())
+			assert.Equal(t, u.snippet, e.Snippet())
+		})
+	}
+}
+
+func TestPreflight(t *testing.T) {
+	uu := map[string]struct {
+		auth  bool
+		err   error
+		e     string
+		isAcc bool
+	}{
+		"allowed": {
+			auth: true,
+		},
+		"cachedDenial": {
+			e:     "missing create pods/exec in ns fred",
+			isAcc: true,
+		},
+		"denied": {
+			err:   client.NewAccessError("fred", client.NewGVR("v1/pods:exec"), "create"),
+			e:     "missing create pods/exec in ns fred",
+			isAcc: true,
+		},
+		"dialFailed": {
+			err: errors.New("boom"),
+			e:   "boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := client.Preflight(authorizer{auth: u.auth, err: u.err}, "fred", client.NewGVR("v1/pods:exec"), "create")
+			if u.e == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.Equal(t, u.e, err.Error())
+			_, ok := err.(*client.AccessError)
+			assert.Equal(t, u.isAcc, ok)
+		})
+	}
+}
+
+// Helpers...
+
+type authorizer struct {
+	auth bool
+	err  error
+}
+
+func (a authorizer) CanI(string, string, []string) (bool, error) {
+	return a.auth, a.err
+}
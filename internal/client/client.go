@@ -1,7 +1,6 @@
 package client
 
 import (
-	"fmt"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -30,6 +29,8 @@ const (
 
 var supportedMetricsAPIVersions = []string{"v1beta1"}
 
+var _ TokenSwitcher = (*APIClient)(nil)
+
 // APIClient represents a Kubernetes api client.
 type APIClient struct {
 	checkClientSet *kubernetes.Clientset
@@ -99,7 +100,7 @@ func (a *APIClient) CanI(ns, gvr string, verbs []string) (auth bool, err error)
 		}
 		if !resp.Status.Allowed {
 			a.cache.Add(key, false, cacheExpiry)
-			return auth, fmt.Errorf("`%s access denied for user on %q:%s", v, ns, gvr)
+			return auth, NewAccessError(ns, NewGVR(gvr), v)
 		}
 	}
 
@@ -108,6 +109,32 @@ func (a *APIClient) CanI(ns, gvr string, verbs []string) (auth bool, err error)
 	return
 }
 
+// RulesFor returns the user access rules in a given namespace.
+// Cluster wide namespaces have no rules.
+func (a *APIClient) RulesFor(ns string) (*Rules, error) {
+	if IsClusterWide(ns) {
+		return nil, nil
+	}
+	key := "rules:" + ns
+	if v, ok := a.cache.Get(key); ok {
+		if r, ok := v.(*Rules); ok {
+			return r, nil
+		}
+	}
+	review := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: ns},
+	}
+	resp, err := a.DialOrDie().AuthorizationV1().SelfSubjectRulesReviews().Create(review)
+	if err != nil {
+		a.cache.Add(key, (*Rules)(nil), cacheExpiry)
+		return nil, err
+	}
+	r := NewRules(resp.Status.ResourceRules, resp.Status.Incomplete)
+	a.cache.Add(key, r, cacheExpiry)
+
+	return r, nil
+}
+
 // CurrentNamespaceName return namespace name set via either cli arg or cluster config.
 func (a *APIClient) CurrentNamespaceName() (string, error) {
 	return a.config.CurrentNamespaceName()
@@ -275,6 +302,18 @@ func (a *APIClient) SwitchContextOrDie(ctx string) {
 	}
 }
 
+// SwitchToken authenticates using the service account token located at path.
+func (a *APIClient) SwitchToken(path string) error {
+	if err := a.config.SwitchToken(path); err != nil {
+		return err
+	}
+	a.cachedClient = nil
+	a.reset()
+	_ = a.supportsMxServer()
+
+	return nil
+}
+
 func (a *APIClient) reset() {
 	a.mx.Lock()
 	defer a.mx.Unlock()
@@ -11,6 +11,7 @@ import (
 	restclient "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
 )
 
 const (
@@ -25,6 +26,8 @@ type Config struct {
 	currentContext string
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
+	saToken        *SAToken
+	rateLimitsFn   RateLimitsFunc
 	mutex          *sync.RWMutex
 }
 
@@ -55,6 +58,44 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ForContext returns a new configuration targeting another kubeconfig context.
+// Flags overriding the current context cluster or user are not carried over.
+func (c *Config) ForContext(name string) *Config {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig, flags.CacheDir, flags.Context = c.flags.KubeConfig, c.flags.CacheDir, &name
+	cfg := NewConfig(flags)
+	cfg.rateLimitsFn = c.rateLimitsFn
+
+	return cfg
+}
+
+// SwitchToken authenticates using the service account token located at path.
+// The token file is reread as it gets rotated.
+func (c *Config) SwitchToken(path string) error {
+	t, err := ReadSAToken(path)
+	if err != nil {
+		return err
+	}
+
+	c.reset()
+	c.saToken = &t
+
+	return nil
+}
+
+// ActiveSAToken returns the service account token in use if any.
+func (c *Config) ActiveSAToken() (SAToken, bool) {
+	if c.saToken != nil {
+		return *c.saToken, true
+	}
+	if !IsInCluster() {
+		return SAToken{}, false
+	}
+	t, err := ReadSAToken(InClusterTokenPath)
+
+	return t, err == nil
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -185,6 +226,9 @@ func (c *Config) CurrentUserName() (string, error) {
 	if isSet(c.flags.Impersonate) {
 		return *c.flags.Impersonate, nil
 	}
+	if c.saToken != nil {
+		return c.saToken.Subject(), nil
+	}
 
 	if isSet(c.flags.AuthInfoName) {
 		return *c.flags.AuthInfoName, nil
@@ -202,6 +246,9 @@ func (c *Config) CurrentUserName() (string, error) {
 	if ctx, ok := cfg.Contexts[current]; ok {
 		return ctx.AuthInfo, nil
 	}
+	if t, ok := c.ActiveSAToken(); ok {
+		return t.Subject(), nil
+	}
 
 	return "", errors.New("unable to locate current user")
 }
@@ -275,6 +322,23 @@ func (c *Config) RawConfig() (clientcmdapi.Config, error) {
 	return *c.rawConfig, nil
 }
 
+// SetRateLimitsFn specifies how to fetch the rate limits of a cluster.
+func (c *Config) SetRateLimitsFn(f RateLimitsFunc) {
+	c.rateLimitsFn = f
+}
+
+func (c *Config) rateLimits() RateLimits {
+	if c.rateLimitsFn == nil {
+		return RateLimits{}
+	}
+	cluster, err := c.CurrentClusterName()
+	if err != nil {
+		return RateLimits{}
+	}
+
+	return c.rateLimitsFn(cluster)
+}
+
 // RESTConfig fetch the current REST api service connection.
 func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.restConfig != nil {
@@ -285,8 +349,17 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.restConfig, err = c.flags.ToRESTConfig(); err != nil {
 		return nil, err
 	}
-	c.restConfig.QPS = defaultQPS
-	c.restConfig.Burst = defaultBurst
+	if c.saToken != nil {
+		useTokenFile(c.restConfig, c.saToken.Path)
+	}
+	limits := c.rateLimits()
+	c.restConfig.QPS = limits.qps()
+	c.restConfig.Burst = limits.burst()
+	c.restConfig.WrapTransport = transport.Wrappers(
+		c.restConfig.WrapTransport,
+		trackCalls,
+		throttleCalls(limits.Throttle),
+	)
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
 
 	return c.restConfig, nil
@@ -307,3 +380,11 @@ func (c *Config) ensureConfig() {
 func isSet(s *string) bool {
 	return s != nil && len(*s) != 0
 }
+
+// UseTokenFile swaps the config credentials for a bearer token file.
+func useTokenFile(cfg *restclient.Config, path string) {
+	cfg.BearerToken, cfg.BearerTokenFile = "", path
+	cfg.Username, cfg.Password = "", ""
+	cfg.AuthProvider, cfg.ExecProvider = nil, nil
+	cfg.CertFile, cfg.CertData, cfg.KeyFile, cfg.KeyData = "", nil, "", nil
+}
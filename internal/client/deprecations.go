@@ -0,0 +1,106 @@
+package client
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// DeprecationRemoved indicates the api version is no longer served.
+	DeprecationRemoved = "Removed"
+
+	// DeprecationDeprecated indicates the api version is served but deprecated.
+	DeprecationDeprecated = "Deprecated"
+
+	// DeprecationUpcoming indicates the api version will be deprecated in a later release.
+	DeprecationUpcoming = "Upcoming"
+)
+
+// Deprecation tracks a deprecated api version for a given kind.
+type Deprecation struct {
+	APIVersion   string
+	Kind         string
+	DeprecatedIn string
+	RemovedIn    string
+	Replacement  string
+}
+
+// Deprecations tracks known deprecated api versions.
+var Deprecations = []Deprecation{
+	{"extensions/v1beta1", "Deployment", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta1", "Deployment", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta2", "Deployment", "1.9", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "DaemonSet", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta2", "DaemonSet", "1.9", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "ReplicaSet", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta1", "ReplicaSet", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta2", "ReplicaSet", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta1", "StatefulSet", "1.9", "1.16", "apps/v1"},
+	{"apps/v1beta2", "StatefulSet", "1.9", "1.16", "apps/v1"},
+	{"extensions/v1beta1", "NetworkPolicy", "1.9", "1.16", "networking.k8s.io/v1"},
+	{"extensions/v1beta1", "PodSecurityPolicy", "1.11", "1.16", "policy/v1beta1"},
+	{"extensions/v1beta1", "Ingress", "1.14", "1.22", "networking.k8s.io/v1"},
+	{"networking.k8s.io/v1beta1", "Ingress", "1.19", "1.22", "networking.k8s.io/v1"},
+	{"apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "1.16", "1.22", "apiextensions.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRole", "1.17", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "Role", "1.17", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "RoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"},
+	{"scheduling.k8s.io/v1beta1", "PriorityClass", "1.14", "1.22", "scheduling.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "StorageClass", "1.6", "1.22", "storage.k8s.io/v1"},
+	{"batch/v1beta1", "CronJob", "1.21", "1.25", "batch/v1"},
+	{"policy/v1beta1", "PodDisruptionBudget", "1.21", "1.25", "policy/v1"},
+	{"policy/v1beta1", "PodSecurityPolicy", "1.21", "1.25", "<none>"},
+	{"autoscaling/v2beta1", "HorizontalPodAutoscaler", "1.22", "1.25", "autoscaling/v2"},
+	{"autoscaling/v2beta2", "HorizontalPodAutoscaler", "1.23", "1.26", "autoscaling/v2"},
+}
+
+// DeprecationFor returns deprecation information for a given api version and kind if any.
+func DeprecationFor(apiVersion, kind string) (Deprecation, bool) {
+	for _, d := range Deprecations {
+		if d.APIVersion == apiVersion && d.Kind == kind {
+			return d, true
+		}
+	}
+
+	return Deprecation{}, false
+}
+
+// DeprecatedKinds returns all kinds with known deprecations.
+func DeprecatedKinds() map[string]struct{} {
+	kk := make(map[string]struct{}, len(Deprecations))
+	for _, d := range Deprecations {
+		kk[d.Kind] = struct{}{}
+	}
+
+	return kk
+}
+
+// Status returns the deprecation status relative to a given server version.
+// An empty status is returned when the server version is unknown.
+func (d Deprecation) Status(serverVersion string) string {
+	v, err := version.ParseGeneric(strings.TrimPrefix(serverVersion, "v"))
+	if err != nil {
+		return ""
+	}
+	if atLeast(v, d.RemovedIn) {
+		return DeprecationRemoved
+	}
+	if atLeast(v, d.DeprecatedIn) {
+		return DeprecationDeprecated
+	}
+
+	return DeprecationUpcoming
+}
+
+func atLeast(v *version.Version, min string) bool {
+	m, err := version.ParseGeneric(min)
+	if err != nil {
+		return false
+	}
+
+	return v.AtLeast(m)
+}
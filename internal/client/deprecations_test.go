@@ -0,0 +1,36 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationFor(t *testing.T) {
+	d, ok := client.DeprecationFor("extensions/v1beta1", "Ingress")
+	assert.True(t, ok)
+	assert.Equal(t, "1.22", d.RemovedIn)
+
+	_, ok = client.DeprecationFor("apps/v1", "Deployment")
+	assert.False(t, ok)
+}
+
+func TestDeprecationStatus(t *testing.T) {
+	d, _ := client.DeprecationFor("extensions/v1beta1", "Ingress")
+	uu := map[string]struct {
+		v, e string
+	}{
+		"upcoming":   {v: "v1.13.2", e: client.DeprecationUpcoming},
+		"deprecated": {v: "v1.16.3-gke.1", e: client.DeprecationDeprecated},
+		"removed":    {v: "v1.22.0", e: client.DeprecationRemoved},
+		"garbage":    {v: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, d.Status(u.v))
+		})
+	}
+}
@@ -170,6 +170,8 @@ func mapVerb(v string) ([]string, error) {
 		return []string{"get", "list"}, nil
 	case "delete":
 		return []string{"delete"}, nil
+	case DeleteCollectionVerb:
+		return []string{DeleteCollectionVerb}, nil
 	case "edit":
 		return []string{"patch", "update"}, nil
 	default:
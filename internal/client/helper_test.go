@@ -22,6 +22,24 @@ func TestNamespaced(t *testing.T) {
 	}
 }
 
+func TestContextPath(t *testing.T) {
+	uu := []struct {
+		ctx, p, e string
+	}{
+		{"ctx1", "fred/blee:co", "ctx1|fred/blee:co"},
+		{"arn:aws:eks:c1", "fred/blee:co", "arn:aws:eks:c1|fred/blee:co"},
+		{"", "fred/blee:co", "fred/blee:co"},
+	}
+
+	for _, u := range uu {
+		id := client.ContextPath(u.ctx, u.p)
+		assert.Equal(t, u.e, id)
+		ctx, p := client.SplitContextPath(id)
+		assert.Equal(t, u.ctx, ctx)
+		assert.Equal(t, u.p, p)
+	}
+}
+
 func TestFQN(t *testing.T) {
 	uu := []struct {
 		ns, n string
@@ -53,6 +53,23 @@ func Namespaced(p string) (string, string) {
 	return strings.Trim(ns, "/"), n
 }
 
+// ContextPath scopes a resource path to a kube context.
+func ContextPath(ctx, p string) string {
+	if ctx == "" {
+		return p
+	}
+	return ctx + "|" + p
+}
+
+// SplitContextPath returns the kube context and resource path of a context scoped path.
+func SplitContextPath(p string) (string, string) {
+	tokens := strings.SplitN(p, "|", 2)
+	if len(tokens) < 2 {
+		return "", p
+	}
+	return tokens[0], tokens[1]
+}
+
 // FQN returns a fully qualified resource name.
 func FQN(ns, n string) string {
 	if ns == "" {
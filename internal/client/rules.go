@@ -0,0 +1,68 @@
+package client
+
+import (
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// RulesReviewer reviews a user access rules in a namespace.
+type RulesReviewer interface {
+	// RulesFor returns the user access rules in a given namespace.
+	RulesFor(ns string) (*Rules, error)
+}
+
+// Rules tracks a user resource access rules in a namespace.
+type Rules struct {
+	rules      []authorizationv1.ResourceRule
+	incomplete bool
+}
+
+// NewRules returns a new set of access rules.
+func NewRules(rr []authorizationv1.ResourceRule, incomplete bool) *Rules {
+	return &Rules{rules: rr, incomplete: incomplete}
+}
+
+// Can returns true if the rules grant a verb on a resource or sub resource.
+// Unknown or incomplete rules grant everything and leave it to the api server.
+func (r *Rules) Can(gvr GVR, verb string) bool {
+	if r == nil || r.incomplete {
+		return true
+	}
+
+	res := gvr.GVR().Resource
+	if sr := gvr.SubResource(); sr != "" {
+		res += "/" + sr
+	}
+	for _, rule := range r.rules {
+		if matches(rule.APIGroups, gvr.GVR().Group) &&
+			matchesResource(rule.Resources, res) &&
+			matches(rule.Verbs, verb) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matches(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == "*" || v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesResource(rr []string, res string) bool {
+	if matches(rr, res) {
+		return true
+	}
+	i := strings.Index(res, "/")
+	if i < 0 {
+		return false
+	}
+
+	return matches(rr, "*"+res[i:])
+}
@@ -0,0 +1,82 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestRulesCan(t *testing.T) {
+	rr := []authorizationv1.ResourceRule{
+		{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}},
+		{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"*/exec"}},
+		{Verbs: []string{"*"}, APIGroups: []string{"apps"}, Resources: []string{"deployments"}},
+	}
+
+	uu := map[string]struct {
+		rules *client.Rules
+		gvr   string
+		verb  string
+		e     bool
+	}{
+		"get": {
+			rules: client.NewRules(rr, false),
+			gvr:   "v1/pods",
+			verb:  "get",
+			e:     true,
+		},
+		"noDelete": {
+			rules: client.NewRules(rr, false),
+			gvr:   "v1/pods",
+			verb:  "delete",
+		},
+		"subResource": {
+			rules: client.NewRules(rr, false),
+			gvr:   "v1/pods:log",
+			verb:  "get",
+			e:     true,
+		},
+		"wildSubResource": {
+			rules: client.NewRules(rr, false),
+			gvr:   "v1/pods:exec",
+			verb:  "create",
+			e:     true,
+		},
+		"noPortForward": {
+			rules: client.NewRules(rr, false),
+			gvr:   "v1/pods:portforward",
+			verb:  "create",
+		},
+		"wildVerb": {
+			rules: client.NewRules(rr, false),
+			gvr:   "apps/v1/deployments",
+			verb:  "patch",
+			e:     true,
+		},
+		"wrongGroup": {
+			rules: client.NewRules(rr, false),
+			gvr:   "extensions/v1beta1/deployments",
+			verb:  "patch",
+		},
+		"incomplete": {
+			rules: client.NewRules(rr, true),
+			gvr:   "v1/pods",
+			verb:  "delete",
+			e:     true,
+		},
+		"unknown": {
+			gvr:  "v1/pods",
+			verb: "delete",
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.rules.Can(client.NewGVR(u.gvr), u.verb))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// InClusterTokenPath tracks the service account token mounted in pods.
+	InClusterTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// SATokensDir tracks the default location of extra service account tokens.
+	SATokensDir = "/var/run/secrets/k9s/tokens"
+
+	saSubjectPrefix = "system:serviceaccount:"
+)
+
+// TokenSwitcher represents a connection able to switch service account tokens.
+type TokenSwitcher interface {
+	// SwitchToken authenticates using the service account token located at path.
+	SwitchToken(path string) error
+}
+
+// SAToken represents a service account token.
+type SAToken struct {
+	Path, Namespace, Name string
+}
+
+// Subject returns the token rbac subject.
+func (t SAToken) Subject() string {
+	return saSubjectPrefix + t.Namespace + ":" + t.Name
+}
+
+// IsInCluster checks if k9s runs in a pod.
+func IsInCluster() bool {
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" {
+		return false
+	}
+	_, err := os.Stat(InClusterTokenPath)
+
+	return err == nil
+}
+
+// LoadSATokens loads service account tokens from files or directories.
+// A directory may hold token files or projected volumes with a token file.
+func LoadSATokens(paths ...string) []SAToken {
+	var tt []SAToken
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			continue
+		}
+		if !fi.IsDir() {
+			if t, err := ReadSAToken(p); err == nil {
+				tt = append(tt, t)
+			}
+			continue
+		}
+		ff, err := ioutil.ReadDir(p)
+		if err != nil {
+			continue
+		}
+		for _, f := range ff {
+			path := filepath.Join(p, f.Name())
+			if f.IsDir() {
+				path = filepath.Join(path, "token")
+			}
+			if t, err := ReadSAToken(path); err == nil {
+				tt = append(tt, t)
+			}
+		}
+	}
+
+	return tt
+}
+
+// ReadSAToken reads a service account token file.
+func ReadSAToken(path string) (SAToken, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return SAToken{}, err
+	}
+	ns, n, err := parseSAToken(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return SAToken{}, fmt.Errorf("invalid token %s: %w", path, err)
+	}
+
+	return SAToken{Path: path, Namespace: ns, Name: n}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseSAToken(token string) (string, string, error) {
+	tokens := strings.Split(token, ".")
+	if len(tokens) != 3 {
+		return "", "", errors.New("not a JWT")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokens[1], "="))
+	if err != nil {
+		return "", "", err
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return "", "", err
+	}
+	if !strings.HasPrefix(claims.Subject, saSubjectPrefix) {
+		return "", "", fmt.Errorf("not a service account subject %q", claims.Subject)
+	}
+	sa := strings.Split(strings.TrimPrefix(claims.Subject, saSubjectPrefix), ":")
+	if len(sa) != 2 {
+		return "", "", fmt.Errorf("invalid service account subject %q", claims.Subject)
+	}
+
+	return sa[0], sa[1], nil
+}
@@ -0,0 +1,95 @@
+package client_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestReadSAToken(t *testing.T) {
+	uu := map[string]struct {
+		token string
+		e     client.SAToken
+		err   bool
+	}{
+		"sa": {
+			token: makeJWT(`{"sub":"system:serviceaccount:default:fred"}`),
+			e:     client.SAToken{Namespace: "default", Name: "fred"},
+		},
+		"user": {
+			token: makeJWT(`{"sub":"fred@acme.com"}`),
+			err:   true,
+		},
+		"garbage": {
+			token: "blee",
+			err:   true,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "k9s-tokens")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path := filepath.Join(dir, k)
+			assert.Nil(t, ioutil.WriteFile(path, []byte(u.token+"\n"), 0600))
+			tok, err := client.ReadSAToken(path)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			u.e.Path = path
+			assert.Equal(t, u.e, tok)
+			assert.Equal(t, "system:serviceaccount:default:fred", tok.Subject())
+		})
+	}
+}
+
+func TestConfigSwitchToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-tokens")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fred")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(makeJWT(`{"sub":"system:serviceaccount:default:fred"}`)), 0600))
+
+	kubeConfig := "./assets/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	assert.Nil(t, cfg.SwitchToken(path))
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "", rc.BearerToken)
+	assert.Equal(t, path, rc.BearerTokenFile)
+	assert.Equal(t, "", rc.Username)
+	assert.Nil(t, rc.AuthProvider)
+	tok, ok := cfg.ActiveSAToken()
+	assert.True(t, ok)
+	assert.Equal(t, "fred", tok.Name)
+}
+
+func TestLoadSATokens(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-tokens")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "t1"), []byte(makeJWT(`{"sub":"system:serviceaccount:default:t1"}`)), 0600))
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "t2"), 0700))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "t2", "token"), []byte(makeJWT(`{"sub":"system:serviceaccount:blee:t2"}`)), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "bad"), []byte("duh"), 0600))
+
+	tt := client.LoadSATokens(dir, "/no/such/path")
+	assert.Equal(t, 2, len(tt))
+	assert.Equal(t, "t1", tt[0].Name)
+	assert.Equal(t, "blee", tt[1].Namespace)
+}
+
+func makeJWT(claims string) string {
+	return "e30." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2ln"
+}
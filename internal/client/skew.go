@@ -0,0 +1,43 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// MaxKubeletSkew tracks how many minor versions a kubelet may lag behind the api server.
+const MaxKubeletSkew = 2
+
+// MinorSkew returns how many minor versions a given version lags behind the server version.
+// A negative skew indicates the version is newer than the server.
+func MinorSkew(serverVersion, v string) (int, error) {
+	sv, err := parseVersion(serverVersion)
+	if err != nil {
+		return 0, err
+	}
+	vv, err := parseVersion(v)
+	if err != nil {
+		return 0, err
+	}
+	if sv.Major() != vv.Major() {
+		return 0, fmt.Errorf("major version mismatch %s vs %s", serverVersion, v)
+	}
+
+	return int(sv.Minor()) - int(vv.Minor()), nil
+}
+
+// NextMinor returns the next minor release for a given version ie v1.16.3 -> 1.17.
+func NextMinor(v string) (string, error) {
+	vv, err := parseVersion(v)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d", vv.Major(), vv.Minor()+1), nil
+}
+
+func parseVersion(v string) (*version.Version, error) {
+	return version.ParseGeneric(strings.TrimPrefix(v, "v"))
+}
@@ -0,0 +1,40 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinorSkew(t *testing.T) {
+	uu := map[string]struct {
+		server, v string
+		e         int
+		err       bool
+	}{
+		"same":    {server: "v1.16.3", v: "v1.16.0", e: 0},
+		"behind":  {server: "v1.16.3-gke.1", v: "v1.14.10", e: 2},
+		"ahead":   {server: "v1.15.0", v: "v1.16.2", e: -1},
+		"major":   {server: "v1.16.0", v: "v2.16.0", err: true},
+		"garbage": {server: "v1.16.0", v: "fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := client.MinorSkew(u.server, u.v)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestNextMinor(t *testing.T) {
+	v, err := client.NextMinor("v1.16.3-eks.2")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.17", v)
+
+	_, err = client.NextMinor("fred")
+	assert.NotNil(t, err)
+}
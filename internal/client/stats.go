@@ -0,0 +1,136 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APICalls tracks api server requests per resource.
+var APICalls = NewCallStats()
+
+// CallStat tracks timed calls for a given key.
+type CallStat struct {
+	Count, Errors int
+	Total, Max    time.Duration
+}
+
+// Avg returns the average call duration.
+func (c CallStat) Avg() time.Duration {
+	if c.Count == 0 {
+		return 0
+	}
+	return c.Total / time.Duration(c.Count)
+}
+
+// CallStats tracks timed calls by key.
+type CallStats struct {
+	start time.Time
+	calls map[string]CallStat
+	mx    sync.RWMutex
+}
+
+// NewCallStats returns a new call tracker.
+func NewCallStats() *CallStats {
+	return &CallStats{
+		start: time.Now(),
+		calls: make(map[string]CallStat),
+	}
+}
+
+// Record tracks a call.
+func (s *CallStats) Record(key string, d time.Duration, failed bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	c := s.calls[key]
+	c.Count++
+	c.Total += d
+	if d > c.Max {
+		c.Max = d
+	}
+	if failed {
+		c.Errors++
+	}
+	s.calls[key] = c
+}
+
+// Snapshot returns a copy of the tracked calls.
+func (s *CallStats) Snapshot() map[string]CallStat {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	cc := make(map[string]CallStat, len(s.calls))
+	for k, v := range s.calls {
+		cc[k] = v
+	}
+
+	return cc
+}
+
+// Uptime returns how long calls have been tracked for.
+func (s *CallStats) Uptime() time.Duration {
+	return time.Since(s.start)
+}
+
+// Rate returns the number of calls per second.
+func (s *CallStats) Rate(c CallStat) float64 {
+	secs := s.Uptime().Seconds()
+	if secs <= 0 {
+		return 0
+	}
+	return float64(c.Count) / secs
+}
+
+// GVRFromPath extracts a resource gvr from an api server request path,
+// ie /apis/apps/v1/namespaces/default/deployments/fred -> apps/v1/deployments.
+func GVRFromPath(path string) string {
+	tokens := strings.Split(strings.Trim(path, "/"), "/")
+	var gv []string
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		gv, tokens = tokens[1:2], tokens[2:]
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		gv, tokens = tokens[1:3], tokens[3:]
+	default:
+		return path
+	}
+	if len(tokens) > 0 && tokens[0] == "watch" {
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 2 && tokens[0] == "namespaces" {
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 {
+		return strings.Join(gv, "/")
+	}
+
+	return strings.Join(append(gv, tokens[0]), "/")
+}
+
+type statsTransport struct {
+	rt http.RoundTripper
+}
+
+// RoundTrip tracks api requests durations.
+func (t statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	failed := err != nil || (resp != nil && resp.StatusCode >= http.StatusBadRequest)
+	verb := req.Method
+	if isWatch(req) {
+		verb = "WATCH"
+	}
+	APICalls.Record(verb+" "+GVRFromPath(req.URL.Path), time.Since(start), failed)
+
+	return resp, err
+}
+
+func isWatch(req *http.Request) bool {
+	return req.URL.Query().Get("watch") == "true" || strings.Contains(req.URL.Path, "/watch/")
+}
+
+func trackCalls(rt http.RoundTripper) http.RoundTripper {
+	return statsTransport{rt: rt}
+}
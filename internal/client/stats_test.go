@@ -0,0 +1,43 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGVRFromPath(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"core":        {path: "/api/v1/pods", e: "v1/pods"},
+		"namespaced":  {path: "/api/v1/namespaces/default/pods/fred", e: "v1/pods"},
+		"namespace":   {path: "/api/v1/namespaces/default", e: "v1/namespaces"},
+		"group":       {path: "/apis/apps/v1/namespaces/default/deployments", e: "apps/v1/deployments"},
+		"watch":       {path: "/api/v1/watch/namespaces/default/pods", e: "v1/pods"},
+		"discovery":   {path: "/apis/apps/v1", e: "apps/v1"},
+		"nonResource": {path: "/version", e: "/version"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.GVRFromPath(u.path))
+		})
+	}
+}
+
+func TestCallStats(t *testing.T) {
+	s := client.NewCallStats()
+	s.Record("GET v1/pods", 2*time.Second, false)
+	s.Record("GET v1/pods", 4*time.Second, true)
+
+	c := s.Snapshot()["GET v1/pods"]
+	assert.Equal(t, 2, c.Count)
+	assert.Equal(t, 1, c.Errors)
+	assert.Equal(t, 4*time.Second, c.Max)
+	assert.Equal(t, 3*time.Second, c.Avg())
+	assert.True(t, s.Rate(c) > 0)
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Throttles tracks api requests throttled by the api server or k9s.
+var Throttles = &ThrottleCounter{}
+
+type (
+	// RateLimits tracks api server client side rate limits.
+	RateLimits struct {
+		// QPS tracks the overall request rate.
+		QPS float32
+
+		// Burst tracks the overall request burst.
+		Burst int
+
+		// Throttle tracks request rates per gvr.
+		Throttle map[string]float32
+	}
+
+	// RateLimitsFunc returns the rate limits for a given cluster.
+	RateLimitsFunc func(cluster string) RateLimits
+)
+
+func (r RateLimits) qps() float32 {
+	if r.QPS <= 0 {
+		return defaultQPS
+	}
+	return r.QPS
+}
+
+func (r RateLimits) burst() int {
+	if r.Burst <= 0 {
+		return defaultBurst
+	}
+	return r.Burst
+}
+
+// ThrottleCounter counts throttled requests.
+type ThrottleCounter struct {
+	server, client int64
+}
+
+// Server records a request throttled by the api server, ie 429.
+func (t *ThrottleCounter) Server() {
+	atomic.AddInt64(&t.server, 1)
+}
+
+// Client records a request throttled by k9s.
+func (t *ThrottleCounter) Client() {
+	atomic.AddInt64(&t.client, 1)
+}
+
+// Drain returns the throttled requests counts since the last drain.
+func (t *ThrottleCounter) Drain() (server, client int64) {
+	return atomic.SwapInt64(&t.server, 0), atomic.SwapInt64(&t.client, 0)
+}
+
+type throttleTransport struct {
+	rt       http.RoundTripper
+	limiters map[string]flowcontrol.RateLimiter
+}
+
+// RoundTrip waits on the request gvr rate limiter if any.
+func (t throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if l, ok := t.limiters[GVRFromPath(req.URL.Path)]; ok && !isWatch(req) && !l.TryAccept() {
+		Throttles.Client()
+		if err := l.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := t.rt.RoundTrip(req)
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		Throttles.Server()
+	}
+
+	return resp, err
+}
+
+func throttleCalls(throttle map[string]float32) func(http.RoundTripper) http.RoundTripper {
+	ll := make(map[string]flowcontrol.RateLimiter, len(throttle))
+	for gvr, qps := range throttle {
+		if qps <= 0 {
+			continue
+		}
+		burst := int(qps)
+		if burst < 1 {
+			burst = 1
+		}
+		ll[gvr] = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	}
+
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return throttleTransport{rt: rt, limiters: ll}
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitsDefaults(t *testing.T) {
+	var r RateLimits
+	assert.Equal(t, float32(defaultQPS), r.qps())
+	assert.Equal(t, defaultBurst, r.burst())
+
+	r = RateLimits{QPS: 5, Burst: 10}
+	assert.Equal(t, float32(5), r.qps())
+	assert.Equal(t, 10, r.burst())
+}
+
+func TestThrottleTransport(t *testing.T) {
+	Throttles.Drain()
+	rt := throttleCalls(map[string]float32{"v1/pods": 100})(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusTooManyRequests}, nil
+	}))
+	for _, path := range []string{"/api/v1/pods", "/api/v1/nodes"} {
+		for i := 0; i < 102; i++ {
+			req, err := http.NewRequest(http.MethodGet, "http://localhost"+path, nil)
+			assert.Nil(t, err)
+			_, err = rt.RoundTrip(req)
+			assert.Nil(t, err)
+		}
+	}
+
+	rejected, delayed := Throttles.Drain()
+	assert.Equal(t, int64(204), rejected)
+	assert.True(t, delayed >= 2 && delayed <= 3)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
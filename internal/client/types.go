@@ -33,6 +33,8 @@ const (
 	PatchVerb = "patch"
 	// DeleteVerb represents a delete access on a resource.
 	DeleteVerb = "delete"
+	// DeleteCollectionVerb represents a collection delete access on a resource.
+	DeleteCollectionVerb = "deletecollection"
 	// GetVerb represents a get access on a resource.
 	GetVerb = "get"
 	// ListVerb represents a list access on a resource.
@@ -2,6 +2,8 @@ package color
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 )
 
 // Paint describes a terminal color.
@@ -29,3 +31,14 @@ func Colorize(s string, c Paint) string {
 	}
 	return fmt.Sprintf("\x1b[%dm%s\x1b[0m", c, s)
 }
+
+var ansiRX = regexp.MustCompile(`\x1b(\[[0-9;?]*[ -/]*[@-~]|\][^\x07\x1b]*(\x07|\x1b\\)|[@-Z\\-_])`)
+
+// StripANSI removes ANSI escape sequences from a string.
+func StripANSI(s string) string {
+	if !strings.ContainsRune(s, '\x1b') {
+		return s
+	}
+
+	return ansiRX.ReplaceAllString(s, "")
+}
@@ -24,3 +24,22 @@ func TestColorize(t *testing.T) {
 		})
 	}
 }
+
+func TestStripANSI(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain":    {"blee", "blee"},
+		"colors":   {"\x1b[31mblee\x1b[0m duh", "blee duh"},
+		"extended": {"\x1b[38;5;208mblee\x1b[39m", "blee"},
+		"erase":    {"blee\x1b[K", "blee"},
+		"title":    {"\x1b]0;title\x07blee", "blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, StripANSI(u.s))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
@@ -11,7 +12,8 @@ import (
 // K9sAlias manages K9s aliases.
 var K9sAlias = filepath.Join(K9sHome, "alias.yml")
 
-// Alias tracks shortname to GVR mappings.
+// Alias tracks shortname to GVR mappings. A GVR may be followed by
+// command arguments, ie `v1/pods prod -l app=web`.
 type Alias map[string]string
 
 // ShortNames represents a collection of shortnames for aliases.
@@ -35,8 +37,22 @@ func (a Aliases) loadDefaults() {
 		portFwds   = "portforwards"
 		benchmarks = "benchmarks"
 		dumps      = "screendumps"
+		deps       = "deprecations"
+		pdbCov     = "pdbcoverage"
+		upgrade    = "upgrade"
+		ctrlPlane  = "controlplane"
+		stats      = "stats"
+		podSec     = "podsecurity"
+		apps       = "applications"
+		images     = "images"
+		saTokens   = "satokens"
+		pinboard   = "pinboard"
+		tasks      = "tasks"
+		sessions   = "sessions"
+		audit      = "audit"
 		groups     = "groups"
 		users      = "users"
+		configs    = "configs"
 	)
 
 	a.Alias["dp"] = "apps/v1/deployments"
@@ -75,8 +91,68 @@ func (a Aliases) loadDefaults() {
 	{
 		a.Alias["sd"] = dumps
 		a.Alias["screendump"] = dumps
+		a.Alias["dumps"] = dumps
+		a.Alias["dump"] = dumps
 		a.Alias[dumps] = dumps
 	}
+	{
+		a.Alias["dpr"] = deps
+		a.Alias["deprecation"] = deps
+		a.Alias[deps] = deps
+	}
+	{
+		a.Alias["skew"] = upgrade
+		a.Alias[upgrade] = upgrade
+	}
+	{
+		a.Alias["health"] = ctrlPlane
+		a.Alias[ctrlPlane] = ctrlPlane
+	}
+	{
+		a.Alias["perf"] = stats
+		a.Alias[stats] = stats
+	}
+	{
+		a.Alias["pdbc"] = pdbCov
+		a.Alias[pdbCov] = pdbCov
+	}
+	{
+		a.Alias["psec"] = podSec
+		a.Alias[podSec] = podSec
+	}
+	{
+		a.Alias["apps"] = apps
+	}
+	{
+		a.Alias["img"] = images
+		a.Alias["image"] = images
+		a.Alias[images] = images
+	}
+	{
+		a.Alias["token"] = saTokens
+		a.Alias["tokens"] = saTokens
+		a.Alias[saTokens] = saTokens
+		a.Alias["pin"] = pinboard
+		a.Alias["pins"] = pinboard
+		a.Alias[pinboard] = pinboard
+	}
+	{
+		a.Alias["task"] = tasks
+		a.Alias[tasks] = tasks
+	}
+	{
+		a.Alias["session"] = sessions
+		a.Alias[sessions] = sessions
+	}
+	{
+		a.Alias["audits"] = audit
+		a.Alias[audit] = audit
+	}
+	{
+		a.Alias["config"] = configs
+		a.Alias["cfg"] = configs
+		a.Alias[configs] = configs
+	}
 }
 
 // Load K9s aliases.
@@ -85,12 +161,27 @@ func (a Aliases) Load() error {
 	return a.LoadAliases(K9sAlias)
 }
 
+// LoadDefaults loads K9s built in aliases only.
+func (a Aliases) LoadDefaults() {
+	a.loadDefaults()
+}
+
 // Get retrieves an alias.
 func (a Aliases) Get(k string) (string, bool) {
 	v, ok := a.Alias[k]
 	return v, ok
 }
 
+// SplitAlias splits an alias expansion into a gvr and its command arguments.
+func SplitAlias(s string) (string, []string) {
+	ff := strings.Fields(s)
+	if len(ff) == 0 {
+		return "", nil
+	}
+
+	return ff[0], ff[1:]
+}
+
 // Define declares a new alias.
 func (a Aliases) Define(gvr string, aliases ...string) {
 	for _, alias := range aliases {
@@ -0,0 +1,63 @@
+package config
+
+import "fmt"
+
+// A collection of audit log sources.
+const (
+	AuditSourceExec = "exec"
+	AuditSourceHTTP = "http"
+	AuditSourceLoki = "loki"
+)
+
+const (
+	defaultAuditLines     = 500
+	defaultAuditLokiQuery = `{job="kubernetes-audit"}`
+)
+
+// Audit tracks a cluster audit log source.
+type Audit struct {
+	// Source represents the audit log source ie exec, http or loki.
+	Source string `yaml:"source"`
+	// Pod is the pod path holding the audit log file for the exec source.
+	Pod string `yaml:"pod,omitempty"`
+	// Container is the container holding the audit log file.
+	Container string `yaml:"container,omitempty"`
+	// Path is the audit log file path for the exec source.
+	Path string `yaml:"path,omitempty"`
+	// URL is the http or loki endpoint.
+	URL string `yaml:"url,omitempty"`
+	// Query is the loki log stream selector.
+	Query string `yaml:"query,omitempty"`
+	// Headers are additional http headers ie authorization.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Lines is the maximum number of audit events to fetch.
+	Lines int `yaml:"lines,omitempty"`
+}
+
+// Validate checks the audit source and sets defaults.
+func (a *Audit) Validate() error {
+	if a.Lines <= 0 {
+		a.Lines = defaultAuditLines
+	}
+	switch a.Source {
+	case AuditSourceExec:
+		if a.Pod == "" || a.Path == "" {
+			return fmt.Errorf("audit %s source requires a pod and a path", a.Source)
+		}
+	case AuditSourceHTTP:
+		if a.URL == "" {
+			return fmt.Errorf("audit %s source requires an url", a.Source)
+		}
+	case AuditSourceLoki:
+		if a.URL == "" {
+			return fmt.Errorf("audit %s source requires an url", a.Source)
+		}
+		if a.Query == "" {
+			a.Query = defaultAuditLokiQuery
+		}
+	default:
+		return fmt.Errorf("unknown audit source %q", a.Source)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditValidate(t *testing.T) {
+	uu := map[string]struct {
+		a      config.Audit
+		query  string
+		hasErr bool
+	}{
+		"exec": {
+			a: config.Audit{Source: config.AuditSourceExec, Pod: "kube-system/audit-x", Path: "/var/log/audit.log"},
+		},
+		"execNoPath": {
+			a:      config.Audit{Source: config.AuditSourceExec, Pod: "kube-system/audit-x"},
+			hasErr: true,
+		},
+		"http": {
+			a: config.Audit{Source: config.AuditSourceHTTP, URL: "https://audit.example.com/audit.log"},
+		},
+		"loki": {
+			a:     config.Audit{Source: config.AuditSourceLoki, URL: "http://loki:3100"},
+			query: `{job="kubernetes-audit"}`,
+		},
+		"lokiNoURL": {
+			a:      config.Audit{Source: config.AuditSourceLoki},
+			hasErr: true,
+		},
+		"unknown": {
+			a:      config.Audit{Source: "s3"},
+			hasErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.a.Validate()
+			if u.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, 500, u.a.Lines)
+			assert.Equal(t, u.query, u.a.Query)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	"gopkg.in/yaml.v2"
 )
@@ -83,6 +85,30 @@ func newBenchmarks() *Benchmarks {
 	}
 }
 
+// BenchFile returns a context benchmarks configuration file. Context names are
+// sanitized like dump file names. Falls back to the legacy cluster wide file
+// when the context does not have one.
+func BenchFile(context, cluster string) string {
+	path := benchFile(dumpCharsRX.ReplaceAllString(context, "-"))
+	if _, err := os.Stat(path); err == nil || cluster == "" {
+		return path
+	}
+	if legacy := benchFile(cluster); isFile(legacy) {
+		return legacy
+	}
+
+	return path
+}
+
+func benchFile(n string) string {
+	return filepath.Join(K9sHome, K9sBench+"-"+n+".yml")
+}
+
+func isFile(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && !fi.IsDir()
+}
+
 // NewBench creates a new default config.
 func NewBench(path string) (*Bench, error) {
 	s := &Bench{Benchmarks: newBenchmarks()}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"net/http"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -188,3 +189,25 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchFile(t *testing.T) {
+	uu := map[string]struct {
+		context, e string
+	}{
+		"plain": {
+			context: "fred",
+			e:       K9sBench + "-fred.yml",
+		},
+		"eks": {
+			context: "arn:aws:eks:us-east-1:123:cluster/fred",
+			e:       K9sBench + "-arn-aws-eks-us-east-1-123-cluster-fred.yml",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, filepath.Join(K9sHome, u.e), BenchFile(u.context, ""))
+		})
+	}
+}
@@ -4,8 +4,53 @@ import "github.com/derailed/k9s/internal/client"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace *Namespace  `yaml:"namespace"`
+	View      *View       `yaml:"view"`
+	Pins      []Pin       `yaml:"pins,omitempty"`
+	Audit     *Audit      `yaml:"audit,omitempty"`
+	Logs      *LogBackend `yaml:"logs,omitempty"`
+	API       *APILimits  `yaml:"api,omitempty"`
+}
+
+// APILimits tracks api server client side rate limits.
+type APILimits struct {
+	// QPS tracks the overall requests per second. Default 50.
+	QPS float32 `yaml:"qps,omitempty"`
+
+	// Burst tracks the overall requests burst. Default 50.
+	Burst int `yaml:"burst,omitempty"`
+
+	// Throttle tracks requests per second per resource, ie v1/pods: 5.
+	Throttle map[string]float32 `yaml:"throttle,omitempty"`
+}
+
+// RateLimits returns the client rate limits.
+func (a *APILimits) RateLimits() client.RateLimits {
+	if a == nil {
+		return client.RateLimits{}
+	}
+
+	return client.RateLimits{QPS: a.QPS, Burst: a.Burst, Throttle: a.Throttle}
+}
+
+// Pin represents a resource pinned to the pinboard.
+type Pin struct {
+	GVR  string `yaml:"gvr"`
+	Path string `yaml:"path"`
+}
+
+// TogglePin pins or unpins a resource. It returns true if the resource is
+// now pinned.
+func (c *Cluster) TogglePin(gvr, path string) bool {
+	for i, p := range c.Pins {
+		if p.GVR == gvr && p.Path == path {
+			c.Pins = append(c.Pins[:i], c.Pins[i+1:]...)
+			return false
+		}
+	}
+	c.Pins = append(c.Pins, Pin{GVR: gvr, Path: path})
+
+	return true
 }
 
 // NewCluster creates a new cluster configuration.
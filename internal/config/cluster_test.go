@@ -56,3 +56,17 @@ func namespaces() []v1.Namespace {
 		},
 	}
 }
+
+func TestClusterTogglePin(t *testing.T) {
+	c := config.NewCluster()
+
+	assert.True(t, c.TogglePin("apps/v1/deployments", "default/fred"))
+	assert.True(t, c.TogglePin("v1/nodes", "n1"))
+	assert.Equal(t, []config.Pin{
+		{GVR: "apps/v1/deployments", Path: "default/fred"},
+		{GVR: "v1/nodes", Path: "n1"},
+	}, c.Pins)
+
+	assert.False(t, c.TogglePin("apps/v1/deployments", "default/fred"))
+	assert.Equal(t, []config.Pin{{GVR: "v1/nodes", Path: "n1"}}, c.Pins)
+}
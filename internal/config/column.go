@@ -0,0 +1,31 @@
+package config
+
+// CustomColumn represents a user defined column extracting a resource label
+// or annotation value.
+type CustomColumn struct {
+	Name       string `yaml:"name"`
+	Label      string `yaml:"label,omitempty"`
+	Annotation string `yaml:"annotation,omitempty"`
+	ShortCut   string `yaml:"shortCut,omitempty"`
+}
+
+// CustomColumns tracks user defined columns keyed by resource gvr.
+type CustomColumns map[string][]CustomColumn
+
+// Validate drops invalid column definitions.
+func (cc CustomColumns) Validate() {
+	for gvr, cols := range cc {
+		valid := make([]CustomColumn, 0, len(cols))
+		for _, c := range cols {
+			if c.Name == "" || (c.Label == "" && c.Annotation == "") {
+				continue
+			}
+			valid = append(valid, c)
+		}
+		if len(valid) == 0 {
+			delete(cc, gvr)
+			continue
+		}
+		cc[gvr] = valid
+	}
+}
@@ -0,0 +1,30 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomColumnsValidate(t *testing.T) {
+	cc := config.CustomColumns{
+		"apps/v1/deployments": {
+			{Name: "TEAM", Label: "team"},
+			{Name: "SHA"},
+			{Label: "app"},
+			{Name: "COMMIT", Annotation: "example.com/sha", ShortCut: "Shift-C"},
+		},
+		"v1/pods": {
+			{Name: "BLEE"},
+		},
+	}
+	cc.Validate()
+
+	assert.Equal(t, config.CustomColumns{
+		"apps/v1/deployments": {
+			{Name: "TEAM", Label: "team"},
+			{Name: "COMMIT", Annotation: "example.com/sha", ShortCut: "Shift-C"},
+		},
+	}, cc)
+}
@@ -16,13 +16,13 @@ import (
 
 var (
 	// K9sHome represent K9s home directory.
-	K9sHome = filepath.Join(mustK9sHome(), ".k9s")
+	K9sHome = configDir(mustK9sHome(), "")
 	// K9sConfigFile represents K9s config file location.
 	K9sConfigFile = filepath.Join(K9sHome, "config.yml")
 	// K9sLogs represents K9s log.
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
-	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	K9sDumpDir = dumpDir(mustK9sHome(), "")
 )
 
 type (
@@ -130,6 +130,30 @@ func (c *Config) FavNamespaces() []string {
 	return []string{}
 }
 
+// Pins returns the pinned resources in the current cluster.
+func (c *Config) Pins() []Pin {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.Pins
+	}
+	return nil
+}
+
+// Audit returns the audit log source of the current cluster if any.
+func (c *Config) Audit() *Audit {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.Audit
+	}
+	return nil
+}
+
+// LogBackend returns the historical log backend of the current cluster if any.
+func (c *Config) LogBackend() *LogBackend {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.Logs
+	}
+	return nil
+}
+
 // SetActiveNamespace set the active namespace in the current cluster.
 func (c *Config) SetActiveNamespace(ns string) error {
 	if c.K9s.ActiveCluster() != nil {
@@ -141,18 +165,21 @@ func (c *Config) SetActiveNamespace(ns string) error {
 	return err
 }
 
-// ActiveView returns the active view in the current cluster.
+// ActiveView returns the active view in the current cluster. The overview
+// dashboard takes over when enabled unless a command was given.
 func (c *Config) ActiveView() string {
 	if c.K9s.ActiveCluster() == nil {
 		return defaultView
 	}
 
-	cmd := c.K9s.ActiveCluster().View.Active
 	if c.K9s.manualCommand != nil && *c.K9s.manualCommand != "" {
-		cmd = *c.K9s.manualCommand
+		return *c.K9s.manualCommand
+	}
+	if c.K9s.OverviewConfig().Enabled {
+		return overviewView
 	}
 
-	return cmd
+	return c.K9s.ActiveCluster().View.Active
 }
 
 // SetActiveView set the currently cluster active view
@@ -163,6 +190,34 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// ViewState returns a resource view state in the current context.
+func (c *Config) ViewState(gvr string) ViewState {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.View.State(c.K9s.CurrentContext, gvr)
+	}
+	return ViewState{}
+}
+
+// SetViewState records a resource view state in the current context.
+// Returns true if the state changed.
+func (c *Config) SetViewState(gvr string, s ViewState) bool {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.View.SetState(c.K9s.CurrentContext, gvr, s)
+	}
+	return false
+}
+
+// ResetState clears out the current cluster active view, namespace and
+// view states.
+func (c *Config) ResetState() {
+	cl := c.K9s.ActiveCluster()
+	if cl == nil {
+		return
+	}
+	cl.View.Reset()
+	cl.Namespace.Active = defaultNS
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.client
@@ -199,14 +254,24 @@ func (c *Config) Save() error {
 }
 
 // SaveFile K9s configuration to disk.
+// Any comments heading the existing file are preserved.
 func (c *Config) SaveFile(path string) error {
+	return c.saveFile(path, leadingComments(path))
+}
+
+// SaveCommented saves the configuration along with a header documenting the main settings.
+func (c *Config) SaveCommented(path string) error {
+	return c.saveFile(path, []byte(configHeader))
+}
+
+func (c *Config) saveFile(path string, header []byte) error {
 	EnsurePath(path, DefaultDirMod)
 	cfg, err := yaml.Marshal(c)
 	if err != nil {
 		log.Error().Msgf("[Config] Unable to save K9s config file: %v", err)
 		return err
 	}
-	return ioutil.WriteFile(path, cfg, 0644)
+	return ioutil.WriteFile(path, append(header, cfg...), 0644)
 }
 
 // Validate the configuration.
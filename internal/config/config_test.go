@@ -155,6 +155,17 @@ func TestConfigActiveView(t *testing.T) {
 	assert.Equal(t, "ctx", cfg.ActiveView())
 }
 
+func TestConfigActiveViewOverview(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+
+	assert.Nil(t, cfg.Load("test_assets/k9s.yml"))
+	cfg.K9s.Overview = &config.Overview{Enabled: true}
+	assert.Equal(t, "overview", cfg.ActiveView())
+	cfg.K9s.OverrideCommand("svc")
+	assert.Equal(t, "svc", cfg.ActiveView())
+}
+
 func TestConfigActiveViewBlank(t *testing.T) {
 	cfg := config.Config{K9s: new(config.K9s)}
 	assert.Equal(t, "po", cfg.ActiveView())
@@ -314,3 +325,23 @@ var resetConfig = `k9s:
       view:
         active: po
 `
+
+func TestConfigViewState(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("test_assets/k9s.yml"))
+
+	s := config.ViewState{Filter: "fred", SortColumn: "NAME:desc"}
+	assert.True(t, cfg.SetViewState("v1/pods", s))
+	assert.Equal(t, s, cfg.ViewState("v1/pods"))
+
+	ctx := cfg.K9s.CurrentContext
+	cfg.K9s.CurrentContext = "fred"
+	assert.Equal(t, config.ViewState{}, cfg.ViewState("v1/pods"))
+	cfg.K9s.CurrentContext = ctx
+
+	cfg.SetActiveView("svc")
+	cfg.ResetState()
+	assert.Equal(t, config.ViewState{}, cfg.ViewState("v1/pods"))
+	assert.Equal(t, "po", cfg.ActiveView())
+	assert.Equal(t, "default", cfg.ActiveNamespace())
+}
@@ -0,0 +1,371 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
+)
+
+var lineRX = regexp.MustCompile(`^\s*(?:yaml: )?line (\d+): (.+)$`)
+
+// Diagnostic represents a configuration file problem.
+type Diagnostic struct {
+	File    string
+	Line    int
+	Message string
+}
+
+// String returns a file:line: message representation.
+func (d Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.File, d.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s", d.File, d.Line, d.Message)
+}
+
+// KnownGVRFunc checks if a resource is known. Nil funcs know all resources.
+type KnownGVRFunc func(gvr string) bool
+
+// Diagnostics represents a collection of problems.
+type Diagnostics []Diagnostic
+
+// Sort orders problems by file and line.
+func (dd Diagnostics) Sort() {
+	sort.SliceStable(dd, func(i, j int) bool {
+		if dd[i].File != dd[j].File {
+			return dd[i].File < dd[j].File
+		}
+		return dd[i].Line < dd[j].Line
+	})
+}
+
+// Diagnose validates all K9s configuration files.
+func Diagnose(known KnownGVRFunc) Diagnostics {
+	var dd Diagnostics
+	dd = append(dd, DiagnoseConfig(K9sConfigFile, known)...)
+	dd = append(dd, DiagnoseSkin(K9sStylesFile)...)
+	dd = append(dd, DiagnosePlugins(K9sPlugins)...)
+	dd = append(dd, DiagnoseHotKeys(K9sHotKeys)...)
+	dd = append(dd, DiagnoseAliases(K9sAlias, known)...)
+	dd = append(dd, DiagnoseNotifications(K9sNotify, known)...)
+	dd.Sort()
+
+	return dd
+}
+
+// DiagnoseConfig validates a K9s config file.
+func DiagnoseConfig(path string, known KnownGVRFunc) Diagnostics {
+	var cfg Config
+	d, ok := newDiagnoser(path, &cfg)
+	if !ok || cfg.K9s == nil {
+		return d.dd
+	}
+
+	k := cfg.K9s
+	if k.RefreshRate < 0 {
+		d.add("refreshRate", "refresh rate must be positive")
+	}
+	if k.KeyProfile != "" && k.KeyProfile != DefaultKeyProfile && k.KeyProfile != PrintableKeyProfile {
+		d.addf("keyProfile", "unknown key profile %q. Expecting %s or %s", k.KeyProfile, DefaultKeyProfile, PrintableKeyProfile)
+	}
+	if _, err := k.LogErrorRX(); err != nil {
+		d.addf("logErrorPattern", "invalid log error pattern: %s", err)
+	}
+	if _, err := k.AgeLocation(); err != nil {
+		d.addf("ageTimezone", "invalid age timezone: %s", err)
+	}
+	if k.AgeLayout != "" && time.Now().Format(k.AgeLayout) == k.AgeLayout {
+		d.addf("ageLayout", "age layout %q holds no time fields", k.AgeLayout)
+	}
+	for _, gvr := range sortedKeys(k.CustomColumns) {
+		d.checkGVR(gvr, gvr, known)
+		for _, c := range k.CustomColumns[gvr] {
+			if c.Name == "" {
+				d.addf(gvr, "custom column on %s is missing a name", gvr)
+			}
+			if c.Label == "" && c.Annotation == "" {
+				d.addf(gvr, "custom column %q on %s needs a label or an annotation", c.Name, gvr)
+			}
+		}
+	}
+
+	return d.dd
+}
+
+// DiagnoseSkin validates a skin file.
+func DiagnoseSkin(path string) Diagnostics {
+	var s Styles
+	d, ok := newDiagnoser(path, &s)
+	if !ok {
+		return d.dd
+	}
+	// Skins may define color anchors at the top level.
+	dd := d.dd[:0]
+	for _, p := range d.dd {
+		if !strings.HasSuffix(p.Message, "not found in type config.Styles") {
+			dd = append(dd, p)
+		}
+	}
+	d.dd = dd
+
+	var m map[interface{}]interface{}
+	if err := yaml.Unmarshal(d.raw, &m); err != nil {
+		return d.dd
+	}
+	d.checkColors(m)
+
+	return d.dd
+}
+
+// DiagnosePlugins validates a plugins file.
+func DiagnosePlugins(path string) Diagnostics {
+	var pp Plugins
+	d, ok := newDiagnoser(path, &pp)
+	if !ok {
+		return d.dd
+	}
+
+	for _, n := range sortedKeys(pp.Plugin) {
+		p := pp.Plugin[n]
+		d.checkShortCut(n, p.ShortCut)
+		if p.Command == "" {
+			d.addf(n, "plugin %q is missing a command", n)
+		}
+		if len(p.Scopes) == 0 {
+			d.addf(n, "plugin %q is missing scopes", n)
+		}
+	}
+
+	return d.dd
+}
+
+// DiagnoseHotKeys validates a hotkeys file.
+func DiagnoseHotKeys(path string) Diagnostics {
+	var hh HotKeys
+	d, ok := newDiagnoser(path, &hh)
+	if !ok {
+		return d.dd
+	}
+
+	for _, n := range sortedKeys(hh.HotKey) {
+		h := hh.HotKey[n]
+		d.checkShortCut(n, h.ShortCut)
+		if h.Command == "" {
+			d.addf(n, "hotkey %q is missing a command", n)
+		}
+	}
+
+	return d.dd
+}
+
+// DiagnoseAliases validates an aliases file.
+func DiagnoseAliases(path string, known KnownGVRFunc) Diagnostics {
+	var aa Aliases
+	d, ok := newDiagnoser(path, &aa)
+	if !ok {
+		return d.dd
+	}
+
+	for _, k := range sortedKeys(aa.Alias) {
+		gvr, _ := SplitAlias(aa.Alias[k])
+		if gvr == "" {
+			d.addf(k, "alias %q is missing a resource", k)
+			continue
+		}
+		d.checkGVR(k, gvr, known)
+	}
+
+	return d.dd
+}
+
+// DiagnoseNotifications validates a notifications file.
+func DiagnoseNotifications(path string, known KnownGVRFunc) Diagnostics {
+	var nn Notifications
+	d, ok := newDiagnoser(path, &nn)
+	if !ok {
+		return d.dd
+	}
+
+	for _, n := range sortedKeys(nn.Sinks) {
+		switch k := nn.Sinks[n].Kind; k {
+		case DesktopSink, WebhookSink, SlackSink, ExecSink:
+		default:
+			d.addf(n, "sink %q has an unknown kind %q", n, k)
+		}
+	}
+	checkSinks := func(name string, ss []string) {
+		for _, s := range ss {
+			if _, ok := nn.Sinks[s]; !ok {
+				d.addf(name, "%q references an undefined sink %q", name, s)
+			}
+		}
+	}
+	for _, r := range nn.Rules {
+		if r.GVR == "" || r.Condition == "" {
+			d.addf(r.Name, "rule %q needs a gvr and a condition", r.Name)
+		} else {
+			d.checkGVR(r.Name, r.GVR, known)
+		}
+		checkSinks(r.Name, r.Sinks)
+	}
+	for _, a := range nn.LogAlerts {
+		if _, err := regexp.Compile(a.Pattern); a.Pattern == "" || err != nil {
+			d.addf(a.Name, "log alert %q has an invalid pattern %q", a.Name, a.Pattern)
+		}
+		checkSinks(a.Name, a.Sinks)
+	}
+
+	return d.dd
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type diagnoser struct {
+	path string
+	raw  []byte
+	dd   Diagnostics
+}
+
+// newDiagnoser strictly decodes a config file in a schema. Returns false if
+// the file is missing or can not be decoded further.
+func newDiagnoser(path string, schema interface{}) (*diagnoser, bool) {
+	d := diagnoser{path: path}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			d.dd = append(d.dd, Diagnostic{File: path, Message: err.Error()})
+		}
+		return &d, false
+	}
+	d.raw = raw
+	err = yaml.UnmarshalStrict(raw, schema)
+	if err == nil {
+		return &d, true
+	}
+	if e, ok := err.(*yaml.TypeError); ok {
+		for _, m := range e.Errors {
+			d.dd = append(d.dd, asDiagnostic(path, m))
+		}
+		return &d, true
+	}
+	d.dd = append(d.dd, asDiagnostic(path, err.Error()))
+
+	return &d, false
+}
+
+func asDiagnostic(path, msg string) Diagnostic {
+	mm := lineRX.FindStringSubmatch(msg)
+	if mm == nil {
+		return Diagnostic{File: path, Message: strings.TrimPrefix(msg, "yaml: ")}
+	}
+	l, _ := strconv.Atoi(mm[1])
+
+	return Diagnostic{File: path, Line: l, Message: mm[2]}
+}
+
+func (d *diagnoser) add(key, msg string) {
+	d.dd = append(d.dd, Diagnostic{File: d.path, Line: lineOf(d.raw, key), Message: msg})
+}
+
+func (d *diagnoser) addf(key, format string, args ...interface{}) {
+	d.add(key, fmt.Sprintf(format, args...))
+}
+
+func (d *diagnoser) checkGVR(key, gvr string, known KnownGVRFunc) {
+	if known != nil && !known(gvr) {
+		d.addf(key, "unknown resource %q", gvr)
+	}
+}
+
+func (d *diagnoser) checkShortCut(name, key string) {
+	if key == "" {
+		d.addf(name, "%q is missing a shortCut", name)
+		return
+	}
+	for _, v := range tcell.KeyNames {
+		if v == key {
+			return
+		}
+	}
+	d.addf(name, "%q has an unknown shortCut %q", name, key)
+}
+
+func (d *diagnoser) checkColors(m map[interface{}]interface{}) {
+	for k, v := range m {
+		key := fmt.Sprintf("%v", k)
+		switch val := v.(type) {
+		case map[interface{}]interface{}:
+			d.checkColors(val)
+		case string:
+			if strings.HasSuffix(strings.ToLower(key), "color") && !isColor(val) {
+				d.dd = append(d.dd, Diagnostic{
+					File:    d.path,
+					Line:    lineOfPair(d.raw, key, val),
+					Message: fmt.Sprintf("invalid %s color %q", key, val),
+				})
+			}
+		}
+	}
+}
+
+func isColor(c string) bool {
+	if c == "" || c == "default" {
+		return true
+	}
+
+	return AsColor(c) != tcell.ColorDefault
+}
+
+// lineOf returns the first line defining a key or 0 if not found.
+func lineOf(raw []byte, key string) int {
+	if key == "" {
+		return 0
+	}
+	for i, l := range bytes.Split(raw, []byte("\n")) {
+		l = bytes.TrimLeft(bytes.TrimSpace(l), "- ")
+		l = bytes.Trim(l, `"'`)
+		if bytes.HasPrefix(l, []byte(key+":")) || bytes.HasPrefix(l, []byte(key+`":`)) || bytes.HasPrefix(l, []byte(key+`':`)) {
+			return i + 1
+		}
+		if bytes.HasPrefix(l, []byte("name: "+key)) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// lineOfPair returns the first line defining a key with a given value or 0 if not found.
+func lineOfPair(raw []byte, key, val string) int {
+	rx := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(key) + `:\s*["']?` + regexp.QuoteMeta(val) + `["']?\s*$`)
+	for i, l := range bytes.Split(raw, []byte("\n")) {
+		if rx.Match(l) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// sortedKeys returns the sorted keys of a string keyed map.
+func sortedKeys(m interface{}) []string {
+	kk := make([]string, 0, reflect.ValueOf(m).Len())
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		kk = append(kk, k.String())
+	}
+	sort.Strings(kk)
+
+	return kk
+}
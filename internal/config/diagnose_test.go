@@ -0,0 +1,115 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnose(t *testing.T) {
+	dir := "test_assets/diagnose"
+	known := func(gvr string) bool { return gvr != "v1/pods" }
+	uu := map[string]struct {
+		fn func(string) config.Diagnostics
+		f  string
+		e  []string
+	}{
+		"config": {
+			fn: func(p string) config.Diagnostics { return config.DiagnoseConfig(p, known) },
+			f:  "config.yml",
+			e: []string{
+				"config.yml:6: field fred not found in type config.K9s",
+				"config.yml:2: refresh rate must be positive",
+				`config.yml:3: unknown key profile "bozo". Expecting default or printable`,
+				"config.yml:4: invalid log error pattern: error parsing regexp: missing closing ): `(oops`",
+				"config.yml:5: invalid age timezone: unknown time zone Mars/Olympus",
+				`config.yml:8: unknown resource "v1/pods"`,
+				`config.yml:8: custom column "TEAM" on v1/pods needs a label or an annotation`,
+			},
+		},
+		"skin": {
+			fn: config.DiagnoseSkin,
+			f:  "skin.yml",
+			e:  []string{`skin.yml:4: invalid bgColor color "bozo"`},
+		},
+		"plugins": {
+			fn: config.DiagnosePlugins,
+			f:  "plugin.yml",
+			e: []string{
+				`plugin.yml:7: "blee" has an unknown shortCut "Bozo"`,
+				`plugin.yml:7: plugin "blee" is missing a command`,
+				`plugin.yml:7: plugin "blee" is missing scopes`,
+			},
+		},
+		"hotkeys": {
+			fn: config.DiagnoseHotKeys,
+			f:  "hotkey.yml",
+			e:  []string{`hotkey.yml:5: "blee" is missing a shortCut`},
+		},
+		"aliases": {
+			fn: func(p string) config.Diagnostics { return config.DiagnoseAliases(p, known) },
+			f:  "alias.yml",
+			e: []string{
+				`alias.yml:3: alias "blee" is missing a resource`,
+				`alias.yml:2: unknown resource "v1/pods"`,
+			},
+		},
+		"notify": {
+			fn: func(p string) config.Diagnostics { return config.DiagnoseNotifications(p, nil) },
+			f:  "notify.yml",
+			e: []string{
+				`notify.yml:8: sink "desk" has an unknown kind "pigeon"`,
+				`notify.yml:2: "crash" references an undefined sink "zorg"`,
+			},
+		},
+		"syntax": {
+			fn: func(p string) config.Diagnostics { return config.DiagnoseConfig(p, nil) },
+			f:  "bad.yml",
+			e:  []string{"bad.yml:2: did not find expected key"},
+		},
+		"missing": {
+			fn: func(p string) config.Diagnostics { return config.DiagnoseConfig(p, nil) },
+			f:  "zorg.yml",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dd := u.fn(filepath.Join(dir, u.f))
+			ss := make([]string, 0, len(dd))
+			for _, d := range dd {
+				ss = append(ss, d.String()[len(dir)+1:])
+			}
+			if len(u.e) == 0 {
+				assert.Empty(t, ss)
+				return
+			}
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
+
+func TestDiagnoseSkinAnchors(t *testing.T) {
+	dd := config.DiagnoseSkin("../../skins/dracula.yml")
+
+	assert.Equal(t, 1, len(dd))
+	assert.Equal(t, "field highlightcolor not found in type config.Status", dd[0].Message)
+}
+
+func TestDiagnosticsSort(t *testing.T) {
+	dd := config.Diagnostics{
+		{File: "b.yml", Line: 1},
+		{File: "a.yml", Line: 3},
+		{File: "a.yml", Line: 1},
+	}
+	dd.Sort()
+
+	assert.Equal(t, config.Diagnostics{
+		{File: "a.yml", Line: 1},
+		{File: "a.yml", Line: 3},
+		{File: "b.yml", Line: 1},
+	}, dd)
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultDumpName represents the default dump file name template.
+	DefaultDumpName = "$RESOURCE-$NAMESPACE-$NAME-$TIMESTAMP"
+
+	// BenchDumpDir represents the dump sub directory holding benchmarks.
+	BenchDumpDir = "benchmarks"
+
+	dumpDateFmat = "20060102-150405"
+)
+
+var (
+	dumpSepRX   = regexp.MustCompile(`[-_.]{2,}`)
+	dumpCharsRX = regexp.MustCompile(`[/\\:*?"<>| ]+`)
+)
+
+// Dump describes a file exported by K9s ie a table, logs or a manifest.
+type Dump struct {
+	Context   string
+	Cluster   string
+	Namespace string
+	Resource  string
+	Name      string
+	Ext       string
+	Time      time.Time
+}
+
+// DumpRoot returns the directory all K9s exports are persisted to.
+func (k *K9s) DumpRoot() string {
+	if k.DumpDir == "" {
+		return K9sDumpDir
+	}
+
+	return expandHome(os.ExpandEnv(k.DumpDir))
+}
+
+// DumpDirFor returns a cluster dump directory.
+func (k *K9s) DumpDirFor(cluster string) string {
+	return filepath.Join(k.DumpRoot(), cluster)
+}
+
+// BenchDir returns a cluster benchmarks directory.
+func (k *K9s) BenchDir(cluster string) string {
+	return filepath.Join(k.DumpDirFor(cluster), BenchDumpDir)
+}
+
+// DumpPath returns a dump file path based on the dump name template and
+// ensures its directory exists.
+func (k *K9s) DumpPath(d Dump) (string, error) {
+	if IsServed() {
+		return "", ErrServed
+	}
+	dir := k.DumpDirFor(d.Cluster)
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return "", err
+	}
+	tpl := k.DumpName
+	if tpl == "" {
+		tpl = DefaultDumpName
+	}
+	name := DumpName(tpl, d)
+	if d.Ext != "" {
+		name += "." + d.Ext
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// DumpName expands a dump name template. $CONTEXT, $CLUSTER, $NAMESPACE,
+// $RESOURCE, $NAME, $DATE and $TIMESTAMP are substituted and blank values
+// are dropped.
+func DumpName(tpl string, d Dump) string {
+	if d.Time.IsZero() {
+		d.Time = time.Now()
+	}
+	vars := map[string]string{
+		"CONTEXT":   d.Context,
+		"CLUSTER":   d.Cluster,
+		"NAMESPACE": d.Namespace,
+		"RESOURCE":  d.Resource,
+		"NAME":      d.Name,
+		"DATE":      d.Time.Format(dumpDateFmat),
+		"TIMESTAMP": strconv.FormatInt(d.Time.UnixNano(), 10),
+	}
+	name := os.Expand(tpl, func(v string) string {
+		return dumpCharsRX.ReplaceAllString(vars[v], "-")
+	})
+	name = dumpSepRX.ReplaceAllStringFunc(name, func(s string) string {
+		return s[:1]
+	})
+
+	return strings.Trim(name, "-_.")
+}
@@ -0,0 +1,60 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpName(t *testing.T) {
+	at := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	uu := map[string]struct {
+		tpl  string
+		d    config.Dump
+		name string
+	}{
+		"full": {
+			tpl:  "${CONTEXT}_$RESOURCE-$NAMESPACE-$NAME-$DATE",
+			d:    config.Dump{Context: "ctx", Namespace: "default", Resource: "v1/pods", Name: "fred", Time: at},
+			name: "ctx_v1-pods-default-fred-20200304-050607",
+		},
+		"blanks": {
+			tpl:  config.DefaultDumpName,
+			d:    config.Dump{Resource: "logs", Time: at},
+			name: "logs-1583298367000000000",
+		},
+		"sanitize": {
+			tpl:  "$NAME",
+			d:    config.Dump{Name: "a b:c", Time: at},
+			name: "a-b-c",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.name, config.DumpName(u.tpl, u.d))
+		})
+	}
+}
+
+func TestDumpPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-dumps")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	k := config.NewK9s()
+	k.DumpDir = dir
+	k.DumpName = "$RESOURCE-$NAME"
+	p, err := k.DumpPath(config.Dump{Cluster: "c1", Resource: "pods", Name: "fred", Ext: "yml"})
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "c1", "pods-fred.yml"), p)
+	_, err = os.Stat(filepath.Join(dir, "c1"))
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "c1", config.BenchDumpDir), k.BenchDir("c1"))
+}
@@ -18,6 +18,10 @@ type Flags struct {
 	Headless      *bool
 	Command       *string
 	AllNamespaces *bool
+	Record        *string
+	Clean         *bool
+	Profile       *string
+	Anonymize     *bool
 }
 
 // NewFlags returns new configuration flags.
@@ -28,6 +32,10 @@ func NewFlags() *Flags {
 		Headless:      boolPtr(false),
 		Command:       strPtr(DefaultCommand),
 		AllNamespaces: boolPtr(false),
+		Record:        strPtr(""),
+		Clean:         boolPtr(false),
+		Profile:       strPtr(""),
+		Anonymize:     boolPtr(false),
 	}
 }
 
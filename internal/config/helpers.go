@@ -1,9 +1,13 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"os/user"
 	"path/filepath"
+	"strings"
+	"unicode"
 
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
@@ -63,3 +67,52 @@ func EnsurePath(path string, mod os.FileMode) {
 		}
 	}
 }
+
+// ShellWords splits a command line into words using shell quoting rules.
+// An error is returned along with the words parsed so far on unterminated
+// quotes or escapes.
+func ShellWords(s string, escapes bool) ([]string, error) {
+	var (
+		args           []string
+		b              strings.Builder
+		quote          rune
+		inArg, escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'' && escapes:
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			b.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, b.String())
+	}
+	switch {
+	case quote != 0:
+		return args, fmt.Errorf("unterminated %c quote", quote)
+	case escaped:
+		return args, errors.New("unterminated escape")
+	}
+
+	return args, nil
+}
@@ -82,3 +82,43 @@ func TestEnsurePathNoOpt(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "drwxr--r--", p.Mode().String())
 }
+
+func TestShellWords(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   []string
+		err bool
+	}{
+		"plain": {
+			s: "ls -al /tmp",
+			e: []string{"ls", "-al", "/tmp"},
+		},
+		"quoted": {
+			s: `sh -c 'echo "hello world"'`,
+			e: []string{"sh", "-c", `echo "hello world"`},
+		},
+		"escaped": {
+			s: `cat /data/my\ file`,
+			e: []string{"cat", "/data/my file"},
+		},
+		"unterminated": {
+			s:   `echo "fred`,
+			e:   []string{"echo", "fred"},
+			err: true,
+		},
+		"danglingEscape": {
+			s:   `echo fred\`,
+			e:   []string{"echo", "fred"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			args, err := config.ShellWords(u.s, true)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, args)
+		})
+	}
+}
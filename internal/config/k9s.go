@@ -1,11 +1,25 @@
 package config
 
-import "github.com/derailed/k9s/internal/client"
+import (
+	"regexp"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
 
 const (
 	defaultRefreshRate    = 2
 	defaultLogRequestSize = 200
 	defaultLogBufferSize  = 1000
+
+	// DefaultKeyProfile uses the standard control key bindings.
+	DefaultKeyProfile = "default"
+
+	// PrintableKeyProfile provides printable key alternatives for terminals swallowing control keys.
+	PrintableKeyProfile = "printable"
+
+	// DefaultLogErrorPattern matches error log lines.
+	DefaultLogErrorPattern = `(?i)\b(error|fatal|panic|exception)\b`
 )
 
 // K9s tracks K9s configuration options.
@@ -17,9 +31,30 @@ type K9s struct {
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	FullScreenLogs    bool                `yaml:"fullScreenLogs"`
+	LogErrorPattern   string              `yaml:"logErrorPattern,omitempty"`
+	KeyProfile        string              `yaml:"keyProfile,omitempty"`
+	VimMode           bool                `yaml:"vimMode,omitempty"`
+	ShellPane         bool                `yaml:"shellPane,omitempty"`
+	FindResources     []string            `yaml:"findResources,omitempty"`
+	AppLabel          string              `yaml:"appLabel,omitempty"`
+	SATokens          []string            `yaml:"saTokens,omitempty"`
+	ExtendedResources []string            `yaml:"extendedResources,omitempty"`
+	CustomColumns     CustomColumns       `yaml:"customColumns,omitempty"`
+	AbsoluteAge       bool                `yaml:"absoluteAge,omitempty"`
+	AgeLayout         string              `yaml:"ageLayout,omitempty"`
+	AgeTimezone       string              `yaml:"ageTimezone,omitempty"`
+	RowDecay          int                 `yaml:"rowDecay,omitempty"`
+	FrozenColumns     int                 `yaml:"frozenColumns,omitempty"`
+	Pager             string              `yaml:"pager,omitempty"`
+	ReadOnly          bool                `yaml:"readOnly,omitempty"`
+	DumpDir           string              `yaml:"dumpDir,omitempty"`
+	DumpName          string              `yaml:"dumpName,omitempty"`
+	Anonymize         bool                `yaml:"anonymize,omitempty"`
+	Overview          *Overview           `yaml:"overview,omitempty"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
+	manualAnonymize   *bool
 	manualCommand     *string
 }
 
@@ -43,6 +78,11 @@ func (k *K9s) OverrideHeadless(b bool) {
 	k.manualHeadless = &b
 }
 
+// OverrideAnonymize set the anonymous mode manually.
+func (k *K9s) OverrideAnonymize(b bool) {
+	k.manualAnonymize = &b
+}
+
 // OverrideCommand set the command manually.
 func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
@@ -58,6 +98,15 @@ func (k *K9s) GetHeadless() bool {
 	return h
 }
 
+// GetAnonymize returns true if resource identities should be masked.
+func (k *K9s) GetAnonymize() bool {
+	if k.manualAnonymize != nil {
+		return *k.manualAnonymize
+	}
+
+	return k.Anonymize
+}
+
 // GetRefreshRate returns the current refresh rate.
 func (k *K9s) GetRefreshRate() int {
 	rate := k.RefreshRate
@@ -68,6 +117,24 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
+// LogErrorRX returns the regexp matching error log lines.
+func (k *K9s) LogErrorRX() (*regexp.Regexp, error) {
+	if k.LogErrorPattern == "" {
+		return regexp.Compile(DefaultLogErrorPattern)
+	}
+
+	return regexp.Compile(k.LogErrorPattern)
+}
+
+// AgeLocation returns the timezone used to show absolute ages.
+func (k *K9s) AgeLocation() (*time.Location, error) {
+	if k.AgeTimezone == "" {
+		return time.Local, nil
+	}
+
+	return time.LoadLocation(k.AgeTimezone)
+}
+
 // ActiveCluster returns the currently active cluster.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
@@ -82,6 +149,15 @@ func (k *K9s) ActiveCluster() *Cluster {
 	return k.Clusters[k.CurrentCluster]
 }
 
+// RateLimits returns the api server rate limits of a given cluster.
+func (k *K9s) RateLimits(cluster string) client.RateLimits {
+	if c, ok := k.Clusters[cluster]; ok {
+		return c.API.RateLimits()
+	}
+
+	return client.RateLimits{}
+}
+
 func (k *K9s) validateDefaults() {
 	if k.RefreshRate <= 0 {
 		k.RefreshRate = defaultRefreshRate
@@ -94,6 +170,25 @@ func (k *K9s) validateDefaults() {
 	if k.LogRequestSize <= 0 {
 		k.LogRequestSize = defaultLogRequestSize
 	}
+
+	if k.KeyProfile != PrintableKeyProfile {
+		k.KeyProfile = ""
+	}
+
+	k.CustomColumns.Validate()
+
+	if k.Overview != nil {
+		k.Overview.Validate()
+	}
+}
+
+// OverviewConfig returns the overview dashboard options.
+func (k *K9s) OverviewConfig() *Overview {
+	if k.Overview == nil {
+		return NewOverview()
+	}
+
+	return k.Overview
 }
 
 func (k *K9s) checkClusters(ks KubeSettings) {
@@ -2,7 +2,9 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	m "github.com/petergtz/pegomock"
 	"github.com/stretchr/testify/assert"
@@ -79,3 +81,59 @@ func TestK9sActiveCluster(t *testing.T) {
 	assert.Equal(t, "kube-system", cl.Namespace.Active)
 	assert.Equal(t, 5, len(cl.Namespace.Favorites))
 }
+
+func TestK9sLogErrorRX(t *testing.T) {
+	c := config.NewK9s()
+	rx, err := c.LogErrorRX()
+	assert.Nil(t, err)
+	assert.True(t, rx.MatchString("2020/01/01 ERROR boom"))
+	assert.False(t, rx.MatchString("no errors here"))
+
+	c.LogErrorPattern = `level=(warn|error)`
+	rx, err = c.LogErrorRX()
+	assert.Nil(t, err)
+	assert.True(t, rx.MatchString("level=warn msg=slow"))
+
+	c.LogErrorPattern = `(`
+	_, err = c.LogErrorRX()
+	assert.NotNil(t, err)
+}
+
+func TestK9sAgeLocation(t *testing.T) {
+	c := config.NewK9s()
+	loc, err := c.AgeLocation()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Local, loc)
+
+	c.AgeTimezone = "UTC"
+	loc, err = c.AgeLocation()
+	assert.Nil(t, err)
+	assert.Equal(t, "UTC", loc.String())
+
+	c.AgeTimezone = "Mars/Olympus"
+	_, err = c.AgeLocation()
+	assert.NotNil(t, err)
+}
+
+func TestK9sAnonymize(t *testing.T) {
+	c := config.NewK9s()
+	assert.False(t, c.GetAnonymize())
+
+	c.Anonymize = true
+	assert.True(t, c.GetAnonymize())
+
+	c.OverrideAnonymize(false)
+	assert.False(t, c.GetAnonymize())
+}
+
+func TestK9sRateLimits(t *testing.T) {
+	k := config.NewK9s()
+	k.Clusters["c1"] = &config.Cluster{
+		API: &config.APILimits{QPS: 5, Burst: 10, Throttle: map[string]float32{"v1/pods": 2}},
+	}
+	k.Clusters["c2"] = config.NewCluster()
+
+	assert.Equal(t, client.RateLimits{QPS: 5, Burst: 10, Throttle: map[string]float32{"v1/pods": 2}}, k.RateLimits("c1"))
+	assert.Equal(t, client.RateLimits{}, k.RateLimits("c2"))
+	assert.Equal(t, client.RateLimits{}, k.RateLimits("c3"))
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const kubectlPluginPrefix = "kubectl-"
+
+// KubectlPlugins tracks kubectl plugins exposed as K9s actions.
+type KubectlPlugins struct {
+	Allow  []string          `yaml:"allow"`
+	Plugin map[string]Plugin `yaml:"plugin"`
+}
+
+// KnownKubectlPlugins tracks action settings for popular kubectl plugins.
+// The plugin command is resolved from the PATH at discovery time.
+var KnownKubectlPlugins = map[string]Plugin{
+	"neat": {
+		ShortCut:    "Shift-Y",
+		Description: "Neat",
+		Scopes:      []string{"all"},
+		Args:        []string{"get", "--", "$RESOURCE", "$NAME", "-n", "$NAMESPACE", "--context", "$CONTEXT", "-o", "yaml"},
+	},
+	"tree": {
+		ShortCut:    "Shift-J",
+		Description: "Tree",
+		Scopes:      []string{"all"},
+		Args:        []string{"$RESOURCE", "$NAME", "-n", "$NAMESPACE", "--context", "$CONTEXT"},
+	},
+	"blame": {
+		ShortCut:    "Shift-B",
+		Description: "Blame",
+		Scopes:      []string{"all"},
+		Args:        []string{"$RESOURCE", "$NAME", "-n", "$NAMESPACE", "--context", "$CONTEXT"},
+	},
+}
+
+// NewKubectlPlugins returns a new kubectl plugins configuration.
+func NewKubectlPlugins() *KubectlPlugins {
+	return &KubectlPlugins{
+		Plugin: make(map[string]Plugin),
+	}
+}
+
+// Actions returns allowed plugins found in the given discovered binaries
+// keyed by plugin name. Custom settings override known plugin settings.
+func (k KubectlPlugins) Actions(bins map[string]string) map[string]Plugin {
+	pp := make(map[string]Plugin, len(k.Allow))
+	for _, n := range k.Allow {
+		bin, ok := bins[n]
+		if !ok {
+			continue
+		}
+		p, ok := k.Plugin[n]
+		if !ok {
+			if p, ok = KnownKubectlPlugins[n]; !ok {
+				continue
+			}
+		}
+		p.Command = bin
+		pp[n] = p
+	}
+
+	return pp
+}
+
+// DiscoverKubectlPlugins scans the PATH for kubectl plugin binaries.
+func DiscoverKubectlPlugins() map[string]string {
+	return KubectlPluginsIn(filepath.SplitList(os.Getenv("PATH")))
+}
+
+// KubectlPluginsIn returns kubectl plugin binaries keyed by plugin name found
+// in the given directories. As with kubectl, earlier directories win.
+func KubectlPluginsIn(dirs []string) map[string]string {
+	bins := make(map[string]string)
+	for _, dir := range dirs {
+		ff, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, f := range ff {
+			n, ok := kubectlPluginName(f)
+			if !ok {
+				continue
+			}
+			if _, ok := bins[n]; !ok {
+				bins[n] = filepath.Join(dir, f.Name())
+			}
+		}
+	}
+
+	return bins
+}
+
+func kubectlPluginName(f os.FileInfo) (string, bool) {
+	if f.IsDir() || !strings.HasPrefix(f.Name(), kubectlPluginPrefix) {
+		return "", false
+	}
+	n := strings.TrimPrefix(f.Name(), kubectlPluginPrefix)
+	if runtime.GOOS == "windows" {
+		ext := filepath.Ext(n)
+		if !strings.EqualFold(ext, ".exe") {
+			return "", false
+		}
+		n = strings.TrimSuffix(n, ext)
+	} else if f.Mode()&0111 == 0 {
+		return "", false
+	}
+	if n == "" {
+		return "", false
+	}
+
+	return strings.Replace(n, "_", "-", -1), true
+}
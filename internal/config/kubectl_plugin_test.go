@@ -0,0 +1,65 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubectlPluginsIn(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are detected by extension on windows")
+	}
+	dir1, dir2 := tempDir(t), tempDir(t)
+	defer os.RemoveAll(dir1)
+	defer os.RemoveAll(dir2)
+
+	touch(t, filepath.Join(dir1, "kubectl-neat"), 0755)
+	touch(t, filepath.Join(dir1, "kubectl-view_secret"), 0755)
+	touch(t, filepath.Join(dir1, "kubectl-nope"), 0644)
+	touch(t, filepath.Join(dir1, "kubectl-"), 0755)
+	touch(t, filepath.Join(dir1, "helm"), 0755)
+	touch(t, filepath.Join(dir2, "kubectl-neat"), 0755)
+	touch(t, filepath.Join(dir2, "kubectl-tree"), 0755)
+
+	bins := config.KubectlPluginsIn([]string{dir1, "/k9s/no/such/dir", dir2})
+	assert.Equal(t, map[string]string{
+		"neat":        filepath.Join(dir1, "kubectl-neat"),
+		"view-secret": filepath.Join(dir1, "kubectl-view_secret"),
+		"tree":        filepath.Join(dir2, "kubectl-tree"),
+	}, bins)
+}
+
+func TestKubectlPluginsActions(t *testing.T) {
+	bins := map[string]string{
+		"neat":   "/bin/kubectl-neat",
+		"tree":   "/bin/kubectl-tree",
+		"fred":   "/bin/kubectl-fred",
+		"whoami": "/bin/kubectl-whoami",
+	}
+	k := config.NewKubectlPlugins()
+	k.Allow = []string{"neat", "fred", "whoami", "blame"}
+	k.Plugin["whoami"] = config.Plugin{ShortCut: "Shift-W", Scopes: []string{"all"}}
+
+	pp := k.Actions(bins)
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, "/bin/kubectl-neat", pp["neat"].Command)
+	assert.Equal(t, config.KnownKubectlPlugins["neat"].Args, pp["neat"].Args)
+	assert.Equal(t, "/bin/kubectl-whoami", pp["whoami"].Command)
+	assert.Equal(t, "Shift-W", pp["whoami"].ShortCut)
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "k9s-plugins")
+	assert.Nil(t, err)
+	return dir
+}
+
+func touch(t *testing.T, path string, mode os.FileMode) {
+	assert.Nil(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"), mode))
+}
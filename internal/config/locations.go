@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// XDGConfigHomeEnv tracks the XDG config base directory env var.
+	XDGConfigHomeEnv = "XDG_CONFIG_HOME"
+
+	// XDGDataHomeEnv tracks the XDG data base directory env var.
+	XDGDataHomeEnv = "XDG_DATA_HOME"
+
+	profilesDir = "profiles"
+)
+
+var (
+	// K9sProfile tracks the active config profile. Blank for the default profile.
+	K9sProfile string
+
+	// K9sDataDir represents K9s data directory.
+	K9sDataDir = dataDir(mustK9sHome(), "")
+)
+
+// InitLocations points all K9s files to a given profile config tree.
+// K9s uses $HOME/.k9s if present, the XDG config and data directories otherwise.
+func InitLocations(profile string) {
+	home := mustK9sHome()
+	K9sProfile = profile
+	K9sHome = configDir(home, profile)
+	K9sDataDir = dataDir(home, profile)
+	K9sDumpDir = dumpDir(home, profile)
+	K9sConfigFile = filepath.Join(K9sHome, "config.yml")
+	K9sStylesFile = filepath.Join(K9sHome, "skin.yml")
+	K9sSkins = filepath.Join(K9sHome, "skins")
+	K9sAlias = filepath.Join(K9sHome, "alias.yml")
+	K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
+	K9sHotKeys = filepath.Join(K9sHome, "hotkey.yml")
+	K9sNotify = filepath.Join(K9sHome, "notify.yml")
+}
+
+// Profiles returns the available config profiles.
+func Profiles() []string {
+	dirs, err := filepath.Glob(filepath.Join(configDir(mustK9sHome(), ""), profilesDir, "*"))
+	if err != nil {
+		return nil
+	}
+	pp := make([]string, 0, len(dirs))
+	for _, d := range dirs {
+		if fi, err := os.Stat(d); err == nil && fi.IsDir() {
+			pp = append(pp, filepath.Base(d))
+		}
+	}
+
+	return pp
+}
+
+func legacyDir(home string) (string, bool) {
+	dir := filepath.Join(home, ".k9s")
+	fi, err := os.Stat(dir)
+
+	return dir, err == nil && fi.IsDir()
+}
+
+func withProfile(dir, profile string) string {
+	if profile == "" {
+		return dir
+	}
+
+	return filepath.Join(dir, profilesDir, profile)
+}
+
+func configDir(home, profile string) string {
+	if dir, ok := legacyDir(home); ok {
+		return withProfile(dir, profile)
+	}
+
+	return withProfile(filepath.Join(xdgDir(XDGConfigHomeEnv, home, ".config"), "k9s"), profile)
+}
+
+func dataDir(home, profile string) string {
+	if dir, ok := legacyDir(home); ok {
+		return withProfile(dir, profile)
+	}
+
+	return withProfile(filepath.Join(xdgDir(XDGDataHomeEnv, home, ".local", "share"), "k9s"), profile)
+}
+
+// dumpDir keeps screen dumps in the temp directory for legacy layouts.
+func dumpDir(home, profile string) string {
+	if _, ok := legacyDir(home); ok {
+		return filepath.Join(os.TempDir(), tempName("k9s-screens", profile))
+	}
+
+	return filepath.Join(dataDir(home, profile), "screen-dumps")
+}
+
+func tempName(prefix, profile string) string {
+	n := fmt.Sprintf("%s-%s", prefix, MustK9sUser())
+	if profile != "" {
+		n += "-" + profile
+	}
+
+	return n
+}
+
+func xdgDir(env, home string, dd ...string) string {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return dir
+	}
+
+	return filepath.Join(append([]string{home}, dd...)...)
+}
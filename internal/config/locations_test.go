@@ -0,0 +1,52 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationsXDG(t *testing.T) {
+	home, err := ioutil.TempDir("", "k9s-home")
+	assert.Nil(t, err)
+	defer os.RemoveAll(home)
+	defer os.Setenv(XDGConfigHomeEnv, os.Getenv(XDGConfigHomeEnv))
+	defer os.Setenv(XDGDataHomeEnv, os.Getenv(XDGDataHomeEnv))
+
+	assert.Nil(t, os.Unsetenv(XDGConfigHomeEnv))
+	assert.Nil(t, os.Unsetenv(XDGDataHomeEnv))
+	assert.Equal(t, filepath.Join(home, ".config", "k9s"), configDir(home, ""))
+	assert.Equal(t, filepath.Join(home, ".local", "share", "k9s"), dataDir(home, ""))
+	assert.Equal(t, filepath.Join(home, ".local", "share", "k9s", "screen-dumps"), dumpDir(home, ""))
+
+	assert.Nil(t, os.Setenv(XDGConfigHomeEnv, "/xdg/config"))
+	assert.Nil(t, os.Setenv(XDGDataHomeEnv, "relative/data"))
+	assert.Equal(t, "/xdg/config/k9s/profiles/work", configDir(home, "work"))
+	assert.Equal(t, filepath.Join(home, ".local", "share", "k9s", "profiles", "work"), dataDir(home, "work"))
+}
+
+func TestLocationsLegacy(t *testing.T) {
+	home, err := ioutil.TempDir("", "k9s-home")
+	assert.Nil(t, err)
+	defer os.RemoveAll(home)
+	assert.Nil(t, os.Mkdir(filepath.Join(home, ".k9s"), DefaultDirMod))
+
+	assert.Equal(t, filepath.Join(home, ".k9s"), configDir(home, ""))
+	assert.Equal(t, filepath.Join(home, ".k9s"), dataDir(home, ""))
+	assert.Equal(t, filepath.Join(home, ".k9s", "profiles", "demo"), configDir(home, "demo"))
+	assert.Equal(t, filepath.Join(os.TempDir(), "k9s-screens-"+MustK9sUser()+"-demo"), dumpDir(home, "demo"))
+}
+
+func TestInitLocations(t *testing.T) {
+	defer InitLocations("")
+
+	InitLocations("demo")
+	assert.Equal(t, "demo", K9sProfile)
+	assert.Equal(t, "demo", filepath.Base(K9sHome))
+	assert.Equal(t, filepath.Join(K9sHome, "config.yml"), K9sConfigFile)
+	assert.Equal(t, filepath.Join(K9sHome, "plugin.yml"), K9sPlugins)
+	assert.Equal(t, filepath.Join(K9sHome, "alias.yml"), K9sAlias)
+}
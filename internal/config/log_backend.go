@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// A collection of historical log backends.
+const (
+	LogBackendLoki          = "loki"
+	LogBackendElasticsearch = "elasticsearch"
+)
+
+const (
+	defaultLogBackendSince = time.Hour
+	defaultLokiLogQuery    = `{namespace="$NAMESPACE", pod="$POD", container="$CONTAINER"}`
+	defaultESLogIndex      = "logstash-*"
+)
+
+// LogBackend tracks a historical log store for a cluster.
+type LogBackend struct {
+	// Kind represents the backend kind ie loki or elasticsearch.
+	Kind string `yaml:"kind"`
+	// URL is the backend endpoint.
+	URL string `yaml:"url"`
+	// Headers are additional http headers ie authorization.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Since represents how far back to look for logs. Default 1h.
+	Since string `yaml:"since,omitempty"`
+	// Query is the loki stream selector. $NAMESPACE, $POD and $CONTAINER are
+	// substituted and blank matchers are dropped.
+	Query string `yaml:"query,omitempty"`
+	// Index is the elasticsearch index pattern.
+	Index string `yaml:"index,omitempty"`
+	// Fields maps elasticsearch document fields.
+	Fields ESLogFields `yaml:"fields,omitempty"`
+}
+
+// ESLogFields tracks the elasticsearch log document field names.
+type ESLogFields struct {
+	Timestamp string `yaml:"timestamp,omitempty"`
+	Message   string `yaml:"message,omitempty"`
+	Namespace string `yaml:"namespace,omitempty"`
+	Pod       string `yaml:"pod,omitempty"`
+	Container string `yaml:"container,omitempty"`
+}
+
+// Validate checks the log backend and sets defaults.
+func (l *LogBackend) Validate() error {
+	if l.URL == "" {
+		return fmt.Errorf("%s log backend requires an url", l.Kind)
+	}
+	if _, err := l.SinceDuration(); err != nil {
+		return err
+	}
+	switch l.Kind {
+	case LogBackendLoki:
+		if l.Query == "" {
+			l.Query = defaultLokiLogQuery
+		}
+	case LogBackendElasticsearch:
+		if l.Index == "" {
+			l.Index = defaultESLogIndex
+		}
+		l.Fields.defaults()
+	default:
+		return fmt.Errorf("unknown log backend %q", l.Kind)
+	}
+
+	return nil
+}
+
+// SinceDuration returns the log lookback duration.
+func (l *LogBackend) SinceDuration() (time.Duration, error) {
+	if l.Since == "" {
+		return defaultLogBackendSince, nil
+	}
+	d, err := time.ParseDuration(l.Since)
+	if err != nil {
+		return 0, fmt.Errorf("invalid log backend since %q: %s", l.Since, err)
+	}
+
+	return d, nil
+}
+
+func (f *ESLogFields) defaults() {
+	if f.Timestamp == "" {
+		f.Timestamp = "@timestamp"
+	}
+	if f.Message == "" {
+		f.Message = "log"
+	}
+	if f.Namespace == "" {
+		f.Namespace = "kubernetes.namespace_name"
+	}
+	if f.Pod == "" {
+		f.Pod = "kubernetes.pod_name"
+	}
+	if f.Container == "" {
+		f.Container = "kubernetes.container_name"
+	}
+}
@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBackendValidate(t *testing.T) {
+	uu := map[string]struct {
+		l      config.LogBackend
+		since  time.Duration
+		hasErr bool
+	}{
+		"loki": {
+			l:     config.LogBackend{Kind: config.LogBackendLoki, URL: "http://loki:3100"},
+			since: time.Hour,
+		},
+		"es": {
+			l:     config.LogBackend{Kind: config.LogBackendElasticsearch, URL: "http://es:9200", Since: "6h"},
+			since: 6 * time.Hour,
+		},
+		"noURL": {
+			l:      config.LogBackend{Kind: config.LogBackendLoki},
+			hasErr: true,
+		},
+		"badSince": {
+			l:      config.LogBackend{Kind: config.LogBackendLoki, URL: "http://loki:3100", Since: "1 day"},
+			hasErr: true,
+		},
+		"unknown": {
+			l:      config.LogBackend{Kind: "splunk", URL: "http://splunk"},
+			hasErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.l.Validate()
+			if u.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			d, _ := u.l.SinceDuration()
+			assert.Equal(t, u.since, d)
+		})
+	}
+}
+
+func TestLogBackendDefaults(t *testing.T) {
+	l := config.LogBackend{Kind: config.LogBackendElasticsearch, URL: "http://es:9200"}
+	assert.Nil(t, l.Validate())
+
+	assert.Equal(t, "logstash-*", l.Index)
+	assert.Equal(t, "@timestamp", l.Fields.Timestamp)
+	assert.Equal(t, "log", l.Fields.Message)
+	assert.Equal(t, "kubernetes.pod_name", l.Fields.Pod)
+
+	l = config.LogBackend{Kind: config.LogBackendLoki, URL: "http://loki:3100"}
+	assert.Nil(t, l.Validate())
+	assert.Equal(t, `{namespace="$NAMESPACE", pod="$POD", container="$CONTAINER"}`, l.Query)
+}
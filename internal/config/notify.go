@@ -0,0 +1,94 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sNotify manages K9s notification rules.
+var K9sNotify = filepath.Join(K9sHome, "notify.yml")
+
+const (
+	// DesktopSink pops a desktop notification.
+	DesktopSink = "desktop"
+
+	// WebhookSink posts a notification as json.
+	WebhookSink = "webhook"
+
+	// SlackSink posts a notification to a Slack incoming webhook.
+	SlackSink = "slack"
+
+	// ExecSink runs a command.
+	ExecSink = "exec"
+)
+
+// Notifications represents a collection of notification rules and sinks.
+type Notifications struct {
+	Rules     []NotifyRule          `yaml:"rules"`
+	LogAlerts []LogAlert            `yaml:"logAlerts"`
+	Sinks     map[string]NotifySink `yaml:"sinks"`
+}
+
+// NotifyRule describes a resource condition to alert on.
+type NotifyRule struct {
+	Name      string   `yaml:"name"`
+	GVR       string   `yaml:"gvr"`
+	Namespace string   `yaml:"namespace"`
+	Condition string   `yaml:"condition"`
+	Sinks     []string `yaml:"sinks"`
+}
+
+// LogAlert describes a log pattern to alert on. Alerts without a resource
+// path apply to the open log views, otherwise the resource logs are tailed
+// in the background.
+type LogAlert struct {
+	Name      string   `yaml:"name"`
+	Pattern   string   `yaml:"pattern"`
+	GVR       string   `yaml:"gvr"`
+	Path      string   `yaml:"path"`
+	Container string   `yaml:"container"`
+	Beep      bool     `yaml:"beep"`
+	Sinks     []string `yaml:"sinks"`
+}
+
+// NotifySink describes where notifications are sent.
+type NotifySink struct {
+	Kind    string   `yaml:"kind"`
+	URL     string   `yaml:"url"`
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args"`
+}
+
+// NewNotifications returns a new notifications configuration.
+func NewNotifications() *Notifications {
+	return &Notifications{
+		Sinks: make(map[string]NotifySink),
+	}
+}
+
+// Load K9s notifications.
+func (n *Notifications) Load() error {
+	return n.LoadNotifications(K9sNotify)
+}
+
+// LoadNotifications loads notifications from a given file.
+func (n *Notifications) LoadNotifications(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var nn Notifications
+	if err := yaml.Unmarshal(f, &nn); err != nil {
+		return err
+	}
+	n.Rules = append(n.Rules, nn.Rules...)
+	n.LogAlerts = append(n.LogAlerts, nn.LogAlerts...)
+	for k, v := range nn.Sinks {
+		n.Sinks[k] = v
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsLoad(t *testing.T) {
+	n := config.NewNotifications()
+	assert.Nil(t, n.LoadNotifications("test_assets/notify.yml"))
+
+	assert.Equal(t, 2, len(n.Rules))
+	r := n.Rules[0]
+	assert.Equal(t, "crashy", r.Name)
+	assert.Equal(t, "v1/pods", r.GVR)
+	assert.Equal(t, "default", r.Namespace)
+	assert.Equal(t, "RS > 3", r.Condition)
+	assert.Equal(t, []string{"desktop", "team"}, r.Sinks)
+
+	assert.Equal(t, 2, len(n.LogAlerts))
+	a := n.LogAlerts[1]
+	assert.Equal(t, "OOM", a.Pattern)
+	assert.Equal(t, "apps/v1/deployments", a.GVR)
+	assert.Equal(t, "default/fred", a.Path)
+	assert.True(t, n.LogAlerts[0].Beep)
+
+	assert.Equal(t, 2, len(n.Sinks))
+	s, ok := n.Sinks["team"]
+	assert.True(t, ok)
+	assert.Equal(t, config.SlackSink, s.Kind)
+	assert.Equal(t, "https://hooks.slack.com/services/fred", s.URL)
+}
@@ -0,0 +1,52 @@
+package config
+
+// A collection of overview dashboard sections.
+const (
+	OverviewCluster  = "cluster"
+	OverviewProblems = "problems"
+	OverviewEvents   = "events"
+	OverviewNodes    = "nodes"
+	OverviewPins     = "pins"
+)
+
+const defaultOverviewEvents = 10
+
+// OverviewSections lists all the overview sections in display order.
+var OverviewSections = []string{OverviewCluster, OverviewProblems, OverviewEvents, OverviewNodes, OverviewPins}
+
+// Overview tracks the landing dashboard options.
+type Overview struct {
+	// Enabled shows the overview at startup instead of the last active view.
+	Enabled bool `yaml:"enabled"`
+	// Sections lists the sections to show. Default all.
+	Sections []string `yaml:"sections,omitempty"`
+	// MaxEvents caps the number of recent warning events. Default 10.
+	MaxEvents int `yaml:"maxEvents,omitempty"`
+}
+
+// NewOverview returns a new overview configuration.
+func NewOverview() *Overview {
+	return &Overview{Sections: OverviewSections, MaxEvents: defaultOverviewEvents}
+}
+
+// Validate drops unknown sections and sets defaults.
+func (o *Overview) Validate() {
+	ss := make([]string, 0, len(o.Sections))
+	for _, s := range o.Sections {
+		if InList(OverviewSections, s) && !InList(ss, s) {
+			ss = append(ss, s)
+		}
+	}
+	if len(ss) == 0 {
+		ss = OverviewSections
+	}
+	o.Sections = ss
+	if o.MaxEvents <= 0 {
+		o.MaxEvents = defaultOverviewEvents
+	}
+}
+
+// Shows returns true if the overview includes a given section.
+func (o *Overview) Shows(section string) bool {
+	return InList(o.Sections, section)
+}
@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverviewValidate(t *testing.T) {
+	uu := map[string]struct {
+		o        config.Overview
+		sections []string
+		events   int
+	}{
+		"defaults": {
+			sections: config.OverviewSections,
+			events:   10,
+		},
+		"custom": {
+			o:        config.Overview{Sections: []string{"events", "bozo", "pins", "events"}, MaxEvents: 5},
+			sections: []string{"events", "pins"},
+			events:   5,
+		},
+		"unknown": {
+			o:        config.Overview{Sections: []string{"bozo"}},
+			sections: config.OverviewSections,
+			events:   10,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.o.Validate()
+			assert.Equal(t, u.sections, u.o.Sections)
+			assert.Equal(t, u.events, u.o.MaxEvents)
+			assert.True(t, u.o.Shows(u.sections[0]))
+		})
+	}
+}
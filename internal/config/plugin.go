@@ -12,7 +12,8 @@ var K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
 
 // Plugins represents a collection of plugins.
 type Plugins struct {
-	Plugin map[string]Plugin `yaml:"plugin"`
+	Plugin  map[string]Plugin `yaml:"plugin"`
+	Kubectl *KubectlPlugins   `yaml:"kubectl"`
 }
 
 // Plugin describes a K9s plugin
@@ -28,7 +29,8 @@ type Plugin struct {
 // NewPlugins returns a new plugin.
 func NewPlugins() Plugins {
 	return Plugins{
-		Plugin: make(map[string]Plugin),
+		Plugin:  make(map[string]Plugin),
+		Kubectl: NewKubectlPlugins(),
 	}
 }
 
@@ -51,6 +53,12 @@ func (p Plugins) LoadPlugins(path string) error {
 	for k, v := range pp.Plugin {
 		p.Plugin[k] = v
 	}
+	if pp.Kubectl != nil {
+		p.Kubectl.Allow = append(p.Kubectl.Allow, pp.Kubectl.Allow...)
+		for k, v := range pp.Kubectl.Plugin {
+			p.Kubectl.Plugin[k] = v
+		}
+	}
 
 	return nil
 }
@@ -20,3 +20,14 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, "duh", k.Command)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
 }
+
+func TestPluginLoadKubectl(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins("test_assets/plugin.yml"))
+
+	assert.Equal(t, []string{"neat", "view-secret"}, p.Kubectl.Allow)
+	k, ok := p.Kubectl.Plugin["view-secret"]
+	assert.True(t, ok)
+	assert.Equal(t, "Shift-W", k.ShortCut)
+	assert.Equal(t, []string{"secrets"}, k.Scopes)
+}
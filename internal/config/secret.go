@@ -0,0 +1,197 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A collection of secret sources.
+const (
+	SecretEnv  = "env"
+	SecretFile = "file"
+	SecretCmd  = "cmd"
+)
+
+// secretCmdTimeout tracks how long a secret command may run.
+const secretCmdTimeout = 10 * time.Second
+
+// secretRX matches secret references ie ${env:TOKEN}, ${file:~/.loki} or ${cmd:pass show loki}.
+var secretRX = regexp.MustCompile(`\$\{(env|file|cmd):([^}]+)\}`)
+
+// secretCmds caches secret commands output so password managers are only prompted once.
+var secretCmds sync.Map
+
+// ResolveSecrets expands the secret references held in a value.
+// Secrets are read from an env var, a file or a command output.
+func ResolveSecrets(s string) (string, error) {
+	var err error
+	res := secretRX.ReplaceAllStringFunc(s, func(ref string) string {
+		if err != nil {
+			return ""
+		}
+		mm := secretRX.FindStringSubmatch(ref)
+		var v string
+		v, err = resolveSecret(mm[1], strings.TrimSpace(mm[2]))
+		return v
+	})
+
+	return res, err
+}
+
+// IsSecretRef checks if a value references a secret.
+func IsSecretRef(s string) bool {
+	return secretRX.MatchString(s)
+}
+
+func resolveSecret(kind, ref string) (string, error) {
+	switch kind {
+	case SecretEnv:
+		v, ok := os.LookupEnv(ref)
+		if !ok {
+			return "", fmt.Errorf("secret env var %q is not set", ref)
+		}
+		return v, nil
+	case SecretFile:
+		raw, err := ioutil.ReadFile(expandHome(ref))
+		if err != nil {
+			return "", fmt.Errorf("secret file: %s", err)
+		}
+		return strings.TrimRight(string(raw), "\r\n"), nil
+	default:
+		return secretCmd(ref)
+	}
+}
+
+func secretCmd(cmd string) (string, error) {
+	if v, ok := secretCmds.Load(cmd); ok {
+		return v.(string), nil
+	}
+	args, err := ShellWords(cmd, true)
+	if err != nil {
+		return "", fmt.Errorf("secret command %q: %s", cmd, err)
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("secret command is blank")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), secretCmdTimeout)
+	defer cancel()
+	var out, errOut bytes.Buffer
+	c := exec.CommandContext(ctx, args[0], args[1:]...)
+	c.Stdout, c.Stderr = &out, &errOut
+	if err := c.Run(); err != nil {
+		if msg := strings.TrimSpace(errOut.String()); msg != "" {
+			return "", fmt.Errorf("secret command %q failed: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("secret command %q failed: %s", args[0], err)
+	}
+	v := strings.TrimRight(out.String(), "\r\n")
+	secretCmds.Store(cmd, v)
+
+	return v, nil
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		return filepath.Join(mustK9sHome(), path[1:])
+	}
+
+	return path
+}
+
+func resolveHeaders(hh map[string]string) (map[string]string, error) {
+	if hh == nil {
+		return nil, nil
+	}
+	res := make(map[string]string, len(hh))
+	for k, v := range hh {
+		s, err := ResolveSecrets(v)
+		if err != nil {
+			return nil, fmt.Errorf("header %s: %s", k, err)
+		}
+		res[k] = s
+	}
+
+	return res, nil
+}
+
+// WithSecrets returns a copy of the log backend with its secrets resolved.
+func (l LogBackend) WithSecrets() (*LogBackend, error) {
+	var err error
+	if l.URL, err = ResolveSecrets(l.URL); err != nil {
+		return nil, err
+	}
+	if l.Headers, err = resolveHeaders(l.Headers); err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// WithSecrets returns a copy of the audit source with its secrets resolved.
+func (a Audit) WithSecrets() (*Audit, error) {
+	var err error
+	if a.URL, err = ResolveSecrets(a.URL); err != nil {
+		return nil, err
+	}
+	if a.Headers, err = resolveHeaders(a.Headers); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// WithSecrets returns a copy of the sink with its secrets resolved.
+func (s NotifySink) WithSecrets() (NotifySink, error) {
+	var err error
+	if s.URL, err = ResolveSecrets(s.URL); err != nil {
+		return s, err
+	}
+	args := make([]string, 0, len(s.Args))
+	for _, a := range s.Args {
+		v, err := ResolveSecrets(a)
+		if err != nil {
+			return s, err
+		}
+		args = append(args, v)
+	}
+	s.Args = args
+
+	return s, nil
+}
+
+// WithSecrets returns a copy of the benchmark config with its secrets resolved.
+func (b BenchConfig) WithSecrets() (BenchConfig, error) {
+	var err error
+	if b.Auth.User, err = ResolveSecrets(b.Auth.User); err != nil {
+		return b, err
+	}
+	if b.Auth.Password, err = ResolveSecrets(b.Auth.Password); err != nil {
+		return b, err
+	}
+	if b.HTTP.Headers == nil {
+		return b, nil
+	}
+	hh := make(http.Header, len(b.HTTP.Headers))
+	for k, vv := range b.HTTP.Headers {
+		for _, v := range vv {
+			s, err := ResolveSecrets(v)
+			if err != nil {
+				return b, fmt.Errorf("header %s: %s", k, err)
+			}
+			hh[k] = append(hh[k], s)
+		}
+	}
+	b.HTTP.Headers = hh
+
+	return b, nil
+}
@@ -0,0 +1,86 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-secrets")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "token")
+	assert.Nil(t, ioutil.WriteFile(f, []byte("s3cr3t\n"), 0600))
+	assert.Nil(t, os.Setenv("K9S_TEST_TOKEN", "fred"))
+	defer os.Unsetenv("K9S_TEST_TOKEN")
+
+	uu := map[string]struct {
+		s, e string
+		err  bool
+	}{
+		"plain":    {s: "https://hooks.slack.com/blee", e: "https://hooks.slack.com/blee"},
+		"env":      {s: "${env:K9S_TEST_TOKEN}", e: "fred"},
+		"embedded": {s: "Bearer ${env:K9S_TEST_TOKEN}", e: "Bearer fred"},
+		"file":     {s: "${file:" + f + "}", e: "s3cr3t"},
+		"cmd":      {s: "${cmd:echo zorg}", e: "zorg"},
+		"many":     {s: "${env:K9S_TEST_TOKEN}:${file:" + f + "}", e: "fred:s3cr3t"},
+		"noEnv":    {s: "${env:K9S_TEST_NO_TOKEN}", err: true},
+		"noFile":   {s: "${file:" + filepath.Join(dir, "zorg") + "}", err: true},
+		"badCmd":   {s: "${cmd:false}", err: true},
+		"quoted":   {s: `${cmd:echo "my  entry"}`, e: "my  entry"},
+		"badQuote": {s: `${cmd:echo "my entry}`, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := config.ResolveSecrets(u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestIsSecretRef(t *testing.T) {
+	assert.True(t, config.IsSecretRef("Bearer ${cmd:pass show loki}"))
+	assert.False(t, config.IsSecretRef("Bearer $TOKEN"))
+}
+
+func TestLogBackendWithSecrets(t *testing.T) {
+	assert.Nil(t, os.Setenv("K9S_TEST_TOKEN", "fred"))
+	defer os.Unsetenv("K9S_TEST_TOKEN")
+
+	l := config.LogBackend{
+		Kind:    config.LogBackendLoki,
+		URL:     "http://loki:3100",
+		Headers: map[string]string{"Authorization": "Bearer ${env:K9S_TEST_TOKEN}"},
+	}
+	r, err := l.WithSecrets()
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer fred", r.Headers["Authorization"])
+	assert.Equal(t, "Bearer ${env:K9S_TEST_TOKEN}", l.Headers["Authorization"])
+}
+
+func TestBenchConfigWithSecrets(t *testing.T) {
+	assert.Nil(t, os.Setenv("K9S_TEST_TOKEN", "fred"))
+	defer os.Unsetenv("K9S_TEST_TOKEN")
+
+	b := config.BenchConfig{
+		Auth: config.Auth{User: "blee", Password: "${env:K9S_TEST_TOKEN}"},
+		HTTP: config.HTTP{Headers: map[string][]string{"X-Token": {"${env:K9S_TEST_TOKEN}"}}},
+	}
+	r, err := b.WithSecrets()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", r.Auth.Password)
+	assert.Equal(t, []string{"fred"}, r.HTTP.Headers["X-Token"])
+	assert.Equal(t, "${env:K9S_TEST_TOKEN}", b.Auth.Password)
+}
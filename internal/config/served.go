@@ -0,0 +1,19 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+// RemoteUserEnv tracks the ssh user of a session served by k9s serve.
+const RemoteUserEnv = "K9S_REMOTE_USER"
+
+// ErrServed indicates a feature reaching out to the host is off in served
+// sessions.
+var ErrServed = errors.New("not available in served sessions")
+
+// IsServed checks if K9s runs as a session served over ssh. Served sessions
+// never run host commands, read host paths or write dumps on the host.
+func IsServed() bool {
+	return os.Getenv(RemoteUserEnv) != ""
+}
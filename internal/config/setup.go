@@ -0,0 +1,162 @@
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// K9sSkins tracks a directory of skins to pick from on first run.
+var K9sSkins = filepath.Join(K9sHome, "skins")
+
+// StockSkin designates K9s default skin.
+const StockSkin = "stock"
+
+// configHeader documents K9s main settings atop a generated config.
+const configHeader = `# K9s configuration. Generated by the K9s setup wizard.
+#
+# k9s:
+#   refreshRate: views refresh rate in seconds.
+#   readOnly: hides actions mutating the cluster, ie delete, edit, shell, port-forward.
+#   currentContext/currentCluster: last viewed context and cluster.
+#   clusters.<name>.namespace.active: last viewed namespace in that cluster.
+#   clusters.<name>.view.active: last viewed resource in that cluster.
+#
+# Skins live in skin.yml, plugins in plugin.yml and hotkeys in hotkey.yml
+# next to this file. See https://k9scli.io for all settings.
+`
+
+const samplePlugins = `# K9s sample plugins. Shortcuts run a command on the selected resource.
+plugin:
+  # Tails the selected pod logs with kubectl using Shift-L.
+  tail:
+    shortCut: Shift-L
+    description: Tail logs
+    scopes:
+    - po
+    command: kubectl
+    background: false
+    args:
+    - logs
+    - -f
+    - $NAME
+    - -n
+    - $NAMESPACE
+    - --context
+    - $CONTEXT
+`
+
+const sampleHotKeys = `# K9s sample hotkeys. Shortcuts jump to a view from anywhere.
+hotKey:
+  shift-0:
+    shortCut: Shift-0
+    description: View pods
+    command: pods
+  shift-1:
+    shortCut: Shift-1
+    description: View deployments
+    command: dp
+`
+
+// Setup tracks first run setup choices.
+type Setup struct {
+	Context     string
+	Namespace   string
+	Skin        string
+	ReadOnly    bool
+	RefreshRate int
+	Samples     bool
+}
+
+// IsFirstRun returns true if no K9s config exists yet.
+func IsFirstRun(path string) bool {
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
+}
+
+// Skins returns the stock skin followed by the skins found in a directory.
+func Skins(dir string) []string {
+	ss := []string{StockSkin}
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return ss
+	}
+	var nn []string
+	for _, f := range ff {
+		if ext := filepath.Ext(f.Name()); !f.IsDir() && (ext == ".yml" || ext == ".yaml") {
+			nn = append(nn, strings.TrimSuffix(f.Name(), ext))
+		}
+	}
+	sort.Strings(nn)
+
+	return append(ss, nn...)
+}
+
+// InstallSkin copies a skin from a directory to the given skin file.
+// The stock skin removes the skin file.
+func InstallSkin(dir, skin, path string) error {
+	if skin == StockSkin {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	raw, err := ioutil.ReadFile(filepath.Join(dir, skin+".yml"))
+	if os.IsNotExist(err) {
+		raw, err = ioutil.ReadFile(filepath.Join(dir, skin+".yaml"))
+	}
+	if err != nil {
+		return err
+	}
+	EnsurePath(path, DefaultDirMod)
+
+	return ioutil.WriteFile(path, raw, 0644)
+}
+
+// WriteSamples writes sample plugins and hotkeys files unless already present.
+func WriteSamples(pluginsPath, hotKeysPath string) error {
+	for path, sample := range map[string]string{pluginsPath: samplePlugins, hotKeysPath: sampleHotKeys} {
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+		EnsurePath(path, DefaultDirMod)
+		if err := ioutil.WriteFile(path, []byte(sample), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply updates the configuration with the setup choices.
+func (s Setup) Apply(c *Config) error {
+	c.K9s.ReadOnly = s.ReadOnly
+	if s.RefreshRate > 0 {
+		c.K9s.RefreshRate = s.RefreshRate
+	}
+	if s.Namespace != "" {
+		return c.SetActiveNamespace(s.Namespace)
+	}
+
+	return nil
+}
+
+// leadingComments returns the comment block heading a file if any.
+func leadingComments(path string) []byte {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var b bytes.Buffer
+	for _, l := range bytes.SplitAfter(raw, []byte("\n")) {
+		if !bytes.HasPrefix(l, []byte("#")) {
+			break
+		}
+		b.Write(l)
+	}
+
+	return b.Bytes()
+}
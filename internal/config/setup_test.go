@@ -0,0 +1,104 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFirstRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-setup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yml")
+	assert.True(t, config.IsFirstRun(path))
+	assert.Nil(t, ioutil.WriteFile(path, []byte("k9s:\n"), 0644))
+	assert.False(t, config.IsFirstRun(path))
+}
+
+func TestSkins(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-skins")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, f := range []string{"zorg.yml", "dracula.yaml", "README.md"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, f), []byte("k9s:\n"), 0644))
+	}
+
+	assert.Equal(t, []string{"stock", "dracula", "zorg"}, config.Skins(dir))
+	assert.Equal(t, []string{"stock"}, config.Skins(filepath.Join(dir, "blee")))
+}
+
+func TestInstallSkin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-skins")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "zorg.yml"), []byte("zorg"), 0644))
+	dst := filepath.Join(dir, "k9s", "skin.yml")
+
+	assert.Nil(t, config.InstallSkin(dir, "zorg", dst))
+	raw, err := ioutil.ReadFile(dst)
+	assert.Nil(t, err)
+	assert.Equal(t, "zorg", string(raw))
+
+	assert.NotNil(t, config.InstallSkin(dir, "blee", dst))
+	assert.Nil(t, config.InstallSkin(dir, config.StockSkin, dst))
+	_, err = os.Stat(dst)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWriteSamples(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-samples")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	plugins, hotKeys := filepath.Join(dir, "plugin.yml"), filepath.Join(dir, "hotkey.yml")
+	assert.Nil(t, ioutil.WriteFile(hotKeys, []byte("hotKey: {}\n"), 0644))
+	assert.Nil(t, config.WriteSamples(plugins, hotKeys))
+
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins(plugins))
+	assert.Equal(t, 1, len(p.Plugin))
+	raw, err := ioutil.ReadFile(hotKeys)
+	assert.Nil(t, err)
+	assert.Equal(t, "hotKey: {}\n", string(raw))
+}
+
+func TestSetupApply(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+	assert.Nil(t, cfg.Load("test_assets/k9s.yml"))
+
+	s := config.Setup{Namespace: "ns2", ReadOnly: true, RefreshRate: 5}
+	assert.Nil(t, s.Apply(cfg))
+	assert.True(t, cfg.K9s.ReadOnly)
+	assert.Equal(t, 5, cfg.K9s.RefreshRate)
+	assert.Equal(t, "ns2", cfg.ActiveNamespace())
+}
+
+func TestConfigSaveCommented(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+	assert.Nil(t, cfg.Load("test_assets/k9s.yml"))
+
+	path := filepath.Join("/tmp", "k9s_commented.yml")
+	defer os.Remove(path)
+	assert.Nil(t, cfg.SaveCommented(path))
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(raw), "# K9s configuration."))
+
+	cfg.K9s.RefreshRate = 7
+	assert.Nil(t, cfg.SaveFile(path))
+	raw, err = ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(raw), "# K9s configuration."))
+	assert.Contains(t, string(raw), "refreshRate: 7")
+}
@@ -107,6 +107,7 @@ type (
 		BgColor     string      `yaml:"bgColor"`
 		CursorColor string      `yaml:"cursorColor"`
 		MarkColor   string      `yaml:"markColor"`
+		DeltaColor  string      `yaml:"deltaColor"`
 		Header      TableHeader `yaml:"header"`
 	}
 
@@ -246,6 +247,7 @@ func newTable() Table {
 		BgColor:     "black",
 		CursorColor: "aqua",
 		MarkColor:   "palegreen",
+		DeltaColor:  "orange",
 		Header:      newTableHeader(),
 	}
 }
@@ -1,10 +1,22 @@
 package config
 
-const defaultView = "po"
+import "strings"
+
+const (
+	defaultView  = "po"
+	overviewView = "overview"
+)
 
 // View tracks view configuration options.
 type View struct {
-	Active string `yaml:"active"`
+	Active string                          `yaml:"active"`
+	States map[string]map[string]ViewState `yaml:"states,omitempty"`
+}
+
+// ViewState tracks a resource view filter and sort order.
+type ViewState struct {
+	Filter     string `yaml:"filter,omitempty"`
+	SortColumn string `yaml:"sortColumn,omitempty"`
 }
 
 // NewView creates a new view configuration.
@@ -18,3 +30,59 @@ func (v *View) Validate() {
 		v.Active = defaultView
 	}
 }
+
+// Reset clears out the active view and view states.
+func (v *View) Reset() {
+	v.Active, v.States = defaultView, nil
+}
+
+// State returns a given resource view state in a context.
+func (v *View) State(ctx, gvr string) ViewState {
+	return v.States[ctx][gvr]
+}
+
+// SetState records a resource view state in a context. Returns true if the
+// state changed.
+func (v *View) SetState(ctx, gvr string, s ViewState) bool {
+	if v.States[ctx][gvr] == s {
+		return false
+	}
+	if s == (ViewState{}) {
+		delete(v.States[ctx], gvr)
+		if len(v.States[ctx]) == 0 {
+			delete(v.States, ctx)
+		}
+		return true
+	}
+	if v.States == nil {
+		v.States = make(map[string]map[string]ViewState)
+	}
+	if v.States[ctx] == nil {
+		v.States[ctx] = make(map[string]ViewState)
+	}
+	v.States[ctx][gvr] = s
+
+	return true
+}
+
+// NewSortColumn returns a sort column spec ie NAME:asc.
+func NewSortColumn(name string, asc bool) string {
+	if name == "" {
+		return ""
+	}
+	if asc {
+		return name + ":asc"
+	}
+
+	return name + ":desc"
+}
+
+// Sort returns the sorted column name and order.
+func (s ViewState) Sort() (string, bool) {
+	tokens := strings.Split(s.SortColumn, ":")
+	if len(tokens) < 2 {
+		return tokens[0], true
+	}
+
+	return tokens[0], tokens[1] != "desc"
+}
@@ -23,3 +23,28 @@ func TestViewValidateBlank(t *testing.T) {
 	v.Validate()
 	assert.Equal(t, "po", v.Active)
 }
+
+func TestViewState(t *testing.T) {
+	v := config.NewView()
+
+	assert.Equal(t, config.ViewState{}, v.State("ctx1", "v1/pods"))
+	s := config.ViewState{Filter: "fred", SortColumn: config.NewSortColumn("AGE", false)}
+	assert.True(t, v.SetState("ctx1", "v1/pods", s))
+	assert.False(t, v.SetState("ctx1", "v1/pods", s))
+	assert.Equal(t, s, v.State("ctx1", "v1/pods"))
+
+	col, asc := v.State("ctx1", "v1/pods").Sort()
+	assert.Equal(t, "AGE", col)
+	assert.False(t, asc)
+
+	assert.Equal(t, config.ViewState{}, v.State("ctx2", "v1/pods"))
+
+	assert.True(t, v.SetState("ctx1", "v1/pods", config.ViewState{}))
+	assert.Equal(t, 0, len(v.States))
+
+	v.Active = "svc"
+	v.SetState("ctx1", "v1/pods", s)
+	v.Reset()
+	assert.Equal(t, "po", v.Active)
+	assert.Equal(t, 0, len(v.States))
+}
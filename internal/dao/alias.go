@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -70,13 +71,25 @@ func (a *Alias) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 
 // AsGVR returns a matching gvr if it exists.
 func (a *Alias) AsGVR(cmd string) (client.GVR, bool) {
-	gvr, ok := a.Aliases.Get(cmd)
+	exp, ok := a.Aliases.Get(cmd)
 	if ok {
+		gvr, _ := config.SplitAlias(exp)
 		return client.NewGVR(gvr), true
 	}
 	return client.GVR{}, false
 }
 
+// Args returns the command arguments an alias expands to if any.
+func (a *Alias) Args(cmd string) []string {
+	exp, ok := a.Aliases.Get(cmd)
+	if !ok {
+		return nil
+	}
+	_, args := config.SplitAlias(exp)
+
+	return args
+}
+
 // Get fetch a resource.
 func (a *Alias) Get(_ context.Context, _ string) (runtime.Object, error) {
 	return nil, errors.New("NYI!!")
@@ -95,6 +108,51 @@ func (a *Alias) load() error {
 		return err
 	}
 
+	return defineResources(a.Aliases)
+}
+
+// BuiltinAliases returns K9s default and api server resources aliases.
+func BuiltinAliases() (config.Alias, error) {
+	a := config.NewAliases()
+	a.LoadDefaults()
+	if err := defineResources(a); err != nil {
+		return nil, err
+	}
+
+	return a.Alias, nil
+}
+
+// CheckAliases validates custom aliases resolve to known resources.
+// It returns the built in aliases the custom ones shadow if any.
+func CheckAliases(custom, builtins config.Alias) ([]string, error) {
+	var (
+		errs     []string
+		shadowed []string
+	)
+	for k, v := range custom {
+		gvr, _ := config.SplitAlias(v)
+		if gvr == "" {
+			errs = append(errs, fmt.Sprintf("alias %q has no resource", k))
+			continue
+		}
+		if _, err := MetaFor(client.NewGVR(gvr)); err != nil {
+			errs = append(errs, fmt.Sprintf("alias %q: unknown resource %q", k, gvr))
+			continue
+		}
+		if b, ok := builtins[k]; ok && b != v {
+			shadowed = append(shadowed, fmt.Sprintf("%s (%s)", k, b))
+		}
+	}
+	sort.Strings(shadowed)
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return shadowed, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return shadowed, nil
+}
+
+func defineResources(a config.Aliases) error {
 	for _, gvr := range AllGVRs() {
 		meta, err := MetaFor(gvr)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
@@ -28,6 +29,54 @@ func TestAliasList(t *testing.T) {
 	assert.Equal(t, 2, len(oo[0].(render.AliasRes).Aliases))
 }
 
+func TestAliasArgs(t *testing.T) {
+	a := makeAliases()
+	a.Alias["prodweb"] = "v1/pods prod -l app=web"
+
+	gvr, ok := a.AsGVR("prodweb")
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", gvr.String())
+	assert.Equal(t, []string{"prod", "-l", "app=web"}, a.Args("prodweb"))
+	assert.Equal(t, []string{}, a.Args("fred"))
+	assert.Nil(t, a.Args("zorg"))
+}
+
+func TestCheckAliases(t *testing.T) {
+	dao.RegisterMeta("v1/pods", metav1.APIResource{Name: "pods", Kind: "Pod", Namespaced: true})
+	builtins := config.Alias{"po": "v1/pods", "svc": "v1/services"}
+
+	uu := map[string]struct {
+		custom   config.Alias
+		shadowed []string
+		err      string
+	}{
+		"ok": {
+			custom: config.Alias{"pp": "v1/pods prod -l app=web", "po": "v1/pods"},
+		},
+		"shadow": {
+			custom:   config.Alias{"svc": "v1/pods"},
+			shadowed: []string{"svc (v1/services)"},
+		},
+		"unknown": {
+			custom: config.Alias{"zz": "v1/zorg", "aa": ""},
+			err:    "alias \"aa\" has no resource\nalias \"zz\": unknown resource \"v1/zorg\"",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			shadowed, err := dao.CheckAliases(u.custom, builtins)
+			assert.Equal(t, u.shadowed, shadowed)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
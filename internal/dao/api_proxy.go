@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/client-go/rest"
+)
+
+// APIProxyResponse represents a response fetched through the api server proxy.
+type APIProxyResponse struct {
+	URL    string
+	Status int
+	Body   string
+}
+
+// APIProxyGet issues a GET request against a service or a pod port through
+// the api server proxy sub resource. Error statuses are returned as responses
+// so their body may be inspected.
+func APIProxyGet(ctx context.Context, c client.Connection, gvr, path, port, subPath string) (APIProxyResponse, error) {
+	res, err := apiProxyResource(gvr)
+	if err != nil {
+		return APIProxyResponse{}, err
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := c.CanI(ns, gvr+":proxy", []string{client.GetVerb})
+	if err != nil {
+		return APIProxyResponse{}, err
+	}
+	if !auth {
+		return APIProxyResponse{}, fmt.Errorf("user is not authorized to proxy to %s", path)
+	}
+
+	req, err := apiProxyRequest(c.DialOrDie().CoreV1().RESTClient().Get(), ns, res, n, port, subPath)
+	if err != nil {
+		return APIProxyResponse{}, err
+	}
+	var code int
+	body, err := req.Context(ctx).Do().StatusCode(&code).Raw()
+	if code == 0 && err != nil {
+		return APIProxyResponse{}, err
+	}
+
+	return APIProxyResponse{
+		URL:    req.URL().String(),
+		Status: code,
+		Body:   prettyBody(body),
+	}, nil
+}
+
+// String renders the response.
+func (r APIProxyResponse) String() string {
+	return fmt.Sprintf("# GET %s\n# %d %s\n\n%s", r.URL, r.Status, http.StatusText(r.Status), r.Body)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func apiProxyResource(gvr string) (string, error) {
+	switch gvr {
+	case "v1/services":
+		return "services", nil
+	case "v1/pods":
+		return "pods", nil
+	default:
+		return "", fmt.Errorf("api proxy is not supported on %s", gvr)
+	}
+}
+
+func apiProxyRequest(req *rest.Request, ns, res, n, port, subPath string) (*rest.Request, error) {
+	u, err := url.Parse(subPath)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Namespace(ns).
+		Resource(res).
+		Name(n + ":" + port).
+		SubResource("proxy")
+	if p := strings.TrimPrefix(u.Path, "/"); p != "" {
+		req = req.Suffix(p)
+	}
+	for k, vv := range u.Query() {
+		for _, v := range vv {
+			req = req.Param(k, v)
+		}
+	}
+
+	return req, nil
+}
+
+// PrettyBody indents json bodies.
+func prettyBody(body []byte) string {
+	var out bytes.Buffer
+	if json.Indent(&out, body, "", "  ") == nil {
+		return out.String()
+	}
+
+	return string(body)
+}
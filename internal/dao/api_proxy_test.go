@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestAPIProxyRequest(t *testing.T) {
+	uu := map[string]struct {
+		res, port, subPath, e string
+	}{
+		"root": {
+			res:     "services",
+			port:    "80",
+			subPath: "/",
+			e:       "http://localhost/api/v1/namespaces/default/services/fred:80/proxy",
+		},
+		"path": {
+			res:     "pods",
+			port:    "http",
+			subPath: "/healthz?verbose=1",
+			e:       "http://localhost/api/v1/namespaces/default/pods/fred:http/proxy/healthz?verbose=1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			base, _ := url.Parse("http://localhost")
+			c, err := rest.NewRESTClient(base, "/api/v1", rest.ContentConfig{NegotiatedSerializer: scheme.Codecs}, 0, 0, nil, nil)
+			assert.Nil(t, err)
+			req, err := apiProxyRequest(c.Get(), "default", u.res, "fred", u.port, u.subPath)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, req.URL().String())
+		})
+	}
+}
+
+func TestPrettyBody(t *testing.T) {
+	assert.Equal(t, "{\n  \"a\": 1\n}", prettyBody([]byte(`{"a":1}`)))
+	assert.Equal(t, "hello", prettyBody([]byte("hello")))
+}
@@ -0,0 +1,142 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// DefaultAppLabel represents the default label used to group workloads into applications.
+	DefaultAppLabel = "app.kubernetes.io/name"
+
+	appVersionLabel = "app.kubernetes.io/version"
+)
+
+var _ Accessor = (*Application)(nil)
+
+// AppWorkloads tracks workloads grouped into applications.
+var AppWorkloads = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+}
+
+// Application tracks workloads grouped by an application label.
+type Application struct {
+	NonResource
+}
+
+// List returns all applications and their aggregated health.
+func (a *Application) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	label, _ := ctx.Value(internal.KeyAppLabel).(string)
+	if label == "" {
+		label = DefaultAppLabel
+	}
+	sel, err := labels.Parse(label)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := make(map[string]*render.ApplicationRes)
+	for _, gvr := range AppWorkloads {
+		oo, err := a.Factory.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Application scan skipped %q", gvr)
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+			}
+			name, ok := appName(label, u.GetLabels(), podLabelsOf(u))
+			if !ok {
+				continue
+			}
+			app := appFor(apps, u.GetNamespace(), name)
+			app.Workloads = append(app.Workloads, u.GetKind()+"/"+u.GetName())
+		}
+	}
+
+	oo, err := a.Factory.List("v1/pods", ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		addAppPod(appFor(apps, po.Namespace, po.Labels[label]), &po)
+	}
+
+	res := make([]runtime.Object, 0, len(apps))
+	for _, app := range apps {
+		sort.Strings(app.Workloads)
+		sort.Strings(app.Versions)
+		res = append(res, *app)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func appName(label string, ll ...map[string]string) (string, bool) {
+	for _, l := range ll {
+		if v, ok := l[label]; ok && v != "" {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func appFor(apps map[string]*render.ApplicationRes, ns, name string) *render.ApplicationRes {
+	id := client.FQN(ns, name)
+	if _, ok := apps[id]; !ok {
+		apps[id] = &render.ApplicationRes{Namespace: ns, Name: name}
+	}
+
+	return apps[id]
+}
+
+func addAppPod(a *render.ApplicationRes, po *v1.Pod) {
+	a.Pods++
+	if isPodReady(po) {
+		a.Ready++
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		a.Restarts += int(s.RestartCount)
+	}
+
+	for _, v := range podVersions(po) {
+		if !in(a.Versions, v) {
+			a.Versions = append(a.Versions, v)
+		}
+	}
+}
+
+func podVersions(po *v1.Pod) []string {
+	if v, ok := po.Labels[appVersionLabel]; ok {
+		return []string{v}
+	}
+	vv := make([]string, 0, len(po.Spec.Containers))
+	for _, c := range po.Spec.Containers {
+		_, tag := splitImage(c.Image)
+		vv = append(vv, tag)
+	}
+
+	return vv
+}
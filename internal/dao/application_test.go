@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppName(t *testing.T) {
+	uu := map[string]struct {
+		ll   []map[string]string
+		name string
+		ok   bool
+	}{
+		"workload": {
+			ll:   []map[string]string{{DefaultAppLabel: "fred"}, {DefaultAppLabel: "blee"}},
+			name: "fred",
+			ok:   true,
+		},
+		"template": {
+			ll:   []map[string]string{{"app": "fred"}, {DefaultAppLabel: "blee"}},
+			name: "blee",
+			ok:   true,
+		},
+		"none": {
+			ll: []map[string]string{{"app": "fred"}, nil},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, ok := appName(DefaultAppLabel, u.ll...)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.name, name)
+		})
+	}
+}
+
+func TestAddAppPod(t *testing.T) {
+	var a render.ApplicationRes
+	addAppPod(&a, makeAppPod("p1", map[string]string{appVersionLabel: "1.0"}, true, 2, "fred:0.1"))
+	addAppPod(&a, makeAppPod("p2", nil, false, 1, "fred:0.2", "localhost:5000/blee"))
+	addAppPod(&a, makeAppPod("p3", map[string]string{appVersionLabel: "1.0"}, true, 0, "fred:0.1"))
+
+	assert.Equal(t, 3, a.Pods)
+	assert.Equal(t, 2, a.Ready)
+	assert.Equal(t, 3, a.Restarts)
+	assert.Equal(t, []string{"1.0", "0.2", "latest"}, a.Versions)
+}
+
+func makeAppPod(n string, ll map[string]string, ready bool, restarts int32, images ...string) *v1.Pod {
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, Labels: ll}}
+	for i, img := range images {
+		po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: n + string(rune('a'+i)), Image: img})
+	}
+	po.Status.ContainerStatuses = []v1.ContainerStatus{{RestartCount: restarts}}
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	po.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+
+	return &po
+}
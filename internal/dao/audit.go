@@ -0,0 +1,279 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const auditLokiWindow = time.Hour
+
+var (
+	_ Accessor  = (*Audit)(nil)
+	_ Describer = (*Audit)(nil)
+)
+
+// AuditFilter narrows audit events by resource, user or verb. Blank fields
+// match all events.
+type AuditFilter struct {
+	Resource  string
+	Namespace string
+	Name      string
+	User      string
+	Verb      string
+}
+
+// Matches checks if an audit event matches the filter.
+func (f AuditFilter) Matches(e *AuditEvent) bool {
+	switch {
+	case f.User != "" && e.User.Username != f.User:
+		return false
+	case f.Verb != "" && e.Verb != f.Verb:
+		return false
+	case f.Resource == "":
+		return true
+	case e.ObjectRef == nil || e.ObjectRef.Resource != f.Resource:
+		return false
+	case f.Namespace != "" && e.ObjectRef.Namespace != f.Namespace:
+		return false
+	default:
+		return f.Name == "" || e.ObjectRef.Name == f.Name
+	}
+}
+
+// AuditEvent represents a kubernetes audit event.
+type AuditEvent struct {
+	Level      string   `json:"level"`
+	AuditID    string   `json:"auditID"`
+	Stage      string   `json:"stage"`
+	RequestURI string   `json:"requestURI"`
+	Verb       string   `json:"verb"`
+	SourceIPs  []string `json:"sourceIPs,omitempty"`
+	UserAgent  string   `json:"userAgent,omitempty"`
+	User       struct {
+		Username string   `json:"username"`
+		Groups   []string `json:"groups,omitempty"`
+	} `json:"user"`
+	ObjectRef *struct {
+		Resource    string `json:"resource,omitempty"`
+		Namespace   string `json:"namespace,omitempty"`
+		Name        string `json:"name,omitempty"`
+		APIGroup    string `json:"apiGroup,omitempty"`
+		APIVersion  string `json:"apiVersion,omitempty"`
+		Subresource string `json:"subresource,omitempty"`
+	} `json:"objectRef,omitempty"`
+	ResponseStatus *struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp metav1.MicroTime `json:"requestReceivedTimestamp"`
+	StageTimestamp           metav1.MicroTime `json:"stageTimestamp"`
+
+	raw []byte
+}
+
+// ID returns a unique event identifier.
+func (e *AuditEvent) ID() string {
+	return e.AuditID + ":" + e.Stage
+}
+
+// Audit tracks audit events from a cluster audit log source.
+type Audit struct {
+	NonResource
+
+	mx     sync.RWMutex
+	events map[string][]byte
+}
+
+// List returns the most recent audit events matching the context filter.
+func (a *Audit) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	cfg, ok := ctx.Value(internal.KeyAuditCfg).(*config.Audit)
+	if !ok || cfg == nil {
+		return nil, errors.New("no audit log source configured for this cluster")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg, err := cfg.WithSecrets()
+	if err != nil {
+		return nil, err
+	}
+	filter, _ := ctx.Value(internal.KeyAuditFilter).(AuditFilter)
+
+	ee, err := a.fetch(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(map[string][]byte, len(ee))
+	oo := make([]runtime.Object, 0, len(ee))
+	for i := range ee {
+		if !filter.Matches(&ee[i]) {
+			continue
+		}
+		events[ee[i].ID()] = ee[i].raw
+		oo = append(oo, auditRes(&ee[i]))
+	}
+	a.mx.Lock()
+	a.events = events
+	a.mx.Unlock()
+
+	return oo, nil
+}
+
+// Describe returns the audit event as yaml.
+func (a *Audit) Describe(path string) (string, error) {
+	return a.ToYAML(path)
+}
+
+// ToYAML returns the audit event as yaml.
+func (a *Audit) ToYAML(path string) (string, error) {
+	a.mx.RLock()
+	raw, ok := a.events[path]
+	a.mx.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("no audit event found for %s", path)
+	}
+	bb, err := yaml.JSONToYAML(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func (a *Audit) fetch(ctx context.Context, cfg *config.Audit) ([]AuditEvent, error) {
+	switch cfg.Source {
+	case config.AuditSourceExec:
+		var out, errOut bytes.Buffer
+		err := Exec(ctx, a.Client(), ExecOptions{
+			Path:      cfg.Pod,
+			Container: cfg.Container,
+			Command:   []string{"tail", "-n", strconv.Itoa(cfg.Lines), cfg.Path},
+			Stdout:    &out,
+			Stderr:    &errOut,
+		})
+		if err != nil {
+			if msg := strings.TrimSpace(errOut.String()); msg != "" {
+				return nil, fmt.Errorf("%s: %s", err, msg)
+			}
+			return nil, err
+		}
+		return ParseAuditLog(out.Bytes()), nil
+	case config.AuditSourceHTTP:
+		raw, err := httpGet(ctx, cfg.URL, cfg.Headers)
+		if err != nil {
+			return nil, err
+		}
+		return lastAuditEvents(ParseAuditLog(raw), cfg.Lines), nil
+	case config.AuditSourceLoki:
+		now := time.Now()
+		ll, err := LokiQuery(ctx, cfg.URL, cfg.Headers, cfg.Query, now.Add(-auditLokiWindow), now, cfg.Lines)
+		if err != nil {
+			return nil, err
+		}
+		var b bytes.Buffer
+		for _, l := range ll {
+			b.WriteString(l.Line + "\n")
+		}
+		return ParseAuditLog(b.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("unknown audit source %q", cfg.Source)
+	}
+}
+
+// ParseAuditLog parses audit events either as json lines or as an event list.
+// Malformed lines are skipped.
+func ParseAuditLog(raw []byte) []AuditEvent {
+	var list struct {
+		Kind  string            `json:"kind"`
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &list); err == nil && list.Kind == "EventList" {
+		ee := make([]AuditEvent, 0, len(list.Items))
+		for _, item := range list.Items {
+			if e, ok := parseAuditEvent(item); ok {
+				ee = append(ee, e)
+			}
+		}
+		return ee
+	}
+
+	var ee []AuditEvent
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if e, ok := parseAuditEvent(scanner.Bytes()); ok {
+			ee = append(ee, e)
+		}
+	}
+
+	return ee
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseAuditEvent(raw []byte) (AuditEvent, bool) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return AuditEvent{}, false
+	}
+	var e AuditEvent
+	if err := json.Unmarshal(raw, &e); err != nil || e.AuditID == "" {
+		return AuditEvent{}, false
+	}
+	e.raw = append([]byte(nil), raw...)
+
+	return e, true
+}
+
+func lastAuditEvents(ee []AuditEvent, n int) []AuditEvent {
+	if n > 0 && len(ee) > n {
+		return ee[len(ee)-n:]
+	}
+
+	return ee
+}
+
+func auditRes(e *AuditEvent) render.AuditRes {
+	res := render.AuditRes{
+		ID:    e.ID(),
+		Time:  e.StageTimestamp.Time,
+		Stage: e.Stage,
+		User:  e.User.Username,
+		Verb:  e.Verb,
+		URI:   e.RequestURI,
+	}
+	if res.Time.IsZero() {
+		res.Time = e.RequestReceivedTimestamp.Time
+	}
+	if len(e.SourceIPs) > 0 {
+		res.SourceIP = e.SourceIPs[0]
+	}
+	if r := e.ObjectRef; r != nil {
+		res.Resource, res.Namespace, res.Name = r.Resource, r.Namespace, r.Name
+		if r.Subresource != "" {
+			res.Resource += "/" + r.Subresource
+		}
+	}
+	if s := e.ResponseStatus; s != nil {
+		res.Code = s.Code
+	}
+
+	return res
+}
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuditLog(t *testing.T) {
+	raw, err := ioutil.ReadFile("test_assets/audit.log")
+	assert.Nil(t, err)
+
+	ee := ParseAuditLog(raw)
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, "a1:ResponseComplete", ee[0].ID())
+	assert.Equal(t, "bob", ee[0].User.Username)
+	assert.Equal(t, "fred", ee[0].ObjectRef.Name)
+	assert.Equal(t, 403, ee[1].ResponseStatus.Code)
+	assert.Equal(t, "list", ee[2].Verb)
+}
+
+func TestParseAuditLogEventList(t *testing.T) {
+	raw := `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[
+  {"auditID":"a1","stage":"RequestReceived","verb":"get","user":{"username":"bob"}},
+  {"auditID":"a1","stage":"ResponseComplete","verb":"get","user":{"username":"bob"}}
+]}`
+
+	ee := ParseAuditLog([]byte(raw))
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "a1:RequestReceived", ee[0].ID())
+	assert.Equal(t, "a1:ResponseComplete", ee[1].ID())
+}
+
+func TestAuditFilterMatches(t *testing.T) {
+	raw, err := ioutil.ReadFile("test_assets/audit.log")
+	assert.Nil(t, err)
+	ee := ParseAuditLog(raw)
+
+	uu := map[string]struct {
+		f AuditFilter
+		e []string
+	}{
+		"none": {
+			e: []string{"a1", "a2", "a3"},
+		},
+		"user": {
+			f: AuditFilter{User: "bob"},
+			e: []string{"a1", "a3"},
+		},
+		"verb": {
+			f: AuditFilter{User: "bob", Verb: "delete"},
+			e: []string{"a1"},
+		},
+		"resource": {
+			f: AuditFilter{Resource: "pods", Namespace: "default", Name: "fred"},
+			e: []string{"a1", "a2"},
+		},
+		"namespace": {
+			f: AuditFilter{Resource: "deployments", Namespace: "default"},
+		},
+		"clusterScoped": {
+			f: AuditFilter{Resource: "deployments"},
+			e: []string{"a3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ids []string
+			for i := range ee {
+				if u.f.Matches(&ee[i]) {
+					ids = append(ids, ee[i].AuditID)
+				}
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestAuditRes(t *testing.T) {
+	raw, err := ioutil.ReadFile("test_assets/audit.log")
+	assert.Nil(t, err)
+	ee := ParseAuditLog(raw)
+
+	res := auditRes(&ee[1])
+	assert.Equal(t, "pods/exec", res.Resource)
+	assert.Equal(t, "alice", res.User)
+	assert.Equal(t, 403, res.Code)
+	assert.Equal(t, "", res.SourceIP)
+
+	res = auditRes(&ee[0])
+	assert.Equal(t, "10.0.0.1", res.SourceIP)
+	assert.Equal(t, 2020, res.Time.Year())
+}
+
+func TestLastAuditEvents(t *testing.T) {
+	ee := []AuditEvent{{AuditID: "a1"}, {AuditID: "a2"}, {AuditID: "a3"}}
+
+	assert.Equal(t, ee[1:], lastAuditEvents(ee, 2))
+	assert.Equal(t, ee, lastAuditEvents(ee, 5))
+	assert.Equal(t, ee, lastAuditEvents(ee, 0))
+}
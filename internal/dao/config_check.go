@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ConfigCheck)(nil)
+
+// ConfigCheck tracks K9s configuration files problems.
+type ConfigCheck struct {
+	NonResource
+}
+
+// List returns all K9s configuration files problems.
+func (c *ConfigCheck) List(context.Context, string) ([]runtime.Object, error) {
+	dd := config.Diagnose(KnownGVR)
+	oo := make([]runtime.Object, 0, len(dd))
+	for i, d := range dd {
+		oo = append(oo, render.ConfigCheckRes{Index: i, Diagnostic: d})
+	}
+
+	return oo, nil
+}
+
+// KnownGVR checks if a resource is known to K9s.
+func KnownGVR(gvr string) bool {
+	_, err := MetaFor(client.NewGVR(gvr))
+	return err == nil
+}
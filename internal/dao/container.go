@@ -76,6 +76,7 @@ func (c *Container) TailLogs(ctx context.Context, logChan chan<- string, opts Lo
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
 		return err
 	}
+	opts.Node = po.Spec.NodeName
 
 	return tailLogs(ctx, c, logChan, opts)
 }
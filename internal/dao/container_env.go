@@ -0,0 +1,329 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EnvVar represents a container environment variable as the container sees it.
+type EnvVar struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	From    string `json:"from,omitempty"`
+	Missing string `json:"missing,omitempty"`
+	Secret  bool   `json:"-"`
+}
+
+// EnvLookup fetches the data of a configmap or secret.
+type EnvLookup func(gvr, path string) (map[string]string, error)
+
+// ResolveEnv returns the environment of a pod container, resolving envFrom
+// sources, configmap/secret keys and downward API fields.
+func ResolveEnv(f Factory, path, co string) ([]EnvVar, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, err
+	}
+	for _, c := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if c.Name == co {
+			return resolveEnv(&po, &c, factoryLookup(f)), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no container %q found in pod %s", co, path)
+}
+
+func factoryLookup(f Factory) EnvLookup {
+	cache := make(map[string]map[string]string)
+	return func(gvr, path string) (map[string]string, error) {
+		if m, ok := cache[gvr+":"+path]; ok {
+			return m, nil
+		}
+		o, err := f.Get(gvr, path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		m := make(map[string]string)
+		if gvr == "v1/secrets" {
+			var sec v1.Secret
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+				return nil, err
+			}
+			for k, v := range sec.Data {
+				m[k] = string(v)
+			}
+			for k, v := range sec.StringData {
+				m[k] = v
+			}
+		} else {
+			var cm v1.ConfigMap
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cm); err != nil {
+				return nil, err
+			}
+			for k, v := range cm.Data {
+				m[k] = v
+			}
+			for k, v := range cm.BinaryData {
+				m[k] = string(v)
+			}
+		}
+		cache[gvr+":"+path] = m
+
+		return m, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ResolveEnv computes a container environment. Explicit env entries override
+// envFrom ones and may reference previously defined variables using $(VAR).
+func resolveEnv(po *v1.Pod, co *v1.Container, lookup EnvLookup) []EnvVar {
+	var (
+		ee  []EnvVar
+		idx = make(map[string]int)
+	)
+	set := func(e EnvVar) {
+		if i, ok := idx[e.Name]; ok {
+			ee[i] = e
+			return
+		}
+		idx[e.Name] = len(ee)
+		ee = append(ee, e)
+	}
+	values := func() map[string]string {
+		m := make(map[string]string, len(ee))
+		for _, e := range ee {
+			if e.Missing == "" {
+				m[e.Name] = e.Value
+			}
+		}
+		return m
+	}
+
+	for _, src := range co.EnvFrom {
+		gvr, name, optional, secret := "v1/configmaps", "", false, false
+		switch {
+		case src.ConfigMapRef != nil:
+			name, optional = src.ConfigMapRef.Name, isOptional(src.ConfigMapRef.Optional)
+		case src.SecretRef != nil:
+			gvr, name, optional, secret = "v1/secrets", src.SecretRef.Name, isOptional(src.SecretRef.Optional), true
+		default:
+			continue
+		}
+		from := envRef(gvr, name, "")
+		data, err := lookup(gvr, client.FQN(po.Namespace, name))
+		if err != nil {
+			set(EnvVar{Name: src.Prefix + "*", From: from, Missing: missingRef(err, optional)})
+			continue
+		}
+		kk := make([]string, 0, len(data))
+		for k := range data {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		for _, k := range kk {
+			set(EnvVar{Name: src.Prefix + k, Value: data[k], From: from, Secret: secret})
+		}
+	}
+
+	for _, env := range co.Env {
+		if env.ValueFrom == nil {
+			set(EnvVar{Name: env.Name, Value: expandEnv(env.Value, values())})
+			continue
+		}
+		set(resolveEnvSource(po, co, env, lookup))
+	}
+
+	return ee
+}
+
+func resolveEnvSource(po *v1.Pod, co *v1.Container, env v1.EnvVar, lookup EnvLookup) EnvVar {
+	e, src := EnvVar{Name: env.Name}, env.ValueFrom
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+		e.From = envRef("v1/configmaps", ref.Name, ref.Key)
+		e.Value, e.Missing = lookupKey(lookup, "v1/configmaps", client.FQN(po.Namespace, ref.Name), ref.Key, isOptional(ref.Optional))
+	case src.SecretKeyRef != nil:
+		ref := src.SecretKeyRef
+		e.From, e.Secret = envRef("v1/secrets", ref.Name, ref.Key), true
+		e.Value, e.Missing = lookupKey(lookup, "v1/secrets", client.FQN(po.Namespace, ref.Name), ref.Key, isOptional(ref.Optional))
+	case src.FieldRef != nil:
+		e.From = "fieldRef " + src.FieldRef.FieldPath
+		e.Value, e.Missing = podField(po, src.FieldRef.FieldPath)
+	case src.ResourceFieldRef != nil:
+		e.From = "resourceFieldRef " + src.ResourceFieldRef.Resource
+		e.Value, e.Missing = containerResource(co, src.ResourceFieldRef)
+	}
+
+	return e
+}
+
+func lookupKey(lookup EnvLookup, gvr, path, key string, optional bool) (string, string) {
+	data, err := lookup(gvr, path)
+	if err != nil {
+		return "", missingRef(err, optional)
+	}
+	v, ok := data[key]
+	if !ok {
+		return "", missingRef(fmt.Errorf("key %q not found", key), optional)
+	}
+
+	return v, ""
+}
+
+// PodField resolves a downward API field path.
+func podField(po *v1.Pod, path string) (string, string) {
+	if k, ok := fieldKey(path, "metadata.labels"); ok {
+		return po.Labels[k], ""
+	}
+	if k, ok := fieldKey(path, "metadata.annotations"); ok {
+		return po.Annotations[k], ""
+	}
+
+	switch path {
+	case "metadata.name":
+		return po.Name, ""
+	case "metadata.namespace":
+		return po.Namespace, ""
+	case "metadata.uid":
+		return string(po.UID), ""
+	case "spec.nodeName":
+		return po.Spec.NodeName, ""
+	case "spec.serviceAccountName":
+		return po.Spec.ServiceAccountName, ""
+	case "status.hostIP":
+		return po.Status.HostIP, ""
+	case "status.podIP":
+		return po.Status.PodIP, ""
+	case "status.podIPs":
+		ii := make([]string, 0, len(po.Status.PodIPs))
+		for _, ip := range po.Status.PodIPs {
+			ii = append(ii, ip.IP)
+		}
+		return strings.Join(ii, ","), ""
+	default:
+		return "", fmt.Sprintf("unsupported field path %q", path)
+	}
+}
+
+func fieldKey(path, prefix string) (string, bool) {
+	if !strings.HasPrefix(path, prefix+"['") || !strings.HasSuffix(path, "']") {
+		return "", false
+	}
+
+	return path[len(prefix)+2 : len(path)-2], true
+}
+
+// ContainerResource resolves a resource field reference the way the kubelet
+// does, rounding up to the divisor.
+func containerResource(co *v1.Container, ref *v1.ResourceFieldSelector) (string, string) {
+	tokens := strings.SplitN(ref.Resource, ".", 2)
+	if len(tokens) != 2 {
+		return "", fmt.Sprintf("unsupported resource %q", ref.Resource)
+	}
+	rl := co.Resources.Limits
+	if tokens[0] == "requests" {
+		rl = co.Resources.Requests
+	}
+	q, ok := rl[v1.ResourceName(tokens[1])]
+	if !ok {
+		if tokens[0] == "limits" {
+			return "", "no limit set, defaults to the node allocatable"
+		}
+		return "0", ""
+	}
+
+	div := ref.Divisor
+	if div.IsZero() {
+		div = resource.MustParse("1")
+	}
+	if tokens[1] == string(v1.ResourceCPU) {
+		return fmt.Sprintf("%d", ceilDiv(q.MilliValue(), div.MilliValue())), ""
+	}
+
+	return fmt.Sprintf("%d", ceilDiv(q.Value(), div.Value())), ""
+}
+
+func ceilDiv(a, b int64) int64 {
+	if b == 0 {
+		return 0
+	}
+
+	return (a + b - 1) / b
+}
+
+// ExpandEnv substitutes $(VAR) references with known values. Unknown
+// references are kept as is and $$ escapes a literal $.
+func expandEnv(s string, vars map[string]string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '(':
+			end := strings.IndexByte(s[i+2:], ')')
+			if end < 0 {
+				b.WriteByte(s[i])
+				continue
+			}
+			ref := s[i : i+2+end+1]
+			if v, ok := vars[s[i+2:i+2+end]]; ok {
+				b.WriteString(v)
+			} else {
+				b.WriteString(ref)
+			}
+			i += len(ref) - 1
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
+
+func envRef(gvr, name, key string) string {
+	kind := "configMap"
+	if gvr == "v1/secrets" {
+		kind = "secret"
+	}
+	if key == "" {
+		return kind + " " + name
+	}
+
+	return kind + " " + name + "[" + key + "]"
+}
+
+func missingRef(err error, optional bool) string {
+	if optional {
+		return err.Error() + " (optional)"
+	}
+
+	return err.Error()
+}
+
+func isOptional(b *bool) bool {
+	return b != nil && *b
+}
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveEnv(t *testing.T) {
+	optional := true
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "fred",
+			Labels:    map[string]string{"app": "blee"},
+		},
+		Spec:   v1.PodSpec{NodeName: "n1"},
+		Status: v1.PodStatus{PodIP: "10.0.0.1"},
+	}
+	co := v1.Container{
+		Name: "c1",
+		EnvFrom: []v1.EnvFromSource{
+			{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+			{Prefix: "S_", SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+			{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "nope"}}},
+		},
+		Env: []v1.EnvVar{
+			{Name: "A", Value: "override"},
+			{Name: "URL", Value: "http://$(HOST):$(PORT)/$$(HOST)"},
+			{Name: "POD", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			{Name: "APP", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels['app']"}}},
+			{Name: "IP", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+			{Name: "PWD", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}, Key: "pwd"}}},
+			{Name: "GONE", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}, Key: "zorg"}}},
+			{Name: "OPT", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "nope"}, Key: "a", Optional: &optional}}},
+			{Name: "CPU", ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.cpu", Divisor: resource.MustParse("1m")}}},
+			{Name: "MEM", ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.memory", Divisor: resource.MustParse("1Mi")}}},
+		},
+		Resources: v1.ResourceRequirements{
+			Limits: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("250m"),
+			},
+		},
+	}
+	lookup := func(gvr, path string) (map[string]string, error) {
+		switch gvr + ":" + path {
+		case "v1/configmaps:default/cm1":
+			return map[string]string{"A": "a", "HOST": "h1", "PORT": "80"}, nil
+		case "v1/secrets:default/s1":
+			return map[string]string{"pwd": "s3cr3t"}, nil
+		default:
+			return nil, errors.New("not found")
+		}
+	}
+
+	ee := resolveEnv(&po, &co, lookup)
+	m := make(map[string]EnvVar, len(ee))
+	for _, e := range ee {
+		m[e.Name] = e
+	}
+
+	assert.Equal(t, 14, len(ee))
+	assert.Equal(t, "override", m["A"].Value)
+	assert.Equal(t, "", m["A"].From)
+	assert.Equal(t, "configMap cm1", m["HOST"].From)
+	assert.Equal(t, "http://h1:80/$(HOST)", m["URL"].Value)
+	assert.True(t, m["S_pwd"].Secret)
+	assert.Equal(t, "s3cr3t", m["PWD"].Value)
+	assert.Equal(t, "secret s1[pwd]", m["PWD"].From)
+	assert.Equal(t, "not found", m["*"].Missing)
+	assert.Equal(t, "fred", m["POD"].Value)
+	assert.Equal(t, "blee", m["APP"].Value)
+	assert.Equal(t, "10.0.0.1", m["IP"].Value)
+	assert.Equal(t, `key "zorg" not found`, m["GONE"].Missing)
+	assert.Equal(t, "not found (optional)", m["OPT"].Missing)
+	assert.Equal(t, "250", m["CPU"].Value)
+	assert.Equal(t, "no limit set, defaults to the node allocatable", m["MEM"].Missing)
+}
+
+func TestExpandEnv(t *testing.T) {
+	vars := map[string]string{"A": "1", "B": "2"}
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain":     {s: "fred", e: "fred"},
+		"refs":      {s: "$(A)-$(B)", e: "1-2"},
+		"unknown":   {s: "$(C)", e: "$(C)"},
+		"escaped":   {s: "$$(A)", e: "$(A)"},
+		"unclosed":  {s: "$(A", e: "$(A"},
+		"trailing":  {s: "a$", e: "a$"},
+		"shellLike": {s: "$A", e: "$A"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, expandEnv(u.s, vars))
+		})
+	}
+}
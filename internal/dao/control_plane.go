@@ -0,0 +1,166 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	apiServerComponent = "kube-apiserver"
+	featureGatesFlag   = "feature-gates"
+)
+
+var _ Accessor = (*ControlPlane)(nil)
+
+// ControlPlane tracks control plane components health and api server settings.
+type ControlPlane struct {
+	NonResource
+}
+
+// List returns component statuses, api server health checks and flags.
+func (c *ControlPlane) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	var rr []render.ControlPlaneRes
+	rr = append(rr, c.componentStatuses()...)
+	rr = append(rr, c.healthChecks(render.SourceReadyz, "/readyz")...)
+	rr = append(rr, c.healthChecks(render.SourceLivez, "/livez")...)
+	rr = append(rr, c.apiServerFlags()...)
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+func (c *ControlPlane) componentStatuses() []render.ControlPlaneRes {
+	cc, err := c.Client().DialOrDie().CoreV1().ComponentStatuses().List(metav1.ListOptions{})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Component statuses unavailable")
+		return nil
+	}
+
+	rr := make([]render.ControlPlaneRes, 0, len(cc.Items))
+	for _, cs := range cc.Items {
+		rr = append(rr, componentHealth(cs))
+	}
+
+	return rr
+}
+
+func (c *ControlPlane) healthChecks(source, path string) []render.ControlPlaneRes {
+	raw, err := c.Client().DialOrDie().Discovery().RESTClient().Get().AbsPath(path).Param("verbose", "true").DoRaw()
+	if len(raw) == 0 && err != nil {
+		return []render.ControlPlaneRes{{Source: source, Name: path, Status: render.HealthFailed, Detail: err.Error()}}
+	}
+
+	return parseHealthChecks(source, string(raw))
+}
+
+func (c *ControlPlane) apiServerFlags() []render.ControlPlaneRes {
+	sel := labels.SelectorFromSet(labels.Set{"component": apiServerComponent})
+	oo, err := c.Factory.List("v1/pods", "kube-system", true, sel)
+	if err != nil || len(oo) == 0 {
+		log.Warn().Err(err).Msgf("No visible %s pods", apiServerComponent)
+		return nil
+	}
+
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(oo[0].(*unstructured.Unstructured).Object, &po); err != nil {
+		log.Warn().Err(err).Msgf("Pod conversion failed")
+		return nil
+	}
+
+	return parseAPIServerFlags(po.Spec)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func componentHealth(cs v1.ComponentStatus) render.ControlPlaneRes {
+	r := render.ControlPlaneRes{Source: render.SourceComponent, Name: cs.Name, Status: render.HealthFailed}
+	for _, cond := range cs.Conditions {
+		if cond.Type != v1.ComponentHealthy {
+			continue
+		}
+		if cond.Status == v1.ConditionTrue {
+			r.Status = render.HealthOK
+		}
+		r.Detail = cond.Message
+		if cond.Error != "" {
+			r.Detail = cond.Error
+		}
+	}
+
+	return r
+}
+
+// parseHealthChecks parses a verbose health endpoint response ie [+]etcd ok.
+func parseHealthChecks(source, raw string) []render.ControlPlaneRes {
+	var rr []render.ControlPlaneRes
+	for _, l := range strings.Split(raw, "\n") {
+		l = strings.TrimSpace(l)
+		if len(l) < 4 || l[0] != '[' || l[2] != ']' {
+			continue
+		}
+		tokens := strings.SplitN(l[3:], " ", 2)
+		r := render.ControlPlaneRes{Source: source, Name: tokens[0], Status: render.HealthOK}
+		if l[1] != '+' {
+			r.Status = render.HealthFailed
+		}
+		if len(tokens) > 1 {
+			r.Detail = tokens[1]
+		}
+		rr = append(rr, r)
+	}
+
+	return rr
+}
+
+// parseAPIServerFlags extracts the api server flags and feature gates from its pod spec.
+func parseAPIServerFlags(spec v1.PodSpec) []render.ControlPlaneRes {
+	var rr []render.ControlPlaneRes
+	for _, co := range spec.Containers {
+		if co.Name != apiServerComponent {
+			continue
+		}
+		for _, a := range append(co.Command, co.Args...) {
+			if !strings.HasPrefix(a, "--") {
+				continue
+			}
+			tokens := strings.SplitN(strings.TrimPrefix(a, "--"), "=", 2)
+			var val string
+			if len(tokens) > 1 {
+				val = tokens[1]
+			}
+			if tokens[0] != featureGatesFlag {
+				rr = append(rr, render.ControlPlaneRes{Source: render.SourceFlag, Name: tokens[0], Detail: val})
+				continue
+			}
+			for _, g := range strings.Split(val, ",") {
+				kv := strings.SplitN(g, "=", 2)
+				if len(kv) != 2 {
+					continue
+				}
+				rr = append(rr, render.ControlPlaneRes{Source: render.SourceFeatureGate, Name: kv[0], Detail: kv[1]})
+			}
+		}
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		if rr[i].Source != rr[j].Source {
+			return rr[i].Source == render.SourceFeatureGate
+		}
+		return rr[i].Name < rr[j].Name
+	})
+
+	return rr
+}
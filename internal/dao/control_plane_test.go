@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseHealthChecks(t *testing.T) {
+	raw := `[+]ping ok
+[+]log ok
+[-]etcd failed: reason withheld
+[+]poststarthook/start-kube-aggregator-informers ok
+healthz check failed
+`
+	rr := parseHealthChecks(render.SourceReadyz, raw)
+	assert.Equal(t, 4, len(rr))
+	assert.Equal(t, render.ControlPlaneRes{Source: render.SourceReadyz, Name: "ping", Status: render.HealthOK, Detail: "ok"}, rr[0])
+	assert.Equal(t, render.ControlPlaneRes{Source: render.SourceReadyz, Name: "etcd", Status: render.HealthFailed, Detail: "failed: reason withheld"}, rr[2])
+	assert.Equal(t, "poststarthook/start-kube-aggregator-informers", rr[3].Name)
+}
+
+func TestParseAPIServerFlags(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name: apiServerComponent,
+				Command: []string{
+					"kube-apiserver",
+					"--secure-port=6443",
+					"--feature-gates=TTLAfterFinished=true,EphemeralContainers=false",
+					"--allow-privileged",
+				},
+			},
+			{Name: "sidecar", Args: []string{"--fred=blee"}},
+		},
+	}
+
+	rr := parseAPIServerFlags(spec)
+	assert.Equal(t, []render.ControlPlaneRes{
+		{Source: render.SourceFeatureGate, Name: "EphemeralContainers", Detail: "false"},
+		{Source: render.SourceFeatureGate, Name: "TTLAfterFinished", Detail: "true"},
+		{Source: render.SourceFlag, Name: "allow-privileged"},
+		{Source: render.SourceFlag, Name: "secure-port", Detail: "6443"},
+	}, rr)
+}
+
+func TestComponentHealth(t *testing.T) {
+	cs := v1.ComponentStatus{
+		ObjectMeta: metav1.ObjectMeta{Name: "etcd-0"},
+		Conditions: []v1.ComponentCondition{
+			{Type: v1.ComponentHealthy, Status: v1.ConditionFalse, Error: "dial tcp: connection refused"},
+		},
+	}
+	assert.Equal(t, render.ControlPlaneRes{Source: render.SourceComponent, Name: "etcd-0", Status: render.HealthFailed, Detail: "dial tcp: connection refused"}, componentHealth(cs))
+
+	cs.Conditions[0] = v1.ComponentCondition{Type: v1.ComponentHealthy, Status: v1.ConditionTrue, Message: `{"health":"true"}`}
+	assert.Equal(t, render.HealthOK, componentHealth(cs).Status)
+}
@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// A collection of copy conflict policies.
+const (
+	CopySkip      = "skip"
+	CopyOverwrite = "overwrite"
+	CopyRename    = "rename"
+)
+
+// CopyConflicts tracks copy conflict policies.
+var CopyConflicts = []string{CopySkip, CopyOverwrite, CopyRename}
+
+const maxCopyRenames = 10
+
+// CopyOptions represents a copy destination and how to handle existing resources.
+type CopyOptions struct {
+	// Namespace to copy to. Ignored for cluster scoped resources.
+	Namespace string
+	// Context to copy to. Empty means the current context.
+	Context string
+	// Conflict specifies what to do when the destination exists.
+	Conflict string
+}
+
+// Copy copies a resource to another namespace and/or context. It returns the
+// destination path or an empty path if the copy was skipped.
+func (g *Generic) Copy(path string, opts CopyOptions) (string, error) {
+	o, err := g.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	ns, _ := client.Namespaced(path)
+	if !client.IsClusterScoped(ns) && opts.Namespace != "" {
+		ns = opts.Namespace
+	}
+	dial, err := g.dynDialFor(opts.Context)
+	if err != nil {
+		return "", err
+	}
+	res := dial.Resource(g.gvr.GVR())
+	dst := res.Namespace(ns)
+	if client.IsClusterScoped(ns) {
+		dst = res
+	}
+
+	return copyTo(dst, copyOf(u, ns), opts.Conflict)
+}
+
+func (g *Generic) dynDialFor(ctx string) (dynamic.Interface, error) {
+	if current, err := g.Client().Config().CurrentContextName(); err == nil && (ctx == "" || ctx == current) {
+		return g.Client().DynDialOrDie(), nil
+	}
+	cfg, err := g.Client().Config().ForContext(ctx).RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(cfg)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func copyTo(dst dynamic.ResourceInterface, u *unstructured.Unstructured, conflict string) (string, error) {
+	name := u.GetName()
+	for i := 0; i <= maxCopyRenames; i++ {
+		existing, err := dst.Get(u.GetName(), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			_, err = dst.Create(u, metav1.CreateOptions{})
+			return copyPath(u), err
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch conflict {
+		case CopyOverwrite:
+			u.SetResourceVersion(existing.GetResourceVersion())
+			_, err = dst.Update(u, metav1.UpdateOptions{})
+			return copyPath(u), err
+		case CopyRename:
+			u.SetName(name + "-copy")
+			if i > 0 {
+				u.SetName(name + "-copy-" + strconv.Itoa(i+1))
+			}
+		default:
+			log.Debug().Msgf("Copy skipped. %s already exists", copyPath(u))
+			return "", nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to find a free name to copy %s", name)
+}
+
+func copyPath(u *unstructured.Unstructured) string {
+	return client.FQN(u.GetNamespace(), u.GetName())
+}
+
+// copyOf returns a resource stripped from its server populated fields.
+func copyOf(u *unstructured.Unstructured, ns string) *unstructured.Unstructured {
+	c := u.DeepCopy()
+	if client.IsClusterScoped(ns) {
+		ns = ""
+	}
+	c.SetNamespace(ns)
+	for _, f := range []string{"uid", "resourceVersion", "selfLink", "creationTimestamp", "generation", "managedFields", "ownerReferences", "deletionTimestamp", "deletionGracePeriodSeconds"} {
+		unstructured.RemoveNestedField(c.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(c.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	unstructured.RemoveNestedField(c.Object, "status")
+	if c.GetKind() == "Service" {
+		unstructured.RemoveNestedField(c.Object, "spec", "clusterIP")
+	}
+
+	return c
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestCopyOf(t *testing.T) {
+	u := makeCopyCM("default", "fred")
+	u.Object["metadata"].(map[string]interface{})["uid"] = "u1"
+	u.Object["metadata"].(map[string]interface{})["resourceVersion"] = "10"
+	u.Object["metadata"].(map[string]interface{})["annotations"] = map[string]interface{}{
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		"blee": "duh",
+	}
+	u.Object["status"] = map[string]interface{}{"phase": "ok"}
+
+	c := copyOf(u, "blee")
+	assert.Equal(t, "blee", c.GetNamespace())
+	assert.Equal(t, "", string(c.GetUID()))
+	assert.Equal(t, "", c.GetResourceVersion())
+	assert.Equal(t, map[string]string{"blee": "duh"}, c.GetAnnotations())
+	_, ok := c.Object["status"]
+	assert.False(t, ok)
+	assert.Equal(t, "default", u.GetNamespace())
+}
+
+func TestCopyTo(t *testing.T) {
+	uu := map[string]struct {
+		conflict, e string
+	}{
+		"new":       {conflict: CopySkip, e: "blee/blee"},
+		"skip":      {conflict: CopySkip, e: ""},
+		"overwrite": {conflict: CopyOverwrite, e: "blee/fred"},
+		"rename":    {conflict: CopyRename, e: "blee/fred-copy-2"},
+	}
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewSimpleDynamicClient(runtime.NewScheme(),
+				makeCopyCM("blee", "fred"),
+				makeCopyCM("blee", "fred-copy"),
+			)
+			n := "fred"
+			if k == "new" {
+				n = "blee"
+			}
+			path, err := copyTo(dial.Resource(gvr).Namespace("blee"), makeCopyCM("blee", n), u.conflict)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, path)
+		})
+	}
+}
+
+func makeCopyCM(ns, n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"namespace": ns, "name": n},
+		"data":       map[string]interface{}{"a": "b"},
+	}}
+}
@@ -22,10 +22,38 @@ type CustomResourceDefinition struct {
 func (c *CustomResourceDefinition) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
 	lsel := labels.Everything()
-	if sel, e := labels.ConvertSelectorToLabelsMap(strLabel); ok && e == nil {
-		lsel = sel.AsSelector()
+	if sel, e := labels.Parse(strLabel); ok && e == nil {
+		lsel = sel
 	}
 
 	const gvr = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
 	return c.Factory.List(gvr, "-", true, lsel)
 }
+
+// Schema returns the openAPI schema for a given CRD.
+func (c *CustomResourceDefinition) Schema(path string) (*SchemaNode, error) {
+	o, err := c.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+	crd, err := toCRD(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToCRDSchema(crd)
+}
+
+// Skeleton returns a sample custom resource manifest for a given CRD.
+func (c *CustomResourceDefinition) Skeleton(path string) (string, error) {
+	o, err := c.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+	crd, err := toCRD(o)
+	if err != nil {
+		return "", err
+	}
+
+	return ToCRDSkeleton(crd)
+}
@@ -0,0 +1,211 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// SchemaNode represents a CRD openAPI schema property.
+type SchemaNode struct {
+	Name        string
+	Type        string
+	Format      string
+	Description string
+	Default     interface{}
+	Enum        []interface{}
+	Required    bool
+	Children    []*SchemaNode
+}
+
+// CRDInfo represents CRD metadata needed to build custom resources.
+type CRDInfo struct {
+	Group, Version, Kind string
+	Namespaced           bool
+}
+
+// APIVersion returns the CRD resource api version.
+func (c CRDInfo) APIVersion() string {
+	return c.Group + "/" + c.Version
+}
+
+// ToCRDInfo extracts custom resource info from a CRD.
+func ToCRDInfo(crd *unstructured.Unstructured) (CRDInfo, error) {
+	var info CRDInfo
+	info.Group, _, _ = unstructured.NestedString(crd.Object, "spec", "group")
+	info.Kind, _, _ = unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+	info.Namespaced = isNamespaced(scope)
+	if info.Version = storageVersion(crd); info.Version == "" {
+		return info, fmt.Errorf("no version found for crd %s", crd.GetName())
+	}
+
+	return info, nil
+}
+
+// ToCRDSchema extracts the openAPI schema for the CRD storage version.
+func ToCRDSchema(crd *unstructured.Unstructured) (*SchemaNode, error) {
+	raw, ok := crdSchema(crd)
+	if !ok {
+		return nil, fmt.Errorf("no openAPI schema defined for crd %s", crd.GetName())
+	}
+
+	return toSchemaNode(crd.GetName(), raw, false), nil
+}
+
+// ToCRDSkeleton generates a skeleton custom resource manifest for a given CRD.
+func ToCRDSkeleton(crd *unstructured.Unstructured) (string, error) {
+	info, err := ToCRDInfo(crd)
+	if err != nil {
+		return "", err
+	}
+
+	meta := map[string]interface{}{"name": "CHANGE_ME"}
+	if info.Namespaced {
+		meta["namespace"] = "default"
+	}
+	o := map[string]interface{}{
+		"apiVersion": info.APIVersion(),
+		"kind":       info.Kind,
+		"metadata":   meta,
+	}
+	if root, err := ToCRDSchema(crd); err == nil {
+		for _, c := range root.Children {
+			switch c.Name {
+			case "apiVersion", "kind", "metadata", "status":
+				continue
+			}
+			o[c.Name] = c.Sample()
+		}
+	}
+
+	raw, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// Sample returns a sample value for the schema node.
+func (s *SchemaNode) Sample() interface{} {
+	if s.Default != nil {
+		return s.Default
+	}
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+
+	switch s.Type {
+	case "object":
+		m := make(map[string]interface{}, len(s.Children))
+		for _, c := range s.Children {
+			m[c.Name] = c.Sample()
+		}
+		return m
+	case "array":
+		if len(s.Children) == 1 {
+			return []interface{}{s.Children[0].Sample()}
+		}
+		return []interface{}{}
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	default:
+		return ""
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func storageVersion(crd *unstructured.Unstructured) string {
+	vv, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := m["storage"].(bool); storage {
+			n, _ := m["name"].(string)
+			return n
+		}
+	}
+	v, _, _ := unstructured.NestedString(crd.Object, "spec", "version")
+
+	return v
+}
+
+func crdSchema(crd *unstructured.Unstructured) (map[string]interface{}, bool) {
+	version := storageVersion(crd)
+	vv, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != version {
+			continue
+		}
+		if s, ok, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema"); ok {
+			return s, true
+		}
+	}
+	s, ok, _ := unstructured.NestedMap(crd.Object, "spec", "validation", "openAPIV3Schema")
+
+	return s, ok
+}
+
+func toSchemaNode(name string, m map[string]interface{}, required bool) *SchemaNode {
+	n := SchemaNode{
+		Name:     name,
+		Required: required,
+		Default:  m["default"],
+	}
+	n.Type, _ = m["type"].(string)
+	n.Format, _ = m["format"].(string)
+	n.Description, _ = m["description"].(string)
+	n.Enum, _ = m["enum"].([]interface{})
+
+	if items, ok := m["items"].(map[string]interface{}); ok {
+		n.Children = append(n.Children, toSchemaNode("[]", items, false))
+	}
+
+	props, ok := m["properties"].(map[string]interface{})
+	if !ok {
+		return &n
+	}
+	req := make(map[string]struct{})
+	if rr, ok := m["required"].([]interface{}); ok {
+		for _, r := range rr {
+			if s, ok := r.(string); ok {
+				req[s] = struct{}{}
+			}
+		}
+	}
+	kk := make([]string, 0, len(props))
+	for k := range props {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		p, ok := props[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		_, isReq := req[k]
+		n.Children = append(n.Children, toSchemaNode(k, p, isReq))
+	}
+
+	return &n
+}
+
+func toCRD(o interface{}) (*unstructured.Unstructured, error) {
+	crd, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.New("expecting an unstructured crd")
+	}
+
+	return crd, nil
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToCRDInfo(t *testing.T) {
+	info, err := ToCRDInfo(loadJSON(t, "crd"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, CRDInfo{Group: "k9s.io", Version: "v1alpha1", Kind: "Flea", Namespaced: true}, info)
+	assert.Equal(t, "k9s.io/v1alpha1", info.APIVersion())
+}
+
+func TestToCRDSchema(t *testing.T) {
+	root, err := ToCRDSchema(loadJSON(t, "crd"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(root.Children))
+	spec := root.Children[3]
+	assert.Equal(t, "spec", spec.Name)
+	assert.Equal(t, "Flea specification.", spec.Description)
+	assert.Equal(t, []string{"color", "hosts", "jumpy", "size"}, schemaNames(spec.Children))
+	assert.True(t, spec.Children[3].Required)
+	assert.Equal(t, true, spec.Children[2].Default)
+	assert.Equal(t, "[]", spec.Children[1].Children[0].Name)
+}
+
+func TestToCRDSkeleton(t *testing.T) {
+	raw, err := ToCRDSkeleton(loadJSON(t, "crd"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, `apiVersion: k9s.io/v1alpha1
+kind: Flea
+metadata:
+  name: CHANGE_ME
+  namespace: default
+spec:
+  color: red
+  hosts:
+  - ""
+  jumpy: true
+  size: 0
+`, raw)
+}
+
+// Helpers...
+
+func schemaNames(nn []*SchemaNode) []string {
+	ss := make([]string, 0, len(nn))
+	for _, n := range nn {
+		ss = append(ss, n.Name)
+	}
+
+	return ss
+}
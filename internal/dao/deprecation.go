@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+var _ Accessor = (*Deprecation)(nil)
+
+// Deprecation tracks live resources using deprecated api versions.
+type Deprecation struct {
+	NonResource
+}
+
+// List returns all resources last applied using a deprecated api version.
+func (d *Deprecation) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	var serverVersion string
+	if info, err := d.Client().ServerVersion(); err == nil {
+		serverVersion = info.GitVersion
+	} else {
+		log.Warn().Err(err).Msgf("Unable to fetch server version")
+	}
+
+	kinds := client.DeprecatedKinds()
+	var oo []runtime.Object
+	for _, gvr := range AllGVRs() {
+		meta, err := MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) {
+			continue
+		}
+		if _, ok := kinds[meta.Kind]; !ok {
+			continue
+		}
+		lns := ns
+		if !meta.Namespaced {
+			lns = client.ClusterScope
+		}
+		rr, err := d.Factory.List(gvr.String(), lns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Deprecation scan skipped %q", gvr)
+			continue
+		}
+		for _, o := range rr {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+			}
+			dep, ok := DeprecationFor(u)
+			if !ok {
+				continue
+			}
+			oo = append(oo, render.DeprecationRes{
+				GVR:           gvr.String(),
+				Path:          client.MetaFQN(metav1.ObjectMeta{Namespace: u.GetNamespace(), Name: u.GetName()}),
+				Deprecation:   dep,
+				ServerVersion: serverVersion,
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+// DeprecationFor checks if a resource was last applied using a deprecated api version.
+func DeprecationFor(u *unstructured.Unstructured) (client.Deprecation, bool) {
+	if dep, ok := client.DeprecationFor(u.GetAPIVersion(), u.GetKind()); ok {
+		return dep, true
+	}
+	raw, ok := u.GetAnnotations()[lastAppliedKey]
+	if !ok {
+		return client.Deprecation{}, false
+	}
+	var last struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := yaml.Unmarshal([]byte(raw), &last); err != nil {
+		return client.Deprecation{}, false
+	}
+
+	return client.DeprecationFor(last.APIVersion, last.Kind)
+}
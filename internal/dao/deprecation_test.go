@@ -0,0 +1,35 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDeprecationFor(t *testing.T) {
+	uu := map[string]struct {
+		lastApplied string
+		e           bool
+	}{
+		"none":       {},
+		"current":    {lastApplied: `{"apiVersion":"apps/v1","kind":"Deployment"}`},
+		"deprecated": {lastApplied: `{"apiVersion":"extensions/v1beta1","kind":"Deployment"}`, e: true},
+		"toast":      {lastApplied: `{"apiVersion":`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{}
+			o.SetAPIVersion("apps/v1")
+			o.SetKind("Deployment")
+			if u.lastApplied != "" {
+				o.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": u.lastApplied})
+			}
+			_, ok := dao.DeprecationFor(&o)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
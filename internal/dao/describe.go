@@ -1,14 +1,26 @@
 package dao
 
 import (
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/kubectl/pkg/describe"
 	"k8s.io/kubectl/pkg/describe/versioned"
 )
 
+var describeSettings = describe.DescriberSettings{ShowEvents: true}
+
 // Describe describes a resource.
 func Describe(c client.Connection, gvr client.GVR, path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = client.AllNamespaces
+	}
+
 	mapper := RestMapper{Connection: c}
 	m, err := mapper.ToRESTMapper()
 	if err != nil {
@@ -18,24 +30,57 @@ func Describe(c client.Connection, gvr client.GVR, path string) (string, error)
 
 	gvk, err := m.KindFor(gvr.GVR())
 	if err != nil {
-		log.Error().Err(err).Msgf("No GVK for resource %s", gvr)
+		log.Warn().Err(err).Msgf("No GVK for resource %s. Using generic describer", gvr)
+		return describeGeneric(c, gvr, ns, n)
+	}
+	mapping, err := mapper.ResourceFor(gvr.AsResourceName(), gvk.Kind)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to find mapper for %s %s. Using generic describer", gvr, n)
+		return describeGeneric(c, gvr, ns, n)
+	}
+	d, err := versioned.Describer(c.Config().Flags(), mapping)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to find describer for %#v", mapping)
 		return "", err
 	}
 
-	ns, n := client.Namespaced(path)
-	if client.IsClusterScoped(ns) {
-		ns = client.AllNamespaces
+	return d.Describe(ns, n, describeSettings)
+}
+
+// DescribeGeneric describes a resource the discovery knows nothing about
+// from its unstructured content.
+func describeGeneric(c client.Connection, gvr client.GVR, ns, n string) (string, error) {
+	var (
+		u   *unstructured.Unstructured
+		err error
+	)
+	dial := c.DynDialOrDie().Resource(gvr.GVR())
+	if ns == client.AllNamespaces {
+		u, err = dial.Get(n, metav1.GetOptions{})
+	} else {
+		u, err = dial.Namespace(ns).Get(n, metav1.GetOptions{})
 	}
-	mapping, err := mapper.ResourceFor(gvr.AsResourceName(), gvk.Kind)
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to find mapper for %s %s", gvr, n)
 		return "", err
 	}
-	d, err := versioned.Describer(c.Config().Flags(), mapping)
+
+	scope := meta.RESTScopeRoot
+	if u.GetNamespace() != "" {
+		scope = meta.RESTScopeNamespace
+	}
+	mapping := meta.RESTMapping{
+		Resource:         gvr.GVR(),
+		GroupVersionKind: u.GroupVersionKind(),
+		Scope:            scope,
+	}
+	cfg, err := c.Config().RESTConfig()
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to find describer for %#v", mapping)
 		return "", err
 	}
+	d, ok := versioned.GenericDescriberFor(&mapping, cfg)
+	if !ok {
+		return "", fmt.Errorf("no describer found for %s", gvr)
+	}
 
-	return d.Describe(ns, n, describe.DescriberSettings{ShowEvents: true})
+	return d.Describe(ns, n, describeSettings)
 }
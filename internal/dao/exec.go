@@ -0,0 +1,211 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/pty"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// ExecOptions represents a container command execution.
+type ExecOptions struct {
+	Path      string
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	TTY       bool
+	Sizes     remotecommand.TerminalSizeQueue
+}
+
+// Exec runs a command in a container until it exits or the context is
+// canceled.
+func Exec(ctx context.Context, c client.Connection, opts ExecOptions) error {
+	ns, po := client.Namespaced(opts.Path)
+	auth, err := c.CanI(ns, "v1/pods:exec", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec in pod %s", opts.Path)
+	}
+
+	req := c.DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(po).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil && !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+
+	cfg, err := c.Config().RESTConfig()
+	if err != nil {
+		return err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return err
+	}
+	exec, err := remotecommand.NewSPDYExecutorForTransports(transport, &ctxUpgrader{Upgrader: upgrader, ctx: ctx}, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+	err = exec.Stream(remotecommand.StreamOptions{
+		Stdin:             opts.Stdin,
+		Stdout:            opts.Stdout,
+		Stderr:            opts.Stderr,
+		Tty:               opts.TTY,
+		TerminalSizeQueue: opts.Sizes,
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// ExecSpawner spawns a command in a container attached to a remote terminal.
+func ExecSpawner(c client.Connection, path, co string, cmd []string) Spawner {
+	return func(cols, rows uint16) (pty.Terminal, Process, error) {
+		inR, inW := io.Pipe()
+		outR, outW := io.Pipe()
+		t := execTerminal{
+			stdin:  inW,
+			stdout: outR,
+			sizes:  make(chan remotecommand.TerminalSize, 1),
+			done:   make(chan struct{}),
+		}
+		_ = t.Resize(cols, rows)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := execProcess{cancel: cancel, done: make(chan struct{})}
+		go func() {
+			p.err = Exec(ctx, c, ExecOptions{
+				Path:      path,
+				Container: co,
+				Command:   cmd,
+				Stdin:     inR,
+				Stdout:    outW,
+				TTY:       true,
+				Sizes:     &t,
+			})
+			_ = outW.Close()
+			_ = inR.Close()
+			close(t.done)
+			close(p.done)
+		}()
+
+		return &t, &p, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CtxUpgrader closes exec connections once their context is canceled.
+type ctxUpgrader struct {
+	spdy.Upgrader
+
+	ctx context.Context
+}
+
+// NewConnection creates a new connection.
+func (u *ctxUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-u.ctx.Done():
+			_ = conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+
+	return conn, nil
+}
+
+// ExecTerminal represents a remote container terminal.
+type execTerminal struct {
+	stdin  *io.PipeWriter
+	stdout *io.PipeReader
+	sizes  chan remotecommand.TerminalSize
+	done   chan struct{}
+}
+
+// Read reads the terminal output.
+func (t *execTerminal) Read(bb []byte) (int, error) {
+	return t.stdout.Read(bb)
+}
+
+// Write writes the terminal input.
+func (t *execTerminal) Write(bb []byte) (int, error) {
+	return t.stdin.Write(bb)
+}
+
+// Close closes the terminal streams.
+func (t *execTerminal) Close() error {
+	_ = t.stdin.Close()
+
+	return t.stdout.Close()
+}
+
+// Resize queues a terminal resize, superseding any pending one.
+func (t *execTerminal) Resize(cols, rows uint16) error {
+	if cols == 0 || rows == 0 {
+		return nil
+	}
+	select {
+	case <-t.sizes:
+	default:
+	}
+	t.sizes <- remotecommand.TerminalSize{Width: cols, Height: rows}
+
+	return nil
+}
+
+// Next returns the next terminal size or nil once the exec completes.
+func (t *execTerminal) Next() *remotecommand.TerminalSize {
+	select {
+	case s := <-t.sizes:
+		return &s
+	case <-t.done:
+		return nil
+	}
+}
+
+type execProcess struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// Wait waits for the exec to complete.
+func (p *execProcess) Wait() error {
+	<-p.done
+
+	return p.err
+}
+
+// Hangup terminates the exec.
+func (p *execProcess) Hangup() error {
+	p.cancel()
+
+	return nil
+}
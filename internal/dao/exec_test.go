@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+func TestExecTerminalSizes(t *testing.T) {
+	term := execTerminal{
+		sizes: make(chan remotecommand.TerminalSize, 1),
+		done:  make(chan struct{}),
+	}
+
+	assert.Nil(t, term.Resize(0, 24))
+	assert.Nil(t, term.Resize(80, 24))
+	assert.Nil(t, term.Resize(120, 40))
+	assert.Equal(t, &remotecommand.TerminalSize{Width: 120, Height: 40}, term.Next())
+
+	close(term.done)
+	assert.Nil(t, term.Next())
+}
@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type patchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// IsTerminating checks if a resource deletion is pending.
+func IsTerminating(u *unstructured.Unstructured) bool {
+	return u.GetDeletionTimestamp() != nil
+}
+
+// FinalizersOf returns a resource finalizers, including namespace spec finalizers.
+func FinalizersOf(u *unstructured.Unstructured) []string {
+	ff := u.GetFinalizers()
+	if u.GetKind() != "Namespace" {
+		return ff
+	}
+	ss, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "finalizers")
+
+	return append(ff, ss...)
+}
+
+// PruneSpecFinalizers removes the given namespace spec finalizers in place.
+// Returns true if any were removed. Spec finalizers can only be updated via
+// the namespace finalize subresource.
+func PruneSpecFinalizers(u *unstructured.Unstructured, finalizers []string) bool {
+	if u.GetKind() != "Namespace" {
+		return false
+	}
+	ss, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "finalizers")
+	kept := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if !in(finalizers, s) {
+			kept = append(kept, s)
+		}
+	}
+	if len(kept) == len(ss) {
+		return false
+	}
+	if len(kept) == 0 {
+		unstructured.RemoveNestedField(u.Object, "spec", "finalizers")
+		return true
+	}
+	_ = unstructured.SetNestedStringSlice(u.Object, kept, "spec", "finalizers")
+
+	return true
+}
+
+// PruneMetaPatch returns a JSON patch removing the given finalizers and owner
+// references. Each removal is guarded by a test op so a concurrent change
+// fails the patch instead of removing the wrong entry.
+func PruneMetaPatch(u *unstructured.Unstructured, finalizers []string, owners []types.UID) ([]byte, error) {
+	var ops []patchOp
+	ff := u.GetFinalizers()
+	for i := len(ff) - 1; i >= 0; i-- {
+		if !in(finalizers, ff[i]) {
+			continue
+		}
+		p := fmt.Sprintf("/metadata/finalizers/%d", i)
+		ops = append(ops, patchOp{Op: "test", Path: p, Value: ff[i]}, patchOp{Op: "remove", Path: p})
+	}
+	refs := u.GetOwnerReferences()
+	for i := len(refs) - 1; i >= 0; i-- {
+		if !hasUID(owners, refs[i].UID) {
+			continue
+		}
+		p := fmt.Sprintf("/metadata/ownerReferences/%d", i)
+		ops = append(ops, patchOp{Op: "test", Path: p + "/uid", Value: refs[i].UID}, patchOp{Op: "remove", Path: p})
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("nothing to remove on %s", u.GetName())
+	}
+
+	return json.Marshal(ops)
+}
+
+func hasUID(uids []types.UID, uid types.UID) bool {
+	for _, u := range uids {
+		if u == uid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestFinalizersOf(t *testing.T) {
+	uu := map[string]struct {
+		o           map[string]interface{}
+		terminating bool
+		e           []string
+	}{
+		"none": {
+			o: map[string]interface{}{
+				"kind":     "Pod",
+				"metadata": map[string]interface{}{"name": "fred"},
+			},
+		},
+		"pod": {
+			o: map[string]interface{}{
+				"kind": "Pod",
+				"metadata": map[string]interface{}{
+					"name":              "fred",
+					"deletionTimestamp": "2020-01-01T00:00:00Z",
+					"finalizers":        []interface{}{"blee/cleanup"},
+				},
+			},
+			terminating: true,
+			e:           []string{"blee/cleanup"},
+		},
+		"namespace": {
+			o: map[string]interface{}{
+				"kind": "Namespace",
+				"metadata": map[string]interface{}{
+					"name":              "fred",
+					"deletionTimestamp": "2020-01-01T00:00:00Z",
+					"finalizers":        []interface{}{"blee/cleanup"},
+				},
+				"spec": map[string]interface{}{
+					"finalizers": []interface{}{"kubernetes"},
+				},
+			},
+			terminating: true,
+			e:           []string{"blee/cleanup", "kubernetes"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: u.o}
+			assert.Equal(t, u.terminating, IsTerminating(&o))
+			assert.Equal(t, u.e, FinalizersOf(&o))
+		})
+	}
+}
+
+func TestPruneSpecFinalizers(t *testing.T) {
+	ns := func(ff ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"kind":     "Namespace",
+			"metadata": map[string]interface{}{"name": "fred"},
+			"spec":     map[string]interface{}{"finalizers": ff},
+		}
+	}
+	uu := map[string]struct {
+		o          map[string]interface{}
+		finalizers []string
+		pruned     bool
+		e          []string
+	}{
+		"pod": {
+			o: map[string]interface{}{
+				"kind":     "Pod",
+				"metadata": map[string]interface{}{"name": "fred"},
+			},
+			finalizers: []string{"kubernetes"},
+		},
+		"none": {
+			o:          ns("kubernetes"),
+			finalizers: []string{"blee/cleanup"},
+			e:          []string{"kubernetes"},
+		},
+		"some": {
+			o:          ns("kubernetes", "blee/cleanup"),
+			finalizers: []string{"blee/cleanup"},
+			pruned:     true,
+			e:          []string{"kubernetes"},
+		},
+		"all": {
+			o:          ns("kubernetes"),
+			finalizers: []string{"kubernetes"},
+			pruned:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: u.o}
+			assert.Equal(t, u.pruned, PruneSpecFinalizers(&o, u.finalizers))
+			ss, _, _ := unstructured.NestedStringSlice(o.Object, "spec", "finalizers")
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
+
+func TestPruneMetaPatch(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Pod",
+		"metadata": map[string]interface{}{
+			"name":       "fred",
+			"finalizers": []interface{}{"a/f1", "b/f2", "c/f3"},
+			"ownerReferences": []interface{}{
+				map[string]interface{}{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "rs1", "uid": "u1"},
+				map[string]interface{}{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "rs2", "uid": "u2"},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		finalizers []string
+		owners     []types.UID
+		e          string
+		err        bool
+	}{
+		"finalizers": {
+			finalizers: []string{"a/f1", "c/f3"},
+			e:          `[{"op":"test","path":"/metadata/finalizers/2","value":"c/f3"},{"op":"remove","path":"/metadata/finalizers/2"},{"op":"test","path":"/metadata/finalizers/0","value":"a/f1"},{"op":"remove","path":"/metadata/finalizers/0"}]`,
+		},
+		"owners": {
+			owners: []types.UID{"u2"},
+			e:      `[{"op":"test","path":"/metadata/ownerReferences/1/uid","value":"u2"},{"op":"remove","path":"/metadata/ownerReferences/1"}]`,
+		},
+		"nothing": {
+			finalizers: []string{"z/f9"},
+			err:        true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := PruneMetaPatch(&o, u.finalizers, u.owners)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
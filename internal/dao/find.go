@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FindAll indicates a search should scan all listable resources.
+const FindAll = "all"
+
+var _ Accessor = (*Find)(nil)
+
+// DefaultFindGVRs tracks resources scanned by default when searching.
+var DefaultFindGVRs = []string{
+	"v1/pods",
+	"v1/services",
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/serviceaccounts",
+	"v1/persistentvolumeclaims",
+	"v1/persistentvolumes",
+	"v1/namespaces",
+	"v1/nodes",
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"apps/v1/replicasets",
+	"batch/v1/jobs",
+	"batch/v1beta1/cronjobs",
+	"extensions/v1beta1/ingresses",
+}
+
+// Find searches resources by name or labels.
+type Find struct {
+	NonResource
+}
+
+// List returns all resources whose name or labels match the search pattern.
+func (f *Find) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	pattern, ok := ctx.Value(internal.KeyPattern).(string)
+	if !ok || pattern == "" {
+		return nil, fmt.Errorf("expecting a search pattern")
+	}
+	rx, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, err
+	}
+	gvrs, _ := ctx.Value(internal.KeyGVRs).([]string)
+
+	var oo []runtime.Object
+	for _, gvr := range findGVRs(gvrs) {
+		meta, err := MetaFor(client.NewGVR(gvr))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Find skipped %q", gvr)
+			continue
+		}
+		lns := ns
+		if !meta.Namespaced {
+			lns = client.ClusterScope
+		}
+		rr, err := f.Factory.List(gvr, lns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Find skipped %q", gvr)
+			continue
+		}
+		for _, o := range rr {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+			}
+			m, ok := findMatch(rx, u.GetName(), u.GetLabels())
+			if !ok {
+				continue
+			}
+			oo = append(oo, render.FindRes{
+				GVR:     gvr,
+				Path:    client.MetaFQN(metav1.ObjectMeta{Namespace: u.GetNamespace(), Name: u.GetName()}),
+				Match:   m,
+				Created: u.GetCreationTimestamp(),
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func findGVRs(gvrs []string) []string {
+	if len(gvrs) == 0 {
+		return DefaultFindGVRs
+	}
+	if len(gvrs) > 1 || gvrs[0] != FindAll {
+		return gvrs
+	}
+
+	var all []string
+	for _, gvr := range AllGVRs() {
+		meta, err := MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) || !in(meta.Verbs, "list") {
+			continue
+		}
+		all = append(all, gvr.String())
+	}
+
+	return all
+}
+
+func findMatch(rx *regexp.Regexp, name string, ll map[string]string) (string, bool) {
+	if rx.MatchString(name) {
+		return "name", true
+	}
+	kk := make([]string, 0, len(ll))
+	for k := range ll {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		if l := k + "=" + ll[k]; rx.MatchString(l) {
+			return "label:" + l, true
+		}
+	}
+
+	return "", false
+}
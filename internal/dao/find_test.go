@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMatch(t *testing.T) {
+	uu := map[string]struct {
+		pattern, name string
+		labels        map[string]string
+		match         string
+		ok            bool
+	}{
+		"name": {
+			pattern: "payments",
+			name:    "payments-cache-0",
+			match:   "name",
+			ok:      true,
+		},
+		"case": {
+			pattern: "PAYMENTS-cache",
+			name:    "payments-cache-0",
+			match:   "name",
+			ok:      true,
+		},
+		"label": {
+			pattern: "app=payments",
+			name:    "redis-0",
+			labels:  map[string]string{"tier": "cache", "app": "payments"},
+			match:   "label:app=payments",
+			ok:      true,
+		},
+		"none": {
+			pattern: "payments",
+			name:    "redis-0",
+			labels:  map[string]string{"app": "billing"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m, ok := findMatch(regexp.MustCompile("(?i)"+u.pattern), u.name, u.labels)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.match, m)
+		})
+	}
+}
+
+func TestFindGVRs(t *testing.T) {
+	assert.Equal(t, DefaultFindGVRs, findGVRs(nil))
+	assert.Equal(t, []string{"v1/pods"}, findGVRs([]string{"v1/pods"}))
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// CountingDialer tallies the bytes flowing through port forward streams.
+type countingDialer struct {
+	httpstream.Dialer
+
+	bytes *int64
+}
+
+// Dial opens a counting streaming connection.
+func (d countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, proto, err
+	}
+
+	return countingConn{Connection: conn, bytes: d.bytes}, proto, nil
+}
+
+type countingConn struct {
+	httpstream.Connection
+
+	bytes *int64
+}
+
+// CreateStream creates a new counting stream.
+func (c countingConn) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	s, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return countingStream{Stream: s, bytes: c.bytes}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+
+	bytes *int64
+}
+
+// Read reads from the stream.
+func (s countingStream) Read(bb []byte) (int, error) {
+	n, err := s.Stream.Read(bb)
+	atomic.AddInt64(s.bytes, int64(n))
+
+	return n, err
+}
+
+// Write writes to the stream.
+func (s countingStream) Write(bb []byte) (int, error) {
+	n, err := s.Stream.Write(bb)
+	atomic.AddInt64(s.bytes, int64(n))
+
+	return n, err
+}
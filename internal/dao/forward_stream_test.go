@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+func TestCountingStream(t *testing.T) {
+	var (
+		n   int64
+		buf bytes.Buffer
+		s   = countingStream{Stream: &bufStream{buf: &buf}, bytes: &n}
+	)
+
+	_, err := s.Write([]byte("hello"))
+	assert.Nil(t, err)
+	bb := make([]byte, 3)
+	_, err = s.Read(bb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), n)
+}
+
+func TestPortForwarderStatus(t *testing.T) {
+	pf := NewPortForwarder(nil)
+	assert.Equal(t, ForwardReconnecting, pf.Status())
+	pf.SetActive(true)
+	assert.Equal(t, ForwardActive, pf.Status())
+	pf.SetStatus(ForwardDead)
+	assert.Equal(t, ForwardDead, pf.Status())
+
+	assert.False(t, pf.Stopped())
+	pf.Stop()
+	pf.Stop()
+	assert.True(t, pf.Stopped())
+}
+
+func TestPortForwarderDrop(t *testing.T) {
+	pf := NewPortForwarder(nil)
+	pf.Drop()
+
+	link := make(chan struct{})
+	pf.linkChan = link
+	pf.Drop()
+	pf.Drop()
+	_, open := <-link
+	assert.False(t, open)
+	assert.False(t, pf.Stopped())
+}
+
+// Helpers...
+
+type bufStream struct {
+	httpstream.Stream
+
+	buf *bytes.Buffer
+}
+
+func (s *bufStream) Read(bb []byte) (int, error)  { return s.buf.Read(bb) }
+func (s *bufStream) Write(bb []byte) (int, error) { return s.buf.Write(bb) }
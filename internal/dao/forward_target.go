@@ -0,0 +1,227 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ForwardEndpoint represents a pod container port backing a service or a
+// workload.
+type ForwardEndpoint struct {
+	Path, Container, Port string
+}
+
+// ForwardTargetPorts returns the ports a service, a deployment or a pod
+// exposes.
+func ForwardTargetPorts(f Factory, gvr, path string) ([]string, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	var pp []string
+	switch gvr {
+	case "v1/services":
+		var svc v1.Service
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return nil, err
+		}
+		for _, p := range svc.Spec.Ports {
+			if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+				pp = append(pp, strconv.Itoa(int(p.Port)))
+			}
+		}
+	case "apps/v1/deployments":
+		var dp appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dp); err != nil {
+			return nil, err
+		}
+		for _, co := range dp.Spec.Template.Spec.Containers {
+			for _, p := range co.Ports {
+				if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+					pp = append(pp, strconv.Itoa(int(p.ContainerPort)))
+				}
+			}
+		}
+	case "v1/pods":
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		for _, co := range po.Spec.Containers {
+			for _, p := range co.Ports {
+				if p.Protocol == v1.ProtocolTCP || p.Protocol == "" {
+					pp = append(pp, strconv.Itoa(int(p.ContainerPort)))
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("port forwards are not supported on %s", gvr)
+	}
+
+	return pp, nil
+}
+
+// ForwardEndpointFor picks a ready pod backing a service or a deployment and
+// resolves the container port to forward to. Services map their port to the
+// target port. The excluded pod is skipped so forwards may fail over.
+func ForwardEndpointFor(f Factory, gvr, path, port, exclude string) (ForwardEndpoint, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return ForwardEndpoint{}, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return ForwardEndpoint{}, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	var (
+		sel    labels.Selector
+		target = intstr.Parse(port)
+	)
+	switch gvr {
+	case "v1/services":
+		var svc v1.Service
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+			return ForwardEndpoint{}, err
+		}
+		if len(svc.Spec.Selector) == 0 {
+			return ForwardEndpoint{}, fmt.Errorf("service %s has no selector", path)
+		}
+		sel = labels.SelectorFromSet(svc.Spec.Selector)
+		if target, err = serviceTargetPort(&svc, port); err != nil {
+			return ForwardEndpoint{}, err
+		}
+	case "apps/v1/deployments":
+		var dp appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dp); err != nil {
+			return ForwardEndpoint{}, err
+		}
+		if sel, err = metav1.LabelSelectorAsSelector(dp.Spec.Selector); err != nil {
+			return ForwardEndpoint{}, err
+		}
+	default:
+		return ForwardEndpoint{}, fmt.Errorf("port forwards are not supported on %s", gvr)
+	}
+
+	ns, _ := client.Namespaced(path)
+	oo, err := f.List("v1/pods", ns, true, sel)
+	if err != nil {
+		return ForwardEndpoint{}, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return ForwardEndpoint{}, err
+		}
+		pods = append(pods, po)
+	}
+
+	return pickEndpoint(pods, target, exclude)
+}
+
+// ReconnectEndpoint returns the endpoint a pod port forward should reconnect
+// to. Live pods are reconnected as is while pods gone are replaced by the most
+// recent pod of their owner, if known.
+func ReconnectEndpoint(f Factory, ep ForwardEndpoint, ownerGVR, ownerPath string) (ForwardEndpoint, error) {
+	o, err := f.Get("v1/pods", ep.Path, true, labels.Everything())
+	if err == nil {
+		if u, ok := o.(*unstructured.Unstructured); ok && u.GetDeletionTimestamp() == nil {
+			return ep, nil
+		}
+	}
+	if ownerGVR == "" {
+		return ForwardEndpoint{}, fmt.Errorf("pod %s is gone", ep.Path)
+	}
+	path, err := PodSuccessor(f, ownerGVR, ownerPath, ep.Path, ep.Container)
+	if err != nil {
+		return ForwardEndpoint{}, err
+	}
+
+	return ForwardEndpoint{Path: path, Container: ep.Container, Port: ep.Port}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func serviceTargetPort(svc *v1.Service, port string) (intstr.IntOrString, error) {
+	for _, p := range svc.Spec.Ports {
+		if strconv.Itoa(int(p.Port)) != port && p.Name != port {
+			continue
+		}
+		if p.TargetPort.Type == intstr.Int && p.TargetPort.IntVal == 0 {
+			return intstr.FromInt(int(p.Port)), nil
+		}
+		return p.TargetPort, nil
+	}
+
+	return intstr.IntOrString{}, fmt.Errorf("service %s exposes no port %s", svc.Name, port)
+}
+
+// PickEndpoint returns the first ready pod, by name, exposing the target port.
+func pickEndpoint(pods []v1.Pod, target intstr.IntOrString, exclude string) (ForwardEndpoint, error) {
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	for _, po := range pods {
+		fqn := client.FQN(po.Namespace, po.Name)
+		if fqn == exclude || !podReady(&po) {
+			continue
+		}
+		if co, port, ok := containerPort(po.Spec.Containers, target); ok {
+			return ForwardEndpoint{Path: fqn, Container: co, Port: port}, nil
+		}
+	}
+
+	return ForwardEndpoint{}, fmt.Errorf("no ready pod exposes port %s", target.String())
+}
+
+func podReady(po *v1.Pod) bool {
+	if po.DeletionTimestamp != nil || po.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// ContainerPort finds the container exposing a port. Numeric ports not
+// declared by any container go to the first container.
+func containerPort(cc []v1.Container, target intstr.IntOrString) (string, string, bool) {
+	for _, co := range cc {
+		for _, p := range co.Ports {
+			if (target.Type == intstr.String && p.Name == target.StrVal) ||
+				(target.Type == intstr.Int && p.ContainerPort == target.IntVal) {
+				return co.Name, strconv.Itoa(int(p.ContainerPort)), true
+			}
+		}
+	}
+	if target.Type == intstr.Int && len(cc) > 0 {
+		return cc[0].Name, target.String(), true
+	}
+
+	return "", "", false
+}
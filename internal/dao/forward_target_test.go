@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestServiceTargetPort(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("web")},
+				{Name: "admin", Port: 9000, TargetPort: intstr.FromInt(9090)},
+				{Name: "raw", Port: 5000},
+			},
+		},
+	}
+	uu := map[string]struct {
+		port string
+		e    intstr.IntOrString
+		err  bool
+	}{
+		"named":   {port: "80", e: intstr.FromString("web")},
+		"byName":  {port: "admin", e: intstr.FromInt(9090)},
+		"default": {port: "5000", e: intstr.FromInt(5000)},
+		"missing": {port: "443", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := serviceTargetPort(&svc, u.port)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
+
+func TestPickEndpoint(t *testing.T) {
+	pods := []v1.Pod{
+		makeForwardPod("p3", true),
+		makeForwardPod("p1", false),
+		makeForwardPod("p2", true),
+	}
+	uu := map[string]struct {
+		target  intstr.IntOrString
+		exclude string
+		e       ForwardEndpoint
+		err     bool
+	}{
+		"named": {
+			target: intstr.FromString("web"),
+			e:      ForwardEndpoint{Path: "default/p2", Container: "c2", Port: "8080"},
+		},
+		"declared": {
+			target: intstr.FromInt(8080),
+			e:      ForwardEndpoint{Path: "default/p2", Container: "c2", Port: "8080"},
+		},
+		"undeclared": {
+			target: intstr.FromInt(7000),
+			e:      ForwardEndpoint{Path: "default/p2", Container: "c1", Port: "7000"},
+		},
+		"failover": {
+			target:  intstr.FromString("web"),
+			exclude: "default/p2",
+			e:       ForwardEndpoint{Path: "default/p3", Container: "c2", Port: "8080"},
+		},
+		"unknownName": {
+			target: intstr.FromString("grpc"),
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ep, err := pickEndpoint(pods, u.target, u.exclude)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, ep)
+		})
+	}
+}
+
+// Helpers...
+
+func makeForwardPod(n string, ready bool) v1.Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "c1"},
+				{Name: "c2", Ports: []v1.ContainerPort{{Name: "web", ContainerPort: 8080}}},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+}
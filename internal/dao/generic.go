@@ -9,12 +9,19 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 )
 
-var _ Describer = (*Generic)(nil)
+var (
+	_ Describer       = (*Generic)(nil)
+	_ Editor          = (*Generic)(nil)
+	_ CollectionNuker = (*Generic)(nil)
+)
 
 var defaultKillGrace int64
 
@@ -30,18 +37,20 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 	if !ok {
 		log.Warn().Msgf("No label selector found in context. Listing all resources")
 	}
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
 	if client.IsAllNamespace(ns) {
 		ns = client.AllNamespaces
 	}
 
+	opts := metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel}
 	var (
 		ll  *unstructured.UnstructuredList
 		err error
 	)
 	if client.IsClusterScoped(ns) {
-		ll, err = g.dynClient().List(metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = g.dynClient().List(opts)
 	} else {
-		ll, err = g.dynClient().Namespace(ns).List(metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = g.dynClient().Namespace(ns).List(opts)
 	}
 	if err != nil {
 		return nil, err
@@ -87,9 +96,44 @@ func (g *Generic) ToYAML(path string) (string, error) {
 	return raw, nil
 }
 
+// Manifest returns the live resource manifest.
+func (g *Generic) Manifest(path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	u, err := g.resourceFor(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+
+	return ToYAML(u)
+}
+
+// Update replaces a resource with an edited manifest.
+func (g *Generic) Update(path string, manifest []byte) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	u, err := DecodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	if u.GetName() != n || (!client.IsClusterScoped(ns) && u.GetNamespace() != ns) {
+		return fmt.Errorf("the name or namespace of %s cannot be changed", path)
+	}
+	_, err = g.resourceFor(ns).Update(u, metav1.UpdateOptions{})
+
+	return err
+}
+
 // Delete deletes a resource.
-func (g *Generic) Delete(path string, cascade, force bool) error {
-	log.Debug().Msgf("DELETE %q -- %t:%t", path, cascade, force)
+func (g *Generic) Delete(path string, opts DeleteOptions) error {
+	log.Debug().Msgf("DELETE %q -- %s:%d", path, opts.Propagation, opts.GracePeriod)
 	ns, n := client.Namespaced(path)
 	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.DeleteVerb})
 	if err != nil {
@@ -99,23 +143,127 @@ func (g *Generic) Delete(path string, cascade, force bool) error {
 		return fmt.Errorf("user is not authorized to delete %s", path)
 	}
 
-	p := metav1.DeletePropagationOrphan
-	if cascade {
-		p = metav1.DeletePropagationBackground
+	dOpts := opts.MetaOptions()
+	if client.IsClusterScoped(ns) {
+		return g.dynClient().Delete(n, &dOpts)
+	}
+
+	return g.dynClient().Namespace(ns).Delete(n, &dOpts)
+}
+
+// DeleteCollection deletes all resources in a namespace matching a label selector.
+func (g *Generic) DeleteCollection(ns, sel string, opts DeleteOptions) error {
+	log.Debug().Msgf("DELETE COLLECTION %q -- %q", ns, sel)
+	if sel == "" {
+		return fmt.Errorf("a label selector is required to delete a collection of %s", g.gvr)
+	}
+	if _, err := labels.Parse(sel); err != nil {
+		return fmt.Errorf("invalid label selector %q: %s", sel, err)
+	}
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.DeleteCollectionVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to delete collections of %s", g.gvr)
+	}
+
+	dOpts := opts.MetaOptions()
+	return g.resourceFor(ns).DeleteCollection(&dOpts, metav1.ListOptions{LabelSelector: sel})
+}
+
+// ForceDelete deletes a resource stuck terminating with no grace period,
+// optionally clearing its finalizers first.
+func (g *Generic) ForceDelete(path string, clearFinalizers bool) error {
+	log.Debug().Msgf("FORCE DELETE %q -- %t", path, clearFinalizers)
+	ns, n := client.Namespaced(path)
+	verbs := []string{client.DeleteVerb}
+	if clearFinalizers {
+		verbs = append(verbs, client.PatchVerb)
+	}
+	auth, err := g.Client().CanI(ns, g.gvr.String(), verbs)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to force delete %s", path)
+	}
+
+	dial := g.resourceFor(ns)
+	if clearFinalizers {
+		if err := g.clearFinalizers(dial, n); err != nil {
+			return err
+		}
 	}
-	var grace *int64
-	if force {
-		grace = &defaultKillGrace
+	grace := defaultKillGrace
+	err = dial.Delete(n, &metav1.DeleteOptions{GracePeriodSeconds: &grace})
+	if errors.IsNotFound(err) {
+		return nil
 	}
-	opts := metav1.DeleteOptions{
-		PropagationPolicy:  &p,
-		GracePeriodSeconds: grace,
+
+	return err
+}
+
+// PruneMeta removes the given finalizers and owner references from a resource.
+func (g *Generic) PruneMeta(path string, finalizers []string, owners []types.UID) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
 	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial := g.resourceFor(ns)
+	u, err := dial.Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	var specPruned bool
+	if PruneSpecFinalizers(u, finalizers) {
+		if u, err = dial.Update(u, metav1.UpdateOptions{}, "finalize"); err != nil {
+			return err
+		}
+		specPruned = true
+	}
+	patch, err := PruneMetaPatch(u, finalizers, owners)
+	if err != nil {
+		if specPruned {
+			return nil
+		}
+		return err
+	}
+	_, err = dial.Patch(n, types.JSONPatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func (g *Generic) clearFinalizers(dial dynamic.ResourceInterface, n string) error {
+	if _, err := dial.Patch(n, types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`), metav1.PatchOptions{}); err != nil {
+		return err
+	}
+	if g.gvr.String() != "v1/namespaces" {
+		return nil
+	}
+
+	// Namespace spec finalizers can only be cleared via the finalize subresource.
+	u, err := dial.Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(u.Object, "spec", "finalizers")
+	_, err = dial.Update(u, metav1.UpdateOptions{}, "finalize")
+
+	return err
+}
+
+func (g *Generic) resourceFor(ns string) dynamic.ResourceInterface {
 	if client.IsClusterScoped(ns) {
-		return g.dynClient().Delete(n, &opts)
+		return g.dynClient()
 	}
 
-	return g.dynClient().Namespace(ns).Delete(n, &opts)
+	return g.dynClient().Namespace(ns)
 }
 
 func (g *Generic) dynClient() dynamic.NamespaceableResourceInterface {
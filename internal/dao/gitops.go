@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// FluxReconcileAnnotation requests an out of band Flux reconciliation.
+	FluxReconcileAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+	argoInitiator = "k9s"
+)
+
+// FluxGVRs tracks Flux reconciled resources.
+var FluxGVRs = []string{
+	"kustomize.toolkit.fluxcd.io/v1/kustomizations",
+	"kustomize.toolkit.fluxcd.io/v1beta2/kustomizations",
+	"kustomize.toolkit.fluxcd.io/v1beta1/kustomizations",
+	"helm.toolkit.fluxcd.io/v2/helmreleases",
+	"helm.toolkit.fluxcd.io/v2beta2/helmreleases",
+	"helm.toolkit.fluxcd.io/v2beta1/helmreleases",
+	"source.toolkit.fluxcd.io/v1/gitrepositories",
+	"source.toolkit.fluxcd.io/v1beta2/gitrepositories",
+	"source.toolkit.fluxcd.io/v1/helmrepositories",
+	"source.toolkit.fluxcd.io/v1beta2/helmrepositories",
+	"source.toolkit.fluxcd.io/v1beta2/ocirepositories",
+	"source.toolkit.fluxcd.io/v1beta2/buckets",
+}
+
+// ArgoGVRs tracks ArgoCD applications.
+var ArgoGVRs = []string{
+	"argoproj.io/v1alpha1/applications",
+}
+
+var (
+	_ Accessor     = (*Flux)(nil)
+	_ Reconcilable = (*Flux)(nil)
+	_ Suspendable  = (*Flux)(nil)
+	_ Accessor     = (*Argo)(nil)
+	_ Reconcilable = (*Argo)(nil)
+)
+
+// Flux represents a Flux reconciled resource.
+type Flux struct {
+	Resource
+}
+
+// Reconcile requests a Flux reconciliation by stamping the resource
+// reconcile annotation.
+func (f *Flux) Reconcile(path string) error {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				FluxReconcileAnnotation: time.Now().Format(time.RFC3339Nano),
+			},
+		},
+	}
+
+	return f.mergePatch(path, patch)
+}
+
+// Suspend suspends or resumes Flux reconciliations.
+func (f *Flux) Suspend(path string, suspend bool) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"suspend": suspend,
+		},
+	}
+
+	return f.mergePatch(path, patch)
+}
+
+// Argo represents an ArgoCD application.
+type Argo struct {
+	Resource
+}
+
+// Reconcile triggers an application sync by setting its operation field
+// which is picked up by the Argo application controller.
+func (a *Argo) Reconcile(path string) error {
+	patch := map[string]interface{}{
+		"operation": map[string]interface{}{
+			"initiatedBy": map[string]interface{}{
+				"username": argoInitiator,
+			},
+			"sync": map[string]interface{}{},
+		},
+	}
+
+	return a.mergePatch(path, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (g *Generic) mergePatch(path string, patch map[string]interface{}) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", g.gvr)
+	}
+
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = g.dynClient().Namespace(ns).Patch(n, types.MergePatchType, raw, metav1.PatchOptions{})
+
+	return err
+}
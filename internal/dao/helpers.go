@@ -2,9 +2,18 @@ package dao
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/rs/zerolog/log"
@@ -12,6 +21,8 @@ import (
 	"k8s.io/cli-runtime/pkg/printers"
 )
 
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
 func toPerc(v1, v2 float64) float64 {
 	if v2 == 0 {
 		return 0
@@ -19,6 +30,25 @@ func toPerc(v1, v2 float64) float64 {
 	return math.Round((v1 / v2) * 100)
 }
 
+// NamespacesOf returns the sorted distinct namespaces of the given resource paths.
+func NamespacesOf(paths []string) []string {
+	set := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		ns, _ := client.Namespaced(p)
+		if ns == "" {
+			ns = client.ClusterScope
+		}
+		set[ns] = struct{}{}
+	}
+	nss := make([]string, 0, len(set))
+	for ns := range set {
+		nss = append(nss, ns)
+	}
+	sort.Strings(nss)
+
+	return nss
+}
+
 // Truncate a string to the given l and suffix ellipsis if needed.
 func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
@@ -41,3 +71,33 @@ func ToYAML(o runtime.Object) (string, error) {
 
 	return buff.String(), nil
 }
+
+// HTTPGet fetches a resource from an http endpoint.
+func httpGet(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
+	return httpDo(ctx, http.MethodGet, url, headers, nil)
+}
+
+// HTTPDo issues an http request and returns the response body.
+func httpDo(ctx context.Context, method, url string, headers map[string]string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s failed (%d) %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(raw)))
+	}
+
+	return raw, nil
+}
@@ -19,3 +19,29 @@ func TestToPerc(t *testing.T) {
 		assert.Equal(t, u.e, toPerc(u.v1, u.v2))
 	}
 }
+
+func TestNamespacesOf(t *testing.T) {
+	uu := map[string]struct {
+		paths []string
+		e     []string
+	}{
+		"none": {
+			e: []string{},
+		},
+		"namespaced": {
+			paths: []string{"ns2/fred", "ns1/blee", "ns2/zorg"},
+			e:     []string{"ns1", "ns2"},
+		},
+		"cluster": {
+			paths: []string{"fred", "blee"},
+			e:     []string{"-"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NamespacesOf(u.paths))
+		})
+	}
+}
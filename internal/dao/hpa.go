@@ -3,18 +3,35 @@ package dao
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+const maxHPAEvents = 10
+
 var (
-	_ Accessor = (*HorizontalPodAutoscaler)(nil)
-	_ Nuker    = (*HorizontalPodAutoscaler)(nil)
+	_ Accessor  = (*HorizontalPodAutoscaler)(nil)
+	_ Nuker     = (*HorizontalPodAutoscaler)(nil)
+	_ Diagnoser = (*HorizontalPodAutoscaler)(nil)
 )
 
+var hpaNewSizeRX = regexp.MustCompile(`New size: (\d+)`)
+
 // HorizontalPodAutoscaler represents a HPA resource model.
 type HorizontalPodAutoscaler struct {
 	Resource
@@ -24,8 +41,8 @@ type HorizontalPodAutoscaler struct {
 func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
 	lsel := labels.Everything()
-	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
-		lsel = sel.AsSelector()
+	if sel, err := labels.Parse(strLabel); ok && err == nil {
+		lsel = sel
 	}
 
 	gvrs := []string{
@@ -52,3 +69,178 @@ func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]r
 	}
 	return oo, nil
 }
+
+// Diagnose returns current vs target metrics, last scale events and a
+// replicas history for a HPA.
+func (h *HorizontalPodAutoscaler) Diagnose(path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	hpa, err := h.Client().DialOrDie().AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	oo, err := h.Factory.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ev); err != nil {
+			return "", err
+		}
+		if ev.InvolvedObject.Kind == "HorizontalPodAutoscaler" && ev.InvolvedObject.Name == n {
+			ee = append(ee, ev)
+		}
+	}
+
+	return hpaReport(hpa, ee), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaReport(hpa *autoscalingv2beta2.HorizontalPodAutoscaler, ee []v1.Event) string {
+	var b strings.Builder
+	min := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		min = *hpa.Spec.MinReplicas
+	}
+	fmt.Fprintf(&b, "Target:     %s/%s\n", hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name)
+	fmt.Fprintf(&b, "Replicas:   %d current, %d desired (min %d, max %d)\n",
+		hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, min, hpa.Spec.MaxReplicas)
+	lastScale := "n/a"
+	if hpa.Status.LastScaleTime != nil {
+		lastScale = duration.HumanDuration(time.Since(hpa.Status.LastScaleTime.Time)) + " ago"
+	}
+	fmt.Fprintf(&b, "Last Scale: %s\n", lastScale)
+
+	fmt.Fprintf(&b, "Metrics (current/target):\n")
+	for i, spec := range hpa.Spec.Metrics {
+		var status *autoscalingv2beta2.MetricStatus
+		if i < len(hpa.Status.CurrentMetrics) {
+			status = &hpa.Status.CurrentMetrics[i]
+		}
+		fmt.Fprintf(&b, "  - %s\n", hpaMetric(spec, status))
+	}
+
+	if len(hpa.Status.Conditions) > 0 {
+		fmt.Fprintf(&b, "Conditions:\n")
+		for _, c := range hpa.Status.Conditions {
+			fmt.Fprintf(&b, "  - %s=%s (%s) %s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+	}
+
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].LastTimestamp.Before(&ee[j].LastTimestamp)
+	})
+	history := make([]int, 0, len(ee))
+	for _, e := range ee {
+		if mm := hpaNewSizeRX.FindStringSubmatch(e.Message); len(mm) == 2 {
+			if size, err := strconv.Atoi(mm[1]); err == nil {
+				history = append(history, size)
+			}
+		}
+	}
+	if len(history) > 0 {
+		history = append(history, int(hpa.Status.CurrentReplicas))
+		fmt.Fprintf(&b, "History:    %s (%d -> %d)\n", sparkline(history, int(min), int(hpa.Spec.MaxReplicas)), history[0], history[len(history)-1])
+	}
+
+	fmt.Fprintf(&b, "Scale Events:\n")
+	if len(ee) == 0 {
+		fmt.Fprintf(&b, "  <none>\n")
+	}
+	if len(ee) > maxHPAEvents {
+		ee = ee[len(ee)-maxHPAEvents:]
+	}
+	for i := len(ee) - 1; i >= 0; i-- {
+		e := ee[i]
+		fmt.Fprintf(&b, "  - %s ago [%s] %s\n", duration.HumanDuration(time.Since(e.LastTimestamp.Time)), e.Reason, e.Message)
+	}
+
+	return b.String()
+}
+
+func hpaMetric(spec autoscalingv2beta2.MetricSpec, status *autoscalingv2beta2.MetricStatus) string {
+	switch spec.Type {
+	case autoscalingv2beta2.ResourceMetricSourceType:
+		var current *autoscalingv2beta2.MetricValueStatus
+		if status != nil && status.Resource != nil {
+			current = &status.Resource.Current
+		}
+		return fmt.Sprintf("resource %s: %s", spec.Resource.Name, metricTarget(spec.Resource.Target, current))
+	case autoscalingv2beta2.PodsMetricSourceType:
+		var current *autoscalingv2beta2.MetricValueStatus
+		if status != nil && status.Pods != nil {
+			current = &status.Pods.Current
+		}
+		return fmt.Sprintf("pods %s: %s", spec.Pods.Metric.Name, metricTarget(spec.Pods.Target, current))
+	case autoscalingv2beta2.ObjectMetricSourceType:
+		var current *autoscalingv2beta2.MetricValueStatus
+		if status != nil && status.Object != nil {
+			current = &status.Object.Current
+		}
+		return fmt.Sprintf("object %s/%s %s: %s", spec.Object.DescribedObject.Kind, spec.Object.DescribedObject.Name, spec.Object.Metric.Name, metricTarget(spec.Object.Target, current))
+	case autoscalingv2beta2.ExternalMetricSourceType:
+		var current *autoscalingv2beta2.MetricValueStatus
+		if status != nil && status.External != nil {
+			current = &status.External.Current
+		}
+		return fmt.Sprintf("external %s: %s", spec.External.Metric.Name, metricTarget(spec.External.Target, current))
+	default:
+		return string(spec.Type)
+	}
+}
+
+func metricTarget(target autoscalingv2beta2.MetricTarget, current *autoscalingv2beta2.MetricValueStatus) string {
+	cur := "<unknown>"
+	switch target.Type {
+	case autoscalingv2beta2.UtilizationMetricType:
+		if current != nil && current.AverageUtilization != nil {
+			cur = strconv.Itoa(int(*current.AverageUtilization)) + "%"
+		}
+		var t int32
+		if target.AverageUtilization != nil {
+			t = *target.AverageUtilization
+		}
+		return fmt.Sprintf("%s/%d%%", cur, t)
+	case autoscalingv2beta2.AverageValueMetricType:
+		if current != nil && current.AverageValue != nil {
+			cur = current.AverageValue.String()
+		}
+		return cur + "/" + qtyOrUnknown(target.AverageValue)
+	default:
+		if current != nil && current.Value != nil {
+			cur = current.Value.String()
+		}
+		return cur + "/" + qtyOrUnknown(target.Value)
+	}
+}
+
+func qtyOrUnknown(q *resource.Quantity) string {
+	if q == nil {
+		return "<unknown>"
+	}
+
+	return q.String()
+}
+
+func sparkline(vv []int, min, max int) string {
+	ticks := []rune("▁▂▃▄▅▆▇█")
+	if max <= min {
+		max = min + 1
+	}
+	rr := make([]rune, 0, len(vv))
+	for _, v := range vv {
+		i := (v - min) * (len(ticks) - 1) / (max - min)
+		switch {
+		case i < 0:
+			i = 0
+		case i >= len(ticks):
+			i = len(ticks) - 1
+		}
+		rr = append(rr, ticks[i])
+	}
+
+	return string(rr)
+}
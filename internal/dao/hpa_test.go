@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv       []int
+		min, max int
+		e        string
+	}{
+		"range":   {vv: []int{1, 5, 10}, min: 1, max: 10, e: "▁▄█"},
+		"clamped": {vv: []int{0, 20}, min: 1, max: 10, e: "▁█"},
+		"flat":    {vv: []int{2, 2}, min: 2, max: 2, e: "▁▁"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sparkline(u.vv, u.min, u.max))
+		})
+	}
+}
+
+func TestHPAReport(t *testing.T) {
+	min, util, cur := int32(1), int32(70), int32(85)
+	hpa := autoscalingv2beta2.HorizontalPodAutoscaler{
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{Kind: "Deployment", Name: "fred"},
+			MinReplicas:    &min,
+			MaxReplicas:    8,
+			Metrics: []autoscalingv2beta2.MetricSpec{{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricSource{
+					Name:   v1.ResourceCPU,
+					Target: autoscalingv2beta2.MetricTarget{Type: autoscalingv2beta2.UtilizationMetricType, AverageUtilization: &util},
+				},
+			}},
+		},
+		Status: autoscalingv2beta2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 4,
+			DesiredReplicas: 4,
+			CurrentMetrics: []autoscalingv2beta2.MetricStatus{{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricStatus{
+					Name:    v1.ResourceCPU,
+					Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: &cur},
+				},
+			}},
+		},
+	}
+	now := time.Now()
+	ee := []v1.Event{
+		{Reason: "SuccessfulRescale", Message: "New size: 4; reason: cpu above target", LastTimestamp: metav1.NewTime(now)},
+		{Reason: "SuccessfulRescale", Message: "New size: 2; reason: cpu above target", LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+	}
+
+	r := hpaReport(&hpa, ee)
+	assert.Contains(t, r, "Target:     Deployment/fred\n")
+	assert.Contains(t, r, "  - resource cpu: 85%/70%\n")
+	assert.Contains(t, r, "History:    ▂▄▄ (2 -> 4)\n")
+	assert.Contains(t, r, "Scale Events:\n  - 0s ago [SuccessfulRescale] New size: 4")
+}
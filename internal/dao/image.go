@@ -0,0 +1,108 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Image)(nil)
+
+// Image tracks distinct container images running in pods.
+type Image struct {
+	NonResource
+}
+
+// List returns all running image versions along with their owning workloads.
+func (i *Image) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := i.Factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	images, owners := make(map[string]*render.ImageRes), make(map[string]string)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		w := i.workloadOf(&po, owners)
+		if client.IsAllNamespaces(ns) {
+			w = client.FQN(po.Namespace, w)
+		}
+		for _, c := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+			img, tag := splitImage(c.Image)
+			res, ok := images[img+":"+tag]
+			if !ok {
+				res = &render.ImageRes{Image: img, Tag: tag}
+				images[img+":"+tag] = res
+			}
+			res.Containers++
+			if !in(res.Workloads, w) {
+				res.Workloads = append(res.Workloads, w)
+			}
+		}
+	}
+
+	versions := make(map[string]int)
+	for _, res := range images {
+		versions[res.Image]++
+	}
+	res := make([]runtime.Object, 0, len(images))
+	for _, i := range images {
+		i.Versions = versions[i.Image]
+		sort.Strings(i.Workloads)
+		res = append(res, *i)
+	}
+
+	return res, nil
+}
+
+// workloadOf returns the workload running a pod. Replicasets are resolved to their
+// deployment. Resolved owners are cached by pod owner.
+func (i *Image) workloadOf(po *v1.Pod, owners map[string]string) string {
+	ref, ok := controllerRef(po.OwnerReferences)
+	if !ok {
+		return "Pod/" + po.Name
+	}
+	w := ref.Kind + "/" + ref.Name
+	if ref.Kind != "ReplicaSet" {
+		return w
+	}
+	key := client.FQN(po.Namespace, w)
+	if o, ok := owners[key]; ok {
+		return o
+	}
+	owners[key] = w
+	rs, err := fetchUnstructured(i.Factory, "apps/v1/replicasets", client.FQN(po.Namespace, ref.Name))
+	if err != nil {
+		return w
+	}
+	if ref, ok := controllerRef(rs.GetOwnerReferences()); ok {
+		owners[key] = ref.Kind + "/" + ref.Name
+	}
+
+	return owners[key]
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitImage splits an image reference into its name and tag or digest.
+func splitImage(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+
+	return image, "latest"
+}
@@ -0,0 +1,28 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitImage(t *testing.T) {
+	uu := map[string]struct {
+		image, name, tag string
+	}{
+		"plain":    {image: "nginx", name: "nginx", tag: "latest"},
+		"tagged":   {image: "nginx:1.19", name: "nginx", tag: "1.19"},
+		"registry": {image: "localhost:5000/fred/blee:0.1", name: "localhost:5000/fred/blee", tag: "0.1"},
+		"port":     {image: "localhost:5000/fred", name: "localhost:5000/fred", tag: "latest"},
+		"digest":   {image: "fred@sha256:abc", name: "fred", tag: "sha256:abc"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, tag := splitImage(u.image)
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.tag, tag)
+		})
+	}
+}
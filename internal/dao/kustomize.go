@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"bytes"
+
+	"k8s.io/cli-runtime/pkg/kustomize"
+	"sigs.k8s.io/kustomize/pkg/fs"
+)
+
+// KustomizeBuild renders the manifests for a given kustomization directory.
+func KustomizeBuild(dir string) (string, error) {
+	var buff bytes.Buffer
+	if err := kustomize.RunKustomizeBuild(&buff, fs.MakeRealFS(), dir); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
@@ -0,0 +1,22 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKustomizeBuild(t *testing.T) {
+	raw, err := dao.KustomizeBuild("test_assets/kustomize")
+
+	assert.Nil(t, err)
+	assert.Contains(t, raw, "name: fred-cm")
+	assert.Contains(t, raw, "app: blee")
+}
+
+func TestKustomizeBuildToast(t *testing.T) {
+	_, err := dao.KustomizeBuild("test_assets/fred")
+
+	assert.NotNil(t, err)
+}
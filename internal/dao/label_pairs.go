@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LabelPairs returns the distinct label key=value pairs of a resource
+// collection, most common first.
+func LabelPairs(f Factory, gvr, ns string) ([]string, error) {
+	oo, err := f.List(gvr, ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return labelPairs(oo), nil
+}
+
+func labelPairs(oo []runtime.Object) []string {
+	counts := make(map[string]int)
+	for _, o := range oo {
+		m, ok := o.(metav1.Object)
+		if !ok {
+			continue
+		}
+		for k, v := range m.GetLabels() {
+			counts[k+"="+v]++
+		}
+	}
+
+	pp := make([]string, 0, len(counts))
+	for p := range counts {
+		pp = append(pp, p)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		if counts[pp[i]] != counts[pp[j]] {
+			return counts[pp[i]] > counts[pp[j]]
+		}
+		return pp[i] < pp[j]
+	})
+
+	return pp
+}
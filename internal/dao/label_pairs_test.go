@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLabelPairs(t *testing.T) {
+	oo := []runtime.Object{
+		makeLabeled(map[string]string{"app": "fred", "tier": "web"}),
+		makeLabeled(map[string]string{"app": "fred", "tier": "db"}),
+		makeLabeled(map[string]string{"app": "blee"}),
+		makeLabeled(nil),
+	}
+
+	assert.Equal(t, []string{"app=fred", "app=blee", "tier=db", "tier=web"}, labelPairs(oo))
+}
+
+// Helpers...
+
+func makeLabeled(ll map[string]string) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetName("fred")
+	u.SetLabels(ll)
+
+	return &u
+}
@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+)
+
+// LogHistory fetches historical container logs from a log store.
+type LogHistory interface {
+	// History returns a pod container log lines in the given time range,
+	// oldest first. A blank container matches all the pod containers.
+	History(ctx context.Context, path, co string, start, end time.Time, limit int) ([]string, error)
+
+	// Since returns how far back to look for logs.
+	Since() time.Duration
+}
+
+// NewLogHistory returns a historical logs fetcher for a log backend.
+func NewLogHistory(cfg *config.LogBackend) (LogHistory, error) {
+	cfg, err := cfg.WithSecrets()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	since, _ := cfg.SinceDuration()
+	switch cfg.Kind {
+	case config.LogBackendLoki:
+		return &lokiHistory{cfg: cfg, since: since}, nil
+	default:
+		return &esHistory{cfg: cfg, since: since}, nil
+	}
+}
+
+type lokiHistory struct {
+	cfg   *config.LogBackend
+	since time.Duration
+}
+
+// Since returns how far back to look for logs.
+func (l *lokiHistory) Since() time.Duration {
+	return l.since
+}
+
+// History returns a pod container log lines.
+func (l *lokiHistory) History(ctx context.Context, path, co string, start, end time.Time, limit int) ([]string, error) {
+	ee, err := LokiQuery(ctx, l.cfg.URL, l.cfg.Headers, lokiSelector(l.cfg.Query, path, co), start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+	ll := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ll = append(ll, strings.TrimRight(e.Line, "\n"))
+	}
+
+	return ll, nil
+}
+
+var lokiMatcherRX = regexp.MustCompile(`[\w.]+\s*(=~|!~|!=|=)\s*"((?:[^"\\]|\\.)*)"`)
+
+// LokiSelector expands a stream selector for a pod container, dropping
+// matchers on blank values.
+func lokiSelector(q, path, co string) string {
+	ns, po := client.Namespaced(path)
+	q = strings.NewReplacer("$NAMESPACE", ns, "$POD", po, "$CONTAINER", co).Replace(q)
+
+	end := strings.Index(q, "}")
+	if !strings.HasPrefix(strings.TrimSpace(q), "{") || end < 0 {
+		return q
+	}
+	mm := lokiMatcherRX.FindAllStringSubmatch(q[:end], -1)
+	kept := make([]string, 0, len(mm))
+	for _, m := range mm {
+		if m[2] != "" {
+			kept = append(kept, m[0])
+		}
+	}
+
+	return "{" + strings.Join(kept, ", ") + q[end:]
+}
+
+type esHistory struct {
+	cfg   *config.LogBackend
+	since time.Duration
+}
+
+// Since returns how far back to look for logs.
+func (e *esHistory) Since() time.Duration {
+	return e.since
+}
+
+// History returns a pod container log lines.
+func (e *esHistory) History(ctx context.Context, path, co string, start, end time.Time, limit int) ([]string, error) {
+	body, err := json.Marshal(esLogQuery(e.cfg.Fields, path, co, start, end, limit))
+	if err != nil {
+		return nil, err
+	}
+	url := strings.TrimSuffix(e.cfg.URL, "/") + "/" + e.cfg.Index + "/_search"
+	hh := map[string]string{"Content-Type": "application/json"}
+	for k, v := range e.cfg.Headers {
+		hh[k] = v
+	}
+	raw, err := httpDo(ctx, http.MethodPost, url, hh, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseESLogs(raw, e.cfg.Fields.Message)
+}
+
+func esLogQuery(f config.ESLogFields, path, co string, start, end time.Time, limit int) map[string]interface{} {
+	ns, po := client.Namespaced(path)
+	ff := []interface{}{
+		esMatch(f.Namespace, ns),
+		esMatch(f.Pod, po),
+	}
+	if co != "" {
+		ff = append(ff, esMatch(f.Container, co))
+	}
+	ff = append(ff, map[string]interface{}{
+		"range": map[string]interface{}{
+			f.Timestamp: map[string]interface{}{
+				"gte": start.UTC().Format(time.RFC3339Nano),
+				"lt":  end.UTC().Format(time.RFC3339Nano),
+			},
+		},
+	})
+
+	return map[string]interface{}{
+		"size":    limit,
+		"_source": []string{f.Message},
+		"sort":    []interface{}{map[string]interface{}{f.Timestamp: map[string]string{"order": "desc"}}},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{"filter": ff},
+		},
+	}
+}
+
+func esMatch(field, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"match_phrase": map[string]interface{}{field: value},
+	}
+}
+
+func parseESLogs(raw []byte, field string) ([]string, error) {
+	var resp struct {
+		Hits struct {
+			Hits []struct {
+				Source map[string]interface{} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+
+	hh := resp.Hits.Hits
+	ll := make([]string, 0, len(hh))
+	for i := len(hh) - 1; i >= 0; i-- {
+		v, ok := fieldOf(hh[i].Source, field)
+		if !ok {
+			continue
+		}
+		ll = append(ll, strings.TrimRight(fmt.Sprintf("%v", v), "\n"))
+	}
+
+	return ll, nil
+}
+
+// FieldOf looks up a dotted field either flattened or nested.
+func fieldOf(m map[string]interface{}, field string) (interface{}, bool) {
+	if v, ok := m[field]; ok {
+		return v, true
+	}
+	i := strings.Index(field, ".")
+	if i < 0 {
+		return nil, false
+	}
+	sub, ok := m[field[:i]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return fieldOf(sub, field[i+1:])
+}
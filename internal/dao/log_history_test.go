@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLokiSelector(t *testing.T) {
+	uu := map[string]struct {
+		q, co, e string
+	}{
+		"default": {
+			q:  `{namespace="$NAMESPACE", pod="$POD", container="$CONTAINER"}`,
+			co: "c1",
+			e:  `{namespace="default", pod="p1", container="c1"}`,
+		},
+		"noContainer": {
+			q: `{namespace="$NAMESPACE", pod="$POD", container="$CONTAINER"}`,
+			e: `{namespace="default", pod="p1"}`,
+		},
+		"pipeline": {
+			q: `{cluster="prod",container="$CONTAINER",pod=~"$POD"} |= "error"`,
+			e: `{cluster="prod", pod=~"p1"} |= "error"`,
+		},
+		"escaped": {
+			q:  `{app="a\"b", container="$CONTAINER"}`,
+			co: "c1",
+			e:  `{app="a\"b", container="c1"}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, lokiSelector(u.q, "default/p1", u.co))
+		})
+	}
+}
+
+func TestParseESLogs(t *testing.T) {
+	raw := `{"hits":{"hits":[
+  {"_source":{"log":"l3\n"}},
+  {"_source":{"kubernetes":{"msg":"nested"}}},
+  {"_source":{"log":"l1\n"}}
+]}}`
+
+	ll, err := parseESLogs([]byte(raw), "log")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"l1", "l3"}, ll)
+
+	ll, err = parseESLogs([]byte(raw), "kubernetes.msg")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"nested"}, ll)
+}
+
+func TestESHistory(t *testing.T) {
+	var query map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/logs-*/_search", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_source":{"log":"l2"}},{"_source":{"log":"l1"}}]}}`))
+	}))
+	defer srv.Close()
+
+	h, err := NewLogHistory(&config.LogBackend{Kind: config.LogBackendElasticsearch, URL: srv.URL, Index: "logs-*"})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Hour, h.Since())
+
+	end := time.Now()
+	ll, err := h.History(context.Background(), "default/p1", "c1", end.Add(-time.Hour), end, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"l1", "l2"}, ll)
+	assert.Equal(t, float64(10), query["size"])
+	ff := query["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]interface{})
+	assert.Equal(t, 4, len(ff))
+}
+
+func TestNewLogHistoryInvalid(t *testing.T) {
+	_, err := NewLogHistory(&config.LogBackend{Kind: "splunk", URL: "http://fred"})
+	assert.Error(t, err)
+}
+
+func TestContainerStarted(t *testing.T) {
+	at := time.Date(2020, 3, 1, 14, 32, 0, 0, time.UTC)
+	s := v1.PodStatus{
+		InitContainerStatuses: []v1.ContainerStatus{
+			{Name: "i1", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{StartedAt: metav1.NewTime(at)}}},
+		},
+		ContainerStatuses: []v1.ContainerStatus{
+			{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(at.Add(time.Minute))}}},
+			{Name: "c2", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		},
+	}
+
+	started, ok := containerStarted(s, "i1")
+	assert.True(t, ok)
+	assert.Equal(t, at, started.UTC())
+	started, ok = containerStarted(s, "c1")
+	assert.True(t, ok)
+	assert.Equal(t, at.Add(time.Minute), started.UTC())
+	_, ok = containerStarted(s, "c2")
+	assert.False(t, ok)
+	_, ok = containerStarted(s, "c3")
+	assert.False(t, ok)
+}
+
+func TestSendHistory(t *testing.T) {
+	at := time.Date(2020, 3, 1, 14, 32, 0, 0, time.UTC)
+	h := testHistory{lines: []string{"l1", "l2"}}
+	po := v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(at)}}},
+			},
+		},
+	}
+	c := make(chan string, 10)
+
+	sendHistory(context.Background(), c, LogOptions{Path: "default/p1", Container: "c1", Lines: 5, SingleContainer: true, History: &h}, &po)
+	close(c)
+	var ll []string
+	for l := range c {
+		ll = append(ll, l)
+	}
+	assert.Equal(t, []string{"l1", "l2"}, ll)
+	assert.Equal(t, at, h.end.UTC())
+	assert.Equal(t, at.Add(-time.Minute), h.start.UTC())
+	assert.Equal(t, 5, h.limit)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type testHistory struct {
+	lines      []string
+	start, end time.Time
+	limit      int
+}
+
+func (h *testHistory) History(_ context.Context, _, _ string, start, end time.Time, limit int) ([]string, error) {
+	h.start, h.end, h.limit = start, end, limit
+	return h.lines, nil
+}
+
+func (h *testHistory) Since() time.Duration {
+	return time.Minute
+}
@@ -5,17 +5,26 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/render"
 )
 
+// LogPrefix tracks which metadata prefixes log lines.
+type LogPrefix struct {
+	Pod, Container, Node bool
+}
+
 // LogOptions represent logger options.
 type LogOptions struct {
 	Path            string
 	Container       string
+	Node            string
 	Lines           int64
 	Color           color.Paint
 	Previous        bool
 	SingleContainer bool
 	MultiPods       bool
+	History         LogHistory
+	Prefix          *LogPrefix
 }
 
 // HasContainer checks if a container is present.
@@ -47,11 +56,20 @@ func colorize(c color.Paint, txt string) string {
 }
 
 // DecorateLog add a log header to display po/co information along with the log message.
+// Unless a prefix is set, pod and container names are only shown when
+// needed to tell lines apart.
 func (o LogOptions) DecorateLog(msg string) string {
 	_, n := client.Namespaced(o.Path)
 	if msg == "" {
 		return msg
 	}
+	node := o.Node
+	if render.Anonymous {
+		n, node = render.AnonymizeName(n), render.AnonymizeName(node)
+	}
+	if o.Prefix != nil {
+		return o.prefixLog(n, node, msg)
+	}
 
 	if o.MultiPods {
 		return colorize(o.Color, n+":"+o.Container+" ") + msg
@@ -63,3 +81,21 @@ func (o LogOptions) DecorateLog(msg string) string {
 
 	return msg
 }
+
+func (o LogOptions) prefixLog(po, node, msg string) string {
+	tags := make([]string, 0, 3)
+	if o.Prefix.Node && node != "" {
+		tags = append(tags, colorize(asColor(node), node))
+	}
+	if o.Prefix.Pod {
+		tags = append(tags, colorize(asColor(po), po))
+	}
+	if o.Prefix.Container && o.Container != "" {
+		tags = append(tags, colorize(asColor(po+o.Container), o.Container))
+	}
+	if len(tags) == 0 {
+		return msg
+	}
+
+	return strings.Join(tags, ":") + " " + msg
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecorateLog(t *testing.T) {
+	uu := map[string]struct {
+		opts LogOptions
+		e    string
+	}{
+		"single": {
+			opts: LogOptions{Path: "default/p1", Container: "c1", SingleContainer: true},
+			e:    "hello",
+		},
+		"container": {
+			opts: LogOptions{Path: "default/p1", Container: "c1", Color: color.Red},
+			e:    color.Colorize("c1 ", color.Red) + "hello",
+		},
+		"prefixOff": {
+			opts: LogOptions{Path: "default/p1", Container: "c1", Prefix: &LogPrefix{}},
+			e:    "hello",
+		},
+		"prefixAll": {
+			opts: LogOptions{Path: "default/p1", Container: "c1", Node: "n1", SingleContainer: true, Prefix: &LogPrefix{Pod: true, Container: true, Node: true}},
+			e: color.Colorize("n1", asColor("n1")) + ":" +
+				color.Colorize("p1", asColor("p1")) + ":" +
+				color.Colorize("c1", asColor("p1c1")) + " hello",
+		},
+		"noNode": {
+			opts: LogOptions{Path: "default/p1", Container: "c1", Prefix: &LogPrefix{Node: true}},
+			e:    "hello",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.opts.DecorateLog("hello"))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const lokiQueryPath = "/loki/api/v1/query_range"
+
+// LokiEntry represents a log line from a loki stream.
+type LokiEntry struct {
+	Time   time.Time
+	Labels map[string]string
+	Line   string
+}
+
+type lokiResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// LokiQuery fetches the most recent lines matching a LogQL query in the given
+// time range. Entries are returned oldest first.
+func LokiQuery(ctx context.Context, endpoint string, headers map[string]string, query string, start, end time.Time, limit int) ([]LokiEntry, error) {
+	q := url.Values{}
+	q.Set("query", query)
+	q.Set("direction", "backward")
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	q.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+
+	raw, err := httpGet(ctx, strings.TrimSuffix(endpoint, "/")+lokiQueryPath+"?"+q.Encode(), headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLokiResponse(raw)
+}
+
+func parseLokiResponse(raw []byte) ([]LokiEntry, error) {
+	var resp lokiResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.ResultType != "streams" {
+		return nil, fmt.Errorf("expecting loki streams but got %q", resp.Data.ResultType)
+	}
+
+	var ee []LokiEntry
+	for _, s := range resp.Data.Result {
+		for _, v := range s.Values {
+			ns, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid loki timestamp %q", v[0])
+			}
+			ee = append(ee, LokiEntry{Time: time.Unix(0, ns), Labels: s.Stream, Line: v[1]})
+		}
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.Before(ee[j].Time)
+	})
+
+	return ee, nil
+}
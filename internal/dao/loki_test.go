@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lokiStreams = `{"status":"success","data":{"resultType":"streams","result":[
+  {"stream":{"app":"fred"},"values":[["1583073120000000000","l3"],["1583073060000000000","l1"]]},
+  {"stream":{"app":"blee"},"values":[["1583073090000000000","l2"]]}
+]}}`
+
+func TestParseLokiResponse(t *testing.T) {
+	uu := map[string]struct {
+		raw    string
+		e      []string
+		hasErr bool
+	}{
+		"streams": {
+			raw: lokiStreams,
+			e:   []string{"l1", "l2", "l3"},
+		},
+		"matrix": {
+			raw:    `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			hasErr: true,
+		},
+		"badTime": {
+			raw:    `{"status":"success","data":{"resultType":"streams","result":[{"values":[["x","l1"]]}]}}`,
+			hasErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := parseLokiResponse([]byte(u.raw))
+			if u.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			ll := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ll = append(ll, e.Line)
+			}
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestLokiQuery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != lokiQueryPath || r.Header.Get("X-Scope-OrgID") != "fred" {
+			http.Error(w, "nope", http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, `{job="audit"}`, r.URL.Query().Get("query"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(lokiStreams))
+	}))
+	defer srv.Close()
+
+	now := time.Now()
+	ee, err := LokiQuery(context.Background(), srv.URL+"/", map[string]string{"X-Scope-OrgID": "fred"}, `{job="audit"}`, now.Add(-time.Hour), now, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, "fred", ee[0].Labels["app"])
+
+	_, err = LokiQuery(context.Background(), srv.URL, nil, `{job="audit"}`, now.Add(-time.Hour), now, 10)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// DecodeManifest converts a YAML or JSON manifest to a resource.
+func DecodeManifest(manifest []byte) (*unstructured.Unstructured, error) {
+	raw, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return nil, err
+	}
+	var u unstructured.Unstructured
+	if err := u.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	if u.GetName() == "" && u.GetGenerateName() == "" {
+		return nil, errors.New("manifest has no name")
+	}
+
+	return &u, nil
+}
+
+// Create creates a resource from a manifest and returns its path.
+func Create(c client.Connection, manifest []byte) (string, error) {
+	u, err := DecodeManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+	gvk := u.GroupVersionKind()
+	m, err := (&RestMapper{Connection: c}).ToRESTMapper()
+	if err != nil {
+		return "", err
+	}
+	mapping, err := m.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return "", err
+	}
+
+	ns := client.ClusterScope
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if ns = u.GetNamespace(); ns == "" {
+			ns, _ = c.Config().CurrentNamespaceName()
+		}
+		if ns == "" {
+			ns = metav1.NamespaceDefault
+		}
+	}
+	gvr := client.FromGVAndR(mapping.Resource.GroupVersion().String(), mapping.Resource.Resource)
+	auth, err := c.CanI(ns, gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create %s", gvr.R())
+	}
+
+	dial := c.DynDialOrDie().Resource(mapping.Resource)
+	if client.IsClusterScoped(ns) {
+		u, err = dial.Create(u, metav1.CreateOptions{})
+	} else {
+		u, err = dial.Namespace(ns).Create(u, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(u.GetNamespace(), u.GetName()), nil
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	uu := map[string]struct {
+		m      string
+		kind   string
+		name   string
+		hasErr bool
+	}{
+		"yaml": {
+			m:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: fred\n  namespace: blee\n",
+			kind: "ConfigMap",
+			name: "fred",
+		},
+		"json": {
+			m:    `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "zorg"}}`,
+			kind: "Secret",
+			name: "zorg",
+		},
+		"generateName": {
+			m:    "apiVersion: batch/v1\nkind: Job\nmetadata:\n  generateName: fred-\n",
+			kind: "Job",
+		},
+		"noName": {
+			m:      "apiVersion: v1\nkind: ConfigMap\nmetadata: {}\n",
+			hasErr: true,
+		},
+		"noKind": {
+			m:      "apiVersion: v1\nmetadata:\n  name: fred\n",
+			hasErr: true,
+		},
+		"garbage": {
+			m:      "- a\n- b\n",
+			hasErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := DecodeManifest([]byte(u.m))
+			if u.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.kind, o.GetKind())
+			assert.Equal(t, u.name, o.GetName())
+		})
+	}
+}
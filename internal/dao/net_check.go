@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NetCheckDNS resolves a host name.
+	NetCheckDNS = "dns"
+	// NetCheckHTTP issues a http request.
+	NetCheckHTTP = "http"
+	// NetCheckTCP opens a tcp connection.
+	NetCheckTCP = "tcp"
+
+	// NetshootImage is the image of the injected network debugging container.
+	NetshootImage = "nicolaka/netshoot"
+
+	netshootContainer = "k9s-netshoot"
+	netshootWait      = time.Minute
+	netCheckTimeout   = 10
+
+	dnsCheckScript = `if command -v nslookup >/dev/null 2>&1; then nslookup "$1"
+elif command -v getent >/dev/null 2>&1; then getent hosts "$1"
+elif command -v host >/dev/null 2>&1; then host "$1"
+else echo "no nslookup, getent or host found"; exit 127; fi`
+
+	httpCheckScript = `if command -v curl >/dev/null 2>&1; then curl -sS -o /dev/null -m "$2" -w "%{http_code} in %{time_total}s\n" "$1"
+elif command -v wget >/dev/null 2>&1; then wget -q -S -O /dev/null -T "$2" "$1" 2>&1
+else echo "no curl or wget found"; exit 127; fi`
+
+	tcpCheckScript = `if command -v nc >/dev/null 2>&1; then nc -z -v -w "$3" "$1" "$2" 2>&1
+elif command -v bash >/dev/null 2>&1; then timeout "$3" bash -c 'exec 3<>/dev/tcp/$0/$1' "$1" "$2" && echo "connected to $1:$2"
+else echo "no nc or bash found"; exit 127; fi`
+)
+
+// NetChecks lists the supported network checks.
+var NetChecks = []string{NetCheckDNS, NetCheckHTTP, NetCheckTCP}
+
+// NetCheckResult represents the outcome of a network check run from a pod.
+type NetCheckResult struct {
+	Kind      string `json:"kind"`
+	Target    string `json:"target"`
+	Container string `json:"container"`
+	OK        bool   `json:"ok"`
+	Took      string `json:"latency"`
+	Output    string `json:"output"`
+}
+
+// NetCheckCommand returns the command running a network check against a
+// target. Targets are passed as arguments so they never get interpreted by
+// the shell.
+func NetCheckCommand(kind, target string) ([]string, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return nil, fmt.Errorf("a target is required")
+	}
+	timeout := fmt.Sprintf("%d", netCheckTimeout)
+
+	switch kind {
+	case NetCheckDNS:
+		return []string{"sh", "-c", dnsCheckScript, "k9s", target}, nil
+	case NetCheckHTTP:
+		if !strings.Contains(target, "://") {
+			target = "http://" + target
+		}
+		return []string{"sh", "-c", httpCheckScript, "k9s", target, timeout}, nil
+	case NetCheckTCP:
+		host, port, err := net.SplitHostPort(target)
+		if err != nil {
+			return nil, fmt.Errorf("tcp targets must be host:port (%s)", err)
+		}
+		return []string{"sh", "-c", tcpCheckScript, "k9s", host, port, timeout}, nil
+	default:
+		return nil, fmt.Errorf("unsupported network check %q", kind)
+	}
+}
+
+// RunNetCheck runs a network check from a pod container.
+func RunNetCheck(ctx context.Context, c client.Connection, path, co, kind, target string) (NetCheckResult, error) {
+	res := NetCheckResult{Kind: kind, Target: target, Container: co}
+	cmd, err := NetCheckCommand(kind, target)
+	if err != nil {
+		return res, err
+	}
+
+	var out bytes.Buffer
+	t := time.Now()
+	err = Exec(ctx, c, ExecOptions{
+		Path:      path,
+		Container: co,
+		Command:   cmd,
+		Stdout:    &out,
+		Stderr:    &out,
+	})
+	if ctx.Err() != nil {
+		return res, ctx.Err()
+	}
+	res.Took = time.Since(t).Round(time.Millisecond).String()
+	res.Output = strings.TrimSpace(out.String())
+	res.OK = err == nil
+	if err != nil {
+		res.Output = strings.TrimSpace(res.Output + "\n" + err.Error())
+	}
+
+	return res, nil
+}
+
+// InjectNetshoot adds a network debugging ephemeral container to a pod unless
+// already present and waits for it to run. It returns the container name.
+func InjectNetshoot(ctx context.Context, c client.Connection, path, image string) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := c.CanI(ns, "v1/pods:ephemeralcontainers", []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to add ephemeral containers to pod %s", path)
+	}
+
+	pods := c.DialOrDie().CoreV1().Pods(ns)
+	ec, err := pods.GetEphemeralContainers(n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if !hasEphemeral(ec.EphemeralContainers, netshootContainer) {
+		ec.EphemeralContainers = append(ec.EphemeralContainers, v1.EphemeralContainer{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:    netshootContainer,
+				Image:   image,
+				Command: []string{"sleep", "infinity"},
+				Stdin:   true,
+			},
+		})
+		if _, err := pods.UpdateEphemeralContainers(n, ec); err != nil {
+			return "", err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, netshootWait)
+	defer cancel()
+	for {
+		po, err := pods.Get(n, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		if ephemeralRunning(po.Status.EphemeralContainerStatuses, netshootContainer) {
+			return netshootContainer, nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("container %s did not start within %s", netshootContainer, netshootWait)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hasEphemeral(ee []v1.EphemeralContainer, n string) bool {
+	for _, e := range ee {
+		if e.Name == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ephemeralRunning(ss []v1.ContainerStatus, n string) bool {
+	for _, s := range ss {
+		if s.Name == n && s.State.Running != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNetCheckCommand(t *testing.T) {
+	uu := map[string]struct {
+		kind, target string
+		args         []string
+		err          bool
+	}{
+		"dns": {
+			kind:   NetCheckDNS,
+			target: " kubernetes.default ",
+			args:   []string{"k9s", "kubernetes.default"},
+		},
+		"http": {
+			kind:   NetCheckHTTP,
+			target: "svc:8080/healthz",
+			args:   []string{"k9s", "http://svc:8080/healthz", "10"},
+		},
+		"https": {
+			kind:   NetCheckHTTP,
+			target: "https://svc",
+			args:   []string{"k9s", "https://svc", "10"},
+		},
+		"tcp": {
+			kind:   NetCheckTCP,
+			target: "db:5432",
+			args:   []string{"k9s", "db", "5432", "10"},
+		},
+		"tcpNoPort": {
+			kind:   NetCheckTCP,
+			target: "db",
+			err:    true,
+		},
+		"noTarget": {
+			kind: NetCheckDNS,
+			err:  true,
+		},
+		"unknown": {
+			kind:   "icmp",
+			target: "db",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, err := NetCheckCommand(u.kind, u.target)
+			assert.Equal(t, u.err, err != nil)
+			if u.err {
+				return
+			}
+			assert.Equal(t, "sh", cmd[0])
+			assert.Equal(t, "-c", cmd[1])
+			assert.Equal(t, u.args, cmd[3:])
+		})
+	}
+}
+
+func TestEphemeralRunning(t *testing.T) {
+	ss := []v1.ContainerStatus{
+		{Name: "fred", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}},
+		{Name: netshootContainer, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+	}
+
+	assert.True(t, ephemeralRunning(ss, netshootContainer))
+	assert.False(t, ephemeralRunning(ss, "fred"))
+	assert.False(t, ephemeralRunning(nil, netshootContainer))
+	assert.True(t, hasEphemeral([]v1.EphemeralContainer{{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "blee"}}}, "blee"))
+}
@@ -2,7 +2,10 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -11,12 +14,27 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 var (
-	_ Accessor = (*Node)(nil)
+	_ Accessor  = (*Node)(nil)
+	_ Describer = (*Node)(nil)
+)
+
+const (
+	// Kubelet image garbage collection and hard eviction defaults in percent.
+	imageGCHighThreshold = 85
+	nodeFSEvictionFree   = 10
+	imageFSEvictionFree  = 15
+
+	maxReportedNodeImages = 20
+
+	nodeGCPressureOK       = "OK"
+	nodeGCPressureHigh     = "HIGH -- image garbage collection threshold exceeded"
+	nodeGCPressureCritical = "CRITICAL -- eviction threshold reached"
 )
 
 // NodeMetricsFunc retrieves node metrics.
@@ -46,6 +64,12 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if err != nil {
 		return nil, err
 	}
+	var reqs map[string]v1.ResourceList
+	if len(render.ExtendedResources) > 0 {
+		if reqs, err = n.extendedRequests(); err != nil {
+			log.Warn().Err(err).Msgf("No extended resources requests")
+		}
+	}
 	oo := make([]runtime.Object, len(nn.Items))
 	for i, no := range nn.Items {
 		o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&nn.Items[i])
@@ -53,17 +77,247 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return nil, err
 		}
 		oo[i] = &render.NodeWithMetrics{
-			Raw: &unstructured.Unstructured{Object: o},
-			MX:  nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
+			Raw:       &unstructured.Unstructured{Object: o},
+			MX:        nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
+			Requested: reqs[no.Name],
 		}
 	}
 
 	return oo, nil
 }
 
+// extendedRequests sums up the extended resources requested by active pods
+// per node.
+func (n *Node) extendedRequests() (map[string]v1.ResourceList, error) {
+	oo, err := n.Factory.List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	return nodeExtendedRequests(pods), nil
+}
+
+// Describe describes a node along with its disk usage and cached images.
+func (n *Node) Describe(path string) (string, error) {
+	desc, err := n.Resource.Describe(path)
+	if err != nil {
+		return "", err
+	}
+
+	_, name := client.Namespaced(path)
+	no, err := n.Client().DialOrDie().CoreV1().Nodes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	summary, err := fetchNodeSummary(n.Client(), name)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No stats summary for node %q", name)
+	}
+
+	return desc + "\n" + nodeDiskReport(no, summary), nil
+}
+
+func fetchNodeSummary(c client.Connection, name string) (*nodeSummary, error) {
+	raw, err := c.DialOrDie().CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(name).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+	var s nodeSummary
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func nodeExtendedRequests(pods []v1.Pod) map[string]v1.ResourceList {
+	reqs := make(map[string]v1.ResourceList)
+	for _, po := range pods {
+		if po.Spec.NodeName == "" || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		rl, ok := reqs[po.Spec.NodeName]
+		if !ok {
+			rl = make(v1.ResourceList)
+			reqs[po.Spec.NodeName] = rl
+		}
+		for name, q := range render.PodExtendedRequests(po.Spec) {
+			total := rl[name]
+			total.Add(q)
+			rl[name] = total
+		}
+	}
+
+	return reqs
+}
+
+type fsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes"`
+	CapacityBytes  *uint64 `json:"capacityBytes"`
+	UsedBytes      *uint64 `json:"usedBytes"`
+	InodesFree     *uint64 `json:"inodesFree"`
+	Inodes         *uint64 `json:"inodes"`
+}
+
+type podStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	CPU *struct {
+		UsageNanoCores *uint64 `json:"usageNanoCores"`
+	} `json:"cpu"`
+	Memory *struct {
+		WorkingSetBytes *uint64 `json:"workingSetBytes"`
+	} `json:"memory"`
+}
+
+type nodeSummary struct {
+	Node struct {
+		Fs      *fsStats `json:"fs"`
+		Runtime *struct {
+			ImageFs *fsStats `json:"imageFs"`
+		} `json:"runtime"`
+	} `json:"node"`
+	Pods []podStats `json:"pods"`
+}
+
+func (s *nodeSummary) imageFS() *fsStats {
+	if s == nil || s.Node.Runtime == nil {
+		return nil
+	}
+
+	return s.Node.Runtime.ImageFs
+}
+
+func (s *nodeSummary) nodeFS() *fsStats {
+	if s == nil {
+		return nil
+	}
+
+	return s.Node.Fs
+}
+
+func (f *fsStats) usedPerc() int {
+	if f == nil || f.CapacityBytes == nil || f.AvailableBytes == nil || *f.CapacityBytes == 0 {
+		return 0
+	}
+
+	return int(100 - *f.AvailableBytes*100 / *f.CapacityBytes)
+}
+
+func (f *fsStats) String() string {
+	if f == nil || f.CapacityBytes == nil || f.AvailableBytes == nil {
+		return "n/a"
+	}
+	s := fmt.Sprintf("%s/%s (%d%%)", toHumanBytes(*f.CapacityBytes-*f.AvailableBytes), toHumanBytes(*f.CapacityBytes), f.usedPerc())
+	if f.Inodes != nil && f.InodesFree != nil && *f.Inodes > 0 {
+		s += fmt.Sprintf(" inodes %d%%", 100 - *f.InodesFree*100 / *f.Inodes)
+	}
+
+	return s
+}
+
+func nodeGCPressure(s *nodeSummary) string {
+	nfs, ifs := s.nodeFS(), s.imageFS()
+	if ifs == nil {
+		ifs = nfs
+	}
+	switch {
+	case nfs != nil && nfs.usedPerc() > 100-nodeFSEvictionFree, ifs != nil && ifs.usedPerc() > 100-imageFSEvictionFree:
+		return nodeGCPressureCritical
+	case ifs != nil && ifs.usedPerc() >= imageGCHighThreshold:
+		return nodeGCPressureHigh
+	default:
+		return nodeGCPressureOK
+	}
+}
+
+func nodeDiskReport(no *v1.Node, s *nodeSummary) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Disk:\n")
+	pressure := "Unknown"
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeDiskPressure {
+			pressure = string(c.Status)
+		}
+	}
+	fmt.Fprintf(&b, "  DiskPressure:  %s\n", pressure)
+	if s == nil {
+		fmt.Fprintf(&b, "  Stats:         n/a\n")
+	} else {
+		fmt.Fprintf(&b, "  GC Pressure:   %s\n", nodeGCPressure(s))
+		fmt.Fprintf(&b, "  Filesystem:    %s\n", s.nodeFS())
+		if ifs := s.imageFS(); ifs != nil {
+			fmt.Fprintf(&b, "  ImageFS:       %s\n", ifs)
+		}
+	}
+
+	ii := make([]v1.ContainerImage, len(no.Status.Images))
+	copy(ii, no.Status.Images)
+	sort.Slice(ii, func(i, j int) bool {
+		return ii[i].SizeBytes > ii[j].SizeBytes
+	})
+	var total int64
+	for _, i := range ii {
+		total += i.SizeBytes
+	}
+	fmt.Fprintf(&b, "Images (%d, %s):\n", len(ii), toHumanBytes(uint64(total)))
+	for i, img := range ii {
+		if i == maxReportedNodeImages {
+			fmt.Fprintf(&b, "  ... %d more\n", len(ii)-maxReportedNodeImages)
+			break
+		}
+		fmt.Fprintf(&b, "  %-10s %s\n", toHumanBytes(uint64(img.SizeBytes)), imageName(img.Names))
+	}
+
+	return b.String()
+}
+
+func imageName(nn []string) string {
+	for _, n := range nn {
+		if !strings.Contains(n, "@sha256:") {
+			return n
+		}
+	}
+	if len(nn) > 0 {
+		return nn[0]
+	}
+
+	return "<none>"
+}
+
+func toHumanBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ci", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
 // FetchNodes retrieves all nodes.
 func FetchNodes(f Factory, labelsSel string) (*v1.NodeList, error) {
 	var list v1.NodeList
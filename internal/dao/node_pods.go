@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*NodePods)(nil)
+
+// NodePods tracks the pods scheduled on a node along with their kubelet stats.
+type NodePods struct {
+	NonResource
+}
+
+// List returns the pods running on the node located at the context path.
+func (n *NodePods) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("expecting a node path")
+	}
+	_, name := client.Namespaced(path)
+
+	dial := n.Client().DialOrDie()
+	no, err := dial.CoreV1().Nodes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pods, err := dial.CoreV1().Pods(client.AllNamespaces).List(metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	summary, err := fetchNodeSummary(n.Client(), name)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No stats summary for node %q", name)
+	}
+
+	rr := nodePods(no, pods.Items, summary)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nodePods returns the node pods kubelet usage followed by their total and
+// the node allocatable.
+func nodePods(no *v1.Node, pods []v1.Pod, s *nodeSummary) []render.NodePodRes {
+	stats := make(map[string]podStats)
+	if s != nil {
+		for _, st := range s.Pods {
+			stats[client.FQN(st.PodRef.Namespace, st.PodRef.Name)] = st
+		}
+	}
+
+	alloc := render.NodePodRes{
+		Summary:  render.NodePodAllocatable,
+		HasStats: true,
+		CPU:      no.Status.Allocatable.Cpu().MilliValue(),
+		MEM:      no.Status.Allocatable.Memory().Value(),
+		Extended: make(v1.ResourceList),
+	}
+	alloc.AllocCPU, alloc.AllocMEM = alloc.CPU, alloc.MEM
+	total := render.NodePodRes{
+		Summary:  render.NodePodTotal,
+		AllocCPU: alloc.CPU,
+		AllocMEM: alloc.MEM,
+		Extended: make(v1.ResourceList),
+	}
+	for _, res := range render.ExtendedResources {
+		name := v1.ResourceName(res)
+		alloc.Extended[name] = no.Status.Allocatable[name]
+	}
+	rr := make([]render.NodePodRes, 0, len(pods)+2)
+	for _, po := range pods {
+		r := render.NodePodRes{
+			Path:     client.FQN(po.Namespace, po.Name),
+			Phase:    string(po.Status.Phase),
+			AllocCPU: total.AllocCPU,
+			AllocMEM: total.AllocMEM,
+			Extended: render.PodExtendedRequests(po.Spec),
+		}
+		if po.DeletionTimestamp != nil {
+			r.Phase = "Terminating"
+		}
+		if po.Status.Phase != v1.PodSucceeded && po.Status.Phase != v1.PodFailed {
+			for name, q := range r.Extended {
+				t := total.Extended[name]
+				t.Add(q)
+				total.Extended[name] = t
+			}
+		}
+		if st, ok := stats[r.Path]; ok && st.CPU != nil && st.CPU.UsageNanoCores != nil && st.Memory != nil && st.Memory.WorkingSetBytes != nil {
+			r.HasStats = true
+			r.CPU = int64(*st.CPU.UsageNanoCores / 1e6)
+			r.MEM = int64(*st.Memory.WorkingSetBytes)
+			total.CPU += r.CPU
+			total.MEM += r.MEM
+			total.HasStats = true
+		}
+		rr = append(rr, r)
+	}
+
+	return append(rr, total, alloc)
+}
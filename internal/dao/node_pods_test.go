@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodePods(t *testing.T) {
+	render.ExtendedResources = []string{"nvidia.com/gpu"}
+	defer func() { render.ExtendedResources = nil }()
+
+	gpu := v1.ResourceName("nvidia.com/gpu")
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+				gpu:               resource.MustParse("4"),
+			},
+		},
+	}
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Resources: v1.ResourceRequirements{Limits: v1.ResourceList{gpu: resource.MustParse("1")}}},
+				{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{gpu: resource.MustParse("2")}}},
+			}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p2"}, Status: v1.PodStatus{Phase: v1.PodPending}},
+	}
+	var s nodeSummary
+	s.Pods = []podStats{newPodStats("default", "p1", 250*1e6, 1024*1024*1024)}
+
+	rr := nodePods(&no, pods, &s)
+	assert.Equal(t, 4, len(rr))
+
+	assert.Equal(t, "default/p1", rr[0].Path)
+	assert.Equal(t, "Running", rr[0].Phase)
+	assert.True(t, rr[0].HasStats)
+	assert.Equal(t, int64(250), rr[0].CPU)
+	assert.Equal(t, int64(1024*1024*1024), rr[0].MEM)
+	assert.Equal(t, int64(2000), rr[0].AllocCPU)
+	assert.Equal(t, "3", qty(rr[0].Extended, gpu))
+
+	assert.False(t, rr[1].HasStats)
+	assert.Equal(t, "0", qty(rr[1].Extended, gpu))
+
+	assert.Equal(t, render.NodePodTotal, rr[2].Summary)
+	assert.Equal(t, int64(250), rr[2].CPU)
+	assert.Equal(t, "3", qty(rr[2].Extended, gpu))
+
+	assert.Equal(t, render.NodePodAllocatable, rr[3].Summary)
+	assert.Equal(t, int64(2000), rr[3].CPU)
+	assert.Equal(t, int64(4*1024*1024*1024), rr[3].MEM)
+	assert.Equal(t, "4", qty(rr[3].Extended, gpu))
+}
+
+func TestNodePodsNoSummary(t *testing.T) {
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"}},
+	}
+
+	rr := nodePods(&v1.Node{}, pods, nil)
+	assert.Equal(t, 3, len(rr))
+	assert.False(t, rr[0].HasStats)
+	assert.False(t, rr[1].HasStats)
+}
+
+func newPodStats(ns, n string, cpu, mem uint64) podStats {
+	var st podStats
+	st.PodRef.Namespace, st.PodRef.Name = ns, n
+	st.CPU = &struct {
+		UsageNanoCores *uint64 `json:"usageNanoCores"`
+	}{UsageNanoCores: &cpu}
+	st.Memory = &struct {
+		WorkingSetBytes *uint64 `json:"workingSetBytes"`
+	}{WorkingSetBytes: &mem}
+
+	return st
+}
+
+func qty(rl v1.ResourceList, name v1.ResourceName) string {
+	q := rl[name]
+	return q.String()
+}
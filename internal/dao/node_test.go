@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestNodeGCPressure(t *testing.T) {
+	uu := map[string]struct {
+		nodeFree, imageFree uint64
+		e                   string
+	}{
+		"ok":       {nodeFree: 50, imageFree: 50, e: nodeGCPressureOK},
+		"gc":       {nodeFree: 50, imageFree: 14, e: nodeGCPressureCritical},
+		"high":     {nodeFree: 50, imageFree: 15, e: nodeGCPressureHigh},
+		"nodefs":   {nodeFree: 5, imageFree: 50, e: nodeGCPressureCritical},
+		"no-stats": {e: nodeGCPressureOK},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var s nodeSummary
+			if u.nodeFree > 0 {
+				s.Node.Fs = newFSStats(100, u.nodeFree)
+				s.Node.Runtime = &struct {
+					ImageFs *fsStats `json:"imageFs"`
+				}{ImageFs: newFSStats(100, u.imageFree)}
+			}
+			assert.Equal(t, u.e, nodeGCPressure(&s))
+		})
+	}
+}
+
+func TestNodeDiskReport(t *testing.T) {
+	no := v1.Node{
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue}},
+			Images: []v1.ContainerImage{
+				{Names: []string{"nginx@sha256:abc", "nginx:1.17"}, SizeBytes: 1024 * 1024},
+				{Names: []string{"redis:5"}, SizeBytes: 3 * 1024 * 1024 * 1024},
+			},
+		},
+	}
+	var s nodeSummary
+	s.Node.Fs = newFSStats(100*1024*1024*1024, 50*1024*1024*1024)
+
+	r := nodeDiskReport(&no, &s)
+	assert.Contains(t, r, "DiskPressure:  True")
+	assert.Contains(t, r, "Filesystem:    50.0Gi/100.0Gi (50%)")
+	assert.Contains(t, r, "Images (2, 3.0Gi):\n  3.0Gi      redis:5\n  1.0Mi      nginx:1.17\n")
+}
+
+func newFSStats(capacity, available uint64) *fsStats {
+	return &fsStats{CapacityBytes: &capacity, AvailableBytes: &available}
+}
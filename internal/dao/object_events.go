@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EventsFor returns the most recent events involving the resource at path,
+// newest first. Events of cluster scoped resources are looked up in all
+// namespaces.
+func EventsFor(f Factory, path string, max int) ([]*v1.Event, error) {
+	ns, n := client.Namespaced(path)
+	oo, err := f.List("v1/events", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return latestEvents(oo, n, max)
+}
+
+// LatestEvents picks the newest events involving the named object.
+func latestEvents(oo []runtime.Object, n string, max int) ([]*v1.Event, error) {
+	ee := make([]*v1.Event, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			return nil, err
+		}
+		if evt.InvolvedObject.Name == n {
+			ee = append(ee, &evt)
+		}
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		ti, tj := EventTime(ee[i]), EventTime(ee[j])
+		return tj.Before(&ti)
+	})
+	if max > 0 && len(ee) > max {
+		ee = ee[:max]
+	}
+
+	return ee, nil
+}
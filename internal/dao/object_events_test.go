@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLatestEvents(t *testing.T) {
+	at := time.Date(2020, 3, 1, 14, 32, 0, 0, time.UTC)
+	oo := []runtime.Object{
+		makeObjectEvent(t, "e1", "fred", at),
+		makeObjectEvent(t, "e2", "blee", at.Add(time.Minute)),
+		makeObjectEvent(t, "e3", "fred", at.Add(2*time.Minute)),
+		makeObjectEvent(t, "e4", "fred", at.Add(-time.Minute)),
+	}
+
+	uu := map[string]struct {
+		max int
+		e   []string
+	}{
+		"all":      {max: 0, e: []string{"e3", "e1", "e4"}},
+		"limited":  {max: 2, e: []string{"e3", "e1"}},
+		"overflow": {max: 10, e: []string{"e3", "e1", "e4"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := latestEvents(oo, "fred", u.max)
+			assert.Nil(t, err)
+			nn := make([]string, 0, len(ee))
+			for _, e := range ee {
+				nn = append(nn, e.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+// Helpers...
+
+func makeObjectEvent(t *testing.T, n, involved string, last time.Time) *unstructured.Unstructured {
+	evt := makeTimelineEvent(n, "u1", metav1.NewTime(last), "Normal")
+	evt.InvolvedObject.Name = involved
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(evt)
+	assert.Nil(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}
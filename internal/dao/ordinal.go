@@ -0,0 +1,168 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A collection of ordinal pod states.
+const (
+	OrdinalMissing     = "Missing"
+	OrdinalTerminating = "Terminating"
+)
+
+var _ Accessor = (*Ordinal)(nil)
+
+// Ordinal tracks statefulset ordinals along with their pods and claims.
+type Ordinal struct {
+	NonResource
+}
+
+// List returns the ordinals of the statefulset located at the context path.
+func (o *Ordinal) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context path")
+	}
+	rr, err := StatefulSetOrdinals(o.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// StatefulSetOrdinals returns a statefulset pods and claims per ordinal, including
+// claims left behind by scaled down ordinals.
+func StatefulSetOrdinals(f Factory, path string) ([]render.OrdinalRes, error) {
+	u, err := fetchUnstructured(f, "apps/v1/statefulsets", path)
+	if err != nil {
+		return nil, err
+	}
+	var sts appsv1.StatefulSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string]*v1.Pod)
+	oo, err := f.List("v1/pods", sts.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		if isOwnedBy(po.OwnerReferences, sts.UID) {
+			pods[po.Name] = &po
+		}
+	}
+
+	var pvcs []v1.PersistentVolumeClaim
+	if len(sts.Spec.VolumeClaimTemplates) > 0 {
+		oo, err := f.List("v1/persistentvolumeclaims", sts.Namespace, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			var pvc v1.PersistentVolumeClaim
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc); err != nil {
+				return nil, err
+			}
+			pvcs = append(pvcs, pvc)
+		}
+	}
+
+	return ordinalsOf(&sts, pods, pvcs), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ordinalsOf(sts *appsv1.StatefulSet, pods map[string]*v1.Pod, pvcs []v1.PersistentVolumeClaim) []render.OrdinalRes {
+	var replicas int
+	if sts.Spec.Replicas != nil {
+		replicas = int(*sts.Spec.Replicas)
+	}
+	ordinals := make(map[int]struct{}, replicas)
+	for i := 0; i < replicas; i++ {
+		ordinals[i] = struct{}{}
+	}
+	for n := range pods {
+		if i := podOrdinal(n); i >= 0 {
+			ordinals[i] = struct{}{}
+		}
+	}
+
+	claims := make(map[string]*v1.PersistentVolumeClaim, len(pvcs))
+	for i := range pvcs {
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			prefix := t.Name + "-" + sts.Name + "-"
+			if !strings.HasPrefix(pvcs[i].Name, prefix) {
+				continue
+			}
+			o, err := strconv.Atoi(strings.TrimPrefix(pvcs[i].Name, prefix))
+			if err != nil || o < 0 {
+				continue
+			}
+			ordinals[o] = struct{}{}
+			claims[pvcs[i].Name] = &pvcs[i]
+		}
+	}
+
+	ii := make([]int, 0, len(ordinals))
+	for i := range ordinals {
+		ii = append(ii, i)
+	}
+	sort.Ints(ii)
+
+	var rr []render.OrdinalRes
+	for _, i := range ii {
+		n := sts.Name + "-" + strconv.Itoa(i)
+		res := render.OrdinalRes{
+			Path:      client.FQN(sts.Namespace, n),
+			Ordinal:   i,
+			PodStatus: OrdinalMissing,
+		}
+		if po, ok := pods[n]; ok {
+			res.PodStatus = string(po.Status.Phase)
+			if po.DeletionTimestamp != nil {
+				res.PodStatus = OrdinalTerminating
+			}
+		}
+		if len(sts.Spec.VolumeClaimTemplates) == 0 {
+			rr = append(rr, res)
+			continue
+		}
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			r := res
+			r.Claim, r.ClaimStatus = t.Name+"-"+n, OrdinalMissing
+			if pvc, ok := claims[r.Claim]; ok {
+				r.ClaimStatus, r.Volume = string(pvc.Status.Phase), pvc.Spec.VolumeName
+				if q, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+					r.Capacity = q.String()
+				}
+			}
+			rr = append(rr, r)
+		}
+	}
+
+	return rr
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOrdinalsOf(t *testing.T) {
+	replicas := int32(2)
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			},
+		},
+	}
+	now := metav1.Now()
+	pods := map[string]*v1.Pod{
+		"web-0": {Status: v1.PodStatus{Phase: v1.PodRunning}},
+		"web-1": {ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}, Status: v1.PodStatus{Phase: v1.PodRunning}},
+	}
+	pvcs := []v1.PersistentVolumeClaim{
+		makeOrdinalPVC("data-web-0", "pv0"),
+		makeOrdinalPVC("data-web-2", "pv2"),
+		makeOrdinalPVC("data-fred-0", "pv3"),
+		makeOrdinalPVC("data-web-x", "pv4"),
+	}
+
+	e := []render.OrdinalRes{
+		{Path: "default/web-0", Ordinal: 0, PodStatus: "Running", Claim: "data-web-0", ClaimStatus: "Bound", Volume: "pv0"},
+		{Path: "default/web-1", Ordinal: 1, PodStatus: OrdinalTerminating, Claim: "data-web-1", ClaimStatus: OrdinalMissing},
+		{Path: "default/web-2", Ordinal: 2, PodStatus: OrdinalMissing, Claim: "data-web-2", ClaimStatus: "Bound", Volume: "pv2"},
+	}
+	assert.Equal(t, e, ordinalsOf(&sts, pods, pvcs))
+}
+
+func TestOrdinalsOfNoClaims(t *testing.T) {
+	replicas := int32(1)
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+	}
+
+	e := []render.OrdinalRes{
+		{Path: "default/web-0", Ordinal: 0, PodStatus: OrdinalMissing},
+	}
+	assert.Equal(t, e, ordinalsOf(&sts, nil, nil))
+}
+
+func makeOrdinalPVC(n, pv string) v1.PersistentVolumeClaim {
+	return v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Spec:       v1.PersistentVolumeClaimSpec{VolumeName: pv},
+		Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+	}
+}
@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// OverviewGVRs lists the resources checked for problems on the overview.
+var overviewGVRs = []string{
+	"v1/nodes",
+	"v1/pods",
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1/jobs",
+	"v1/persistentvolumeclaims",
+}
+
+var nodePressures = []string{"MemoryPressure", "DiskPressure", "PIDPressure", "NetworkUnavailable"}
+
+// Overview represents a cluster landing dashboard.
+type Overview struct {
+	Cluster  *OverviewCluster `json:"cluster,omitempty"`
+	Problems map[string]int   `json:"problems,omitempty"`
+	Warnings []string         `json:"warnings,omitempty"`
+	Pressure []string         `json:"nodePressure,omitempty"`
+	Pins     []string         `json:"pins,omitempty"`
+}
+
+// OverviewCluster represents the overview cluster info.
+type OverviewCluster struct {
+	Context string `json:"context"`
+	Cluster string `json:"cluster"`
+	User    string `json:"user"`
+	Version string `json:"version"`
+}
+
+// BuildOverview collects the configured overview sections. Sections the user
+// may not list are skipped.
+func BuildOverview(f Factory, cfg *config.Overview, pins []config.Pin) Overview {
+	var o Overview
+	if cfg.Shows(config.OverviewProblems) {
+		o.Problems = ProblemCounts(f)
+	}
+	if cfg.Shows(config.OverviewEvents) {
+		oo, err := f.List("v1/events", client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Overview events")
+		} else if o.Warnings, err = latestWarnings(oo, cfg.MaxEvents, time.Now()); err != nil {
+			log.Warn().Err(err).Msgf("Overview events")
+		}
+	}
+	if cfg.Shows(config.OverviewNodes) {
+		oo, err := f.List("v1/nodes", client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Overview nodes")
+		} else {
+			o.Pressure = nodePressure(oo)
+		}
+	}
+	if cfg.Shows(config.OverviewPins) {
+		for _, r := range PinStates(f, pins) {
+			o.Pins = append(o.Pins, fmt.Sprintf("%s %s: %s", client.NewGVR(r.GVR).R(), maskPath(r.Path), r.Status))
+		}
+	}
+
+	return o
+}
+
+// ProblemCounts counts the resources failing their readiness by resource.
+func ProblemCounts(f Factory) map[string]int {
+	cc := make(map[string]int, len(overviewGVRs))
+	for _, gvr := range overviewGVRs {
+		oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Overview problems on %s", gvr)
+			continue
+		}
+		cc[client.NewGVR(gvr).R()] = notReady(oo)
+	}
+
+	return cc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func notReady(oo []runtime.Object) int {
+	var count int
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if ready, _ := Readiness(u); !ready {
+			count++
+		}
+	}
+
+	return count
+}
+
+// LatestWarnings lists the newest warning events.
+func latestWarnings(oo []runtime.Object, max int, now time.Time) ([]string, error) {
+	ee := make([]*v1.Event, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			return nil, err
+		}
+		if evt.Type == v1.EventTypeWarning {
+			ee = append(ee, &evt)
+		}
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		ti, tj := EventTime(ee[i]), EventTime(ee[j])
+		return tj.Before(&ti)
+	})
+	if max > 0 && len(ee) > max {
+		ee = ee[:max]
+	}
+
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		o := e.InvolvedObject
+		ss = append(ss, fmt.Sprintf("%s ago %s %s %s: %s",
+			duration.HumanDuration(now.Sub(EventTime(e).Time)),
+			o.Kind,
+			maskPath(client.FQN(o.Namespace, o.Name)),
+			e.Reason,
+			strings.TrimSpace(e.Message),
+		))
+	}
+
+	return ss, nil
+}
+
+// MaskPath masks a resource path in anonymous mode.
+func maskPath(path string) string {
+	if !render.Anonymous {
+		return path
+	}
+
+	return render.AnonymizePath(path)
+}
+
+func nodePressure(oo []runtime.Object) []string {
+	var ss []string
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var pp []string
+		for _, p := range nodePressures {
+			if conditionStatus(u, p) == "True" {
+				pp = append(pp, p)
+			}
+		}
+		if len(pp) > 0 {
+			ss = append(ss, maskPath(u.GetName())+": "+strings.Join(pp, ","))
+		}
+	}
+	sort.Strings(ss)
+
+	return ss
+}
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLatestWarnings(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	oo := []runtime.Object{
+		makeOverviewEvent("e1", "Warning", "BackOff", "fred", now.Add(-2*time.Minute)),
+		makeOverviewEvent("e2", "Normal", "Pulled", "fred", now.Add(-time.Minute)),
+		makeOverviewEvent("e3", "Warning", "FailedMount", "blee", now.Add(-30*time.Second)),
+		makeOverviewEvent("e4", "Warning", "Unhealthy", "zorg", now.Add(-time.Hour)),
+	}
+
+	ss, err := latestWarnings(oo, 2, now)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"30s ago Pod default/blee FailedMount: boom",
+		"2m ago Pod default/fred BackOff: boom",
+	}, ss)
+}
+
+func TestNodePressure(t *testing.T) {
+	oo := []runtime.Object{
+		makeOverviewNode("n2", map[string]string{"Ready": "True", "MemoryPressure": "True", "DiskPressure": "True"}),
+		makeOverviewNode("n1", map[string]string{"Ready": "True", "MemoryPressure": "False"}),
+		makeOverviewNode("n0", map[string]string{"Ready": "False", "PIDPressure": "True"}),
+	}
+
+	assert.Equal(t, []string{"n0: PIDPressure", "n2: MemoryPressure,DiskPressure"}, nodePressure(oo))
+	assert.Equal(t, 1, notReady(oo))
+}
+
+// Helpers...
+
+func makeOverviewEvent(n, kind, reason, obj string, at time.Time) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Event",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": n},
+		"type":       kind,
+		"reason":     reason,
+		"message":    "boom",
+		"involvedObject": map[string]interface{}{
+			"kind":      "Pod",
+			"namespace": "default",
+			"name":      obj,
+		},
+		"lastTimestamp": at.Format(time.RFC3339),
+	}}
+}
+
+func makeOverviewNode(n string, conds map[string]string) *unstructured.Unstructured {
+	cc := make([]interface{}, 0, len(conds))
+	for k, v := range conds {
+		cc = append(cc, map[string]interface{}{"type": k, "status": v})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata":   map[string]interface{}{"name": n},
+		"status":     map[string]interface{}{"conditions": cc},
+	}}
+}
@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RelationChild indicates a resource is controlled by another.
+const RelationChild = "child"
+
+var _ Accessor = (*Children)(nil)
+
+// childGVRs tracks known controllers children resources.
+var childGVRs = map[string][]string{
+	"Deployment":  {"apps/v1/replicasets"},
+	"ReplicaSet":  {"v1/pods"},
+	"StatefulSet": {"v1/pods", "apps/v1/controllerrevisions"},
+	"DaemonSet":   {"v1/pods", "apps/v1/controllerrevisions"},
+	"Job":         {"v1/pods"},
+	"CronJob":     {"batch/v1/jobs"},
+}
+
+// Children tracks resources owned by a given resource.
+type Children struct {
+	NonResource
+}
+
+// List returns all resources owned by the context owner.
+func (c *Children) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context owner gvr")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context path")
+	}
+	owner, err := fetchUnstructured(c.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ownedBy(c.Factory, owner)
+}
+
+// ControllerOf returns the gvr and path of a resource owner, following the
+// controller owner reference first.
+func ControllerOf(f Factory, gvr, path string) (string, string, error) {
+	u, err := fetchUnstructured(f, gvr, path)
+	if err != nil {
+		return "", "", err
+	}
+	ref, ok := controllerRef(u.GetOwnerReferences())
+	if !ok {
+		return "", "", fmt.Errorf("%s has no owner", path)
+	}
+	ogvr, meta, ok := gvrForKind(ref.APIVersion, ref.Kind)
+	if !ok {
+		return "", "", fmt.Errorf("unable to resolve owner kind %s %s", ref.APIVersion, ref.Kind)
+	}
+	ns := u.GetNamespace()
+	if !meta.Namespaced {
+		ns = ""
+	}
+
+	return ogvr.String(), client.FQN(ns, ref.Name), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fetchUnstructured(f Factory, gvr, path string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return u, nil
+}
+
+func ownedBy(f Factory, owner *unstructured.Unstructured) ([]runtime.Object, error) {
+	gvrs, ok := childGVRs[owner.GetKind()]
+	if !ok {
+		gvrs = DefaultFindGVRs
+	}
+
+	var oo []runtime.Object
+	for _, gvr := range gvrs {
+		meta, err := MetaFor(client.NewGVR(gvr))
+		if err != nil || !meta.Namespaced {
+			continue
+		}
+		rr, err := f.List(gvr, owner.GetNamespace(), true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Children scan skipped %q", gvr)
+			continue
+		}
+		for _, o := range rr {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+			}
+			if !isOwnedBy(u.GetOwnerReferences(), owner.GetUID()) {
+				continue
+			}
+			oo = append(oo, render.RelatedRes{
+				GVR:      gvr,
+				Path:     client.FQN(u.GetNamespace(), u.GetName()),
+				Relation: RelationChild,
+				Created:  u.GetCreationTimestamp(),
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, r := range refs {
+		if r.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func controllerRef(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	for _, r := range refs {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+	if len(refs) > 0 {
+		return refs[0], true
+	}
+
+	return metav1.OwnerReference{}, false
+}
+
+func gvrForKind(apiVersion, kind string) (client.GVR, metav1.APIResource, bool) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return client.GVR{}, metav1.APIResource{}, false
+	}
+	for _, gvr := range AllGVRs() {
+		meta, err := MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) {
+			continue
+		}
+		if meta.Kind == kind && meta.Group == gv.Group {
+			return gvr, meta, true
+		}
+	}
+
+	return client.GVR{}, metav1.APIResource{}, false
+}
@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestControllerRef(t *testing.T) {
+	yes := true
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    string
+		ok   bool
+	}{
+		"none": {},
+		"controller": {
+			refs: []metav1.OwnerReference{
+				{Kind: "ConfigMap", Name: "fred"},
+				{Kind: "ReplicaSet", Name: "blee", Controller: &yes},
+			},
+			e:  "blee",
+			ok: true,
+		},
+		"first": {
+			refs: []metav1.OwnerReference{
+				{Kind: "Certificate", Name: "fred"},
+			},
+			e:  "fred",
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ref, ok := controllerRef(u.refs)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, ref.Name)
+		})
+	}
+}
+
+func TestIsOwnedBy(t *testing.T) {
+	refs := []metav1.OwnerReference{{UID: types.UID("1")}, {UID: types.UID("2")}}
+
+	assert.True(t, isOwnedBy(refs, types.UID("2")))
+	assert.False(t, isOwnedBy(refs, types.UID("3")))
+	assert.False(t, isOwnedBy(nil, types.UID("1")))
+}
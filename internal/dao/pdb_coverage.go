@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PDBCoverage)(nil)
+
+// PDBWorkloads tracks workloads subject to voluntary disruptions.
+var PDBWorkloads = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+}
+
+// PDBCoverage tracks workloads disruption budget coverage.
+type PDBCoverage struct {
+	NonResource
+}
+
+// List returns disruption budget coverage for all workloads.
+func (p *PDBCoverage) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Factory.List("policy/v1beta1/poddisruptionbudgets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pdbs := make([]policyv1beta1.PodDisruptionBudget, len(oo))
+	for i, o := range oo {
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pdbs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res []runtime.Object
+	for _, gvr := range PDBWorkloads {
+		ww, err := p.Factory.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("PDB coverage scan skipped %q", gvr)
+			continue
+		}
+		for _, w := range ww {
+			u, ok := w.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", w)
+			}
+			res = append(res, pdbCoverage(gvr, u, pdbs))
+		}
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func pdbCoverage(gvr string, u *unstructured.Unstructured, pdbs []policyv1beta1.PodDisruptionBudget) render.PDBCoverageRes {
+	replicas, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !ok {
+		replicas = 1
+	}
+	podLabels, _, _ := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+
+	c := render.PDBCoverageRes{
+		GVR:      gvr,
+		Path:     client.FQN(u.GetNamespace(), u.GetName()),
+		Kind:     u.GetKind(),
+		Replicas: int32(replicas),
+	}
+	for i := range pdbs {
+		pdb := &pdbs[i]
+		if pdb.Namespace != u.GetNamespace() || !pdbSelects(pdb, podLabels) {
+			continue
+		}
+		if len(c.PDBs) == 0 || pdb.Status.PodDisruptionsAllowed < c.Allowed {
+			c.Allowed = pdb.Status.PodDisruptionsAllowed
+		}
+		c.PDBs = append(c.PDBs, pdb.Name)
+	}
+
+	return c
+}
+
+func pdbSelects(pdb *policyv1beta1.PodDisruptionBudget, podLabels map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || sel.Empty() {
+		return false
+	}
+
+	return sel.Matches(labels.Set(podLabels))
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPDBCoverage(t *testing.T) {
+	dp := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Deployment",
+		"metadata": map[string]interface{}{"name": "fred", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "fred"}},
+			},
+		},
+	}}
+	pdbs := []policyv1beta1.PodDisruptionBudget{
+		makeTypedPDB("default", "p1", "fred", 2),
+		makeTypedPDB("default", "p2", "fred", 1),
+		makeTypedPDB("blee", "p3", "fred", 5),
+		makeTypedPDB("default", "p4", "blee", 5),
+	}
+
+	c := pdbCoverage("apps/v1/deployments", &dp, pdbs)
+	assert.Equal(t, "default/fred", c.Path)
+	assert.Equal(t, int32(3), c.Replicas)
+	assert.Equal(t, []string{"p1", "p2"}, c.PDBs)
+	assert.Equal(t, int32(1), c.Allowed)
+}
+
+func makeTypedPDB(ns, n, app string, allowed int32) policyv1beta1.PodDisruptionBudget {
+	return policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+		},
+		Status: policyv1beta1.PodDisruptionBudgetStatus{PodDisruptionsAllowed: allowed},
+	}
+}
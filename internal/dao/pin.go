@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Pin)(nil)
+
+// PinMissing indicates a pinned resource no longer exists.
+const PinMissing = "Missing"
+
+// Pin represents resources pinned to the pinboard.
+type Pin struct {
+	NonResource
+}
+
+// List returns the pinned resources status.
+func (p *Pin) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	pins, _ := ctx.Value(internal.KeyPins).([]config.Pin)
+	rr := PinStates(p.Factory, pins)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// PinStates returns the readiness of pinned resources.
+func PinStates(f Factory, pins []config.Pin) []render.PinRes {
+	rr := make([]render.PinRes, 0, len(pins))
+	for _, p := range pins {
+		r := render.PinRes{GVR: p.GVR, Path: p.Path, Status: PinMissing}
+		o, err := f.Get(p.GVR, p.Path, false, labels.Everything())
+		if u, ok := o.(*unstructured.Unstructured); err == nil && ok {
+			r.Ready, r.Status = Readiness(u)
+		}
+		rr = append(rr, r)
+	}
+
+	return rr
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -27,9 +30,10 @@ import (
 const defaultTimeout = 1 * time.Second
 
 var (
-	_ Accessor = (*Pod)(nil)
-	_ Nuker    = (*Pod)(nil)
-	_ Loggable = (*Pod)(nil)
+	_ Accessor  = (*Pod)(nil)
+	_ Nuker     = (*Pod)(nil)
+	_ Loggable  = (*Pod)(nil)
+	_ Evictable = (*Pod)(nil)
 )
 
 // Pod represents a pod resource.
@@ -61,15 +65,9 @@ func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
 
 // List returns a collection of nodes.
 func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	sel, ok := ctx.Value(internal.KeyFields).(string)
-	if !ok {
+	if _, ok := ctx.Value(internal.KeyFields).(string); !ok {
 		return nil, fmt.Errorf("expecting a fieldSelector in context")
 	}
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
-	if err != nil {
-		return nil, err
-	}
-	nodeName := fsel["spec.nodeName"]
 
 	oo, err := p.Resource.List(ctx, ns)
 	if err != nil {
@@ -82,27 +80,101 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		log.Warn().Err(err).Msgf("No pods metrics")
 	}
 
-	var res []runtime.Object
+	mmx := indexPodsMetrics(pmx)
+	platforms := nodePlatforms(p.Factory)
+	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
-		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
-			continue
+		pwm := render.PodWithMetrics{Raw: u, MX: mmx[extractFQN(o)]}
+		if node, ok, _ := unstructured.NestedString(u.Object, "spec", "nodeName"); ok {
+			pl := platforms[node]
+			pwm.OS, pwm.Arch = pl.os, pl.arch
 		}
+		res = append(res, &pwm)
+	}
 
-		spec, ok := u.Object["spec"].(map[string]interface{})
-		if !ok {
-			return res, fmt.Errorf("expecting interface map but got `%T", o)
+	return res, nil
+}
+
+// EvictionBlockedError indicates a pod eviction was denied by disruption budgets.
+type EvictionBlockedError struct {
+	Path string
+	PDBs []string
+	Err  error
+}
+
+// Error returns the error message.
+func (e *EvictionBlockedError) Error() string {
+	if len(e.PDBs) == 0 {
+		return fmt.Sprintf("eviction of %s blocked -- %s", e.Path, e.Err)
+	}
+
+	return fmt.Sprintf("eviction of %s blocked by pdb %s", e.Path, strings.Join(e.PDBs, ","))
+}
+
+// Evict evicts a pod using the eviction api so disruption budgets are honored.
+func (p *Pod) Evict(path string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:eviction", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to evict %s", path)
+	}
+
+	err = p.Client().DialOrDie().CoreV1().Pods(ns).Evict(&policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+	})
+	if err == nil || !apierrors.IsTooManyRequests(err) {
+		return err
+	}
+
+	pdbs, perr := p.blockingPDBs(path)
+	if perr != nil {
+		log.Warn().Err(perr).Msgf("Unable to resolve disruption budgets for %s", path)
+	}
+
+	return &EvictionBlockedError{Path: path, PDBs: pdbs, Err: err}
+}
+
+func (p *Pod) blockingPDBs(path string) ([]string, error) {
+	o, err := p.Factory.Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(path)
+	oo, err := p.Factory.List("policy/v1beta1/poddisruptionbudgets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return matchingPDBs(o.(*unstructured.Unstructured).GetLabels(), oo)
+}
+
+// StalePods returns evicted, completed and failed pods paths keyed by status.
+func (p *Pod) StalePods(ns string) (map[string][]string, error) {
+	oo, err := p.Factory.List(p.gvr.String(), ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	stales := make(map[string][]string)
+	for _, o := range oo {
+		var po v1.Pod
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
 		}
-		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+		if s := stalePodStatus(&po); s != "" {
+			stales[s] = append(stales[s], MetaFQN(po.ObjectMeta))
 		}
 	}
 
-	return res, nil
+	return stales, nil
 }
 
 // Logs fetch container logs for a given pod and container.
@@ -152,24 +224,46 @@ func (p *Pod) TailLogs(ctx context.Context, c chan<- string, opts LogOptions) er
 	if !opts.HasContainer() {
 		return p.logs(ctx, c, opts)
 	}
-	return tailLogs(ctx, p, c, opts)
-}
-
-func (p *Pod) logs(ctx context.Context, c chan<- string, opts LogOptions) error {
-	fac, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
-	if !ok {
-		return errors.New("Expecting an informer")
+	if opts.History == nil || opts.Previous {
+		if opts.Prefix != nil && opts.Prefix.Node && opts.Node == "" {
+			if po, err := p.fetchPod(ctx, opts.Path); err == nil {
+				opts.Node = po.Spec.NodeName
+			}
+		}
+		return tailLogs(ctx, p, c, opts)
 	}
-	o, err := fac.Get(p.gvr.String(), opts.Path, true, labels.Everything())
+
+	po, err := p.fetchPod(ctx, opts.Path)
 	if err != nil {
-		return err
+		log.Warn().Err(err).Msgf("Pod %s is gone. Using log history", opts.Path)
+		go sendHistory(ctx, c, opts, nil)
+		return nil
 	}
+	opts.Node = po.Spec.NodeName
+	// History is fetched remotely so stream it and the live logs in the
+	// background to keep them in order without blocking the caller.
+	go func() {
+		sendHistory(ctx, c, opts, po)
+		if err := tailLogs(ctx, p, c, opts); err != nil {
+			log.Error().Err(err).Msgf("Tail logs failed for %s:%s", opts.Path, opts.Container)
+		}
+	}()
 
-	var po v1.Pod
-	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
-		return err
+	return nil
+}
+
+func (p *Pod) logs(ctx context.Context, c chan<- string, opts LogOptions) error {
+	po, err := p.fetchPod(ctx, opts.Path)
+	if err != nil {
+		if opts.History == nil || opts.Previous {
+			return err
+		}
+		log.Warn().Err(err).Msgf("Pod %s is gone. Using log history", opts.Path)
+		opts.SingleContainer = true
+		go sendHistory(ctx, c, opts, nil)
+		return nil
 	}
-	opts.Color = asColor(po.Name)
+	opts.Color, opts.Node = asColor(po.Name), po.Spec.NodeName
 	if len(po.Spec.InitContainers)+len(po.Spec.Containers) == 1 {
 		opts.SingleContainer = true
 	}
@@ -194,6 +288,66 @@ func (p *Pod) logs(ctx context.Context, c chan<- string, opts LogOptions) error
 	return nil
 }
 
+func (p *Pod) fetchPod(ctx context.Context, path string) (*v1.Pod, error) {
+	fac, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
+	if !ok {
+		return nil, errors.New("Expecting an informer")
+	}
+	o, err := fac.Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, err
+	}
+
+	return &po, nil
+}
+
+// SendHistory streams a container logs prior to its current instance from
+// the log history. All logs up to now are sent if the pod is gone or the
+// container is not started.
+func sendHistory(ctx context.Context, c chan<- string, opts LogOptions, po *v1.Pod) {
+	end := time.Now()
+	if po != nil {
+		if started, ok := containerStarted(po.Status, opts.Container); ok {
+			end = started
+		}
+	}
+
+	ll, err := opts.History.History(ctx, opts.Path, opts.Container, end.Add(-opts.History.Since()), end, int(opts.Lines))
+	if err != nil {
+		log.Warn().Err(err).Msgf("Log history failed for %s:%s", opts.Path, opts.Container)
+		return
+	}
+	for _, l := range ll {
+		select {
+		case <-ctx.Done():
+			return
+		case c <- opts.DecorateLog(l):
+		}
+	}
+}
+
+// ContainerStarted returns the start time of a container current instance.
+func containerStarted(s v1.PodStatus, co string) (time.Time, bool) {
+	for _, cs := range append(s.InitContainerStatuses, s.ContainerStatuses...) {
+		if cs.Name != co {
+			continue
+		}
+		switch {
+		case cs.State.Running != nil:
+			return cs.State.Running.StartedAt.Time, true
+		case cs.State.Terminated != nil:
+			return cs.State.Terminated.StartedAt.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
 func tailLogs(ctx context.Context, logger Logger, c chan<- string, opts LogOptions) error {
 	log.Debug().Msgf("Tailing logs for %q -- %q", opts.Path, opts.Container)
 	o := v1.PodLogOptions{
@@ -255,17 +409,48 @@ func readLogs(ctx context.Context, stream io.ReadCloser, c chan<- string, opts L
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func podMetricsFor(o runtime.Object, mmx *mv1beta1.PodMetricsList) *mv1beta1.PodMetrics {
+func matchingPDBs(podLabels map[string]string, oo []runtime.Object) ([]string, error) {
+	var pp []string
+	for _, o := range oo {
+		var pdb policyv1beta1.PodDisruptionBudget
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pdb)
+		if err != nil {
+			return nil, err
+		}
+		if pdbSelects(&pdb, podLabels) {
+			pp = append(pp, MetaFQN(pdb.ObjectMeta))
+		}
+	}
+
+	return pp, nil
+}
+
+func stalePodStatus(po *v1.Pod) string {
+	switch po.Status.Phase {
+	case v1.PodSucceeded:
+		return render.Completed
+	case v1.PodFailed:
+		if po.Status.Reason == render.Evicted {
+			return render.Evicted
+		}
+		return render.Failed
+	default:
+		return ""
+	}
+}
+
+// indexPodsMetrics indexes pods metrics by pod path so large listings ie all
+// namespaces do not rescan every metric for each pod.
+func indexPodsMetrics(mmx *mv1beta1.PodMetricsList) map[string]*mv1beta1.PodMetrics {
 	if mmx == nil {
 		return nil
 	}
-	fqn := extractFQN(o)
-	for _, mx := range mmx.Items {
-		if MetaFQN(mx.ObjectMeta) == fqn {
-			return &mx
-		}
+	mm := make(map[string]*mv1beta1.PodMetrics, len(mmx.Items))
+	for i := range mmx.Items {
+		mm[MetaFQN(mmx.Items[i].ObjectMeta)] = &mmx.Items[i]
 	}
-	return nil
+
+	return mm
 }
 
 // MetaFQN returns a fully qualified resource name.
@@ -336,3 +521,58 @@ func in(ll []string, s string) bool {
 	}
 	return false
 }
+
+// PodOS returns the operating system of the node running a pod.
+func PodOS(f Factory, path string) (string, error) {
+	u, err := fetchUnstructured(f, "v1/pods", path)
+	if err != nil {
+		return "", err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return "", err
+	}
+	if os := po.Spec.NodeSelector[render.LabelOS]; os != "" {
+		return os, nil
+	}
+	if po.Spec.NodeName == "" {
+		return "", fmt.Errorf("pod %s is not scheduled", path)
+	}
+	no, err := fetchUnstructured(f, "v1/nodes", client.FQN(client.ClusterScope, po.Spec.NodeName))
+	if err != nil {
+		return "", err
+	}
+	var node v1.Node
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(no.Object, &node); err != nil {
+		return "", err
+	}
+	os, _ := render.NodePlatform(&node)
+
+	return os, nil
+}
+
+type platform struct {
+	os, arch string
+}
+
+// nodePlatforms returns the known nodes platforms. Nodes might not be
+// listable by the current user in which case platforms are left out.
+func nodePlatforms(f Factory) map[string]platform {
+	pp := make(map[string]platform)
+	oo, err := f.List("v1/nodes", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("No nodes platforms")
+		return pp
+	}
+	for _, o := range oo {
+		var no v1.Node
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no) != nil {
+			continue
+		}
+		os, arch := render.NodePlatform(&no)
+		pp[no.Name] = platform{os: os, arch: arch}
+	}
+
+	return pp
+}
@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	seccompPodAnnotation       = "seccomp.security.alpha.kubernetes.io/pod"
+	seccompContainerAnnotation = "container.seccomp.security.alpha.kubernetes.io/"
+	seccompUnconfined          = "unconfined"
+)
+
+var _ Accessor = (*PodSecurity)(nil)
+
+// PodSecurity tracks pods security posture.
+type PodSecurity struct {
+	NonResource
+}
+
+// List returns the security posture of all pods in a namespace. Only
+// privileged pods are returned when the context privileged flag is set.
+func (p *PodSecurity) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	privOnly, _ := ctx.Value(internal.KeyPrivileged).(bool)
+	oo, err := p.Factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		s := podSecurity(&po)
+		if privOnly && !s.IsPrivileged() {
+			continue
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podSecurity(po *v1.Pod) render.PodSecurityRes {
+	s := render.PodSecurityRes{
+		Path:        client.FQN(po.Namespace, po.Name),
+		HostNetwork: po.Spec.HostNetwork,
+		HostPID:     po.Spec.HostPID,
+		Seccomp:     true,
+	}
+	podSeccomp := seccompSet(po.Annotations[seccompPodAnnotation])
+	caps := make(map[string]struct{})
+	for _, co := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if runsAsRoot(po.Spec.SecurityContext, co.SecurityContext) {
+			s.RunAsRoot = true
+		}
+		if sc := co.SecurityContext; sc != nil {
+			if sc.Privileged != nil && *sc.Privileged {
+				s.Privileged = append(s.Privileged, co.Name)
+			}
+			if sc.Capabilities != nil {
+				for _, c := range sc.Capabilities.Add {
+					caps[string(c)] = struct{}{}
+				}
+			}
+		}
+		if a, ok := po.Annotations[seccompContainerAnnotation+co.Name]; ok {
+			if !seccompSet(a) {
+				s.Seccomp = false
+			}
+			continue
+		}
+		if !podSeccomp {
+			s.Seccomp = false
+		}
+	}
+	for c := range caps {
+		s.Caps = append(s.Caps, c)
+	}
+	sort.Strings(s.Caps)
+
+	return s
+}
+
+func seccompSet(profile string) bool {
+	return profile != "" && !strings.EqualFold(profile, seccompUnconfined)
+}
+
+// runsAsRoot checks if a container may run as root. Containers not setting
+// a user nor requiring a non root user run as the image user, which is
+// assumed to be root.
+func runsAsRoot(psc *v1.PodSecurityContext, sc *v1.SecurityContext) bool {
+	var (
+		uid     *int64
+		nonRoot *bool
+	)
+	if psc != nil {
+		uid, nonRoot = psc.RunAsUser, psc.RunAsNonRoot
+	}
+	if sc != nil {
+		if sc.RunAsUser != nil {
+			uid = sc.RunAsUser
+		}
+		if sc.RunAsNonRoot != nil {
+			nonRoot = sc.RunAsNonRoot
+		}
+	}
+	if uid != nil {
+		return *uid == 0
+	}
+
+	return nonRoot == nil || !*nonRoot
+}
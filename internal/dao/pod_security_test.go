@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodSecurity(t *testing.T) {
+	yes, no, root, user := true, false, int64(0), int64(1000)
+	uu := map[string]struct {
+		po               v1.Pod
+		root, seccomp    bool
+		privileged, caps []string
+	}{
+		"default": {
+			po:   makeSecPod(nil, nil, nil),
+			root: true,
+		},
+		"hardened": {
+			po: makeSecPod(
+				map[string]string{seccompPodAnnotation: "runtime/default"},
+				&v1.PodSecurityContext{RunAsNonRoot: &yes},
+				&v1.SecurityContext{Privileged: &no},
+			),
+			seccomp: true,
+		},
+		"rootUser": {
+			po: makeSecPod(
+				map[string]string{seccompPodAnnotation: "runtime/default"},
+				&v1.PodSecurityContext{RunAsUser: &user},
+				&v1.SecurityContext{RunAsUser: &root},
+			),
+			root:    true,
+			seccomp: true,
+		},
+		"privileged": {
+			po: makeSecPod(
+				map[string]string{seccompPodAnnotation: "runtime/default", seccompContainerAnnotation + "c1": "unconfined"},
+				&v1.PodSecurityContext{RunAsUser: &user},
+				&v1.SecurityContext{
+					Privileged:   &yes,
+					Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_ADMIN", "NET_ADMIN"}},
+				},
+			),
+			privileged: []string{"c1"},
+			caps:       []string{"NET_ADMIN", "SYS_ADMIN"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := podSecurity(&u.po)
+			assert.Equal(t, "default/p1", s.Path)
+			assert.Equal(t, u.root, s.RunAsRoot)
+			assert.Equal(t, u.seccomp, s.Seccomp)
+			assert.Equal(t, u.privileged, s.Privileged)
+			assert.Equal(t, u.caps, s.Caps)
+		})
+	}
+}
+
+func makeSecPod(ann map[string]string, psc *v1.PodSecurityContext, sc *v1.SecurityContext) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", Annotations: ann},
+		Spec: v1.PodSpec{
+			SecurityContext: psc,
+			Containers:      []v1.Container{{Name: "c1", SecurityContext: sc}},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PodOwner returns the gvr and path of the workload controlling a pod.
+// Replicasets are followed up to their deployment if any.
+func PodOwner(f Factory, path string) (string, string, error) {
+	gvr, opath, err := ControllerOf(f, "v1/pods", path)
+	if err != nil {
+		return "", "", err
+	}
+	if gvr != "apps/v1/replicasets" {
+		return gvr, opath, nil
+	}
+	if dgvr, dpath, err := ControllerOf(f, gvr, opath); err == nil {
+		return dgvr, dpath, nil
+	}
+
+	return gvr, opath, nil
+}
+
+// PodSuccessor returns the path of the most recent live pod controlled by the
+// given workload that runs the given container, excluding the pod gone.
+func PodSuccessor(f Factory, ownerGVR, ownerPath, gone, co string) (string, error) {
+	owner, err := fetchUnstructured(f, ownerGVR, ownerPath)
+	if err != nil {
+		return "", err
+	}
+	uids := map[types.UID]struct{}{owner.GetUID(): {}}
+	if owner.GetKind() == "Deployment" {
+		oo, err := f.List("apps/v1/replicasets", owner.GetNamespace(), false, labels.Everything())
+		if err != nil {
+			return "", err
+		}
+		for _, o := range oo {
+			u := o.(*unstructured.Unstructured)
+			if isOwnedBy(u.GetOwnerReferences(), owner.GetUID()) {
+				uids[u.GetUID()] = struct{}{}
+			}
+		}
+	}
+
+	oo, err := f.List("v1/pods", owner.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	pods := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return "", err
+		}
+		pods = append(pods, &po)
+	}
+	po, ok := successorOf(pods, uids, gone, co)
+	if !ok {
+		return "", fmt.Errorf("no replacement found for pod %s", gone)
+	}
+
+	return client.FQN(po.Namespace, po.Name), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func successorOf(pods []*v1.Pod, owners map[types.UID]struct{}, gone, co string) (*v1.Pod, bool) {
+	cc := make([]*v1.Pod, 0, len(pods))
+	for _, po := range pods {
+		if client.FQN(po.Namespace, po.Name) == gone || po.DeletionTimestamp != nil {
+			continue
+		}
+		ref, ok := controllerRef(po.OwnerReferences)
+		if !ok {
+			continue
+		}
+		if _, ok := owners[ref.UID]; !ok {
+			continue
+		}
+		if co != "" && !hasContainer(po.Spec, co) {
+			continue
+		}
+		cc = append(cc, po)
+	}
+	if len(cc) == 0 {
+		return nil, false
+	}
+	sort.SliceStable(cc, func(i, j int) bool {
+		ri, rj := cc[i].Status.Phase == v1.PodRunning, cc[j].Status.Phase == v1.PodRunning
+		if ri != rj {
+			return ri
+		}
+		return cc[j].CreationTimestamp.Before(&cc[i].CreationTimestamp)
+	})
+
+	return cc[0], true
+}
+
+func hasContainer(spec v1.PodSpec, co string) bool {
+	for _, c := range append(spec.InitContainers, spec.Containers...) {
+		if c.Name == co {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSuccessorOf(t *testing.T) {
+	now := time.Now()
+	pods := []*v1.Pod{
+		makeSuccessorPod("web-1", "rs1", "nginx", v1.PodRunning, now.Add(-time.Hour)),
+		makeSuccessorPod("web-2", "rs1", "nginx", v1.PodPending, now),
+		makeSuccessorPod("web-3", "rs2", "nginx", v1.PodRunning, now.Add(-time.Minute)),
+		makeSuccessorPod("web-4", "rs1", "envoy", v1.PodRunning, now),
+		makeSuccessorPod("other", "rs3", "nginx", v1.PodRunning, now),
+	}
+
+	uu := map[string]struct {
+		owners   []types.UID
+		gone, co string
+		e        string
+		ok       bool
+	}{
+		"newest": {
+			owners: []types.UID{"rs1", "rs2"},
+			gone:   "default/web-1",
+			co:     "nginx",
+			e:      "web-3",
+			ok:     true,
+		},
+		"running": {
+			owners: []types.UID{"rs1"},
+			gone:   "default/web-9",
+			co:     "nginx",
+			e:      "web-1",
+			ok:     true,
+		},
+		"pending": {
+			owners: []types.UID{"rs1"},
+			gone:   "default/web-1",
+			co:     "nginx",
+			e:      "web-2",
+			ok:     true,
+		},
+		"anyContainer": {
+			owners: []types.UID{"rs1"},
+			gone:   "default/web-1",
+			e:      "web-4",
+			ok:     true,
+		},
+		"none": {
+			owners: []types.UID{"rs4"},
+			gone:   "default/web-1",
+			co:     "nginx",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			owners := make(map[types.UID]struct{}, len(u.owners))
+			for _, o := range u.owners {
+				owners[o] = struct{}{}
+			}
+			po, ok := successorOf(pods, owners, u.gone, u.co)
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.e, po.Name)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeSuccessorPod(n string, owner types.UID, co string, phase v1.PodPhase, at time.Time) *v1.Pod {
+	yes := true
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              n,
+			CreationTimestamp: metav1.NewTime(at),
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: string(owner), UID: owner, Controller: &yes},
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: co}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestStalePodStatus(t *testing.T) {
+	uu := map[string]struct {
+		status v1.PodStatus
+		e      string
+	}{
+		"running":   {status: v1.PodStatus{Phase: v1.PodRunning}},
+		"pending":   {status: v1.PodStatus{Phase: v1.PodPending}},
+		"completed": {status: v1.PodStatus{Phase: v1.PodSucceeded}, e: "Completed"},
+		"failed":    {status: v1.PodStatus{Phase: v1.PodFailed, Reason: "Error"}, e: "Failed"},
+		"evicted":   {status: v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"}, e: "Evicted"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, stalePodStatus(&v1.Pod{Status: u.status}))
+		})
+	}
+}
+
+func TestMatchingPDBs(t *testing.T) {
+	oo := []runtime.Object{
+		makePDB("fred", map[string]interface{}{"app": "fred"}),
+		makePDB("blee", map[string]interface{}{"app": "blee"}),
+		makePDB("all", map[string]interface{}{}),
+	}
+
+	pp, err := matchingPDBs(map[string]string{"app": "fred", "tier": "web"}, oo)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default/fred"}, pp)
+}
+
+func TestEvictionBlockedError(t *testing.T) {
+	err := EvictionBlockedError{Path: "default/p1", PDBs: []string{"default/fred"}}
+
+	assert.Equal(t, "eviction of default/p1 blocked by pdb default/fred", err.Error())
+}
+
+func makePDB(n string, sel map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "policy/v1beta1",
+		"kind":       "PodDisruptionBudget",
+		"metadata":   map[string]interface{}{"name": n, "namespace": "default"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": sel},
+		},
+	}}
+}
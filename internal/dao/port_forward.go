@@ -9,6 +9,8 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -23,7 +25,7 @@ type PortForward struct {
 }
 
 // Delete a portforward.
-func (p *PortForward) Delete(path string, cascade, force bool) error {
+func (p *PortForward) Delete(path string, _ DeleteOptions) error {
 	ns, _ := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, "v1/pods:portforward", []string{client.DeleteVerb})
 	if err != nil {
@@ -40,19 +42,23 @@ func (p *PortForward) Delete(path string, cascade, force bool) error {
 
 // List returns a collection of screen dumps.
 func (p *PortForward) List(ctx context.Context, _ string) ([]runtime.Object, error) {
-	config, ok := ctx.Value(internal.KeyBenchCfg).(*config.Bench)
+	bench, ok := ctx.Value(internal.KeyBenchCfg).(*config.Bench)
 	if !ok {
 		return nil, fmt.Errorf("no benchconfig found in context")
 	}
 
-	cc := config.Benchmarks.Containers
+	current, _ := p.Client().Config().CurrentContextName()
+	benches := contextBenches(p.Factory.Forwarders(), current, bench, func(ctx string) *config.Bench {
+		return ContextBench(p.Client(), ctx)
+	})
 	oo := make([]runtime.Object, 0, len(p.Factory.Forwarders()))
 	for _, f := range p.Factory.Forwarders() {
+		b := benches[f.Context()]
 		cfg := render.BenchCfg{
-			C: config.Benchmarks.Defaults.C,
-			N: config.Benchmarks.Defaults.N,
+			C: b.Benchmarks.Defaults.C,
+			N: b.Benchmarks.Defaults.N,
 		}
-		if config, ok := cc[containerID(f.Path(), f.Container())]; ok {
+		if config, ok := b.Benchmarks.Containers[containerID(f.Path(), f.Container())]; ok {
 			cfg.C, cfg.N = config.C, config.N
 			cfg.Host, cfg.Path = config.HTTP.Host, config.HTTP.Path
 		}
@@ -65,9 +71,32 @@ func (p *PortForward) List(ctx context.Context, _ string) ([]runtime.Object, err
 	return oo, nil
 }
 
+// ContextBench loads the benchmarks configuration of a given context.
+func ContextBench(c client.Connection, ctx string) *config.Bench {
+	cluster, _ := c.Config().ClusterNameFromContext(ctx)
+	b, err := config.NewBench(config.BenchFile(ctx, cluster))
+	if err != nil {
+		log.Debug().Msgf("No benchmark config found for context %q", ctx)
+	}
+
+	return b
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// ContextBenches loads the benchmarks configuration of each forward context once.
+func contextBenches(ff watch.Forwarders, current string, bench *config.Bench, load func(string) *config.Bench) map[string]*config.Bench {
+	benches := map[string]*config.Bench{current: bench}
+	for _, f := range ff {
+		if _, ok := benches[f.Context()]; !ok {
+			benches[f.Context()] = load(f.Context())
+		}
+	}
+
+	return benches
+}
+
 // ContainerID computes container ID based on ns/po/co.
 func containerID(path, co string) string {
 	ns, n := client.Namespaced(path)
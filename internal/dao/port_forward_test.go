@@ -0,0 +1,29 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextBenches(t *testing.T) {
+	ff := watch.NewForwarders()
+	for k, ctx := range map[string]string{"f1": "c1", "f2": "c2", "f3": "c2", "f4": "c3"} {
+		pf := NewPortForwarder(nil)
+		pf.context = ctx
+		ff[k] = pf
+	}
+
+	var loads []string
+	current := &config.Bench{}
+	bb := contextBenches(ff, "c1", current, func(ctx string) *config.Bench {
+		loads = append(loads, ctx)
+		return &config.Bench{}
+	})
+
+	assert.Equal(t, 3, len(bb))
+	assert.Equal(t, current, bb["c1"])
+	assert.ElementsMatch(t, []string{"c2", "c3"}, loads)
+}
@@ -1,16 +1,22 @@
 package dao
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -21,19 +27,35 @@ import (
 	"k8s.io/client-go/transport/spdy"
 )
 
-const localhost = "localhost"
+const (
+	localhost = "localhost"
+
+	// ForwardActive tracks a forward serving connections.
+	ForwardActive = render.ForwardActive
+	// ForwardReconnecting tracks a forward looking for its target.
+	ForwardReconnecting = render.ForwardReconnecting
+	// ForwardDead tracks a forward that gave up.
+	ForwardDead = render.ForwardDead
+
+	forwardWatchRate = time.Second
+)
 
 // PortForwarder tracks a port forward stream.
 type PortForwarder struct {
 	client.Connection
 	genericclioptions.IOStreams
 
-	stopChan, readyChan chan struct{}
-	active              bool
-	path                string
-	container           string
-	ports               []string
-	age                 time.Time
+	mx                            sync.RWMutex
+	stopChan, linkChan, readyChan chan struct{}
+	stopOnce                      sync.Once
+	active                        bool
+	context                       string
+	path                          string
+	container                     string
+	ports                         []string
+	age                           time.Time
+	status                        atomic.Value
+	bytes                         int64
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -47,49 +69,156 @@ func NewPortForwarder(c client.Connection) *PortForwarder {
 
 // Age returns the port forward age.
 func (p *PortForwarder) Age() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return time.Since(p.age).String()
 }
 
 // Active returns the forward status.
 func (p *PortForwarder) Active() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.active
 }
 
 // SetActive mark a portforward as active.
 func (p *PortForwarder) SetActive(b bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.active = b
 }
 
 // Ports returns the forwarded ports mappings.
 func (p *PortForwarder) Ports() []string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.ports
 }
 
 // Path returns the pod resource path.
 func (p *PortForwarder) Path() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.path + ":" + p.container
 }
 
+// PodPath returns the forwarded pod path.
+func (p *PortForwarder) PodPath() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.path
+}
+
+// Context returns the kube context owning the port forward.
+func (p *PortForwarder) Context() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.context
+}
+
 // Container returns the targetes container.
 func (p *PortForwarder) Container() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.container
 }
 
+// Status returns the forward health.
+func (p *PortForwarder) Status() string {
+	if s, ok := p.status.Load().(string); ok {
+		return s
+	}
+	if p.Active() {
+		return ForwardActive
+	}
+
+	return ForwardReconnecting
+}
+
+// SetStatus updates the forward health.
+func (p *PortForwarder) SetStatus(s string) {
+	p.status.Store(s)
+}
+
+// Transferred returns the number of bytes forwarded so far.
+func (p *PortForwarder) Transferred() int64 {
+	return atomic.LoadInt64(&p.bytes)
+}
+
+// Stopped returns true once the forward got stopped.
+func (p *PortForwarder) Stopped() bool {
+	select {
+	case <-p.stopChan:
+		return true
+	default:
+		return false
+	}
+}
+
 // Stop terminates a port forard
 func (p *PortForwarder) Stop() {
-	log.Debug().Msgf("<<< Stopping PortForward %q %v", p.path, p.ports)
+	log.Debug().Msgf("<<< Stopping PortForward %q %v", p.PodPath(), p.Ports())
+	p.stopOnce.Do(func() {
+		close(p.stopChan)
+	})
+	p.mx.Lock()
+	defer p.mx.Unlock()
 	p.active = false
-	close(p.stopChan)
+	p.dropLink()
+}
+
+// Drop breaks the current forward connection so it may get reconnected.
+// Unlike Stop, the forward remains usable.
+func (p *PortForwarder) Drop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.dropLink()
+}
+
+func (p *PortForwarder) dropLink() {
+	if p.linkChan == nil {
+		return
+	}
+	select {
+	case <-p.linkChan:
+	default:
+		close(p.linkChan)
+	}
 }
 
 // FQN returns the portforward unique id.
 func (p *PortForwarder) FQN() string {
-	return p.path + ":" + p.container
+	return p.Path()
 }
 
-// Start initiates a port forward session for a given pod and ports.
+// Start initiates a port forward session for a given pod and ports. Starting
+// again reconnects the forward, possibly to another pod.
 func (p *PortForwarder) Start(path, co, address string, ports []string) (*portforward.PortForwarder, error) {
-	p.path, p.container, p.ports, p.age = path, co, ports, time.Now()
+	ctx, _ := p.Config().CurrentContextName()
+	p.mx.Lock()
+	p.path, p.container, p.ports = path, co, ports
+	if p.age.IsZero() {
+		p.age = time.Now()
+	}
+	if ctx != "" {
+		p.context = ctx
+	}
+	p.dropLink()
+	p.linkChan, p.readyChan = make(chan struct{}), make(chan struct{})
+	link, ready := p.linkChan, p.readyChan
+	p.mx.Unlock()
+	if p.Stopped() {
+		return nil, fmt.Errorf("port forward %s was stopped", path)
+	}
 
 	ns, n := client.Namespaced(path)
 	auth, err := p.CanI(ns, "v1/pods", []string{client.GetVerb})
@@ -131,10 +260,10 @@ func (p *PortForwarder) Start(path, co, address string, ports []string) (*portfo
 		Name(n).
 		SubResource("portforward")
 
-	return p.forwardPorts("POST", req.URL(), address, ports)
+	return p.forwardPorts("POST", req.URL(), address, ports, link, ready)
 }
 
-func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string, ports []string) (*portforward.PortForwarder, error) {
+func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string, ports []string, link, ready chan struct{}) (*portforward.PortForwarder, error) {
 	cfg, err := p.Config().RESTConfig()
 	if err != nil {
 		return nil, err
@@ -144,12 +273,86 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 		return nil, err
 	}
 
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, method, url)
+	dialer := countingDialer{
+		Dialer: spdy.NewDialer(upgrader, &http.Client{Transport: transport}, method, url),
+		bytes:  &p.bytes,
+	}
 	if address == "" {
 		address = localhost
 	}
 	addrs := strings.Split(address, ",")
-	return portforward.NewOnAddresses(dialer, addrs, ports, p.stopChan, p.readyChan, p.Out, p.ErrOut)
+	return portforward.NewOnAddresses(dialer, addrs, ports, link, ready, p.Out, p.ErrOut)
+}
+
+// WatchForwardPod drops the forward connection once its pod is deleted or
+// stops being ready, so its supervisor may reconnect it. The pod is tracked
+// via the factory informer cache until the context is canceled or the
+// forward stopped.
+func WatchForwardPod(ctx context.Context, f Factory, pf *PortForwarder) {
+	var (
+		watched string
+		ready   bool
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-pf.stopChan:
+			return
+		case <-time.After(forwardWatchRate):
+		}
+
+		path := pf.PodPath()
+		if path != watched {
+			watched, ready = path, false
+		}
+		po, ok, err := cachedPod(f, path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("PortForward pod watch %s", path)
+			continue
+		}
+		switch {
+		case !ok:
+			log.Debug().Msgf("PortForward pod %s is gone", path)
+			pf.Drop()
+		case podReady(po):
+			ready = true
+		case ready || po.DeletionTimestamp != nil || po.Status.Phase != v1.PodRunning:
+			log.Debug().Msgf("PortForward pod %s is no longer ready", path)
+			ready = false
+			pf.Drop()
+		}
+	}
+}
+
+// CachedPod fetches a pod from the informer cache. It returns false when the
+// pod is gone and an error while the cache is syncing.
+func cachedPod(f Factory, path string) (*v1.Pod, bool, error) {
+	ns, n := client.Namespaced(path)
+	inf, err := f.CanForResource(ns, "v1/pods", client.MonitorAccess)
+	if err != nil {
+		return nil, false, err
+	}
+	if !inf.Informer().HasSynced() {
+		return nil, false, fmt.Errorf("pods cache not synced yet")
+	}
+	o, err := inf.Lister().ByNamespace(ns).Get(n)
+	if errors.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return nil, false, err
+	}
+
+	return &po, true, nil
 }
 
 // ----------------------------------------------------------------------------
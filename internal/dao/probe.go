@@ -0,0 +1,303 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	defaultProbeTimeout = time.Second
+	tcpProbeGrace       = 200 * time.Millisecond
+	maxProbeOutput      = 256
+)
+
+// ProbeResult represents the outcome of a probe run.
+type ProbeResult struct {
+	Kind    string        `json:"kind"`
+	Action  string        `json:"action"`
+	OK      bool          `json:"ok"`
+	Latency time.Duration `json:"-"`
+	Took    string        `json:"latency"`
+	Message string        `json:"message,omitempty"`
+}
+
+// ContainerProbe represents a configured container probe.
+type ContainerProbe struct {
+	Kind  string
+	Probe *v1.Probe
+}
+
+// RunProbes executes the probes of a pod container once, the way the kubelet
+// would. HTTP and TCP probes go through a port forward and exec probes run
+// in the container.
+func RunProbes(ctx context.Context, f Factory, path, co string) ([]ProbeResult, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, err
+	}
+	var c *v1.Container
+	for i := range po.Spec.Containers {
+		if po.Spec.Containers[i].Name == co {
+			c = &po.Spec.Containers[i]
+		}
+	}
+	if c == nil {
+		return nil, fmt.Errorf("no container %q found in pod %s", co, path)
+	}
+	pp := ContainerProbes(c)
+	if len(pp) == 0 {
+		return nil, fmt.Errorf("container %s defines no probes", co)
+	}
+
+	rr := make([]ProbeResult, 0, len(pp))
+	for _, p := range pp {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r := runProbe(ctx, f.Client(), path, c, p)
+		r.Took = r.Latency.Round(time.Millisecond).String()
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+// ContainerProbes returns the probes configured on a container.
+func ContainerProbes(co *v1.Container) []ContainerProbe {
+	var pp []ContainerProbe
+	for _, p := range []ContainerProbe{
+		{Kind: "startup", Probe: co.StartupProbe},
+		{Kind: "liveness", Probe: co.LivenessProbe},
+		{Kind: "readiness", Probe: co.ReadinessProbe},
+	} {
+		if p.Probe != nil {
+			pp = append(pp, p)
+		}
+	}
+
+	return pp
+}
+
+// ProbeAction describes a probe handler.
+func ProbeAction(p *v1.Probe) string {
+	switch {
+	case p.Exec != nil:
+		return "exec " + strings.Join(p.Exec.Command, " ")
+	case p.HTTPGet != nil:
+		scheme := strings.ToLower(string(p.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		return fmt.Sprintf("%s GET :%s%s", scheme, p.HTTPGet.Port.String(), p.HTTPGet.Path)
+	case p.TCPSocket != nil:
+		return "tcp :" + p.TCPSocket.Port.String()
+	default:
+		return "unknown"
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func runProbe(ctx context.Context, c client.Connection, path string, co *v1.Container, p ContainerProbe) ProbeResult {
+	r := ProbeResult{Kind: p.Kind, Action: ProbeAction(p.Probe)}
+	timeout := defaultProbeTimeout
+	if p.Probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(p.Probe.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+	switch {
+	case p.Probe.Exec != nil:
+		r.Latency, r.Message, err = execProbe(ctx, c, path, co.Name, p.Probe.Exec.Command)
+	case p.Probe.HTTPGet != nil:
+		r.Latency, r.Message, err = httpProbe(ctx, c, path, co, p.Probe.HTTPGet, timeout)
+	case p.Probe.TCPSocket != nil:
+		r.Latency, err = tcpProbe(c, path, co, p.Probe.TCPSocket)
+	default:
+		err = fmt.Errorf("unsupported probe handler")
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %s", timeout)
+	}
+	r.OK = err == nil
+	if err != nil {
+		r.Message = strings.TrimSpace(err.Error() + " " + r.Message)
+	}
+
+	return r
+}
+
+func execProbe(ctx context.Context, c client.Connection, path, co string, cmd []string) (time.Duration, string, error) {
+	var out bytes.Buffer
+	t := time.Now()
+	err := Exec(ctx, c, ExecOptions{
+		Path:      path,
+		Container: co,
+		Command:   cmd,
+		Stdout:    &out,
+		Stderr:    &out,
+	})
+
+	return time.Since(t), truncateOutput(out.String()), err
+}
+
+func httpProbe(ctx context.Context, c client.Connection, path string, co *v1.Container, get *v1.HTTPGetAction, timeout time.Duration) (time.Duration, string, error) {
+	if get.Host != "" {
+		return 0, "", fmt.Errorf("probes targeting host %s are not supported", get.Host)
+	}
+	port, stop, err := forwardProbePort(c, path, co, get.Port)
+	if err != nil {
+		return 0, "", err
+	}
+	defer stop()
+
+	scheme := strings.ToLower(string(get.Scheme))
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Scheme: scheme, Host: net.JoinHostPort(localhost, strconv.Itoa(port)), Path: get.Path}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, "", err
+	}
+	for _, h := range get.HTTPHeaders {
+		req.Header.Add(h.Name, h.Value)
+	}
+	// Like the kubelet, https probes skip certificate verification.
+	clt := http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+	}
+
+	t := time.Now()
+	resp, err := clt.Do(req.WithContext(ctx))
+	if err != nil {
+		return time.Since(t), "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxProbeOutput))
+	took := time.Since(t)
+	msg := strings.TrimSpace(resp.Status + " " + truncateOutput(string(body)))
+	if !httpProbeOK(resp.StatusCode) {
+		return took, msg, fmt.Errorf("HTTP probe failed")
+	}
+
+	return took, msg, nil
+}
+
+func tcpProbe(c client.Connection, path string, co *v1.Container, sock *v1.TCPSocketAction) (time.Duration, error) {
+	port, stop, err := forwardProbePort(c, path, co, sock.Port)
+	if err != nil {
+		return 0, err
+	}
+	defer stop()
+
+	t := time.Now()
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(localhost, strconv.Itoa(port)), defaultProbeTimeout)
+	if err != nil {
+		return time.Since(t), err
+	}
+	took := time.Since(t)
+	defer func() { _ = conn.Close() }()
+	// The local end always accepts. A refused remote connection closes the
+	// forwarded stream right away.
+	_ = conn.SetReadDeadline(time.Now().Add(tcpProbeGrace))
+	_, err = conn.Read(make([]byte, 1))
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return took, nil
+	}
+	if err != nil {
+		return took, fmt.Errorf("connection refused")
+	}
+
+	return took, nil
+}
+
+// ForwardProbePort forwards a random local port to a container port.
+func forwardProbePort(c client.Connection, path string, co *v1.Container, p intstr.IntOrString) (int, func(), error) {
+	port, err := probePort(co, p)
+	if err != nil {
+		return 0, nil, err
+	}
+	pf := NewPortForwarder(c)
+	pf.Out, pf.ErrOut = ioutil.Discard, ioutil.Discard
+	fw, err := pf.Start(path, co.Name, localhost, []string{":" + strconv.Itoa(port)})
+	if err != nil {
+		return 0, nil, err
+	}
+	errs := make(chan error, 1)
+	go func() { errs <- fw.ForwardPorts() }()
+	select {
+	case <-fw.Ready:
+	case err := <-errs:
+		return 0, nil, err
+	case <-time.After(defaultProbeTimeout * 5):
+		pf.Stop()
+		return 0, nil, fmt.Errorf("port forward to %d timed out", port)
+	}
+	pp, err := fw.GetPorts()
+	if err != nil || len(pp) == 0 {
+		pf.Stop()
+		return 0, nil, fmt.Errorf("no local port forwarded to %d", port)
+	}
+
+	return int(pp[0].Local), pf.Stop, nil
+}
+
+// ProbePort resolves a probe port, looking up named container ports.
+func probePort(co *v1.Container, p intstr.IntOrString) (int, error) {
+	if p.Type == intstr.Int {
+		return p.IntValue(), nil
+	}
+	if n, err := strconv.Atoi(p.StrVal); err == nil {
+		return n, nil
+	}
+	for _, cp := range co.Ports {
+		if cp.Name == p.StrVal {
+			return int(cp.ContainerPort), nil
+		}
+	}
+
+	return 0, fmt.Errorf("no port named %q on container %s", p.StrVal, co.Name)
+}
+
+// HTTPProbeOK checks a status code the way the kubelet does.
+func httpProbeOK(code int) bool {
+	return code >= http.StatusOK && code < http.StatusBadRequest
+}
+
+func truncateOutput(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > maxProbeOutput {
+		return s[:maxProbeOutput] + "..."
+	}
+
+	return s
+}
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestContainerProbes(t *testing.T) {
+	co := v1.Container{
+		ReadinessProbe: &v1.Probe{Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(80)}}},
+		StartupProbe:   &v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/ready"}}}},
+	}
+
+	pp := ContainerProbes(&co)
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, "startup", pp[0].Kind)
+	assert.Equal(t, "readiness", pp[1].Kind)
+	assert.Equal(t, 0, len(ContainerProbes(&v1.Container{})))
+}
+
+func TestProbeAction(t *testing.T) {
+	uu := map[string]struct {
+		p v1.Probe
+		e string
+	}{
+		"exec": {
+			p: v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/ready"}}}},
+			e: "exec cat /tmp/ready",
+		},
+		"http": {
+			p: v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")}}},
+			e: "http GET :http/healthz",
+		},
+		"https": {
+			p: v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/", Port: intstr.FromInt(8443), Scheme: v1.URISchemeHTTPS}}},
+			e: "https GET :8443/",
+		},
+		"tcp": {
+			p: v1.Probe{Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(5432)}}},
+			e: "tcp :5432",
+		},
+		"none": {
+			e: "unknown",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ProbeAction(&u.p))
+		})
+	}
+}
+
+func TestProbePort(t *testing.T) {
+	co := v1.Container{
+		Name:  "c1",
+		Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+	}
+	uu := map[string]struct {
+		p   intstr.IntOrString
+		e   int
+		err bool
+	}{
+		"int":     {p: intstr.FromInt(80), e: 80},
+		"numeric": {p: intstr.FromString("90"), e: 90},
+		"named":   {p: intstr.FromString("http"), e: 8080},
+		"missing": {p: intstr.FromString("grpc"), err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			port, err := probePort(&co, u.p)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, port)
+		})
+	}
+}
+
+func TestHTTPProbeOK(t *testing.T) {
+	assert.True(t, httpProbeOK(200))
+	assert.True(t, httpProbeOK(302))
+	assert.False(t, httpProbeOK(199))
+	assert.False(t, httpProbeOK(404))
+	assert.False(t, httpProbeOK(500))
+}
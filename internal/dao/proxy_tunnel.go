@@ -0,0 +1,515 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// ProxyRelayContainer is the relay pod container name.
+	ProxyRelayContainer = "relay"
+
+	proxyRelayPrefix = "k9s-proxy-relay-"
+	proxyRelayOwner  = "k9s.io/proxy-relay-owner"
+	proxyRelayWait   = 2 * time.Minute
+
+	socksVersion      = 0x05
+	socksNoAuth       = 0x00
+	socksNoMethod     = 0xff
+	socksConnect      = 0x01
+	socksIPv4         = 0x01
+	socksDomain       = 0x03
+	socksIPv6         = 0x04
+	socksSucceeded    = 0x00
+	socksFailure      = 0x01
+	socksNotSupported = 0x07
+
+	// RelayScript connects to the target then flags it on stderr before
+	// piping the connection, so clients only get told once the target is up.
+	relayReady  = "k9s-relay-ready"
+	relayScript = `if command -v nc >/dev/null 2>&1; then nc -z -w 5 "$1" "$2" || exit 1; echo k9s-relay-ready >&2; exec nc "$1" "$2"
+elif command -v socat >/dev/null 2>&1; then socat -u /dev/null "TCP:$1:$2,connect-timeout=5" || exit 1; echo k9s-relay-ready >&2; exec socat - "TCP:$1:$2"
+elif command -v bash >/dev/null 2>&1; then exec bash -c 'exec 3<>/dev/tcp/$0/$1 || exit 1; echo k9s-relay-ready >&2; cat <&3 & cat >&3' "$1" "$2"
+else echo "no nc, socat or bash found" >&2; exit 127; fi`
+)
+
+// ProxyTunnel serves a local SOCKS5 and HTTP proxy. Each proxied connection
+// is relayed by a pod container via an exec session through the api server,
+// so local tools may reach cluster internal services.
+type ProxyTunnel struct {
+	conn            client.Connection
+	path, container string
+	listener        net.Listener
+}
+
+// NewProxyTunnel returns a proxy relaying through a pod container.
+func NewProxyTunnel(c client.Connection, path, co string) *ProxyTunnel {
+	return &ProxyTunnel{conn: c, path: path, container: co}
+}
+
+// Listen binds the proxy to a local address.
+func (t *ProxyTunnel) Listen(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	t.listener = l
+
+	return nil
+}
+
+// Addr returns the proxy local address.
+func (t *ProxyTunnel) Addr() string {
+	if t.listener == nil {
+		return ""
+	}
+	return t.listener.Addr().String()
+}
+
+// Serve proxies connections until the context is canceled.
+func (t *ProxyTunnel) Serve(ctx context.Context) error {
+	if t.listener == nil {
+		return fmt.Errorf("proxy is not listening")
+	}
+	go func() {
+		<-ctx.Done()
+		t.listener.Close()
+	}()
+
+	for {
+		c, err := t.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go t.handle(ctx, c)
+	}
+}
+
+func (t *ProxyTunnel) handle(ctx context.Context, c net.Conn) {
+	defer c.Close()
+
+	r := bufio.NewReader(c)
+	tgt, err := proxyHandshake(r, c)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Proxy handshake failed")
+		return
+	}
+	log.Debug().Msgf("Proxy relaying %s:%s via %s", tgt.host, tgt.port, t.path)
+
+	var stdin io.Reader = r
+	if len(tgt.head) > 0 {
+		stdin = io.MultiReader(bytes.NewReader(tgt.head), r)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stderr := newRelayStderr()
+	stdout := relayStdout{w: c, ok: tgt.ok, ready: stderr.ready, done: ctx.Done()}
+	go stdout.replyOnReady()
+	err = Exec(ctx, t.conn, ExecOptions{
+		Path:      t.path,
+		Container: t.container,
+		Command:   RelayCommand(tgt.host, tgt.port),
+		Stdin:     stdin,
+		Stdout:    &stdout,
+		Stderr:    stderr,
+	})
+	cancel()
+	if !stderr.connected() && len(tgt.fail) > 0 {
+		_, _ = c.Write(tgt.fail)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Warn().Err(err).Msgf("Proxy relay to %s:%s failed %s", tgt.host, tgt.port, stderr.String())
+	}
+}
+
+// RelayCommand returns the command piping a pod connection to a target.
+// Targets are passed as arguments so they never get interpreted by the shell.
+func RelayCommand(host, port string) []string {
+	return []string{"sh", "-c", relayScript, "k9s", host, port}
+}
+
+// ProxyRelays tracks the relay pods launched by a K9s session so they get
+// deleted once the session ends.
+type ProxyRelays struct {
+	mx    sync.Mutex
+	owner string
+	pods  map[string]struct{}
+}
+
+// NewProxyRelays returns a new relay pods tracker.
+func NewProxyRelays() *ProxyRelays {
+	return &ProxyRelays{
+		owner: rand.String(8),
+		pods:  make(map[string]struct{}),
+	}
+}
+
+// Start launches a relay pod in a namespace and waits for it to run. It
+// returns the relay pod path.
+func (r *ProxyRelays) Start(ctx context.Context, c client.Connection, ns, image string) (string, error) {
+	path, err := StartProxyRelay(ctx, c, ns, image, r.owner)
+	if path != "" {
+		r.mx.Lock()
+		r.pods[path] = struct{}{}
+		r.mx.Unlock()
+	}
+	if err != nil && path != "" {
+		_ = r.Stop(c, path)
+	}
+
+	return path, err
+}
+
+// Stop deletes a relay pod unless already gone.
+func (r *ProxyRelays) Stop(c client.Connection, path string) error {
+	r.mx.Lock()
+	_, ok := r.pods[path]
+	delete(r.pods, path)
+	r.mx.Unlock()
+	if !ok {
+		return nil
+	}
+
+	return StopProxyRelay(c, path)
+}
+
+// StopAll deletes all the relay pods still running.
+func (r *ProxyRelays) StopAll(c client.Connection) {
+	r.mx.Lock()
+	pp := make([]string, 0, len(r.pods))
+	for p := range r.pods {
+		pp = append(pp, p)
+	}
+	r.mx.Unlock()
+
+	for _, p := range pp {
+		if err := r.Stop(c, p); err != nil {
+			log.Error().Err(err).Msgf("Unable to delete relay pod %s", p)
+		}
+	}
+}
+
+// StartProxyRelay launches a relay pod labeled with its owner in a namespace
+// and waits for it to run. It returns the relay pod path as soon as the pod
+// got created, even when it failed to run.
+func StartProxyRelay(ctx context.Context, c client.Connection, ns, image, owner string) (string, error) {
+	auth, err := c.CanI(ns, "v1/pods", []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create pods in namespace %s", ns)
+	}
+
+	pods := c.DialOrDie().CoreV1().Pods(ns)
+	po, err := pods.Create(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: proxyRelayPrefix,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "k9s",
+				proxyRelayOwner:                owner,
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:    ProxyRelayContainer,
+					Image:   image,
+					Command: []string{"sleep", "infinity"},
+				},
+			},
+			RestartPolicy: v1.RestartPolicyNever,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	path, n := client.FQN(ns, po.Name), po.Name
+
+	ctx, cancel := context.WithTimeout(ctx, proxyRelayWait)
+	defer cancel()
+	for {
+		po, err := pods.Get(n, metav1.GetOptions{})
+		if err != nil {
+			return path, err
+		}
+		switch po.Status.Phase {
+		case v1.PodRunning:
+			return path, nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return path, fmt.Errorf("relay pod %s terminated (%s) %s", path, po.Status.Phase, po.Status.Message)
+		}
+		select {
+		case <-ctx.Done():
+			return path, fmt.Errorf("relay pod %s did not start within %s", path, proxyRelayWait)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// StopProxyRelay deletes a relay pod.
+func StopProxyRelay(c client.Connection, path string) error {
+	ns, n := client.Namespaced(path)
+	err := c.DialOrDie().CoreV1().Pods(ns).Delete(n, &metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ProxyTarget represents a proxied connection target.
+type proxyTarget struct {
+	host, port string
+	// Head holds the bytes to send to the target first.
+	head []byte
+	// Ok and fail hold the client replies once the target is up or unreachable.
+	ok, fail []byte
+}
+
+// ProxyHandshake negotiates a SOCKS5 or a HTTP proxy connection. Clients get
+// no success reply until the target connected.
+func proxyHandshake(r *bufio.Reader, w io.Writer) (proxyTarget, error) {
+	b, err := r.Peek(1)
+	if err != nil {
+		return proxyTarget{}, err
+	}
+	if b[0] == socksVersion {
+		host, port, err := socksHandshake(r, w)
+		return proxyTarget{
+			host: host,
+			port: port,
+			ok:   socksReply(socksSucceeded),
+			fail: socksReply(socksFailure),
+		}, err
+	}
+
+	return httpHandshake(r, w)
+}
+
+func socksHandshake(r *bufio.Reader, w io.Writer) (string, string, error) {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return "", "", err
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(r, methods); err != nil {
+		return "", "", err
+	}
+	if bytes.IndexByte(methods, socksNoAuth) == -1 {
+		_, _ = w.Write([]byte{socksVersion, socksNoMethod})
+		return "", "", fmt.Errorf("socks clients must support no authentication")
+	}
+	if _, err := w.Write([]byte{socksVersion, socksNoAuth}); err != nil {
+		return "", "", err
+	}
+
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(r, req); err != nil {
+		return "", "", err
+	}
+	if req[1] != socksConnect {
+		_, _ = w.Write(socksReply(socksNotSupported))
+		return "", "", fmt.Errorf("unsupported socks command %d", req[1])
+	}
+	var host string
+	switch req[3] {
+	case socksIPv4, socksIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if req[3] == socksIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", "", err
+		}
+		host = ip.String()
+	case socksDomain:
+		l, err := r.ReadByte()
+		if err != nil {
+			return "", "", err
+		}
+		n := make([]byte, l)
+		if _, err := io.ReadFull(r, n); err != nil {
+			return "", "", err
+		}
+		host = string(n)
+	default:
+		_, _ = w.Write(socksReply(socksNotSupported))
+		return "", "", fmt.Errorf("unsupported socks address type %d", req[3])
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(r, port); err != nil {
+		return "", "", err
+	}
+
+	return host, strconv.Itoa(int(binary.BigEndian.Uint16(port))), nil
+}
+
+func socksReply(code byte) []byte {
+	return []byte{socksVersion, code, 0x00, socksIPv4, 0, 0, 0, 0, 0, 0}
+}
+
+// HttpHandshake handles CONNECT tunnels and plain requests. Plain requests
+// are rewritten to origin form and close the connection once served.
+func httpHandshake(r *bufio.Reader, w io.Writer) (proxyTarget, error) {
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return proxyTarget{}, err
+	}
+
+	fail := []byte("HTTP/1.1 502 Bad Gateway\r\nConnection: close\r\n\r\n")
+	if req.Method == http.MethodConnect {
+		host, port, err := net.SplitHostPort(req.Host)
+		if err != nil {
+			host, port = req.Host, "443"
+		}
+		return proxyTarget{
+			host: host,
+			port: port,
+			ok:   []byte("HTTP/1.1 200 Connection established\r\n\r\n"),
+			fail: fail,
+		}, nil
+	}
+
+	if req.URL.Host == "" {
+		_, _ = io.WriteString(w, "HTTP/1.1 400 Bad Request\r\nConnection: close\r\n\r\n")
+		return proxyTarget{}, fmt.Errorf("proxy requests must use an absolute url")
+	}
+	if req.URL.Scheme != "" && req.URL.Scheme != "http" {
+		_, _ = io.WriteString(w, "HTTP/1.1 501 Not Implemented\r\nConnection: close\r\n\r\n")
+		return proxyTarget{}, fmt.Errorf("unsupported proxy scheme %q", req.URL.Scheme)
+	}
+	host, port := req.URL.Hostname(), req.URL.Port()
+	if port == "" {
+		port = "80"
+	}
+	req.Header.Del("Proxy-Connection")
+	req.Header.Del("Proxy-Authorization")
+	req.Close = true
+	var head bytes.Buffer
+	if err := req.Write(&head); err != nil {
+		return proxyTarget{}, err
+	}
+
+	return proxyTarget{host: host, port: port, head: head.Bytes(), fail: fail}, nil
+}
+
+// RelayStderr collects the relay errors and flags once the target connected.
+type relayStderr struct {
+	mx    sync.Mutex
+	buf   bytes.Buffer
+	ready chan struct{}
+	once  sync.Once
+}
+
+func newRelayStderr() *relayStderr {
+	return &relayStderr{ready: make(chan struct{})}
+}
+
+func (s *relayStderr) Write(bb []byte) (int, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	n, err := s.buf.Write(bb)
+	if strings.Contains(s.buf.String(), relayReady) {
+		s.once.Do(func() { close(s.ready) })
+	}
+
+	return n, err
+}
+
+func (s *relayStderr) connected() bool {
+	select {
+	case <-s.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *relayStderr) String() string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return strings.TrimSpace(strings.Replace(s.buf.String(), relayReady, "", 1))
+}
+
+// RelayStdout replies to the client once the target connected and holds
+// the target output until then.
+type relayStdout struct {
+	mx          sync.Mutex
+	w           io.Writer
+	ok          []byte
+	ready, done <-chan struct{}
+	replied     bool
+}
+
+// ReplyOnReady tells the client the target is up as soon as it connects.
+func (s *relayStdout) replyOnReady() {
+	if s.wait() != nil {
+		return
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if err := s.reply(); err != nil {
+		log.Warn().Err(err).Msgf("Proxy reply failed")
+	}
+}
+
+func (s *relayStdout) Write(bb []byte) (int, error) {
+	if err := s.wait(); err != nil {
+		return 0, err
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if err := s.reply(); err != nil {
+		return 0, err
+	}
+
+	return s.w.Write(bb)
+}
+
+func (s *relayStdout) wait() error {
+	select {
+	case <-s.ready:
+		return nil
+	case <-s.done:
+		return io.ErrClosedPipe
+	}
+}
+
+func (s *relayStdout) reply() error {
+	if s.replied {
+		return nil
+	}
+	s.replied = true
+	if len(s.ok) == 0 {
+		return nil
+	}
+	_, err := s.w.Write(s.ok)
+
+	return err
+}
@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSocksHandshake(t *testing.T) {
+	uu := map[string]struct {
+		req              []byte
+		host, port, resp string
+		err              bool
+	}{
+		"domain": {
+			req:  append([]byte{5, 1, 0, 5, 1, 0, 3, 4}, append([]byte("fred"), 0x1f, 0x90)...),
+			host: "fred",
+			port: "8080",
+			resp: string([]byte{5, 0}),
+		},
+		"ipv4": {
+			req:  []byte{5, 1, 0, 5, 1, 0, 1, 10, 0, 0, 1, 0, 80},
+			host: "10.0.0.1",
+			port: "80",
+			resp: string([]byte{5, 0}),
+		},
+		"auth": {
+			req:  []byte{5, 1, 2},
+			resp: string([]byte{5, 0xff}),
+			err:  true,
+		},
+		"bind": {
+			req:  []byte{5, 1, 0, 5, 2, 0, 1, 10, 0, 0, 1, 0, 80},
+			resp: string([]byte{5, 0, 5, 7, 0, 1, 0, 0, 0, 0, 0, 0}),
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var w bytes.Buffer
+			tgt, err := proxyHandshake(bufio.NewReader(bytes.NewReader(u.req)), &w)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.host, tgt.host)
+			assert.Equal(t, u.port, tgt.port)
+			assert.Nil(t, tgt.head)
+			assert.Equal(t, u.resp, w.String())
+			if !u.err {
+				assert.Equal(t, socksReply(socksSucceeded), tgt.ok)
+			}
+		})
+	}
+}
+
+func TestHTTPHandshake(t *testing.T) {
+	uu := map[string]struct {
+		req, host, port, resp, ok string
+		head                      []string
+		err                       bool
+	}{
+		"connect": {
+			req:  "CONNECT fred.default:443 HTTP/1.1\r\nHost: fred.default:443\r\n\r\n",
+			host: "fred.default",
+			port: "443",
+			ok:   "HTTP/1.1 200 Connection established\r\n\r\n",
+		},
+		"plain": {
+			req:  "GET http://fred.default/blee?a=1 HTTP/1.1\r\nHost: fred.default\r\nProxy-Connection: keep-alive\r\n\r\n",
+			host: "fred.default",
+			port: "80",
+			head: []string{"GET /blee?a=1 HTTP/1.1\r\n", "Host: fred.default\r\n", "Connection: close\r\n"},
+		},
+		"origin": {
+			req:  "GET /blee HTTP/1.1\r\nHost: fred\r\n\r\n",
+			resp: "HTTP/1.1 400 Bad Request\r\nConnection: close\r\n\r\n",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var w bytes.Buffer
+			tgt, err := proxyHandshake(bufio.NewReader(strings.NewReader(u.req)), &w)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.host, tgt.host)
+			assert.Equal(t, u.port, tgt.port)
+			assert.Equal(t, u.resp, w.String())
+			assert.Equal(t, u.ok, string(tgt.ok))
+			for _, h := range u.head {
+				assert.Contains(t, string(tgt.head), h)
+			}
+			assert.NotContains(t, string(tgt.head), "Proxy-Connection")
+		})
+	}
+}
+
+func TestRelayCommand(t *testing.T) {
+	cmd := RelayCommand("fred; rm -rf /", "80")
+	assert.Equal(t, 6, len(cmd))
+	assert.Equal(t, "fred; rm -rf /", cmd[4])
+	assert.Equal(t, "80", cmd[5])
+}
+
+func TestRelayStdout(t *testing.T) {
+	var (
+		w   bytes.Buffer
+		e   = newRelayStderr()
+		out = relayStdout{w: &w, ok: []byte("ok|"), ready: e.ready, done: make(chan struct{})}
+	)
+
+	_, err := e.Write([]byte("k9s-relay-"))
+	assert.Nil(t, err)
+	assert.False(t, e.connected())
+	_, err = e.Write([]byte("ready\n"))
+	assert.Nil(t, err)
+	assert.True(t, e.connected())
+	out.replyOnReady()
+	_, err = out.Write([]byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ok|hello", w.String())
+	assert.Equal(t, "", e.String())
+}
+
+func TestRelayStdoutClosed(t *testing.T) {
+	var (
+		w    bytes.Buffer
+		done = make(chan struct{})
+		out  = relayStdout{w: &w, ok: []byte("ok|"), ready: newRelayStderr().ready, done: done}
+	)
+	close(done)
+
+	_, err := out.Write([]byte("hello"))
+	assert.NotNil(t, err)
+	assert.Equal(t, "", w.String())
+}
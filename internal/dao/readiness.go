@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Readiness reports whether a resource is ready along with a short status.
+func Readiness(u *unstructured.Unstructured) (bool, string) {
+	switch u.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet", "ReplicationController":
+		desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		if !ok {
+			desired = 1
+		}
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+		return ready >= desired, fmt.Sprintf("%d/%d", ready, desired)
+	case "DaemonSet":
+		desired, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "numberReady")
+		return ready >= desired, fmt.Sprintf("%d/%d", ready, desired)
+	case "Job":
+		completions, ok, _ := unstructured.NestedInt64(u.Object, "spec", "completions")
+		if !ok {
+			completions = 1
+		}
+		succeeded, _, _ := unstructured.NestedInt64(u.Object, "status", "succeeded")
+		if failed, _, _ := unstructured.NestedInt64(u.Object, "status", "failed"); failed > 0 {
+			return false, fmt.Sprintf("Failed(%d)", failed)
+		}
+		return succeeded >= completions, fmt.Sprintf("%d/%d", succeeded, completions)
+	case "Node":
+		if conditionStatus(u, "Ready") == "True" {
+			return true, "Ready"
+		}
+		return false, "NotReady"
+	case "PersistentVolumeClaim", "PersistentVolume":
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		return phase == "Bound", phase
+	case "Namespace":
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		return phase == "Active", phase
+	case "Pod":
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		if phase == "Succeeded" {
+			return true, phase
+		}
+		return conditionStatus(u, "Ready") == "True", phase
+	}
+
+	for _, c := range []string{"Ready", "Available"} {
+		switch conditionStatus(u, c) {
+		case "True":
+			return true, c
+		case "":
+		default:
+			return false, "Not" + c
+		}
+	}
+
+	return true, "Exists"
+}
+
+func conditionStatus(u *unstructured.Unstructured, kind string) string {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != kind {
+			continue
+		}
+		s, _ := m["status"].(string)
+		return s
+	}
+
+	return ""
+}
+
+// RolloutProgress reports how many replicas run the latest revision out of
+// the desired count and whether the rollout completed.
+func RolloutProgress(u *unstructured.Unstructured) (int64, int64, bool) {
+	gen := u.GetGeneration()
+	observed, _, _ := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	switch u.GetKind() {
+	case "Deployment", "StatefulSet":
+		desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		if !ok {
+			desired = 1
+		}
+		updated, _, _ := unstructured.NestedInt64(u.Object, "status", "updatedReplicas")
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+		replicas, _, _ := unstructured.NestedInt64(u.Object, "status", "replicas")
+		done := observed >= gen && updated >= desired && ready >= desired && replicas == updated
+		if u.GetKind() == "StatefulSet" {
+			current, _, _ := unstructured.NestedString(u.Object, "status", "currentRevision")
+			update, _, _ := unstructured.NestedString(u.Object, "status", "updateRevision")
+			done = done && current == update
+		}
+		return updated, desired, done
+	case "DaemonSet":
+		desired, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+		updated, _, _ := unstructured.NestedInt64(u.Object, "status", "updatedNumberScheduled")
+		available, _, _ := unstructured.NestedInt64(u.Object, "status", "numberAvailable")
+		return updated, desired, observed >= gen && updated >= desired && available >= desired
+	}
+
+	ready, _ := Readiness(u)
+	if ready {
+		return 1, 1, true
+	}
+	return 0, 1, false
+}
@@ -0,0 +1,190 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestReadiness(t *testing.T) {
+	uu := map[string]struct {
+		o      map[string]interface{}
+		ready  bool
+		status string
+	}{
+		"dp-ready": {
+			o: map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"readyReplicas": int64(2)},
+			},
+			ready:  true,
+			status: "2/2",
+		},
+		"sts-toast": {
+			o: map[string]interface{}{
+				"kind":   "StatefulSet",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(1)},
+			},
+			status: "1/3",
+		},
+		"ds": {
+			o: map[string]interface{}{
+				"kind":   "DaemonSet",
+				"status": map[string]interface{}{"desiredNumberScheduled": int64(3), "numberReady": int64(3)},
+			},
+			ready:  true,
+			status: "3/3",
+		},
+		"node-toast": {
+			o: map[string]interface{}{
+				"kind": "Node",
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "Unknown"},
+				}},
+			},
+			status: "NotReady",
+		},
+		"pvc": {
+			o: map[string]interface{}{
+				"kind":   "PersistentVolumeClaim",
+				"status": map[string]interface{}{"phase": "Pending"},
+			},
+			status: "Pending",
+		},
+		"job-failed": {
+			o: map[string]interface{}{
+				"kind":   "Job",
+				"status": map[string]interface{}{"failed": int64(2)},
+			},
+			status: "Failed(2)",
+		},
+		"generic-available": {
+			o: map[string]interface{}{
+				"kind": "Fred",
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Available", "status": "True"},
+				}},
+			},
+			ready:  true,
+			status: "Available",
+		},
+		"generic-toast": {
+			o: map[string]interface{}{
+				"kind": "Fred",
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				}},
+			},
+			status: "NotReady",
+		},
+		"generic": {
+			o:      map[string]interface{}{"kind": "ConfigMap"},
+			ready:  true,
+			status: "Exists",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ready, status := Readiness(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.ready, ready)
+			assert.Equal(t, u.status, status)
+		})
+	}
+}
+
+func TestRolloutProgress(t *testing.T) {
+	uu := map[string]struct {
+		o                map[string]interface{}
+		updated, desired int64
+		done             bool
+	}{
+		"dp-rolling": {
+			o: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(2)},
+				"spec":     map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(4),
+					"updatedReplicas":    int64(1),
+					"readyReplicas":      int64(3),
+				},
+			},
+			updated: 1,
+			desired: 3,
+		},
+		"dp-done": {
+			o: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(2)},
+				"spec":     map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"updatedReplicas":    int64(3),
+					"readyReplicas":      int64(3),
+				},
+			},
+			updated: 3,
+			desired: 3,
+			done:    true,
+		},
+		"dp-stale": {
+			o: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(1),
+					"updatedReplicas":    int64(1),
+					"readyReplicas":      int64(1),
+				},
+			},
+			updated: 1,
+			desired: 1,
+		},
+		"sts-revision": {
+			o: map[string]interface{}{
+				"kind": "StatefulSet",
+				"spec": map[string]interface{}{"replicas": int64(1)},
+				"status": map[string]interface{}{
+					"replicas":        int64(1),
+					"updatedReplicas": int64(1),
+					"readyReplicas":   int64(1),
+					"currentRevision": "fred-1",
+					"updateRevision":  "fred-2",
+				},
+			},
+			updated: 1,
+			desired: 1,
+		},
+		"ds-done": {
+			o: map[string]interface{}{
+				"kind": "DaemonSet",
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(2),
+					"updatedNumberScheduled": int64(2),
+					"numberAvailable":        int64(2),
+				},
+			},
+			updated: 2,
+			desired: 2,
+			done:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			updated, desired, done := RolloutProgress(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.updated, updated)
+			assert.Equal(t, u.desired, desired)
+			assert.Equal(t, u.done, done)
+		})
+	}
+}
@@ -27,6 +27,7 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("portforwards"):                  &PortForward{},
 		client.NewGVR("v1/services"):                   &Service{},
 		client.NewGVR("v1/pods"):                       &Pod{},
+		client.NewGVR("v1/serviceaccounts"):            &ServiceAccount{},
 		client.NewGVR("apps/v1/deployments"):           &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
 		client.NewGVR("extensions/v1beta1/daemonsets"): &DaemonSet{},
@@ -34,6 +35,56 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
 		client.NewGVR("charts"):                        &Chart{},
+		client.NewGVR("deprecations"):                  &Deprecation{},
+		client.NewGVR("upgrade"):                       &Upgrade{},
+		client.NewGVR("controlplane"):                  &ControlPlane{},
+		client.NewGVR("stats"):                         &Stats{},
+		client.NewGVR("pdbcoverage"):                   &PDBCoverage{},
+		client.NewGVR("podsecurity"):                   &PodSecurity{},
+		client.NewGVR("find"):                          &Find{},
+		client.NewGVR("children"):                      &Children{},
+		client.NewGVR("related"):                       &Related{},
+		client.NewGVR("applications"):                  &Application{},
+		client.NewGVR("images"):                        &Image{},
+		client.NewGVR("rollouts"):                      &Rollout{},
+		client.NewGVR("timelines"):                     &Timeline{},
+		client.NewGVR("ordinals"):                      &Ordinal{},
+		client.NewGVR("nodepods"):                      &NodePods{},
+		client.NewGVR("satokens"):                      &SAToken{},
+		client.NewGVR("pinboard"):                      &Pin{},
+		client.NewGVR("tasks"):                         &Task{},
+		client.NewGVR("sessions"):                      &ExecSession{},
+		client.NewGVR("audit"):                         &Audit{},
+		client.NewGVR("configs"):                       &ConfigCheck{},
+
+		client.NewGVR("autoscaling/v1/horizontalpodautoscalers"):      &HorizontalPodAutoscaler{},
+		client.NewGVR("autoscaling/v2beta1/horizontalpodautoscalers"): &HorizontalPodAutoscaler{},
+		client.NewGVR("autoscaling/v2beta2/horizontalpodautoscalers"): &HorizontalPodAutoscaler{},
+
+		client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions"):      &CustomResourceDefinition{},
+		client.NewGVR("apiextensions.k8s.io/v1beta1/customresourcedefinitions"): &CustomResourceDefinition{},
+
+		client.NewGVR("admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations"): &Webhook{},
+		client.NewGVR("admissionregistration.k8s.io/v1beta1/mutatingwebhookconfigurations"):   &Webhook{},
+		client.NewGVR("admissionregistration.k8s.io/v1/validatingwebhookconfigurations"):      &Webhook{},
+		client.NewGVR("admissionregistration.k8s.io/v1/mutatingwebhookconfigurations"):        &Webhook{},
+	}
+	for _, g := range FluxGVRs {
+		m[client.NewGVR(g)] = &Flux{}
+	}
+	for _, g := range ArgoGVRs {
+		m[client.NewGVR(g)] = &Argo{}
+	}
+	for _, g := range VPAGVRs {
+		m[client.NewGVR(g)] = &VerticalPodAutoscaler{}
+	}
+	for _, v := range IstioVersions {
+		for _, r := range []string{"virtualservices", "destinationrules", "gateways"} {
+			m[client.NewGVR("networking.istio.io/"+v+"/"+r)] = &Traffic{}
+		}
+	}
+	for _, v := range GatewayAPIVersions {
+		m[client.NewGVR("gateway.networking.k8s.io/"+v+"/httproutes")] = &Traffic{}
 	}
 
 	r, ok := m[gvr]
@@ -159,6 +210,163 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:         "deprecations",
+		Kind:         "Deprecations",
+		SingularName: "deprecation",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("upgrade")] = metav1.APIResource{
+		Name:         "upgrade",
+		Kind:         "Upgrade",
+		SingularName: "upgrade",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("controlplane")] = metav1.APIResource{
+		Name:         "controlplane",
+		Kind:         "ControlPlane",
+		SingularName: "controlplane",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("configs")] = metav1.APIResource{
+		Name:         "configs",
+		Kind:         "Configs",
+		SingularName: "config",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("stats")] = metav1.APIResource{
+		Name:         "stats",
+		Kind:         "Stats",
+		SingularName: "stats",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("pdbcoverage")] = metav1.APIResource{
+		Name:         "pdbcoverage",
+		Kind:         "PDBCoverage",
+		SingularName: "pdbcoverage",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("podsecurity")] = metav1.APIResource{
+		Name:         "podsecurity",
+		Kind:         "PodSecurity",
+		SingularName: "podsecurity",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("find")] = metav1.APIResource{
+		Name:         "find",
+		Kind:         "Find",
+		SingularName: "find",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("children")] = metav1.APIResource{
+		Name:         "children",
+		Kind:         "Children",
+		SingularName: "child",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("related")] = metav1.APIResource{
+		Name:         "related",
+		Kind:         "Related",
+		SingularName: "related",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("applications")] = metav1.APIResource{
+		Name:         "applications",
+		Kind:         "Applications",
+		SingularName: "application",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("images")] = metav1.APIResource{
+		Name:         "images",
+		Kind:         "Images",
+		SingularName: "image",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("rollouts")] = metav1.APIResource{
+		Name:         "rollouts",
+		Kind:         "Rollouts",
+		SingularName: "rollout",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("timelines")] = metav1.APIResource{
+		Name:         "timelines",
+		Kind:         "Timelines",
+		SingularName: "timeline",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("ordinals")] = metav1.APIResource{
+		Name:         "ordinals",
+		Kind:         "Ordinals",
+		SingularName: "ordinal",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("nodepods")] = metav1.APIResource{
+		Name:         "nodepods",
+		Kind:         "NodePods",
+		SingularName: "nodepod",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("satokens")] = metav1.APIResource{
+		Name:         "satokens",
+		Kind:         "SATokens",
+		SingularName: "satoken",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("pinboard")] = metav1.APIResource{
+		Name:         "pinboard",
+		Kind:         "Pinboard",
+		SingularName: "pin",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("tasks")] = metav1.APIResource{
+		Name:         "tasks",
+		Kind:         "Tasks",
+		SingularName: "task",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("sessions")] = metav1.APIResource{
+		Name:         "sessions",
+		Kind:         "Sessions",
+		SingularName: "session",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("audit")] = metav1.APIResource{
+		Name:         "audit",
+		Kind:         "Audit",
+		SingularName: "audit",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("containers")] = metav1.APIResource{
 		Name:         "containers",
 		Kind:         "Containers",
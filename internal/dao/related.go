@@ -0,0 +1,463 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A collection of resource relations.
+const (
+	RelationOwner      = "owner"
+	RelationSelects    = "selects"
+	RelationSelectedBy = "selected by"
+	RelationUses       = "uses"
+	RelationUsedBy     = "used by"
+	RelationRoutesTo   = "routes to"
+	RelationRoutedBy   = "routed by"
+	RelationEndpoints  = "endpoints"
+	RelationNode       = "node"
+	RelationBoundBy    = "bound by"
+	RelationToken      = "token"
+	RelationMissing    = " (missing)"
+)
+
+var _ Accessor = (*Related)(nil)
+
+// IngressGVRs tracks ingress api versions.
+var IngressGVRs = []string{
+	"networking.k8s.io/v1/ingresses",
+	"networking.k8s.io/v1beta1/ingresses",
+	"extensions/v1beta1/ingresses",
+}
+
+// workloadGVRs tracks resources holding a pod template.
+var workloadGVRs = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1/jobs",
+	"batch/v1beta1/cronjobs",
+}
+
+var podTemplatePaths = map[string][]string{
+	"Pod":         {"spec"},
+	"Deployment":  {"spec", "template", "spec"},
+	"StatefulSet": {"spec", "template", "spec"},
+	"DaemonSet":   {"spec", "template", "spec"},
+	"ReplicaSet":  {"spec", "template", "spec"},
+	"Job":         {"spec", "template", "spec"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// Related tracks resources related to a given resource via owner references,
+// label selectors and pod spec references.
+type Related struct {
+	NonResource
+}
+
+// List returns all resources related to the context target.
+func (r *Related) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context target gvr")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context path")
+	}
+	u, err := fetchUnstructured(r.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := newRelations(r.Factory, u.GetNamespace())
+	rr.owners(gvr, path)
+	children, err := ownedBy(r.Factory, u)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range children {
+		if res, ok := c.(render.RelatedRes); ok {
+			rr.addRes(res)
+		}
+	}
+
+	if spec, ok := podSpecOf(u); ok {
+		for _, ref := range podSpecRefs(spec) {
+			rr.ref(ref.gvr, ref.name, RelationUses)
+		}
+		if spec.NodeName != "" {
+			rr.ref("v1/nodes", spec.NodeName, RelationNode)
+		}
+		rr.selectedBy(podLabelsOf(u))
+	}
+
+	switch u.GetKind() {
+	case "Service":
+		rr.service(u)
+	case "Ingress":
+		for _, svc := range ingressBackends(u) {
+			rr.ref("v1/services", svc, RelationRoutesTo)
+		}
+		for _, sec := range ingressTLSSecrets(u) {
+			rr.ref("v1/secrets", sec, RelationUses)
+		}
+	case "ConfigMap", "Secret", "PersistentVolumeClaim":
+		rr.usedBy(client.NewGVR(gvr).String(), u.GetName())
+	case "ServiceAccount":
+		rr.serviceAccount(u)
+		rr.usedBy(client.NewGVR(gvr).String(), u.GetName())
+	}
+
+	return rr.oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type podRef struct {
+	gvr, name string
+}
+
+type relations struct {
+	f    Factory
+	ns   string
+	seen map[string]struct{}
+	oo   []runtime.Object
+}
+
+func newRelations(f Factory, ns string) *relations {
+	return &relations{f: f, ns: ns, seen: make(map[string]struct{})}
+}
+
+func (r *relations) add(gvr, path, relation string, u *unstructured.Unstructured) {
+	res := render.RelatedRes{GVR: gvr, Path: path, Relation: relation}
+	if u != nil {
+		res.Created = u.GetCreationTimestamp()
+	}
+	r.addRes(res)
+}
+
+func (r *relations) addRes(res render.RelatedRes) {
+	id := client.FQN(res.GVR, res.Path)
+	if _, ok := r.seen[id]; ok {
+		return
+	}
+	r.seen[id] = struct{}{}
+	r.oo = append(r.oo, res)
+}
+
+func (r *relations) ref(gvr, name, relation string) {
+	path := client.FQN(r.ns, name)
+	if meta, err := MetaFor(client.NewGVR(gvr)); err == nil && !meta.Namespaced {
+		path = name
+	}
+	u, err := fetchUnstructured(r.f, gvr, path)
+	if err != nil {
+		r.add(gvr, path, relation+RelationMissing, nil)
+		return
+	}
+	r.add(gvr, path, relation, u)
+}
+
+func (r *relations) owners(gvr, path string) {
+	for {
+		ogvr, opath, err := ControllerOf(r.f, gvr, path)
+		if err != nil {
+			return
+		}
+		if _, ok := r.seen[client.FQN(ogvr, opath)]; ok {
+			return
+		}
+		u, err := fetchUnstructured(r.f, ogvr, opath)
+		if err != nil {
+			r.add(ogvr, opath, RelationOwner+RelationMissing, nil)
+			return
+		}
+		r.add(ogvr, opath, RelationOwner, u)
+		gvr, path = ogvr, opath
+	}
+}
+
+func (r *relations) list(gvr string, sel labels.Selector) []*unstructured.Unstructured {
+	oo, err := r.f.List(gvr, r.ns, true, sel)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Related scan skipped %q", gvr)
+		return nil
+	}
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+
+	return uu
+}
+
+func (r *relations) selectedBy(podLabels map[string]string) {
+	if len(podLabels) == 0 {
+		return
+	}
+	for _, u := range r.list("v1/services", labels.Everything()) {
+		sel, _, _ := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if len(sel) == 0 || !labels.SelectorFromSet(sel).Matches(labels.Set(podLabels)) {
+			continue
+		}
+		r.add("v1/services", client.FQN(u.GetNamespace(), u.GetName()), RelationSelectedBy, u)
+	}
+}
+
+func (r *relations) service(svc *unstructured.Unstructured) {
+	if sel, _, _ := unstructured.NestedStringMap(svc.Object, "spec", "selector"); len(sel) > 0 {
+		for _, u := range r.list("v1/pods", labels.SelectorFromSet(sel)) {
+			r.add("v1/pods", client.FQN(u.GetNamespace(), u.GetName()), RelationSelects, u)
+		}
+	}
+	r.ref("v1/endpoints", svc.GetName(), RelationEndpoints)
+
+	gvr, ok := servedIngress()
+	if !ok {
+		return
+	}
+	for _, u := range r.list(gvr, labels.Everything()) {
+		if in(ingressBackends(u), svc.GetName()) {
+			r.add(gvr, client.FQN(u.GetNamespace(), u.GetName()), RelationRoutedBy, u)
+		}
+	}
+}
+
+func (r *relations) usedBy(gvr, name string) {
+	for _, u := range r.list("v1/pods", labels.Everything()) {
+		spec, ok := podSpecOf(u)
+		if !ok {
+			continue
+		}
+		for _, ref := range podSpecRefs(spec) {
+			if ref.gvr == gvr && ref.name == name {
+				r.add("v1/pods", client.FQN(u.GetNamespace(), u.GetName()), RelationUsedBy, u)
+				break
+			}
+		}
+	}
+}
+
+// serviceAccount gathers a service account secrets, tokens, bindings and the
+// workloads running under it.
+func (r *relations) serviceAccount(u *unstructured.Unstructured) {
+	var sa v1.ServiceAccount
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sa); err != nil {
+		log.Warn().Err(err).Msgf("Service account conversion failed for %s", u.GetName())
+		return
+	}
+	for _, s := range sa.Secrets {
+		r.ref("v1/secrets", s.Name, RelationUses)
+	}
+	for _, s := range r.list("v1/secrets", labels.Everything()) {
+		if s.GetAnnotations()[v1.ServiceAccountNameKey] == sa.Name {
+			r.add("v1/secrets", client.FQN(s.GetNamespace(), s.GetName()), RelationToken, s)
+		}
+	}
+
+	r.bindings(sa.Namespace, sa.Name)
+
+	for _, gvr := range workloadGVRs {
+		for _, w := range r.list(gvr, labels.Everything()) {
+			spec, ok := podSpecOf(w)
+			if !ok {
+				continue
+			}
+			if name := spec.ServiceAccountName; name == sa.Name || (name == "" && sa.Name == "default") {
+				r.add(gvr, client.FQN(w.GetNamespace(), w.GetName()), RelationUsedBy, w)
+			}
+		}
+	}
+}
+
+func (r *relations) bindings(ns, name string) {
+	if rbs, err := fetchRoleBindings(r.f); err == nil {
+		for _, rb := range rbs {
+			if hasSASubject(rb.Subjects, ns, name) {
+				r.addRes(render.RelatedRes{GVR: rbGVR, Path: client.FQN(rb.Namespace, rb.Name), Relation: RelationBoundBy, Created: rb.CreationTimestamp})
+			}
+		}
+	} else {
+		log.Warn().Err(err).Msgf("Related scan skipped %q", rbGVR)
+	}
+	if crbs, err := fetchClusterRoleBindings(r.f); err == nil {
+		for _, crb := range crbs {
+			if hasSASubject(crb.Subjects, ns, name) {
+				r.addRes(render.RelatedRes{GVR: crbGVR, Path: crb.Name, Relation: RelationBoundBy, Created: crb.CreationTimestamp})
+			}
+		}
+	} else {
+		log.Warn().Err(err).Msgf("Related scan skipped %q", crbGVR)
+	}
+}
+
+func hasSASubject(ss []rbacv1.Subject, ns, name string) bool {
+	for _, s := range ss {
+		if s.Kind == rbacv1.ServiceAccountKind && s.Name == name && s.Namespace == ns {
+			return true
+		}
+	}
+
+	return false
+}
+
+func servedIngress() (string, bool) {
+	for _, gvr := range IngressGVRs {
+		if _, err := MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr, true
+		}
+	}
+
+	return "", false
+}
+
+func podSpecOf(u *unstructured.Unstructured) (*v1.PodSpec, bool) {
+	path, ok := podTemplatePaths[u.GetKind()]
+	if !ok {
+		return nil, false
+	}
+	m, ok, _ := unstructured.NestedMap(u.Object, path...)
+	if !ok {
+		return nil, false
+	}
+	var spec v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+		log.Warn().Err(err).Msgf("Pod spec conversion failed for %s", u.GetName())
+		return nil, false
+	}
+
+	return &spec, true
+}
+
+func podLabelsOf(u *unstructured.Unstructured) map[string]string {
+	path, ok := podTemplatePaths[u.GetKind()]
+	if !ok {
+		return nil
+	}
+	if u.GetKind() == "Pod" {
+		return u.GetLabels()
+	}
+	tpl := append([]string{}, path[:len(path)-1]...)
+	ll, _, _ := unstructured.NestedStringMap(u.Object, append(tpl, "metadata", "labels")...)
+
+	return ll
+}
+
+func podSpecRefs(spec *v1.PodSpec) []podRef {
+	sa := spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	rr := []podRef{{"v1/serviceaccounts", sa}}
+	for _, s := range spec.ImagePullSecrets {
+		rr = append(rr, podRef{"v1/secrets", s.Name})
+	}
+	for _, v := range spec.Volumes {
+		switch {
+		case v.ConfigMap != nil:
+			rr = append(rr, podRef{"v1/configmaps", v.ConfigMap.Name})
+		case v.Secret != nil:
+			rr = append(rr, podRef{"v1/secrets", v.Secret.SecretName})
+		case v.PersistentVolumeClaim != nil:
+			rr = append(rr, podRef{"v1/persistentvolumeclaims", v.PersistentVolumeClaim.ClaimName})
+		case v.Projected != nil:
+			for _, s := range v.Projected.Sources {
+				if s.ConfigMap != nil {
+					rr = append(rr, podRef{"v1/configmaps", s.ConfigMap.Name})
+				}
+				if s.Secret != nil {
+					rr = append(rr, podRef{"v1/secrets", s.Secret.Name})
+				}
+			}
+		}
+	}
+	for _, co := range append(spec.InitContainers, spec.Containers...) {
+		for _, e := range co.EnvFrom {
+			if e.ConfigMapRef != nil {
+				rr = append(rr, podRef{"v1/configmaps", e.ConfigMapRef.Name})
+			}
+			if e.SecretRef != nil {
+				rr = append(rr, podRef{"v1/secrets", e.SecretRef.Name})
+			}
+		}
+		for _, e := range co.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				rr = append(rr, podRef{"v1/configmaps", e.ValueFrom.ConfigMapKeyRef.Name})
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				rr = append(rr, podRef{"v1/secrets", e.ValueFrom.SecretKeyRef.Name})
+			}
+		}
+	}
+
+	return rr
+}
+
+func ingressBackends(u *unstructured.Unstructured) []string {
+	var ss []string
+	add := func(b map[string]interface{}) {
+		if n, ok := b["serviceName"].(string); ok && n != "" && !in(ss, n) {
+			ss = append(ss, n)
+		}
+		if n, _, _ := unstructured.NestedString(b, "service", "name"); n != "" && !in(ss, n) {
+			ss = append(ss, n)
+		}
+	}
+	for _, k := range []string{"backend", "defaultBackend"} {
+		if b, ok, _ := unstructured.NestedMap(u.Object, "spec", k); ok {
+			add(b)
+		}
+	}
+	rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		paths, _, _ := unstructured.NestedSlice(rule, "http", "paths")
+		for _, p := range paths {
+			pm, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if b, ok, _ := unstructured.NestedMap(pm, "backend"); ok {
+				add(b)
+			}
+		}
+	}
+
+	return ss
+}
+
+func ingressTLSSecrets(u *unstructured.Unstructured) []string {
+	tt, _, _ := unstructured.NestedSlice(u.Object, "spec", "tls")
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		m, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if n, ok := m["secretName"].(string); ok && n != "" {
+			ss = append(ss, n)
+		}
+	}
+
+	return ss
+}
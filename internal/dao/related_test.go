@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPodSpecRefs(t *testing.T) {
+	spec := v1.PodSpec{
+		ServiceAccountName: "fred",
+		ImagePullSecrets:   []v1.LocalObjectReference{{Name: "regcred"}},
+		Volumes: []v1.Volume{
+			{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+			{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc1"}}},
+		},
+		Containers: []v1.Container{
+			{
+				Name:    "c1",
+				EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "sec1"}}}},
+				Env: []v1.EnvVar{
+					{Name: "A", Value: "a"},
+					{Name: "B", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}, Key: "b"}}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []podRef{
+		{"v1/serviceaccounts", "fred"},
+		{"v1/secrets", "regcred"},
+		{"v1/configmaps", "cm1"},
+		{"v1/persistentvolumeclaims", "pvc1"},
+		{"v1/secrets", "sec1"},
+		{"v1/configmaps", "cm2"},
+	}, podSpecRefs(&spec))
+}
+
+func TestIngressBackends(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    []string
+	}{
+		"v1beta1": {
+			spec: map[string]interface{}{
+				"backend": map[string]interface{}{"serviceName": "default-svc"},
+				"rules": []interface{}{
+					map[string]interface{}{"http": map[string]interface{}{"paths": []interface{}{
+						map[string]interface{}{"backend": map[string]interface{}{"serviceName": "fred"}},
+						map[string]interface{}{"backend": map[string]interface{}{"serviceName": "default-svc"}},
+					}}},
+				},
+			},
+			e: []string{"default-svc", "fred"},
+		},
+		"v1": {
+			spec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{"http": map[string]interface{}{"paths": []interface{}{
+						map[string]interface{}{"backend": map[string]interface{}{"service": map[string]interface{}{"name": "blee"}}},
+					}}},
+				},
+			},
+			e: []string{"blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}}
+			assert.Equal(t, u.e, ingressBackends(&ing))
+		})
+	}
+}
+
+func TestPodLabelsOf(t *testing.T) {
+	dp := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Deployment",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "fred"}},
+			},
+		},
+	}}
+
+	assert.Equal(t, map[string]string{"app": "fred"}, podLabelsOf(&dp))
+}
+
+func TestHasSASubject(t *testing.T) {
+	uu := map[string]struct {
+		ss []rbacv1.Subject
+		e  bool
+	}{
+		"match": {
+			ss: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "fred"}, {Kind: rbacv1.ServiceAccountKind, Name: "fred", Namespace: "ns1"}},
+			e:  true,
+		},
+		"otherNS": {
+			ss: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "fred", Namespace: "ns2"}},
+		},
+		"user": {
+			ss: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "fred", Namespace: "ns1"}},
+		},
+		"none": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hasSASubject(u.ss, "ns1", "fred"))
+		})
+	}
+}
@@ -21,11 +21,16 @@ type Resource struct {
 }
 
 // List returns a collection of resources.
+// Field selectors are not supported by informers, so these lists are served
+// directly by the api server.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if fsel, ok := ctx.Value(internal.KeyFields).(string); ok && fsel != "" {
+		return r.Generic.List(ctx, ns)
+	}
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
 	lsel := labels.Everything()
-	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
-		lsel = sel.AsSelector()
+	if sel, err := labels.Parse(strLabel); ok && err == nil {
+		lsel = sel
 	}
 
 	return r.Factory.List(r.gvr.String(), ns, false, lsel)
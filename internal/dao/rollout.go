@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A collection of pod rollout states.
+const (
+	RolloutUpdated  = "Updated"
+	RolloutOutdated = "Outdated"
+	RolloutStuck    = "Stuck"
+)
+
+var _ Accessor = (*Rollout)(nil)
+
+// Rollout tracks the template revision of the pods of a daemonset or a statefulset.
+type Rollout struct {
+	NonResource
+}
+
+// List returns the rollout state of each pod managed by the context target.
+func (r *Rollout) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context target gvr")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context path")
+	}
+	u, err := fetchUnstructured(r.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rev string
+	switch u.GetKind() {
+	case "StatefulSet":
+		var sts appsv1.StatefulSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+			return nil, err
+		}
+		rev = sts.Status.UpdateRevision
+	case "DaemonSet":
+		if rev, err = r.daemonSetRevision(u); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("rollout skew is not available for %s", u.GetKind())
+	}
+
+	oo, err := r.Factory.List("v1/pods", u.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		if isOwnedBy(po.OwnerReferences, u.GetUID()) {
+			pods = append(pods, &po)
+		}
+	}
+
+	return rolloutOf(u.GetKind(), rev, pods), nil
+}
+
+// daemonSetRevision returns the hash of the latest daemonset controller revision.
+func (r *Rollout) daemonSetRevision(ds *unstructured.Unstructured) (string, error) {
+	oo, err := r.Factory.List("apps/v1/controllerrevisions", ds.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		rev    string
+		latest int64 = -1
+	)
+	for _, o := range oo {
+		var cr appsv1.ControllerRevision
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cr); err != nil {
+			return "", err
+		}
+		if !isOwnedBy(cr.OwnerReferences, ds.GetUID()) || cr.Revision <= latest {
+			continue
+		}
+		latest, rev = cr.Revision, cr.Labels[appsv1.DefaultDaemonSetUniqueLabelKey]
+		if rev == "" {
+			rev = strings.TrimPrefix(cr.Name, ds.GetName()+"-")
+		}
+	}
+
+	return rev, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func rolloutOf(kind, rev string, pods []*v1.Pod) []runtime.Object {
+	var inProgress bool
+	for _, po := range pods {
+		if po.Labels[appsv1.ControllerRevisionHashLabelKey] != rev {
+			inProgress = true
+			break
+		}
+	}
+
+	oo := make([]runtime.Object, 0, len(pods))
+	for _, po := range pods {
+		res := render.RolloutRes{
+			Path:     client.FQN(po.Namespace, po.Name),
+			Node:     po.Spec.NodeName,
+			Ordinal:  -1,
+			Revision: po.Labels[appsv1.ControllerRevisionHashLabelKey],
+			Ready:    isPodReady(po),
+			Status:   RolloutUpdated,
+			Created:  po.CreationTimestamp,
+		}
+		if kind == "StatefulSet" {
+			res.Ordinal = podOrdinal(po.Name)
+		}
+		if res.Revision != rev {
+			res.Status = RolloutOutdated
+		}
+		if inProgress && !res.Ready {
+			res.Status = RolloutStuck
+		}
+		oo = append(oo, res)
+	}
+
+	return oo
+}
+
+func isPodReady(po *v1.Pod) bool {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func podOrdinal(n string) int {
+	i := strings.LastIndex(n, "-")
+	if i < 0 {
+		return -1
+	}
+	o, err := strconv.Atoi(n[i+1:])
+	if err != nil {
+		return -1
+	}
+
+	return o
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRolloutOf(t *testing.T) {
+	uu := map[string]struct {
+		kind string
+		pods []*v1.Pod
+		e    map[string]string
+	}{
+		"done": {
+			kind: "StatefulSet",
+			pods: []*v1.Pod{makeRolloutPod("web-0", "r2", true), makeRolloutPod("web-1", "r2", false)},
+			e:    map[string]string{"web-0": RolloutUpdated, "web-1": RolloutUpdated},
+		},
+		"progressing": {
+			kind: "StatefulSet",
+			pods: []*v1.Pod{makeRolloutPod("web-0", "r1", true), makeRolloutPod("web-1", "r2", true)},
+			e:    map[string]string{"web-0": RolloutOutdated, "web-1": RolloutUpdated},
+		},
+		"stuck": {
+			kind: "DaemonSet",
+			pods: []*v1.Pod{makeRolloutPod("ds-x1", "r1", true), makeRolloutPod("ds-x2", "r2", false)},
+			e:    map[string]string{"ds-x1": RolloutOutdated, "ds-x2": RolloutStuck},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo := rolloutOf(u.kind, "r2", u.pods)
+			assert.Equal(t, len(u.e), len(oo))
+			for _, o := range oo {
+				res := o.(render.RolloutRes)
+				_, n := client.Namespaced(res.Path)
+				assert.Equal(t, u.e[n], res.Status)
+				if u.kind == "DaemonSet" {
+					assert.Equal(t, -1, res.Ordinal)
+				}
+			}
+		})
+	}
+}
+
+func TestPodOrdinal(t *testing.T) {
+	assert.Equal(t, 12, podOrdinal("web-12"))
+	assert.Equal(t, -1, podOrdinal("web"))
+	assert.Equal(t, -1, podOrdinal("web-x"))
+}
+
+func makeRolloutPod(n, rev string, ready bool) *v1.Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      n,
+			Labels:    map[string]string{appsv1.ControllerRevisionHashLabelKey: rev},
+		},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ Accessor       = (*ServiceAccount)(nil)
+	_ TokenRequester = (*ServiceAccount)(nil)
+)
+
+// ServiceAccount represents a service account.
+type ServiceAccount struct {
+	Resource
+}
+
+// RequestToken issues a time-bound token for a service account using the
+// TokenRequest api.
+func (s *ServiceAccount) RequestToken(path string, ttl time.Duration) (string, time.Time, error) {
+	ns, n := client.Namespaced(path)
+	secs := int64(ttl.Seconds())
+	tr, err := s.Client().DialOrDie().CoreV1().ServiceAccounts(ns).CreateToken(n, &authv1.TokenRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+		Spec:       authv1.TokenRequestSpec{ExpirationSeconds: &secs},
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tr.Status.Token, tr.Status.ExpirationTimestamp.Time, nil
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor   = (*SAToken)(nil)
+	_ Switchable = (*SAToken)(nil)
+)
+
+// DefaultSATokenPaths tracks where service account tokens are looked up by default.
+var DefaultSATokenPaths = []string{client.InClusterTokenPath, client.SATokensDir}
+
+// SAToken represents service account tokens k9s can authenticate with.
+type SAToken struct {
+	NonResource
+}
+
+// List returns all available service account tokens.
+func (s *SAToken) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	paths, ok := ctx.Value(internal.KeyTokens).([]string)
+	if !ok || len(paths) == 0 {
+		paths = DefaultSATokenPaths
+	}
+
+	active, _ := s.Client().Config().ActiveSAToken()
+	tt := client.LoadSATokens(paths...)
+	oo := make([]runtime.Object, 0, len(tt))
+	for _, t := range tt {
+		oo = append(oo, render.SATokenRes{SAToken: t, Active: t.Path == active.Path})
+	}
+
+	return oo, nil
+}
+
+// Switch authenticates using the token located at path.
+func (s *SAToken) Switch(path string) error {
+	switcher, ok := s.Client().(client.TokenSwitcher)
+	if !ok {
+		return errors.New("connection does not support token switches")
+	}
+
+	return switcher.SwitchToken(path)
+}
@@ -3,8 +3,8 @@ package dao
 import (
 	"context"
 	"errors"
-	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/render"
@@ -22,26 +22,32 @@ type ScreenDump struct {
 }
 
 // Delete a ScreenDump.
-func (d *ScreenDump) Delete(path string, cascade, force bool) error {
+func (d *ScreenDump) Delete(path string, _ DeleteOptions) error {
 	return os.Remove(path)
 }
 
-// List returns a collection of screen dumps.
+// List returns a collection of screen dumps, including the ones nested in
+// sub directories ie benchmarks or profiles.
 func (d *ScreenDump) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	dir, ok := ctx.Value(internal.KeyDir).(string)
 	if !ok {
 		return nil, errors.New("no screendump dir found in context")
 	}
 
-	ff, err := ioutil.ReadDir(dir)
-	if err != nil {
+	if _, err := os.Stat(dir); err != nil {
 		return nil, err
 	}
-
-	oo := make([]runtime.Object, len(ff))
-	for i, f := range ff {
-		oo[i] = render.FileRes{File: f, Dir: dir}
-	}
-
-	return oo, nil
+	var oo []runtime.Object
+	err := filepath.Walk(dir, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if f.IsDir() {
+			return nil
+		}
+		oo = append(oo, render.FileRes{File: f, Dir: filepath.Dir(path), Root: dir})
+		return nil
+	})
+
+	return oo, err
 }
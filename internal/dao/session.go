@@ -0,0 +1,320 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/pty"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ExecSession)(nil)
+
+const (
+	// DetachKey detaches from a session (Ctrl-]).
+	DetachKey byte = 0x1d
+
+	scrollbackSize = 256 * 1024
+	replaySize     = 8 * 1024
+)
+
+// ExecSession represents background exec sessions.
+type ExecSession struct {
+	NonResource
+}
+
+// List returns the active sessions.
+func (e *ExecSession) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	sm, ok := ctx.Value(internal.KeySessions).(*SessionManager)
+	if !ok {
+		return nil, fmt.Errorf("expected SessionManager in context but got %T", ctx.Value(internal.KeySessions))
+	}
+	ss := sm.List()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		oo = append(oo, s)
+	}
+
+	return oo, nil
+}
+
+// SessionManager keeps exec sessions alive in background pseudo terminals
+// so they can be reattached.
+type SessionManager struct {
+	tasks    *TaskManager
+	sessions map[int]*Session
+	seq      int
+	mx       sync.Mutex
+}
+
+// NewSessionManager returns a new session manager. Sessions are tracked as
+// tasks so canceling a task kills its session.
+func NewSessionManager(tm *TaskManager) *SessionManager {
+	return &SessionManager{
+		tasks:    tm,
+		sessions: make(map[int]*Session),
+	}
+}
+
+// Process represents a command attached to a terminal.
+type Process interface {
+	// Wait waits for the command to exit.
+	Wait() error
+
+	// Hangup terminates the command.
+	Hangup() error
+}
+
+// Spawner starts a command attached to a terminal of the given size.
+type Spawner func(cols, rows uint16) (pty.Terminal, Process, error)
+
+// CmdSpawner spawns a local command attached to a pseudo terminal.
+func CmdSpawner(bin string, args ...string) Spawner {
+	return func(cols, rows uint16) (pty.Terminal, Process, error) {
+		cmd := exec.Command(bin, args...)
+		t, err := pty.Start(cmd, cols, rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return t, cmdProcess{cmd: cmd}, nil
+	}
+}
+
+type cmdProcess struct {
+	cmd *exec.Cmd
+}
+
+// Wait waits for the command to exit.
+func (p cmdProcess) Wait() error {
+	return p.cmd.Wait()
+}
+
+// Hangup terminates the command.
+func (p cmdProcess) Hangup() error {
+	return pty.Hangup(p.cmd)
+}
+
+// Start runs a command in a background terminal.
+func (m *SessionManager) Start(path, co string, spawn Spawner, cols, rows uint16) (*Session, error) {
+	ctx, done := m.tasks.Track(context.Background(), TaskExec, path+":"+co, "shell")
+	ptm, proc, err := spawn(cols, rows)
+	if err != nil {
+		done()
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := proc.Hangup(); err != nil {
+			log.Debug().Err(err).Msgf("Session hangup")
+		}
+	}()
+
+	m.mx.Lock()
+	m.seq++
+	s := Session{
+		cancel: done,
+		SessionRes: render.SessionRes{
+			ID:        m.seq,
+			Path:      path,
+			Container: co,
+			Started:   time.Now(),
+		},
+		ptm:  ptm,
+		done: make(chan struct{}),
+	}
+	m.sessions[s.ID] = &s
+	m.mx.Unlock()
+	log.Debug().Msgf("Session %d started on %s:%s", s.ID, path, co)
+
+	go func() {
+		s.pump()
+		err := proc.Wait()
+		if err != nil {
+			log.Debug().Err(err).Msgf("Session %d exited", s.ID)
+		}
+		_ = ptm.Close()
+		m.mx.Lock()
+		delete(m.sessions, s.ID)
+		m.mx.Unlock()
+		done()
+		s.mx.Lock()
+		s.err = err
+		s.mx.Unlock()
+		close(s.done)
+	}()
+
+	return &s, nil
+}
+
+// Get returns a session given its id.
+func (m *SessionManager) Get(id string) (*Session, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id %q", id)
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	s, ok := m.sessions[i]
+	if !ok {
+		return nil, fmt.Errorf("no session found with id %q", id)
+	}
+
+	return s, nil
+}
+
+// Kill terminates a session.
+func (m *SessionManager) Kill(id string) error {
+	s, err := m.Get(id)
+	if err != nil {
+		return err
+	}
+	s.cancel()
+
+	return nil
+}
+
+// List returns the active sessions ordered by id.
+func (m *SessionManager) List() []render.SessionRes {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	ss := make([]render.SessionRes, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		s.mx.Lock()
+		ss = append(ss, s.SessionRes)
+		s.mx.Unlock()
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].ID < ss[j].ID })
+
+	return ss
+}
+
+// Session represents an exec session running in a pseudo terminal.
+type Session struct {
+	render.SessionRes
+
+	ptm        pty.Terminal
+	scrollback []byte
+	out        io.Writer
+	cancel     func()
+	done       chan struct{}
+	err        error
+	mx         sync.Mutex
+}
+
+// Done returns a channel closed once the session exits.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the session exit error once done.
+func (s *Session) Err() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.err
+}
+
+// Attach wires the session to a terminal until the detach key is typed or
+// the session exits. It returns true when detached.
+func (s *Session) Attach(in *os.File, out io.Writer) (bool, error) {
+	if cols, rows, err := pty.TermSize(in); err == nil {
+		if err := s.Resize(cols, rows); err != nil {
+			log.Warn().Err(err).Msg("Session resize")
+		}
+	}
+	release, err := s.Mirror(out)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	return pty.Forward(in, s.ptm, DetachKey, s.done)
+}
+
+// Mirror replays the tail of the session output to out and streams its
+// output there until released.
+func (s *Session) Mirror(out io.Writer) (func(), error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	tail := s.scrollback
+	if len(tail) > replaySize {
+		tail = tail[len(tail)-replaySize:]
+	}
+	if _, err := out.Write(tail); err != nil {
+		return nil, err
+	}
+	s.out, s.Attached = out, true
+
+	return func() {
+		s.mx.Lock()
+		defer s.mx.Unlock()
+		if s.out == out {
+			s.out, s.Attached = nil, false
+		}
+	}, nil
+}
+
+// Write sends input to the session.
+func (s *Session) Write(bb []byte) (int, error) {
+	return s.ptm.Write(bb)
+}
+
+// Resize resizes the session terminal.
+func (s *Session) Resize(cols, rows uint16) error {
+	return s.ptm.Resize(cols, rows)
+}
+
+// Scrollback returns the session output stripped of terminal sequences.
+func (s *Session) Scrollback() string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return StripANSI(string(s.scrollback))
+}
+
+func (s *Session) pump() {
+	buff := make([]byte, 4096)
+	for {
+		n, err := s.ptm.Read(buff)
+		if n > 0 {
+			s.write(buff[:n])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Session) write(bb []byte) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.scrollback = append(s.scrollback, bb...)
+	if len(s.scrollback) > scrollbackSize {
+		s.scrollback = s.scrollback[len(s.scrollback)-scrollbackSize:]
+	}
+	if s.out != nil {
+		if _, err := s.out.Write(bb); err != nil {
+			log.Warn().Err(err).Msg("Session output")
+		}
+	}
+}
+
+var ansiRX = regexp.MustCompile(`\x1b(\[[0-9;?]*[ -/]*[@-~]|\][^\x07\x1b]*(\x07|\x1b\\)|[()][0-9A-Za-z]|[=>78])`)
+
+// StripANSI removes terminal control sequences and carriage returns.
+func StripANSI(s string) string {
+	return strings.Replace(ansiRX.ReplaceAllString(s, ""), "\r", "", -1)
+}
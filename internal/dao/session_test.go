@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionManager(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("pseudo terminals are only supported on linux")
+	}
+	tm := NewTaskManager()
+	m := NewSessionManager(tm)
+
+	s, err := m.Start("default/p1", "c1", CmdSpawner("/bin/sh", "-c", "echo hello; sleep 5"), 80, 24)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(m.List()))
+	assert.Equal(t, 1, len(tm.List()))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !strings.Contains(s.Scrollback(), "hello") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, "hello\n", s.Scrollback())
+
+	assert.Nil(t, m.Kill("1"))
+	select {
+	case <-s.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("session did not exit")
+	}
+	assert.Equal(t, 0, len(m.List()))
+	assert.Equal(t, 0, len(tm.List()))
+	assert.NotNil(t, m.Kill("1"))
+}
+
+func TestStripANSI(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain":  {s: "hello\r\n", e: "hello\n"},
+		"colors": {s: "\x1b[1;32mok\x1b[0m", e: "ok"},
+		"title":  {s: "\x1b]0;root@fred\x07# ls", e: "# ls"},
+		"modes":  {s: "\x1b[?2004h\x1b(Bdone", e: "done"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, StripANSI(u.s))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/api/resource"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Stats)(nil)
+
+// InformerLister lists active informers.
+type InformerLister interface {
+	// Informers returns the watched resources.
+	Informers() []string
+}
+
+// Stats tracks k9s own resource usage.
+type Stats struct {
+	NonResource
+}
+
+// List returns runtime, informers, api requests and render stats.
+func (s *Stats) List(ctx context.Context, _ string) ([]kruntime.Object, error) {
+	var ii []string
+	if l, ok := s.Factory.(InformerLister); ok {
+		ii = l.Informers()
+	}
+
+	rr := runtimeStats(len(ii))
+	for _, i := range ii {
+		rr = append(rr, render.StatsRes{Kind: render.StatInformer, Name: i})
+	}
+	rr = append(rr, callStats(render.StatAPI, client.APICalls)...)
+	rr = append(rr, callStats(render.StatRender, render.Renders)...)
+
+	oo := make([]kruntime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func runtimeStats(informers int) []render.StatsRes {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	return []render.StatsRes{
+		{Kind: render.StatRuntime, Name: "goroutines", Value: strconv.Itoa(runtime.NumGoroutine())},
+		{Kind: render.StatRuntime, Name: "heap", Value: toBytes(m.HeapAlloc)},
+		{Kind: render.StatRuntime, Name: "heap-objects", Value: strconv.FormatUint(m.HeapObjects, 10)},
+		{Kind: render.StatRuntime, Name: "sys", Value: toBytes(m.Sys)},
+		{Kind: render.StatRuntime, Name: "gc", Value: strconv.FormatUint(uint64(m.NumGC), 10)},
+		{Kind: render.StatRuntime, Name: "informers", Value: strconv.Itoa(informers)},
+	}
+}
+
+func toBytes(b uint64) string {
+	return resource.NewQuantity(int64(b), resource.BinarySI).String()
+}
+
+func callStats(kind string, s *client.CallStats) []render.StatsRes {
+	cc := s.Snapshot()
+	kk := make([]string, 0, len(cc))
+	for k := range cc {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	rr := make([]render.StatsRes, 0, len(kk))
+	for _, k := range kk {
+		c := cc[k]
+		rr = append(rr, render.StatsRes{
+			Kind:   kind,
+			Name:   k,
+			Value:  strconv.Itoa(c.Count),
+			Rate:   s.Rate(c),
+			Errors: c.Errors,
+			Avg:    c.Avg(),
+			Max:    c.Max,
+		})
+	}
+
+	return rr
+}
@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallStats(t *testing.T) {
+	s := client.NewCallStats()
+	s.Record("GET v1/pods", time.Second, false)
+	s.Record("LIST v1/nodes", 3*time.Second, true)
+
+	rr := callStats(render.StatAPI, s)
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "GET v1/pods", rr[0].Name)
+	assert.Equal(t, "1", rr[0].Value)
+	assert.Equal(t, 1, rr[1].Errors)
+	assert.Equal(t, 3*time.Second, rr[1].Max)
+}
+
+func TestRuntimeStats(t *testing.T) {
+	rr := runtimeStats(3)
+
+	assert.Equal(t, "goroutines", rr[0].Name)
+	assert.Equal(t, "informers", rr[len(rr)-1].Name)
+	assert.Equal(t, "3", rr[len(rr)-1].Value)
+}
@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Task)(nil)
+
+const (
+	// TaskExec tracks a container exec session.
+	TaskExec = "exec"
+
+	// TaskPortForward tracks a port forward.
+	TaskPortForward = "portforward"
+
+	// TaskMutation tracks api mutations.
+	TaskMutation = "mutation"
+
+	// TaskJob tracks a background job.
+	TaskJob = "job"
+
+	// TaskProxy tracks a proxy tunnel.
+	TaskProxy = "proxy"
+)
+
+// Task represents in flight operations.
+type Task struct {
+	NonResource
+}
+
+// List returns the operations in flight.
+func (t *Task) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	tm, ok := ctx.Value(internal.KeyTasks).(*TaskManager)
+	if !ok {
+		return nil, fmt.Errorf("expected TaskManager in context but got %T", ctx.Value(internal.KeyTasks))
+	}
+	tt := tm.List()
+	oo := make([]runtime.Object, 0, len(tt))
+	for _, t := range tt {
+		oo = append(oo, t)
+	}
+
+	return oo, nil
+}
+
+// TaskManager tracks in flight operations so they can be listed and canceled.
+type TaskManager struct {
+	tasks map[int]*task
+	seq   int
+	mx    sync.Mutex
+}
+
+type task struct {
+	render.TaskRes
+	cancel context.CancelFunc
+}
+
+// NewTaskManager returns a new task manager.
+func NewTaskManager() *TaskManager {
+	return &TaskManager{tasks: make(map[int]*task)}
+}
+
+// Track registers an operation. The returned context is canceled when the
+// task is canceled. Callers must invoke the done function once the operation
+// completes.
+func (m *TaskManager) Track(ctx context.Context, kind, target, desc string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	m.mx.Lock()
+	m.seq++
+	id := m.seq
+	m.tasks[id] = &task{
+		TaskRes: render.TaskRes{
+			ID:          id,
+			Kind:        kind,
+			Target:      target,
+			Description: desc,
+			Started:     time.Now(),
+		},
+		cancel: cancel,
+	}
+	m.mx.Unlock()
+	log.Debug().Msgf("Task %d %s started on %q", id, kind, target)
+
+	return ctx, func() {
+		m.mx.Lock()
+		delete(m.tasks, id)
+		m.mx.Unlock()
+		cancel()
+	}
+}
+
+// Cancel cancels an operation given its id.
+func (m *TaskManager) Cancel(id string) error {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		return fmt.Errorf("invalid task id %q", id)
+	}
+	m.mx.Lock()
+	t, ok := m.tasks[i]
+	delete(m.tasks, i)
+	m.mx.Unlock()
+	if !ok {
+		return fmt.Errorf("no task found with id %q", id)
+	}
+	log.Debug().Msgf("Task %d %s canceled on %q", i, t.Kind, t.Target)
+	t.cancel()
+
+	return nil
+}
+
+// CancelAll cancels all operations in flight.
+func (m *TaskManager) CancelAll() {
+	m.mx.Lock()
+	tt := m.tasks
+	m.tasks = make(map[int]*task)
+	m.mx.Unlock()
+	for _, t := range tt {
+		t.cancel()
+	}
+}
+
+// List returns the operations in flight ordered by id.
+func (m *TaskManager) List() []render.TaskRes {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	tt := make([]render.TaskRes, 0, len(m.tasks))
+	for _, t := range m.tasks {
+		tt = append(tt, t.TaskRes)
+	}
+	sort.Slice(tt, func(i, j int) bool { return tt[i].ID < tt[j].ID })
+
+	return tt
+}
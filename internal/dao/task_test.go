@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskManager(t *testing.T) {
+	m := NewTaskManager()
+	ctx1, done1 := m.Track(context.Background(), TaskExec, "default/p1:c1", "ls")
+	ctx2, _ := m.Track(context.Background(), TaskPortForward, "default/p2:c1", "8080:80")
+	_, _ = m.Track(context.Background(), TaskJob, "fred", "apply")
+
+	tt := m.List()
+	assert.Equal(t, 3, len(tt))
+	assert.Equal(t, []int{1, 2, 3}, []int{tt[0].ID, tt[1].ID, tt[2].ID})
+	assert.Equal(t, TaskPortForward, tt[1].Kind)
+
+	done1()
+	assert.NotNil(t, ctx1.Err())
+	assert.Equal(t, 2, len(m.List()))
+
+	assert.Nil(t, m.Cancel("2"))
+	assert.NotNil(t, ctx2.Err())
+	assert.NotNil(t, m.Cancel("2"))
+	assert.NotNil(t, m.Cancel("blee"))
+
+	oo, err := (&Task{}).List(context.WithValue(context.Background(), internal.KeyTasks, m), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	m.CancelAll()
+	assert.Equal(t, 0, len(m.List()))
+}
@@ -0,0 +1,278 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A collection of timeline entry sources.
+const (
+	TimelineEvent     = "Event"
+	TimelineCondition = "Condition"
+	TimelineRevision  = "Revision"
+)
+
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+var _ Accessor = (*Timeline)(nil)
+
+// Timeline merges the events, condition transitions and rollout history of a
+// resource in chronological order.
+type Timeline struct {
+	NonResource
+}
+
+// List returns the timeline entries of the context target.
+func (t *Timeline) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context target gvr")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context path")
+	}
+	u, err := fetchUnstructured(t.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	ee, err := t.events(u)
+	if err != nil {
+		return nil, err
+	}
+	ee = append(ee, conditionEntries(gvr, u)...)
+	rr, err := t.revisions(u)
+	if err != nil {
+		return nil, err
+	}
+	ee = append(ee, rr...)
+	sortTimeline(ee)
+
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+func (t *Timeline) events(u *unstructured.Unstructured) ([]render.TimelineRes, error) {
+	ns := u.GetNamespace()
+	if ns == "" {
+		ns = client.AllNamespaces
+	}
+	oo, err := t.Factory.List("v1/events", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	evts := make([]*v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &evt); err != nil {
+			return nil, err
+		}
+		evts = append(evts, &evt)
+	}
+
+	return eventEntries(u, evts), nil
+}
+
+func (t *Timeline) revisions(u *unstructured.Unstructured) ([]render.TimelineRes, error) {
+	switch u.GetKind() {
+	case "Deployment":
+		oo, err := t.Factory.List("apps/v1/replicasets", u.GetNamespace(), false, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		rss := make([]*appsv1.ReplicaSet, 0, len(oo))
+		for _, o := range oo {
+			var rs appsv1.ReplicaSet
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rs); err != nil {
+				return nil, err
+			}
+			rss = append(rss, &rs)
+		}
+		return replicaSetEntries(u, rss), nil
+	case "DaemonSet", "StatefulSet":
+		oo, err := t.Factory.List("apps/v1/controllerrevisions", u.GetNamespace(), false, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		crs := make([]*appsv1.ControllerRevision, 0, len(oo))
+		for _, o := range oo {
+			var cr appsv1.ControllerRevision
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cr); err != nil {
+				return nil, err
+			}
+			crs = append(crs, &cr)
+		}
+		return controllerRevisionEntries(u, crs), nil
+	default:
+		return nil, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func eventEntries(u *unstructured.Unstructured, evts []*v1.Event) []render.TimelineRes {
+	ee := make([]render.TimelineRes, 0, len(evts))
+	for _, evt := range evts {
+		if evt.InvolvedObject.UID != u.GetUID() {
+			continue
+		}
+		ee = append(ee, render.TimelineRes{
+			GVR:     "v1/events",
+			Path:    client.FQN(evt.Namespace, evt.Name),
+			Time:    EventTime(evt),
+			Source:  TimelineEvent,
+			Type:    evt.Type,
+			Reason:  evt.Reason,
+			Message: strings.TrimSpace(evt.Message),
+			Count:   evt.Count,
+		})
+	}
+
+	return ee
+}
+
+// EventTime returns the most relevant event timestamp.
+func EventTime(evt *v1.Event) metav1.Time {
+	switch {
+	case !evt.LastTimestamp.IsZero():
+		return evt.LastTimestamp
+	case !evt.EventTime.IsZero():
+		return metav1.NewTime(evt.EventTime.Time)
+	case !evt.FirstTimestamp.IsZero():
+		return evt.FirstTimestamp
+	default:
+		return evt.CreationTimestamp
+	}
+}
+
+func conditionEntries(gvr string, u *unstructured.Unstructured) []render.TimelineRes {
+	cc, ok, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !ok {
+		return nil
+	}
+
+	ee := make([]render.TimelineRes, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		at, ok := conditionTime(m)
+		if !ok {
+			continue
+		}
+		ee = append(ee, render.TimelineRes{
+			GVR:     gvr,
+			Path:    client.FQN(u.GetNamespace(), u.GetName()),
+			Key:     stringOf(m, "type"),
+			Time:    at,
+			Source:  TimelineCondition,
+			Type:    stringOf(m, "type") + "=" + stringOf(m, "status"),
+			Reason:  stringOf(m, "reason"),
+			Message: stringOf(m, "message"),
+		})
+	}
+
+	return ee
+}
+
+func conditionTime(m map[string]interface{}) (metav1.Time, bool) {
+	for _, k := range []string{"lastTransitionTime", "lastUpdateTime", "lastProbeTime"} {
+		s := stringOf(m, k)
+		if s == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return metav1.NewTime(t), true
+		}
+	}
+
+	return metav1.Time{}, false
+}
+
+func stringOf(m map[string]interface{}, k string) string {
+	s, _ := m[k].(string)
+	return s
+}
+
+func replicaSetEntries(dp *unstructured.Unstructured, rss []*appsv1.ReplicaSet) []render.TimelineRes {
+	ee := make([]render.TimelineRes, 0, len(rss))
+	for _, rs := range rss {
+		if !isOwnedBy(rs.OwnerReferences, dp.GetUID()) {
+			continue
+		}
+		msg := rs.Annotations[changeCauseAnnotation]
+		if msg == "" {
+			msg = templateImages(rs.Spec.Template.Spec)
+		}
+		ee = append(ee, render.TimelineRes{
+			GVR:     "apps/v1/replicasets",
+			Path:    client.FQN(rs.Namespace, rs.Name),
+			Time:    rs.CreationTimestamp,
+			Source:  TimelineRevision,
+			Type:    rs.Annotations[revisionAnnotation],
+			Reason:  rs.Name,
+			Message: msg,
+		})
+	}
+
+	return ee
+}
+
+func controllerRevisionEntries(owner *unstructured.Unstructured, crs []*appsv1.ControllerRevision) []render.TimelineRes {
+	ee := make([]render.TimelineRes, 0, len(crs))
+	for _, cr := range crs {
+		if !isOwnedBy(cr.OwnerReferences, owner.GetUID()) {
+			continue
+		}
+		ee = append(ee, render.TimelineRes{
+			GVR:     "apps/v1/controllerrevisions",
+			Path:    client.FQN(cr.Namespace, cr.Name),
+			Time:    cr.CreationTimestamp,
+			Source:  TimelineRevision,
+			Type:    fmt.Sprintf("%d", cr.Revision),
+			Reason:  cr.Name,
+			Message: cr.Annotations[changeCauseAnnotation],
+		})
+	}
+
+	return ee
+}
+
+func templateImages(spec v1.PodSpec) string {
+	ii := make([]string, 0, len(spec.Containers))
+	for _, co := range spec.Containers {
+		ii = append(ii, co.Image)
+	}
+
+	return strings.Join(ii, ",")
+}
+
+func sortTimeline(ee []render.TimelineRes) {
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.Before(&ee[j].Time)
+	})
+}
@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEventEntries(t *testing.T) {
+	u := makeTimelineTarget()
+	at := time.Date(2020, 3, 1, 14, 32, 0, 0, time.UTC)
+	evts := []*v1.Event{
+		makeTimelineEvent("e1", "u1", metav1.NewTime(at), "Normal"),
+		makeTimelineEvent("e2", "u2", metav1.NewTime(at), "Normal"),
+		makeTimelineEvent("e3", "u1", metav1.Time{}, "Warning"),
+	}
+
+	ee := eventEntries(u, evts)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "default/e1", ee[0].Path)
+	assert.Equal(t, at, ee[0].Time.UTC())
+	assert.Equal(t, "Warning", ee[1].Type)
+	assert.Equal(t, at.Add(-time.Minute), ee[1].Time.UTC())
+}
+
+func TestConditionEntries(t *testing.T) {
+	u := makeTimelineTarget()
+	u.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":               "Available",
+				"status":             "True",
+				"reason":             "MinimumReplicasAvailable",
+				"lastTransitionTime": "2020-03-01T14:32:00Z",
+			},
+			map[string]interface{}{
+				"type":           "Progressing",
+				"status":         "False",
+				"lastUpdateTime": "2020-03-01T14:30:00Z",
+			},
+			map[string]interface{}{
+				"type":   "Stale",
+				"status": "True",
+			},
+		},
+	}
+
+	ee := conditionEntries("apps/v1/deployments", u)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "Available=True", ee[0].Type)
+	assert.Equal(t, "Available", ee[0].Key)
+	assert.Equal(t, "MinimumReplicasAvailable", ee[0].Reason)
+	assert.Equal(t, "Progressing=False", ee[1].Type)
+	assert.Equal(t, time.Date(2020, 3, 1, 14, 30, 0, 0, time.UTC), ee[1].Time.UTC())
+}
+
+func TestReplicaSetEntries(t *testing.T) {
+	u := makeTimelineTarget()
+	rss := []*appsv1.ReplicaSet{
+		makeTimelineRS("fred-1", "u1", "1", ""),
+		makeTimelineRS("fred-2", "u1", "2", "kubectl set image"),
+		makeTimelineRS("blee-1", "u2", "1", ""),
+	}
+
+	ee := replicaSetEntries(u, rss)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "1", ee[0].Type)
+	assert.Equal(t, "nginx:1.17", ee[0].Message)
+	assert.Equal(t, "kubectl set image", ee[1].Message)
+}
+
+func TestSortTimeline(t *testing.T) {
+	at := time.Date(2020, 3, 1, 14, 32, 0, 0, time.UTC)
+	ee := []render.TimelineRes{
+		{Reason: "c", Time: metav1.NewTime(at.Add(time.Minute))},
+		{Reason: "a", Time: metav1.NewTime(at)},
+		{Reason: "b", Time: metav1.NewTime(at)},
+	}
+
+	sortTimeline(ee)
+	assert.Equal(t, "a", ee[0].Reason)
+	assert.Equal(t, "b", ee[1].Reason)
+	assert.Equal(t, "c", ee[2].Reason)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeTimelineTarget() *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetKind("Deployment")
+	u.SetNamespace("default")
+	u.SetName("fred")
+	u.SetUID("u1")
+
+	return &u
+}
+
+func makeTimelineEvent(n string, uid types.UID, last metav1.Time, kind string) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      n,
+		},
+		InvolvedObject: v1.ObjectReference{UID: uid},
+		FirstTimestamp: metav1.NewTime(time.Date(2020, 3, 1, 14, 31, 0, 0, time.UTC)),
+		LastTimestamp:  last,
+		Type:           kind,
+	}
+}
+
+func makeTimelineRS(n string, uid types.UID, rev, cause string) *appsv1.ReplicaSet {
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			Annotations:     map[string]string{revisionAnnotation: rev},
+			OwnerReferences: []metav1.OwnerReference{{UID: uid}},
+		},
+	}
+	if cause != "" {
+		rs.Annotations[changeCauseAnnotation] = cause
+	}
+	rs.Spec.Template.Spec.Containers = []v1.Container{{Name: "nginx", Image: "nginx:1.17"}}
+
+	return &rs
+}
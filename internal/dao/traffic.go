@@ -0,0 +1,240 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor  = (*Traffic)(nil)
+	_ Diagnoser = (*Traffic)(nil)
+)
+
+// IstioVersions tracks served Istio networking api versions.
+var IstioVersions = []string{"v1", "v1beta1", "v1alpha3"}
+
+// GatewayAPIVersions tracks served Gateway API versions.
+var GatewayAPIVersions = []string{"v1", "v1beta1"}
+
+// Traffic represents an Istio or Gateway API traffic resource.
+type Traffic struct {
+	Resource
+}
+
+// List returns a collection of traffic resources.
+func (t *Traffic) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := t.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	refs, err := t.refs()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.RouteWithRefs{Raw: u, Refs: refs})
+	}
+
+	return res, nil
+}
+
+// Get returns a traffic resource.
+func (t *Traffic) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := t.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	refs, err := t.refs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &render.RouteWithRefs{Raw: u, Refs: refs}, nil
+}
+
+// Diagnose resolves the route -> destination -> workload chain for a traffic resource.
+func (t *Traffic) Diagnose(path string) (string, error) {
+	o, err := t.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+	rr, ok := o.(*render.RouteWithRefs)
+	if !ok {
+		return "", fmt.Errorf("expecting RouteWithRefs but got %T", o)
+	}
+
+	var dd []render.RouteDestination
+	switch t.gvr.R() {
+	case "virtualservices":
+		dd = render.ToVSDestinations(rr.Raw)
+	case "httproutes":
+		dd = render.ToHTTPRouteBackends(rr.Raw)
+	case "destinationrules":
+		host, _, _ := unstructured.NestedString(rr.Raw.Object, "spec", "host")
+		dd = append(dd, render.RouteDestination{Namespace: rr.Raw.GetNamespace(), Host: host})
+	case "gateways":
+		return t.gatewayReport(rr.Raw)
+	}
+
+	var b strings.Builder
+	for _, d := range dd {
+		fmt.Fprintf(&b, "%s:\n", d)
+		svc, ok := d.Service()
+		if !ok {
+			fmt.Fprintf(&b, "  status: EXTERNAL -- host is not resolved in cluster\n")
+			continue
+		}
+		fmt.Fprintf(&b, "  service: %s\n", svc)
+		if issue := rr.Refs.Check(d); issue != "" {
+			fmt.Fprintf(&b, "  status: BROKEN -- %s\n", issue)
+			continue
+		}
+		fmt.Fprintf(&b, "  status: OK\n")
+		ww, err := t.workloads(svc)
+		if err != nil {
+			return "", err
+		}
+		if len(ww) == 0 {
+			fmt.Fprintf(&b, "  workloads: <none>\n")
+			continue
+		}
+		fmt.Fprintf(&b, "  workloads:\n")
+		for _, w := range ww {
+			fmt.Fprintf(&b, "    - %s\n", w)
+		}
+	}
+
+	return b.String(), nil
+}
+
+func (t *Traffic) gatewayReport(gw *unstructured.Unstructured) (string, error) {
+	gvr, ok := servedGVR("networking.istio.io", "virtualservices", IstioVersions)
+	if !ok {
+		return "", fmt.Errorf("no virtualservices found on cluster")
+	}
+	oo, err := t.Factory.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	fqn := client.FQN(gw.GetNamespace(), gw.GetName())
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:\n  virtualServices:\n", fqn)
+	for _, o := range oo {
+		vs := o.(*unstructured.Unstructured)
+		gg, _, _ := unstructured.NestedStringSlice(vs.Object, "spec", "gateways")
+		for _, g := range gg {
+			if g == fqn || (g == gw.GetName() && vs.GetNamespace() == gw.GetNamespace()) {
+				fmt.Fprintf(&b, "    - %s\n", client.FQN(vs.GetNamespace(), vs.GetName()))
+				break
+			}
+		}
+	}
+
+	return b.String(), nil
+}
+
+// workloads returns the pods and their controllers backing a service.
+func (t *Traffic) workloads(svc string) ([]string, error) {
+	o, err := t.Factory.Get("v1/services", svc, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var s v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &s); err != nil {
+		return nil, err
+	}
+	if len(s.Spec.Selector) == 0 {
+		return nil, nil
+	}
+
+	oo, err := t.Factory.List("v1/pods", s.Namespace, true, labels.SelectorFromSet(s.Spec.Selector))
+	if err != nil {
+		return nil, err
+	}
+	ww := make([]string, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		owner := "<none>"
+		for _, r := range po.OwnerReferences {
+			if r.Controller != nil && *r.Controller {
+				owner = r.Kind + "/" + r.Name
+			}
+		}
+		ww = append(ww, fmt.Sprintf("%s (%s) <- %s", po.Name, po.Status.Phase, owner))
+	}
+	sort.Strings(ww)
+
+	return ww, nil
+}
+
+func (t *Traffic) refs() (render.TrafficRefs, error) {
+	refs := render.TrafficRefs{
+		Services: make(map[string]struct{}),
+		Subsets:  make(map[string][]string),
+	}
+	oo, err := t.Factory.List("v1/services", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return refs, err
+	}
+	for _, o := range oo {
+		u := o.(*unstructured.Unstructured)
+		refs.Services[client.FQN(u.GetNamespace(), u.GetName())] = struct{}{}
+	}
+
+	gvr, ok := servedGVR("networking.istio.io", "destinationrules", IstioVersions)
+	if !ok {
+		return refs, nil
+	}
+	oo, err = t.Factory.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return refs, err
+	}
+	for _, o := range oo {
+		u := o.(*unstructured.Unstructured)
+		host, _, _ := unstructured.NestedString(u.Object, "spec", "host")
+		svc, ok := render.RouteDestination{Namespace: u.GetNamespace(), Host: host}.Service()
+		if !ok {
+			continue
+		}
+		refs.Subsets[svc] = append(refs.Subsets[svc], render.ToDRSubsets(u)...)
+	}
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func servedGVR(group, res string, versions []string) (string, bool) {
+	for _, v := range versions {
+		gvr := group + "/" + v + "/" + res
+		if _, err := MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr, true
+		}
+	}
+
+	return "", false
+}
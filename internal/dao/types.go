@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/watch"
@@ -9,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	restclient "k8s.io/client-go/rest"
 )
@@ -85,16 +87,99 @@ type Describer interface {
 	ToYAML(path string) (string, error)
 }
 
+// Editor represents a resource that can be edited.
+type Editor interface {
+	// Manifest returns the live resource manifest.
+	Manifest(path string) (string, error)
+
+	// Update replaces a resource with an edited manifest.
+	Update(path string, manifest []byte) error
+}
+
 // Scalable represents resources that can scale.
 type Scalable interface {
 	// Scale scales a resource up or down.
 	Scale(path string, replicas int32) error
 }
 
+// DefaultGrace uses the resource default termination grace period.
+const DefaultGrace int64 = -1
+
+// DeleteOptions tracks resource deletion options.
+type DeleteOptions struct {
+	// Propagation tracks how dependents get garbage collected.
+	Propagation metav1.DeletionPropagation
+
+	// GracePeriod tracks the termination grace period in seconds.
+	// DefaultGrace uses the resource own grace period.
+	GracePeriod int64
+
+	// DryRun validates the deletion server side without persisting it.
+	DryRun bool
+}
+
+// NewDeleteOptions returns background deletion options with the default grace period.
+func NewDeleteOptions() DeleteOptions {
+	return DeleteOptions{
+		Propagation: metav1.DeletePropagationBackground,
+		GracePeriod: DefaultGrace,
+	}
+}
+
+// ForceDeleteOptions returns background deletion options with no grace period.
+func ForceDeleteOptions() DeleteOptions {
+	return DeleteOptions{
+		Propagation: metav1.DeletePropagationBackground,
+		GracePeriod: defaultKillGrace,
+	}
+}
+
+// MetaOptions returns the api server delete options.
+func (d DeleteOptions) MetaOptions() metav1.DeleteOptions {
+	var opts metav1.DeleteOptions
+	if d.Propagation != "" {
+		p := d.Propagation
+		opts.PropagationPolicy = &p
+	}
+	if d.GracePeriod >= 0 {
+		g := d.GracePeriod
+		opts.GracePeriodSeconds = &g
+	}
+	if d.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	return opts
+}
+
 // Nuker represents a resource deleter.
 type Nuker interface {
 	// Delete removes a resource from the api server.
-	Delete(path string, cascade, force bool) error
+	Delete(path string, opts DeleteOptions) error
+}
+
+// CollectionNuker represents a deleter for collections of resources.
+type CollectionNuker interface {
+	// DeleteCollection removes all resources in a namespace matching a label selector.
+	DeleteCollection(ns, sel string, opts DeleteOptions) error
+}
+
+// ForceNuker represents a deleter for resources stuck terminating.
+type ForceNuker interface {
+	// ForceDelete deletes a resource with no grace period, optionally clearing its finalizers.
+	ForceDelete(path string, clearFinalizers bool) error
+}
+
+// MetaPruner represents a resource whose finalizers and owner references can be pruned.
+type MetaPruner interface {
+	// PruneMeta removes the given finalizers and owner references.
+	PruneMeta(path string, finalizers []string, owners []types.UID) error
+}
+
+// Copier represents a resource that can be copied to another namespace or context.
+type Copier interface {
+	// Copy copies a resource and returns the copy path or empty if skipped.
+	Copy(path string, opts CopyOptions) (string, error)
 }
 
 // Switchable represents a switchable resource.
@@ -109,6 +194,30 @@ type Restartable interface {
 	Restart(path string) error
 }
 
+// Evictable represents a resource that can be evicted.
+type Evictable interface {
+	// Evict evicts a resource honoring its disruption budgets.
+	Evict(path string) error
+}
+
+// Recommender represents a resource providing applicable recommendations.
+type Recommender interface {
+	// ApplyRecommendation patches the target resource with the current recommendation.
+	ApplyRecommendation(path string) error
+}
+
+// Reconcilable represents a resource that can be reconciled on demand.
+type Reconcilable interface {
+	// Reconcile requests a resource reconciliation.
+	Reconcile(path string) error
+}
+
+// Suspendable represents a resource whose reconciliation can be suspended.
+type Suspendable interface {
+	// Suspend suspends or resumes reconciliations.
+	Suspend(path string, suspend bool) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
@@ -120,3 +229,15 @@ type Logger interface {
 	// Logs tails a resource logs.
 	Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error)
 }
+
+// Diagnoser represents a resource that can report on its health.
+type Diagnoser interface {
+	// Diagnose returns a health report for a given resource.
+	Diagnose(path string) (string, error)
+}
+
+// TokenRequester represents a resource that can issue time-bound tokens.
+type TokenRequester interface {
+	// RequestToken issues a token expiring after the given duration.
+	RequestToken(path string, ttl time.Duration) (string, time.Time, error)
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteOptionsMetaOptions(t *testing.T) {
+	bg, orphan := metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan
+	zero, thirty := int64(0), int64(30)
+
+	uu := map[string]struct {
+		opts DeleteOptions
+		e    metav1.DeleteOptions
+	}{
+		"default": {
+			opts: NewDeleteOptions(),
+			e:    metav1.DeleteOptions{PropagationPolicy: &bg},
+		},
+		"force": {
+			opts: ForceDeleteOptions(),
+			e:    metav1.DeleteOptions{PropagationPolicy: &bg, GracePeriodSeconds: &zero},
+		},
+		"orphan": {
+			opts: DeleteOptions{Propagation: orphan, GracePeriod: 30},
+			e:    metav1.DeleteOptions{PropagationPolicy: &orphan, GracePeriodSeconds: &thirty},
+		},
+		"blank": {
+			opts: DeleteOptions{GracePeriod: DefaultGrace},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.opts.MetaOptions())
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Upgrade)(nil)
+
+// Upgrade tracks a cluster readiness for the next minor release.
+type Upgrade struct {
+	NonResource
+}
+
+// List returns the control plane, kubelets and deprecated apis upgrade checks.
+func (u *Upgrade) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	info, err := u.Client().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	nos, err := FetchNodes(u.Factory, "")
+	if err != nil {
+		return nil, err
+	}
+	d := Deprecation{NonResource: u.NonResource}
+	dd, err := d.List(ctx, client.AllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	deps := make([]render.DeprecationRes, 0, len(dd))
+	for _, o := range dd {
+		deps = append(deps, o.(render.DeprecationRes))
+	}
+
+	cc := upgradeChecks(info.GitVersion, nos.Items, deps)
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, c := range cc {
+		oo = append(oo, c)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func upgradeChecks(server string, nodes []v1.Node, deps []render.DeprecationRes) []render.UpgradeRes {
+	target, err := client.NextMinor(server)
+	if err != nil {
+		return []render.UpgradeRes{{Check: render.CheckControlPlane, Name: "apiserver", Version: server, Status: render.UpgradeWarn, Detail: err.Error()}}
+	}
+
+	cc := []render.UpgradeRes{{
+		Check:   render.CheckControlPlane,
+		Name:    "apiserver",
+		Version: server,
+		Target:  target,
+		Status:  render.UpgradeReady,
+	}}
+	for _, no := range nodes {
+		cc = append(cc, kubeletCheck(server, target, no))
+	}
+	cc = append(cc, apiChecks(target, deps)...)
+
+	var blocked, warned int
+	for _, c := range cc {
+		switch c.Status {
+		case render.UpgradeBlocked:
+			blocked++
+		case render.UpgradeWarn:
+			warned++
+		}
+	}
+	summary := render.UpgradeRes{
+		Check:   render.CheckSummary,
+		Name:    "upgrade",
+		Version: server,
+		Target:  target,
+		Status:  render.UpgradeReady,
+		Detail:  fmt.Sprintf("%d blocker(s), %d warning(s)", blocked, warned),
+	}
+	if blocked > 0 {
+		summary.Status = render.UpgradeBlocked
+	}
+
+	return append([]render.UpgradeRes{summary}, cc...)
+}
+
+func kubeletCheck(server, target string, no v1.Node) render.UpgradeRes {
+	kv := no.Status.NodeInfo.KubeletVersion
+	c := render.UpgradeRes{
+		Check:   render.CheckKubelet,
+		Name:    no.Name,
+		Version: kv,
+		Target:  target,
+		Status:  render.UpgradeReady,
+	}
+	skew, err := client.MinorSkew(server, kv)
+	switch {
+	case err != nil:
+		c.Status, c.Detail = render.UpgradeWarn, err.Error()
+	case skew < 0:
+		c.Status, c.Detail = render.UpgradeBlocked, "kubelet newer than control plane"
+	case skew >= client.MaxKubeletSkew:
+		c.Status = render.UpgradeBlocked
+		c.Detail = fmt.Sprintf("%d minor(s) behind, upgrade kubelet first (max skew %d)", skew, client.MaxKubeletSkew)
+	case skew > 0:
+		c.Detail = fmt.Sprintf("%d minor(s) behind", skew)
+	}
+
+	return c
+}
+
+func apiChecks(target string, deps []render.DeprecationRes) []render.UpgradeRes {
+	counts := make(map[client.Deprecation]int)
+	for _, d := range deps {
+		counts[d.Deprecation]++
+	}
+
+	cc := make([]render.UpgradeRes, 0, len(counts))
+	for d, n := range counts {
+		c := render.UpgradeRes{
+			Check:   render.CheckAPI,
+			Name:    d.Kind + "." + d.APIVersion,
+			Version: d.APIVersion,
+			Target:  d.Replacement,
+			Status:  render.UpgradeWarn,
+			Detail:  fmt.Sprintf("%d resource(s), removed in %s", n, d.RemovedIn),
+		}
+		if d.Status(target) == client.DeprecationRemoved {
+			c.Status = render.UpgradeBlocked
+		}
+		cc = append(cc, c)
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Name < cc[j].Name
+	})
+
+	return cc
+}
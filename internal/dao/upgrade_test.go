@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpgradeChecks(t *testing.T) {
+	ing, _ := client.DeprecationFor("networking.k8s.io/v1beta1", "Ingress")
+	cj, _ := client.DeprecationFor("batch/v1beta1", "CronJob")
+	deps := []render.DeprecationRes{
+		{GVR: "networking.k8s.io/v1beta1/ingresses", Path: "default/i1", Deprecation: ing},
+		{GVR: "networking.k8s.io/v1beta1/ingresses", Path: "default/i2", Deprecation: ing},
+		{GVR: "batch/v1beta1/cronjobs", Path: "default/c1", Deprecation: cj},
+	}
+	nodes := []v1.Node{
+		makeKubeletNode("n1", "v1.21.2"),
+		makeKubeletNode("n2", "v1.20.1"),
+		makeKubeletNode("n3", "v1.19.4"),
+	}
+
+	cc := upgradeChecks("v1.21.5", nodes, deps)
+	assert.Equal(t, 7, len(cc))
+
+	assert.Equal(t, render.CheckSummary, cc[0].Check)
+	assert.Equal(t, "1.22", cc[0].Target)
+	assert.Equal(t, render.UpgradeBlocked, cc[0].Status)
+	assert.Equal(t, "2 blocker(s), 1 warning(s)", cc[0].Detail)
+
+	assert.Equal(t, render.CheckControlPlane, cc[1].Check)
+	assert.Equal(t, render.UpgradeReady, cc[2].Status)
+	assert.Equal(t, render.UpgradeReady, cc[3].Status)
+	assert.Equal(t, "1 minor(s) behind", cc[3].Detail)
+	assert.Equal(t, render.UpgradeBlocked, cc[4].Status)
+
+	assert.Equal(t, "CronJob.batch/v1beta1", cc[5].Name)
+	assert.Equal(t, render.UpgradeWarn, cc[5].Status)
+	assert.Equal(t, "Ingress.networking.k8s.io/v1beta1", cc[6].Name)
+	assert.Equal(t, render.UpgradeBlocked, cc[6].Status)
+	assert.Equal(t, "2 resource(s), removed in 1.22", cc[6].Detail)
+}
+
+func TestUpgradeChecksReady(t *testing.T) {
+	cc := upgradeChecks("v1.16.3", []v1.Node{makeKubeletNode("n1", "v1.16.3")}, nil)
+	assert.Equal(t, 3, len(cc))
+	assert.Equal(t, render.UpgradeReady, cc[0].Status)
+	assert.Equal(t, "0 blocker(s), 0 warning(s)", cc[0].Detail)
+}
+
+// Helpers...
+
+func makeKubeletNode(n, v string) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Status:     v1.NodeStatus{NodeInfo: v1.NodeSystemInfo{KubeletVersion: v}},
+	}
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor    = (*VerticalPodAutoscaler)(nil)
+	_ Diagnoser   = (*VerticalPodAutoscaler)(nil)
+	_ Recommender = (*VerticalPodAutoscaler)(nil)
+)
+
+// VPAGVRs tracks vertical pod autoscaler api versions.
+var VPAGVRs = []string{
+	"autoscaling.k8s.io/v1/verticalpodautoscalers",
+	"autoscaling.k8s.io/v1beta2/verticalpodautoscalers",
+}
+
+var vpaTargets = map[string]string{
+	"Deployment":  "apps/v1/deployments",
+	"StatefulSet": "apps/v1/statefulsets",
+	"DaemonSet":   "apps/v1/daemonsets",
+	"ReplicaSet":  "apps/v1/replicasets",
+}
+
+// VerticalPodAutoscaler represents a VPA resource.
+type VerticalPodAutoscaler struct {
+	Resource
+}
+
+// Diagnose returns the VPA recommendations vs the target actual requests.
+func (v *VerticalPodAutoscaler) Diagnose(path string) (string, error) {
+	vpa, target, err := v.resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	return vpaReport(render.ToVPARecommendations(vpa), containerRequests(target)), nil
+}
+
+// ApplyRecommendation patches the VPA target requests with the recommended targets.
+func (v *VerticalPodAutoscaler) ApplyRecommendation(path string) error {
+	vpa, target, err := v.resolve(path)
+	if err != nil {
+		return err
+	}
+	gvr := vpaTargets[target.GetKind()]
+	auth, err := v.Client().CanI(target.GetNamespace(), gvr, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", gvr)
+	}
+
+	patch, err := vpaPatch(render.ToVPARecommendations(vpa))
+	if err != nil {
+		return err
+	}
+	_, err = v.Client().DynDialOrDie().Resource(client.NewGVR(gvr).GVR()).
+		Namespace(target.GetNamespace()).
+		Patch(target.GetName(), types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func (v *VerticalPodAutoscaler) resolve(path string) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	o, err := v.Factory.Get(v.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	vpa, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	kind, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "kind")
+	name, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "name")
+	gvr, ok := vpaTargets[kind]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported vpa target kind %q", kind)
+	}
+	o, err = v.Factory.Get(gvr, client.FQN(vpa.GetNamespace(), name), true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	target, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return vpa, target, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func containerRequests(u *unstructured.Unstructured) map[string]map[string]string {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	res := make(map[string]map[string]string, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		n, _ := m["name"].(string)
+		res[n], _, _ = unstructured.NestedStringMap(m, "resources", "requests")
+	}
+
+	return res
+}
+
+func vpaReport(rr []render.VPARecommendation, requests map[string]map[string]string) string {
+	if len(rr) == 0 {
+		return "No recommendations available yet.\n"
+	}
+
+	var b strings.Builder
+	for _, r := range rr {
+		fmt.Fprintf(&b, "%s:\n", r.Container)
+		for _, res := range []string{"cpu", "memory"} {
+			fmt.Fprintf(&b, "  %s:\n", res)
+			fmt.Fprintf(&b, "    requested:   %s\n", orNone(requests[r.Container][res]))
+			fmt.Fprintf(&b, "    recommended: %s\n", orNone(r.Target[res]))
+			fmt.Fprintf(&b, "    range:       %s - %s\n", orNone(r.Lower[res]), orNone(r.Upper[res]))
+		}
+	}
+
+	return b.String()
+}
+
+func vpaPatch(rr []render.VPARecommendation) ([]byte, error) {
+	if len(rr) == 0 {
+		return nil, fmt.Errorf("no recommendations available")
+	}
+	cc := make([]interface{}, 0, len(rr))
+	for _, r := range rr {
+		if len(r.Target) == 0 {
+			continue
+		}
+		reqs := make(map[string]interface{}, len(r.Target))
+		for k, v := range r.Target {
+			reqs[k] = v
+		}
+		cc = append(cc, map[string]interface{}{
+			"name":      r.Container,
+			"resources": map[string]interface{}{"requests": reqs},
+		})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": cc},
+			},
+		},
+	})
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+
+	return s
+}
@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPAPatch(t *testing.T) {
+	rr := []render.VPARecommendation{
+		{Container: "app", Target: map[string]string{"cpu": "250m", "memory": "256Mi"}},
+		{Container: "sidecar"},
+	}
+
+	raw, err := vpaPatch(rr)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"spec":{"template":{"spec":{"containers":[{"name":"app","resources":{"requests":{"cpu":"250m","memory":"256Mi"}}}]}}}}`, string(raw))
+
+	_, err = vpaPatch(nil)
+	assert.NotNil(t, err)
+}
+
+func TestVPAReport(t *testing.T) {
+	rr := []render.VPARecommendation{
+		{
+			Container: "app",
+			Target:    map[string]string{"cpu": "250m", "memory": "256Mi"},
+			Lower:     map[string]string{"cpu": "100m"},
+			Upper:     map[string]string{"cpu": "1"},
+		},
+	}
+	reqs := map[string]map[string]string{"app": {"cpu": "500m"}}
+
+	r := vpaReport(rr, reqs)
+	assert.Contains(t, r, "app:\n  cpu:\n    requested:   500m\n    recommended: 250m\n    range:       100m - 1\n")
+	assert.Contains(t, r, "  memory:\n    requested:   <none>\n    recommended: 256Mi\n")
+}
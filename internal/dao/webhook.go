@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor  = (*Webhook)(nil)
+	_ Describer = (*Webhook)(nil)
+	_ Diagnoser = (*Webhook)(nil)
+)
+
+// Webhook represents a validating or mutating webhook configuration.
+type Webhook struct {
+	Resource
+}
+
+// List returns a collection of webhook configurations.
+func (w *Webhook) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := w.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	ready, err := w.readyEndpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.WebhookWithEndpoints{Raw: u, Ready: ready})
+	}
+
+	return res, nil
+}
+
+// Get returns a webhook configuration.
+func (w *Webhook) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := w.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	ready, err := w.readyEndpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	return &render.WebhookWithEndpoints{Raw: u, Ready: ready}, nil
+}
+
+// Diagnose returns a health report for each webhooks in a configuration.
+func (w *Webhook) Diagnose(path string) (string, error) {
+	o, err := w.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+	wwe, ok := o.(*render.WebhookWithEndpoints)
+	if !ok {
+		return "", fmt.Errorf("expecting WebhookWithEndpoints but got %T", o)
+	}
+	_, hooks, err := render.ToWebhooks(wwe.Raw)
+	if err != nil {
+		return "", err
+	}
+
+	return webhookReport(hooks, wwe.Ready), nil
+}
+
+func (w *Webhook) readyEndpoints() (map[string]int, error) {
+	oo, err := w.Factory.List("v1/endpoints", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ready := make(map[string]int, len(oo))
+	for _, o := range oo {
+		var ep v1.Endpoints
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ep)
+		if err != nil {
+			return nil, err
+		}
+		var count int
+		for _, s := range ep.Subsets {
+			count += len(s.Addresses)
+		}
+		ready[client.FQN(ep.Namespace, ep.Name)] = count
+	}
+
+	return ready, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func webhookReport(hooks []render.WebhookInfo, ready map[string]int) string {
+	var b strings.Builder
+	for _, h := range hooks {
+		status := "OK"
+		switch {
+		case h.Critical(ready):
+			status = "CRITICAL -- no ready endpoints, admission requests will be rejected!"
+		case !h.Healthy(ready):
+			status = "DEGRADED -- no ready endpoints, admission requests will be ignored"
+		}
+		fmt.Fprintf(&b, "%s:\n", h.Name)
+		fmt.Fprintf(&b, "  status: %s\n", status)
+		fmt.Fprintf(&b, "  failurePolicy: %s\n", h.FailurePolicy)
+		if h.Service != "" {
+			fmt.Fprintf(&b, "  service: %s\n", h.Service)
+			fmt.Fprintf(&b, "  readyEndpoints: %d\n", ready[strings.Split(h.Service, ":")[0]])
+		}
+		if h.URL != "" {
+			fmt.Fprintf(&b, "  url: %s\n", h.URL)
+		}
+		fmt.Fprintf(&b, "  namespaceSelector: %s\n", selOrAll(h.NSSelector))
+		fmt.Fprintf(&b, "  objectSelector: %s\n", selOrAll(h.ObjSelector))
+		if len(h.Rules) > 0 {
+			fmt.Fprintf(&b, "  rules:\n")
+			for _, r := range h.Rules {
+				fmt.Fprintf(&b, "    - %s\n", r)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func selOrAll(s string) string {
+	if s == "" {
+		return "<all>"
+	}
+
+	return s
+}
@@ -0,0 +1,22 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookReport(t *testing.T) {
+	hh := []render.WebhookInfo{
+		{Name: "a", FailurePolicy: "Fail", Service: "default/a:443"},
+		{Name: "b", FailurePolicy: "Ignore", Service: "default/b"},
+		{Name: "c", FailurePolicy: "Fail", URL: "https://c"},
+	}
+	report := webhookReport(hh, map[string]int{})
+
+	assert.Contains(t, report, "a:\n  status: CRITICAL")
+	assert.Contains(t, report, "b:\n  status: DEGRADED")
+	assert.Contains(t, report, "c:\n  status: OK")
+	assert.Contains(t, report, "namespaceSelector: <all>")
+}
@@ -21,8 +21,20 @@ const (
 	KeySubjectKind ContextKey = "subjectKind"
 	KeySubjectName ContextKey = "subjectName"
 	KeyNamespace   ContextKey = "namespace"
+	KeyNamespaces  ContextKey = "namespaces"
 	KeyCluster     ContextKey = "cluster"
 	KeyApp         ContextKey = "app"
 	KeyStyles      ContextKey = "styles"
 	KeyMetrics     ContextKey = "metrics"
+	KeyPattern     ContextKey = "pattern"
+	KeyGVRs        ContextKey = "gvrs"
+	KeyTargetGVR   ContextKey = "targetGVR"
+	KeyAppLabel    ContextKey = "appLabel"
+	KeyTokens      ContextKey = "tokens"
+	KeyPins        ContextKey = "pins"
+	KeyTasks       ContextKey = "tasks"
+	KeySessions    ContextKey = "sessions"
+	KeyAuditCfg    ContextKey = "auditCfg"
+	KeyAuditFilter ContextKey = "auditFilter"
+	KeyPrivileged  ContextKey = "privileged"
 )
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DeepLinkScheme prefixes K9s deep links.
+const DeepLinkScheme = "k9s"
+
+// DeepLink represents a link to a K9s view or to an exact object, ie
+// k9s://pods/prod/api-1?filter=err. Cluster scoped objects use `-` as
+// namespace, ie k9s://nodes/-/node-1.
+type DeepLink struct {
+	Resource  string
+	Namespace string
+	Name      string
+	Filter    string
+	Labels    string
+}
+
+// NewDeepLink returns a link to a resource object.
+func NewDeepLink(gvr, path string) DeepLink {
+	l := DeepLink{Resource: client.NewGVR(gvr).R()}
+	if path == "" {
+		return l
+	}
+	ns, n := client.Namespaced(path)
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+	l.Namespace, l.Name = ns, n
+
+	return l
+}
+
+// IsDeepLink returns true if a command is a deep link.
+func IsDeepLink(s string) bool {
+	return strings.HasPrefix(s, DeepLinkScheme+"://")
+}
+
+// ParseDeepLink parses a deep link.
+func ParseDeepLink(s string) (DeepLink, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return DeepLink{}, err
+	}
+	if u.Scheme != DeepLinkScheme || u.Host == "" {
+		return DeepLink{}, fmt.Errorf("invalid deep link %q. Expecting %s://resource[/namespace[/name]]", s, DeepLinkScheme)
+	}
+
+	l := DeepLink{
+		Resource: u.Host,
+		Filter:   u.Query().Get("filter"),
+		Labels:   u.Query().Get("labels"),
+	}
+	if l.Filter != "" && l.Labels != "" {
+		return DeepLink{}, fmt.Errorf("invalid deep link %q. Use either a filter or labels", s)
+	}
+	tokens := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch len(tokens) {
+	case 2:
+		l.Name = tokens[1]
+		fallthrough
+	case 1:
+		l.Namespace = tokens[0]
+	default:
+		return DeepLink{}, fmt.Errorf("invalid deep link path %q. Expecting /namespace[/name]", u.Path)
+	}
+
+	return l, nil
+}
+
+// String returns the link url.
+func (l DeepLink) String() string {
+	u := url.URL{Scheme: DeepLinkScheme, Host: l.Resource}
+	if l.Namespace != "" {
+		u.Path = "/" + l.Namespace
+		if l.Name != "" {
+			u.Path += "/" + l.Name
+		}
+	}
+	q := url.Values{}
+	if l.Filter != "" {
+		q.Set("filter", l.Filter)
+	}
+	if l.Labels != "" {
+		q.Set("labels", l.Labels)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// Path returns the linked object path if any.
+func (l DeepLink) Path() string {
+	if l.Name == "" {
+		return ""
+	}
+	ns := l.Namespace
+	if ns == client.ClusterScope {
+		ns = ""
+	}
+
+	return client.FQN(ns, l.Name)
+}
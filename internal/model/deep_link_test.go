@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeepLink(t *testing.T) {
+	uu := map[string]struct {
+		link string
+		e    model.DeepLink
+		path string
+		err  bool
+	}{
+		"view": {
+			link: "k9s://pods",
+			e:    model.DeepLink{Resource: "pods"},
+		},
+		"filtered": {
+			link: "k9s://pods/prod?filter=api",
+			e:    model.DeepLink{Resource: "pods", Namespace: "prod", Filter: "api"},
+		},
+		"labels": {
+			link: "k9s://dp/all?labels=app%3Dweb",
+			e:    model.DeepLink{Resource: "dp", Namespace: "all", Labels: "app=web"},
+		},
+		"object": {
+			link: "k9s://pods/prod/api-1",
+			e:    model.DeepLink{Resource: "pods", Namespace: "prod", Name: "api-1"},
+			path: "prod/api-1",
+		},
+		"clusterScoped": {
+			link: "k9s://nodes/-/node-1",
+			e:    model.DeepLink{Resource: "nodes", Namespace: "-", Name: "node-1"},
+			path: "node-1",
+		},
+		"spaces": {
+			link: "k9s://pods/prod?filter=out+of+memory",
+			e:    model.DeepLink{Resource: "pods", Namespace: "prod", Filter: "out of memory"},
+		},
+		"setLabels": {
+			link: "k9s://pods/prod?labels=app+in+%28a%2C+b%29",
+			e:    model.DeepLink{Resource: "pods", Namespace: "prod", Labels: "app in (a, b)"},
+		},
+		"scheme": {
+			link: "http://pods",
+			err:  true,
+		},
+		"tooDeep": {
+			link: "k9s://pods/prod/api-1/blee",
+			err:  true,
+		},
+		"filterAndLabels": {
+			link: "k9s://pods?filter=api&labels=app%3Dweb",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := model.ParseDeepLink(u.link)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, u.e, l)
+			assert.Equal(t, u.path, l.Path())
+			assert.Equal(t, u.link, l.String())
+		})
+	}
+}
+
+func TestNewDeepLink(t *testing.T) {
+	assert.Equal(t, "k9s://pods/default/fred", model.NewDeepLink("v1/pods", "default/fred").String())
+	assert.Equal(t, "k9s://nodes/-/n1", model.NewDeepLink("v1/nodes", "n1").String())
+	assert.Equal(t, "k9s://deployments", model.NewDeepLink("apps/v1/deployments", "").String())
+	assert.True(t, model.IsDeepLink("k9s://pods"))
+	assert.False(t, model.IsDeepLink("pods"))
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+var (
+	labelFilterRX = regexp.MustCompile(`\A(\-l|\-n\s)`)
+	filterFlagRX  = regexp.MustCompile(`\A(!|\-[cx]\s+)`)
+)
+
+// Filter matches content against a regexp filter query. Matching is case
+// insensitive unless the query is prefixed with -c. Queries prefixed with -x
+// are matched literally and queries prefixed with ! match content that does
+// not match the query.
+type Filter struct {
+	rx     *regexp.Regexp
+	invert bool
+}
+
+// NewFilter returns a new filter or an error if the query is not a valid
+// regexp.
+func NewFilter(q string) (*Filter, error) {
+	var f Filter
+	caseSensitive, literal := false, false
+	for !literal {
+		flag := filterFlagRX.FindString(q)
+		if flag == "" {
+			break
+		}
+		q = q[len(flag):]
+		switch strings.TrimSpace(flag) {
+		case "!":
+			f.invert = true
+		case "-c":
+			caseSensitive = true
+		case "-x":
+			literal = true
+		}
+	}
+	if literal {
+		q = regexp.QuoteMeta(q)
+	}
+	if !caseSensitive {
+		q = `(?i)` + q
+	}
+	rx, err := regexp.Compile(q)
+	if err != nil {
+		return nil, filterError(err)
+	}
+	f.rx = rx
+
+	return &f, nil
+}
+
+// Match checks if the given content matches the filter.
+func (f *Filter) Match(s string) bool {
+	return f.rx.MatchString(s) != f.invert
+}
+
+// MatchAny checks if any of the given tokens matches the filter. Inverted
+// filters match when none of the tokens match the query.
+func (f *Filter) MatchAny(ss []string) bool {
+	for _, s := range ss {
+		if f.rx.MatchString(s) {
+			return !f.invert
+		}
+	}
+
+	return f.invert
+}
+
+// ValidateFilter checks a filter query. Label, namespace and fuzzy queries
+// are not validated.
+func ValidateFilter(q string) error {
+	if q == "" || labelFilterRX.MatchString(q) || isFuzzySelector(q) {
+		return nil
+	}
+	_, err := NewFilter(q)
+
+	return err
+}
+
+func filterError(err error) error {
+	if e, ok := err.(*syntax.Error); ok {
+		return fmt.Errorf("invalid filter: %s `%s`", e.Code, strings.TrimPrefix(e.Expr, "(?i)"))
+	}
+
+	return fmt.Errorf("invalid filter: %s", err)
+}
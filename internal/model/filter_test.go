@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterMatch(t *testing.T) {
+	uu := map[string]struct {
+		q, s string
+		e    bool
+	}{
+		"plain":       {q: "fred", s: "Fred Blee", e: true},
+		"rx":          {q: "fr.d", s: "fred", e: true},
+		"noMatch":     {q: "zorg", s: "fred"},
+		"literal":     {q: "-x a.b", s: "a.b", e: true},
+		"literalNoRx": {q: "-x a.b", s: "axb"},
+		"literalMeta": {q: "-x (fred", s: "blee (fred", e: true},
+		"literalCase": {q: "-x FRED", s: "fred", e: true},
+		"dashWord":    {q: "-config", s: "kube-config", e: true},
+		"dashCase":    {q: "-cFred", s: "-CFRED", e: true},
+		"case":        {q: "-c Fred", s: "fred"},
+		"caseMatch":   {q: "-c Fred", s: "Fred", e: true},
+		"invert":      {q: "!kube-system", s: "kube-system/ds-1"},
+		"invertMatch": {q: "!kube-system", s: "default/fred", e: true},
+		"invertCase":  {q: "!-c Kube", s: "kube-system/ds-1", e: true},
+		"invertLit":   {q: "!-x a.b", s: "axb", e: true},
+		"literalBang": {q: "-x !fred", s: "!fred", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := model.NewFilter(u.q)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, f.Match(u.s))
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	uu := map[string]struct {
+		q string
+		e string
+	}{
+		"blank":     {},
+		"valid":     {q: "fred.*"},
+		"label":     {q: "-l app=(fred"},
+		"namespace": {q: "-n kube-(system"},
+		"dashName":  {q: "-nginx(", e: "invalid filter: missing closing ) `-nginx(`"},
+		"fuzzy":     {q: "-f (fred"},
+		"literal":   {q: "-x (fred"},
+		"invalid":   {q: "(fred", e: "invalid filter: missing closing ) `(fred`"},
+		"repeat":    {q: "*fred", e: "invalid filter: missing argument to repetition operator `*`"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := model.ValidateFilter(u.q)
+			if u.e == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, u.e, err.Error())
+			}
+		})
+	}
+}
+
+func TestFilterMatchAny(t *testing.T) {
+	f, err := model.NewFilter("kube-system")
+	assert.Nil(t, err)
+	assert.True(t, f.MatchAny([]string{"v1/pods", "kube-system/ds-1"}))
+	assert.False(t, f.MatchAny([]string{"v1/pods", "default/fred"}))
+
+	f, err = model.NewFilter("!kube-system")
+	assert.Nil(t, err)
+	assert.False(t, f.MatchAny([]string{"v1/pods", "kube-system/ds-1"}))
+	assert.True(t, f.MatchAny([]string{"v1/pods", "default/fred"}))
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+// RowGroup tracks row events sharing a column value.
+type RowGroup struct {
+	Name   string
+	Events render.RowEvents
+}
+
+// Grouper buckets table rows by a column value. Groups can be collapsed.
+type Grouper struct {
+	column    string
+	collapsed map[string]bool
+}
+
+// GroupBy returns the grouped column name if any.
+func (g *Grouper) GroupBy() string {
+	return g.column
+}
+
+// SetGroupBy groups rows by a given column name. Blank clears out grouping.
+func (g *Grouper) SetGroupBy(col string) {
+	g.column, g.collapsed = col, make(map[string]bool)
+}
+
+// ToggleCollapsed collapses or expands a group.
+func (g *Grouper) ToggleCollapsed(name string) {
+	if g.collapsed == nil {
+		g.collapsed = make(map[string]bool)
+	}
+	g.collapsed[name] = !g.collapsed[name]
+}
+
+// IsCollapsed checks if a group is collapsed.
+func (g *Grouper) IsCollapsed(name string) bool {
+	return g.collapsed[name]
+}
+
+// Group buckets row events by the grouped column value. Groups are ordered by
+// name while rows retain their order within a group. Returns nil if rows are
+// not grouped.
+func (g *Grouper) Group(h render.HeaderRow, rr render.RowEvents) []RowGroup {
+	col := groupCol(h, g.column)
+	if col < 0 {
+		return nil
+	}
+
+	index := make(map[string]int)
+	gg := []RowGroup{}
+	for _, e := range rr {
+		var k string
+		if col < len(e.Row.Fields) {
+			k = e.Row.Fields[col]
+		}
+		i, ok := index[k]
+		if !ok {
+			i = len(gg)
+			index[k] = i
+			gg = append(gg, RowGroup{Name: k})
+		}
+		gg[i].Events = append(gg[i].Events, e)
+	}
+	sort.SliceStable(gg, func(i, j int) bool {
+		return gg[i].Name < gg[j].Name
+	})
+
+	return gg
+}
+
+func groupCol(h render.HeaderRow, name string) int {
+	if name == "" {
+		return -1
+	}
+	for i, c := range h {
+		if c.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,49 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrouperGroup(t *testing.T) {
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "NODE"}}
+	rr := render.RowEvents{
+		{Row: render.Row{ID: "A", Fields: render.Fields{"a", "n2"}}},
+		{Row: render.Row{ID: "B", Fields: render.Fields{"b", "n1"}}},
+		{Row: render.Row{ID: "C", Fields: render.Fields{"c", "n2"}}},
+		{Row: render.Row{ID: "D", Fields: render.Fields{"d"}}},
+	}
+
+	var g model.Grouper
+	assert.Nil(t, g.Group(h, rr))
+
+	g.SetGroupBy("NODE")
+	assert.Equal(t, "NODE", g.GroupBy())
+	gg := g.Group(h, rr)
+	assert.Equal(t, 3, len(gg))
+	assert.Equal(t, "", gg[0].Name)
+	assert.Equal(t, "n1", gg[1].Name)
+	assert.Equal(t, "n2", gg[2].Name)
+	assert.Equal(t, "A", gg[2].Events[0].Row.ID)
+	assert.Equal(t, "C", gg[2].Events[1].Row.ID)
+
+	g.SetGroupBy("BLEE")
+	assert.Nil(t, g.Group(h, rr))
+}
+
+func TestGrouperCollapsed(t *testing.T) {
+	var g model.Grouper
+	assert.False(t, g.IsCollapsed("n1"))
+
+	g.ToggleCollapsed("n1")
+	assert.True(t, g.IsCollapsed("n1"))
+	g.ToggleCollapsed("n1")
+	assert.False(t, g.IsCollapsed("n1"))
+
+	g.ToggleCollapsed("n1")
+	g.SetGroupBy("NODE")
+	assert.False(t, g.IsCollapsed("n1"))
+}
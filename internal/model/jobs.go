@@ -0,0 +1,205 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// JobsListener represents a background jobs listener.
+type JobsListener interface {
+	// JobsChanged notifies the background jobs progressed.
+	JobsChanged([]JobState)
+}
+
+// JobState represents a snapshot of a background job.
+type JobState struct {
+	ID                int
+	Name, Status      string
+	Done, Total       int
+	Started, Deadline time.Time
+	Ended             time.Time
+	Canceled          bool
+	Err               error
+}
+
+// Running returns true if the job is still in progress.
+func (s JobState) Running() bool {
+	return s.Ended.IsZero()
+}
+
+// Job represents a long running operation launched in the background.
+type Job struct {
+	state  JobState
+	cancel context.CancelFunc
+	jobs   *Jobs
+}
+
+// ID returns the job identifier.
+func (j *Job) ID() int {
+	return j.state.ID
+}
+
+// SetStatus updates the job status.
+func (j *Job) SetStatus(s string) {
+	j.jobs.update(func() { j.state.Status = s })
+}
+
+// SetProgress updates the job completion.
+func (j *Job) SetProgress(done, total int) {
+	j.jobs.update(func() { j.state.Done, j.state.Total = done, total })
+}
+
+// Finish marks the job as completed.
+func (j *Job) Finish(err error) {
+	j.jobs.update(func() {
+		if !j.state.Running() {
+			return
+		}
+		j.state.Ended, j.state.Err = time.Now(), err
+	})
+	j.cancel()
+}
+
+// Jobs tracks long running operations and their progress.
+type Jobs struct {
+	jobs      []*Job
+	seq       int
+	listeners []JobsListener
+	mx        sync.Mutex
+}
+
+// NewJobs returns a new jobs tracker.
+func NewJobs() *Jobs {
+	return &Jobs{}
+}
+
+// AddListener adds a jobs listener.
+func (j *Jobs) AddListener(l JobsListener) {
+	j.listeners = append(j.listeners, l)
+}
+
+// Start registers a new job. The returned context is canceled once the job
+// finishes, is canceled or times out. A zero timeout means no deadline.
+func (j *Jobs) Start(ctx context.Context, name string, timeout time.Duration) (context.Context, *Job) {
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	j.mx.Lock()
+	j.seq++
+	job := Job{
+		state:  JobState{ID: j.seq, Name: name, Started: time.Now()},
+		cancel: cancel,
+		jobs:   j,
+	}
+	if d, ok := ctx.Deadline(); ok {
+		job.state.Deadline = d
+	}
+	j.jobs = append(j.jobs, &job)
+	j.mx.Unlock()
+	log.Debug().Msgf("Job %d started %q", job.state.ID, name)
+	j.fireChanged()
+
+	return ctx, &job
+}
+
+// Cancel cancels a running job.
+func (j *Jobs) Cancel(id int) bool {
+	j.mx.Lock()
+	var job *Job
+	for _, jj := range j.jobs {
+		if jj.state.ID == id && jj.state.Running() {
+			job = jj
+			break
+		}
+	}
+	if job == nil {
+		j.mx.Unlock()
+		return false
+	}
+	job.state.Ended, job.state.Canceled = time.Now(), true
+	j.mx.Unlock()
+	job.cancel()
+	j.fireChanged()
+
+	return true
+}
+
+// Latest returns the most recent running job if any.
+func (j *Jobs) Latest() (JobState, bool) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	for i := len(j.jobs) - 1; i >= 0; i-- {
+		if j.jobs[i].state.Running() {
+			return j.jobs[i].state, true
+		}
+	}
+
+	return JobState{}, false
+}
+
+// States returns all jobs snapshots ordered by start time.
+func (j *Jobs) States() []JobState {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+	ss := make([]JobState, 0, len(j.jobs))
+	for _, job := range j.jobs {
+		ss = append(ss, job.state)
+	}
+	sort.Slice(ss, func(i, k int) bool { return ss[i].ID < ss[k].ID })
+
+	return ss
+}
+
+// Prune evicts jobs completed for longer than the given ttl.
+func (j *Jobs) Prune(ttl time.Duration) {
+	j.mx.Lock()
+	jj := j.jobs[:0]
+	for _, job := range j.jobs {
+		if job.state.Running() || time.Since(job.state.Ended) < ttl {
+			jj = append(jj, job)
+		}
+	}
+	j.jobs = jj
+	j.mx.Unlock()
+}
+
+// Watch refreshes the jobs listeners at the given rate until canceled.
+func (j *Jobs) Watch(ctx context.Context, rate, ttl time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+			j.mx.Lock()
+			active := len(j.jobs) > 0
+			j.mx.Unlock()
+			if !active {
+				continue
+			}
+			j.Prune(ttl)
+			j.fireChanged()
+		}
+	}
+}
+
+func (j *Jobs) update(f func()) {
+	j.mx.Lock()
+	f()
+	j.mx.Unlock()
+	j.fireChanged()
+}
+
+func (j *Jobs) fireChanged() {
+	ss := j.States()
+	for _, l := range j.listeners {
+		l.JobsChanged(ss)
+	}
+}
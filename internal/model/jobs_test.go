@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobsLifecycle(t *testing.T) {
+	jj := NewJobs()
+	var l jobsListener
+	jj.AddListener(&l)
+
+	ctx1, j1 := jj.Start(context.Background(), "restart", time.Minute)
+	_, j2 := jj.Start(context.Background(), "apply", 0)
+	assert.Equal(t, 2, l.count)
+
+	j1.SetProgress(1, 3)
+	j1.SetStatus("rolling")
+	ss := jj.States()
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, "restart", ss[0].Name)
+	assert.Equal(t, 1, ss[0].Done)
+	assert.Equal(t, 3, ss[0].Total)
+	assert.Equal(t, "rolling", ss[0].Status)
+	assert.False(t, ss[0].Deadline.IsZero())
+	assert.True(t, ss[1].Deadline.IsZero())
+
+	latest, ok := jj.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, 2, latest.ID)
+
+	assert.True(t, jj.Cancel(1))
+	assert.False(t, jj.Cancel(1))
+	assert.NotNil(t, ctx1.Err())
+	assert.True(t, jj.States()[0].Canceled)
+
+	j2.Finish(errors.New("boom"))
+	j2.Finish(nil)
+	ss = jj.States()
+	assert.False(t, ss[1].Running())
+	assert.Equal(t, "boom", ss[1].Err.Error())
+	_, ok = jj.Latest()
+	assert.False(t, ok)
+
+	jj.Prune(time.Hour)
+	assert.Equal(t, 2, len(jj.States()))
+	jj.Prune(0)
+	assert.Equal(t, 0, len(jj.States()))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type jobsListener struct {
+	count int
+}
+
+func (l *jobsListener) JobsChanged([]JobState) {
+	l.count++
+}
@@ -10,9 +10,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // LogsListener represents a log model listener.
@@ -25,8 +28,26 @@ type LogsListener interface {
 
 	// LogFailed indicates a log failure.
 	LogFailed(error)
+
+	// LogSourceGone indicates the logged pod is gone.
+	LogSourceGone(LogSuccession)
+}
+
+// LogSuccession tracks where a log stream may resume once its pod is gone.
+type LogSuccession struct {
+	// Path represents the pod that is gone.
+	Path string
+
+	// Successor represents a replacement pod with the same owner if any.
+	Successor string
+
+	// OwnerGVR and OwnerPath represent a loggable owner if any.
+	OwnerGVR, OwnerPath string
 }
 
+// podCheckInterval represents how often a logged pod existence is checked.
+const podCheckInterval = 2 * time.Second
+
 // Log represents a resource logger.
 type Log struct {
 	factory     dao.Factory
@@ -39,6 +60,8 @@ type Log struct {
 	mx          sync.RWMutex
 	filter      string
 	lastSent    int
+	alertFn     func(string)
+	meter       *LogMeter
 }
 
 // NewLog returns a new model.
@@ -54,14 +77,38 @@ func NewLog(gvr client.GVR, msg string, opts dao.LogOptions, timeOut time.Durati
 // GetPath returns resource path.
 func (l *Log) GetPath() string { return l.logOptions.Path }
 
+// GVR returns the logged resource gvr.
+func (l *Log) GVR() client.GVR { return l.gvr }
+
 // GetContainer returns the resource container if any or "" otherwise.
 func (l *Log) GetContainer() string { return l.logOptions.Container }
 
+// SetHistory sets a historical logs source merged ahead of live logs.
+func (l *Log) SetHistory(h dao.LogHistory) {
+	l.logOptions.History = h
+}
+
+// SetPrefix sets the metadata prefixing log lines. Nil prefixes lines only
+// as needed to tell them apart.
+func (l *Log) SetPrefix(p *dao.LogPrefix) {
+	l.logOptions.Prefix = p
+}
+
 // Init initializes the model.
 func (l *Log) Init(f dao.Factory) {
 	l.factory = f
 }
 
+// SetMeter tracks incoming log lines rates.
+func (l *Log) SetMeter(m *LogMeter) {
+	l.meter = m
+}
+
+// SetAlerter checks incoming log lines for alerts.
+func (l *Log) SetAlerter(f func(string)) {
+	l.alertFn = f
+}
+
 // Clear the logs.
 func (l *Log) Clear() {
 	l.mx.Lock()
@@ -70,6 +117,23 @@ func (l *Log) Clear() {
 	l.fireLogCleared()
 }
 
+// Switch resumes the log stream on another resource. The current logs are
+// kept.
+func (l *Log) Switch(gvr client.GVR, path, co string) {
+	l.Stop()
+	l.gvr = gvr
+	l.logOptions.Path, l.logOptions.Container = path, co
+	l.logOptions.SingleContainer, l.logOptions.MultiPods = false, false
+	l.Start()
+}
+
+// Restart clears and reloads the logs.
+func (l *Log) Restart() {
+	l.Stop()
+	l.Clear()
+	l.Start()
+}
+
 // Start initialize log tailer.
 func (l *Log) Start() {
 	if err := l.load(); err != nil {
@@ -103,6 +167,20 @@ func (l *Log) ClearFilter() {
 	l.fireLogChanged(l.lines)
 }
 
+// Refresh notifies listeners with the current filtered logs.
+func (l *Log) Refresh() {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	filtered, err := applyFilter(l.filter, l.lines)
+	if err != nil {
+		l.fireLogError(err)
+		return
+	}
+	l.fireLogCleared()
+	l.fireLogChanged(filtered)
+}
+
 // Filter filters the model using either fuzzy or regexp.
 func (l *Log) Filter(q string) error {
 	l.mx.RLock()
@@ -143,15 +221,80 @@ func (l *Log) load() error {
 		close(c)
 		return err
 	}
+	if l.IsPodLog() {
+		go l.watchPod(ctx, l.logOptions.Path, l.logOptions.Container)
+	}
 
 	return nil
 }
 
+// IsPodLog checks if a single pod is being logged.
+func (l *Log) IsPodLog() bool {
+	switch l.gvr.String() {
+	case "v1/pods", "containers":
+		return true
+	default:
+		return false
+	}
+}
+
+// WatchPod checks the logged pod is still around and notifies listeners
+// where the logs may resume once it is gone.
+func (l *Log) watchPod(ctx context.Context, path, co string) {
+	if _, err := l.factory.Get("v1/pods", path, false, labels.Everything()); err != nil {
+		return
+	}
+	ogvr, opath, err := dao.PodOwner(l.factory, path)
+	if err != nil {
+		log.Debug().Err(err).Msgf("No owner found for pod %s", path)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(podCheckInterval):
+			_, err := l.factory.Get("v1/pods", path, false, labels.Everything())
+			if err == nil || !errors.IsNotFound(err) {
+				continue
+			}
+			log.Debug().Msgf("Pod %s is gone", path)
+			l.fireLogSourceGone(l.succession(path, co, ogvr, opath))
+			return
+		}
+	}
+}
+
+func (l *Log) succession(path, co, ogvr, opath string) LogSuccession {
+	s := LogSuccession{Path: path}
+	if ogvr == "" {
+		return s
+	}
+	if succ, err := dao.PodSuccessor(l.factory, ogvr, opath, path, co); err == nil {
+		s.Successor = succ
+	}
+	accessor, err := dao.AccessorFor(l.factory, client.NewGVR(ogvr))
+	if err != nil {
+		return s
+	}
+	if _, ok := accessor.(dao.Loggable); ok {
+		s.OwnerGVR, s.OwnerPath = ogvr, opath
+	}
+
+	return s
+}
+
 // Append adds a log line.
 func (l *Log) Append(line string) {
 	if line == "" {
 		return
 	}
+	if l.alertFn != nil {
+		l.alertFn(line)
+	}
+	if l.meter != nil {
+		l.meter.Record(line, time.Now())
+	}
 	l.mx.Lock()
 	defer l.mx.Unlock()
 
@@ -271,6 +414,12 @@ func (l *Log) fireLogChanged(lines []string) {
 	}
 }
 
+func (l *Log) fireLogSourceGone(s LogSuccession) {
+	for _, lis := range l.listeners {
+		lis.LogSourceGone(s)
+	}
+}
+
 func (l *Log) fireLogCleared() {
 	for _, lis := range l.listeners {
 		lis.LogCleared()
@@ -306,7 +455,11 @@ func filter(q string, lines []string) ([]int, error) {
 
 func fuzzyFilter(q string, lines []string) []int {
 	matches := make([]int, 0, len(lines))
-	mm := fuzzy.Find(q, lines)
+	plain := make([]string, 0, len(lines))
+	for _, l := range lines {
+		plain = append(plain, color.StripANSI(l))
+	}
+	mm := fuzzy.Find(q, plain)
 	for _, m := range mm {
 		matches = append(matches, m.Index)
 	}
@@ -315,13 +468,13 @@ func fuzzyFilter(q string, lines []string) []int {
 }
 
 func filterLogs(q string, lines []string) ([]int, error) {
-	rx, err := regexp.Compile(`(?i)` + q)
+	f, err := NewFilter(q)
 	if err != nil {
 		return nil, err
 	}
 	matches := make([]int, 0, len(lines))
 	for i, l := range lines {
-		if rx.MatchString(l) {
+		if f.Match(color.StripANSI(l)) {
 			matches = append(matches, i)
 		}
 	}
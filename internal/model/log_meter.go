@@ -0,0 +1,62 @@
+package model
+
+import (
+	"regexp"
+	"sync"
+	"time"
+)
+
+// LogMeterWindow represents the number of seconds log rates are averaged over.
+const LogMeterWindow = 5
+
+type meterBucket struct {
+	at          int64
+	lines, errs int
+}
+
+// LogMeter tracks log lines and error lines rates.
+type LogMeter struct {
+	rx      *regexp.Regexp
+	buckets [LogMeterWindow]meterBucket
+	mx      sync.Mutex
+}
+
+// NewLogMeter returns a new meter flagging error lines matching the given
+// regexp if any.
+func NewLogMeter(rx *regexp.Regexp) *LogMeter {
+	return &LogMeter{rx: rx}
+}
+
+// Record tracks a log line received at the given time.
+func (m *LogMeter) Record(line string, at time.Time) {
+	isErr := m.rx != nil && m.rx.MatchString(line)
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	s := at.Unix()
+	b := &m.buckets[s%LogMeterWindow]
+	if b.at != s {
+		*b = meterBucket{at: s}
+	}
+	b.lines++
+	if isErr {
+		b.errs++
+	}
+}
+
+// Rates returns the lines and error lines per second as of the given time.
+func (m *LogMeter) Rates(at time.Time) (float64, float64) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	s := at.Unix()
+	var lines, errs int
+	for _, b := range m.buckets {
+		if d := s - b.at; d < 0 || d >= LogMeterWindow {
+			continue
+		}
+		lines, errs = lines+b.lines, errs+b.errs
+	}
+
+	return float64(lines) / LogMeterWindow, float64(errs) / LogMeterWindow
+}
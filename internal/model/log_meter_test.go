@@ -0,0 +1,42 @@
+package model_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogMeter(t *testing.T) {
+	m := model.NewLogMeter(regexp.MustCompile(`(?i)error`))
+	at := time.Unix(1000, 0)
+	for i := 0; i < 10; i++ {
+		m.Record("all good", at)
+	}
+	m.Record("ERROR: boom", at.Add(time.Second))
+	m.Record("error: bang", at.Add(2*time.Second))
+
+	lines, errs := m.Rates(at.Add(2 * time.Second))
+	assert.Equal(t, 12.0/model.LogMeterWindow, lines)
+	assert.Equal(t, 2.0/model.LogMeterWindow, errs)
+
+	lines, errs = m.Rates(at.Add(model.LogMeterWindow * time.Second))
+	assert.Equal(t, 2.0/model.LogMeterWindow, lines)
+	assert.Equal(t, 2.0/model.LogMeterWindow, errs)
+
+	lines, errs = m.Rates(at.Add(time.Hour))
+	assert.Equal(t, 0.0, lines)
+	assert.Equal(t, 0.0, errs)
+}
+
+func TestLogMeterNoPattern(t *testing.T) {
+	m := model.NewLogMeter(nil)
+	at := time.Unix(1000, 0)
+	m.Record("error", at)
+
+	lines, errs := m.Rates(at)
+	assert.Equal(t, 1.0/model.LogMeterWindow, lines)
+	assert.Equal(t, 0.0, errs)
+}
@@ -221,6 +221,7 @@ func (t *testView) LogCleared() {
 	t.clearCalled++
 	t.data = []string{}
 }
+func (t *testView) LogSourceGone(model.LogSuccession) {}
 func (t *testView) LogFailed(err error) {
 	fmt.Println("LogErr", err)
 	t.errCalled++
@@ -256,3 +257,14 @@ func (f testFactory) DeleteForwarder(string) {}
 func makeFactory() dao.Factory {
 	return testFactory{}
 }
+
+func TestLogFilterANSI(t *testing.T) {
+	m := model.NewLog(client.NewGVR("fred"), "Blee", makeLogOpts(10), 10*time.Millisecond)
+	m.Init(makeFactory())
+	v := newTestView()
+	m.AddListener(v)
+
+	m.Set([]string{"\x1b[31merr\x1b[0mor: boom", "all good"})
+	assert.Nil(t, m.Filter("error"))
+	assert.Equal(t, []string{"\x1b[31merr\x1b[0mor: boom"}, v.data)
+}
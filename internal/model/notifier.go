@@ -0,0 +1,451 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NotifyListener represents a notification listener.
+type NotifyListener interface {
+	// Notified notifies a rule fired.
+	Notified(Notification)
+}
+
+// Notification represents a fired notification rule.
+type Notification struct {
+	Rule    string    `json:"rule"`
+	GVR     string    `json:"gvr"`
+	Path    string    `json:"path"`
+	Message string    `json:"message"`
+	Link    string    `json:"link,omitempty"`
+	Time    time.Time `json:"time"`
+	Beep    bool      `json:"-"`
+}
+
+// Notifier checks resources against notification rules and dispatches to
+// sinks. A rule fires once per resource until its condition clears.
+// Log alerts fire at most once per cool down period.
+type Notifier struct {
+	rules     []*notifyRule
+	logAlerts []*logAlert
+	fired     map[string]struct{}
+	listeners []NotifyListener
+	mx        sync.Mutex // guards fired and log alerts last fired time.
+}
+
+type notifyRule struct {
+	config.NotifyRule
+	cond  Condition
+	sinks []Sink
+	table *Table
+}
+
+type logAlert struct {
+	config.LogAlert
+	rx    *regexp.Regexp
+	sinks []Sink
+	last  time.Time
+}
+
+const (
+	logAlertCoolDown = 30 * time.Second
+
+	// LogAlertResync tracks how often log alerts check for rolled pods.
+	logAlertResync = 10 * time.Second
+)
+
+// NewNotifier returns a new notifier.
+func NewNotifier(n *config.Notifications) (*Notifier, error) {
+	nn := Notifier{fired: make(map[string]struct{})}
+	for _, r := range n.Rules {
+		cond, err := ParseCondition(r.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %v", r.Name, err)
+		}
+		rule := notifyRule{NotifyRule: r, cond: cond, table: NewTable(r.GVR)}
+		ns := r.Namespace
+		if ns == "" {
+			ns = client.AllNamespaces
+		}
+		rule.table.SetNamespace(ns)
+		if rule.sinks, err = sinksFor(r.Sinks, n.Sinks); err != nil {
+			return nil, fmt.Errorf("rule %q: %v", r.Name, err)
+		}
+		nn.rules = append(nn.rules, &rule)
+	}
+	for _, a := range n.LogAlerts {
+		rx, err := regexp.Compile(a.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("log alert %q: %v", a.Name, err)
+		}
+		alert := logAlert{LogAlert: a, rx: rx}
+		if alert.sinks, err = sinksFor(a.Sinks, n.Sinks); err != nil {
+			return nil, fmt.Errorf("log alert %q: %v", a.Name, err)
+		}
+		nn.logAlerts = append(nn.logAlerts, &alert)
+	}
+
+	return &nn, nil
+}
+
+// AddListener adds a notification listener.
+func (n *Notifier) AddListener(l NotifyListener) {
+	n.listeners = append(n.listeners, l)
+}
+
+// Empty returns true if no rules are defined.
+func (n *Notifier) Empty() bool {
+	return len(n.rules) == 0 && len(n.logAlerts) == 0
+}
+
+// Watch tails the log alerts resources and checks the rules at the given
+// rate until canceled.
+func (n *Notifier) Watch(ctx context.Context, rate time.Duration) {
+	n.tailLogs(ctx)
+	if len(n.rules) == 0 {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Notifier canceled!")
+			return
+		case <-time.After(rate):
+			n.Check(ctx)
+		}
+	}
+}
+
+// Check evaluates all rules and dispatches new notifications.
+func (n *Notifier) Check(ctx context.Context) {
+	for _, r := range n.rules {
+		if err := r.table.reconcile(ctx); err != nil {
+			log.Warn().Err(err).Msgf("Notify rule %q", r.Name)
+			continue
+		}
+		data := r.table.Peek()
+		data.Mutex.RLock()
+		rows := make([]render.Row, 0, len(data.RowEvents))
+		for _, re := range data.RowEvents {
+			rows = append(rows, re.Row)
+		}
+		data.Mutex.RUnlock()
+		n.evaluate(r, data.Header, rows)
+	}
+}
+
+func (n *Notifier) evaluate(r *notifyRule, h render.HeaderRow, rows []render.Row) {
+	prefix := r.Name + "|"
+	seen := make(map[string]struct{}, len(rows))
+	var nn []Notification
+	n.mx.Lock()
+	for _, row := range rows {
+		key := prefix + row.ID
+		v, ok := r.cond.Match(h, row.Fields)
+		if !ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := n.fired[key]; ok {
+			continue
+		}
+		n.fired[key] = struct{}{}
+		nn = append(nn, Notification{
+			Rule:    r.Name,
+			GVR:     r.GVR,
+			Path:    row.ID,
+			Message: fmt.Sprintf("%s: %s %s %s=%s", r.Name, client.NewGVR(r.GVR).R(), row.ID, r.cond.Column, v),
+			Time:    time.Now(),
+		})
+	}
+	for k := range n.fired {
+		if _, ok := seen[k]; !ok && strings.HasPrefix(k, prefix) {
+			delete(n.fired, k)
+		}
+	}
+	n.mx.Unlock()
+
+	for _, no := range nn {
+		n.dispatch(r.sinks, no)
+	}
+}
+
+// MatchLog checks a log line against the alerts not bound to a resource.
+func (n *Notifier) MatchLog(line string) {
+	for _, a := range n.logAlerts {
+		if a.Path == "" {
+			n.matchLog(a, "", line)
+		}
+	}
+}
+
+func (n *Notifier) matchLog(a *logAlert, path, line string) {
+	if !a.rx.MatchString(line) {
+		return
+	}
+	n.mx.Lock()
+	if time.Since(a.last) < logAlertCoolDown {
+		n.mx.Unlock()
+		return
+	}
+	a.last = time.Now()
+	n.mx.Unlock()
+
+	msg := fmt.Sprintf("%s: %s", a.Name, strings.TrimSpace(line))
+	if path != "" {
+		msg = fmt.Sprintf("%s: %s %s", a.Name, path, strings.TrimSpace(line))
+	}
+	n.dispatch(a.sinks, Notification{
+		Rule:    a.Name,
+		GVR:     a.GVR,
+		Path:    path,
+		Message: msg,
+		Time:    a.last,
+		Beep:    a.Beep,
+	})
+}
+
+func (n *Notifier) tailLogs(ctx context.Context) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		log.Error().Msgf("Expected factory in context but got %T", ctx.Value(internal.KeyFactory))
+		return
+	}
+	for _, a := range n.logAlerts {
+		if a.Path == "" {
+			continue
+		}
+		go n.watchLog(ctx, f, a)
+	}
+}
+
+// WatchLog tails a log alert resource and restarts the tail whenever the
+// pods backing the resource roll.
+func (n *Notifier) watchLog(ctx context.Context, f dao.Factory, a *logAlert) {
+	c := make(chan string, 10)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case line := <-c:
+				n.matchLog(a, a.Path, line)
+			}
+		}
+	}()
+
+	var (
+		pods   string
+		cancel context.CancelFunc = func() {}
+	)
+	defer func() { cancel() }()
+	for {
+		pp, err := alertPods(f, a)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Log alert %q pods lookup failed", a.Name)
+		}
+		if err == nil && pp != pods {
+			cancel()
+			if cancel, err = n.restartTail(ctx, f, a, c); err != nil {
+				log.Error().Err(err).Msgf("Log alert %q tail failed", a.Name)
+				pp = ""
+			}
+			pods = pp
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(logAlertResync):
+		}
+	}
+}
+
+func (n *Notifier) restartTail(ctx context.Context, f dao.Factory, a *logAlert, c chan<- string) (context.CancelFunc, error) {
+	tctx, cancel := context.WithCancel(ctx)
+
+	return cancel, n.tailLog(tctx, f, a, c)
+}
+
+func (n *Notifier) tailLog(ctx context.Context, f dao.Factory, a *logAlert, c chan<- string) error {
+	accessor, err := dao.AccessorFor(f, client.NewGVR(a.gvr()))
+	if err != nil {
+		return err
+	}
+	logger, ok := accessor.(dao.Loggable)
+	if !ok {
+		return fmt.Errorf("resource %s is not tailable", a.gvr())
+	}
+
+	return logger.TailLogs(ctx, c, dao.LogOptions{
+		Path:      a.Path,
+		Container: a.Container,
+	})
+}
+
+func (a *logAlert) gvr() string {
+	if a.GVR == "" {
+		return "v1/pods"
+	}
+
+	return a.GVR
+}
+
+// AlertPods returns a fingerprint of the pods backing a log alert resource.
+func alertPods(f dao.Factory, a *logAlert) (string, error) {
+	o, err := f.Get(a.gvr(), a.Path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+	if a.gvr() == "v1/pods" {
+		return string(u.GetUID()), nil
+	}
+
+	sel, ok, _ := unstructured.NestedStringMap(u.Object, "spec", "selector", "matchLabels")
+	if !ok {
+		sel, _, _ = unstructured.NestedStringMap(u.Object, "spec", "selector")
+	}
+	if len(sel) == 0 {
+		return "", fmt.Errorf("no valid selector found on %s", a.Path)
+	}
+	ns, _ := client.Namespaced(a.Path)
+	oo, err := f.List("v1/pods", ns, true, labels.SelectorFromSet(sel))
+	if err != nil {
+		return "", err
+	}
+	uids := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if m, err := meta.Accessor(o); err == nil {
+			uids = append(uids, string(m.GetUID()))
+		}
+	}
+	sort.Strings(uids)
+
+	return strings.Join(uids, ","), nil
+}
+
+func (n *Notifier) dispatch(sinks []Sink, no Notification) {
+	if no.Link == "" && no.GVR != "" {
+		no.Link = NewDeepLink(no.GVR, no.Path).String()
+	}
+	log.Info().Msgf("Notify %s", no.Message)
+	for _, l := range n.listeners {
+		l.Notified(no)
+	}
+	for _, s := range sinks {
+		go func(s Sink) {
+			if err := s.Notify(no); err != nil {
+				log.Error().Err(err).Msgf("Notify %q sink failed", no.Rule)
+			}
+		}(s)
+	}
+}
+
+func sinksFor(names []string, sinks map[string]config.NotifySink) ([]Sink, error) {
+	ss := make([]Sink, 0, len(names))
+	for _, name := range names {
+		cfg, ok := sinks[name]
+		if !ok {
+			return nil, fmt.Errorf("no sink named %q", name)
+		}
+		s, err := NewSink(cfg)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+// ----------------------------------------------------------------------------
+// Conditions...
+
+var condRX = regexp.MustCompile(`^\s*([^\s<>=!~]+)\s*(>=|<=|==|!=|=~|>|<)\s*(.+?)\s*$`)
+
+// Condition represents a rule condition on a rendered column ie RS > 3.
+type Condition struct {
+	Column, Op, Value string
+	rx                *regexp.Regexp
+}
+
+// ParseCondition parses a condition expression.
+func ParseCondition(s string) (Condition, error) {
+	mm := condRX.FindStringSubmatch(s)
+	if len(mm) != 4 {
+		return Condition{}, fmt.Errorf("invalid condition %q (COLUMN OP VALUE)", s)
+	}
+	c := Condition{Column: strings.ToUpper(mm[1]), Op: mm[2], Value: mm[3]}
+	if c.Op == "=~" {
+		rx, err := regexp.Compile(c.Value)
+		if err != nil {
+			return Condition{}, err
+		}
+		c.rx = rx
+	}
+
+	return c, nil
+}
+
+// Match checks if a row matches the condition and returns the column value.
+func (c Condition) Match(h render.HeaderRow, ff render.Fields) (string, bool) {
+	col := -1
+	for i, hh := range h {
+		if strings.ToUpper(hh.Name) == c.Column {
+			col = i
+			break
+		}
+	}
+	if col < 0 || col >= len(ff) {
+		return "", false
+	}
+	v := ff[col]
+
+	switch c.Op {
+	case "==":
+		return v, v == c.Value
+	case "!=":
+		return v, v != c.Value
+	case "=~":
+		return v, c.rx.MatchString(v)
+	}
+
+	l, err1 := toNumber(v)
+	r, err2 := toNumber(c.Value)
+	if err1 != nil || err2 != nil {
+		return v, false
+	}
+	switch c.Op {
+	case ">":
+		return v, l > r
+	case ">=":
+		return v, l >= r
+	case "<":
+		return v, l < r
+	default:
+		return v, l <= r
+	}
+}
+
+func toNumber(s string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+}
@@ -0,0 +1,292 @@
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestParseCondition(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   Condition
+		err bool
+	}{
+		"num":   {s: "rs > 3", e: Condition{Column: "RS", Op: ">", Value: "3"}},
+		"perc":  {s: "%CPU/R>=90", e: Condition{Column: "%CPU/R", Op: ">=", Value: "90"}},
+		"str":   {s: " STATUS != Ready ", e: Condition{Column: "STATUS", Op: "!=", Value: "Ready"}},
+		"blank": {s: "STATUS", err: true},
+		"badRX": {s: "STATUS =~ (", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := ParseCondition(u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestConditionMatch(t *testing.T) {
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}, {Name: "RS"}, {Name: "%CPU"}}
+	ff := render.Fields{"fred", "NotReady", "5", "12%"}
+
+	uu := map[string]struct {
+		cond string
+		v    string
+		e    bool
+	}{
+		"gt":      {cond: "RS > 3", v: "5", e: true},
+		"lte":     {cond: "RS <= 3", v: "5"},
+		"perc":    {cond: "%CPU < 50", v: "12%", e: true},
+		"eq":      {cond: "STATUS == NotReady", v: "NotReady", e: true},
+		"neq":     {cond: "STATUS != NotReady", v: "NotReady"},
+		"rx":      {cond: "STATUS =~ ^Not", v: "NotReady", e: true},
+		"nan":     {cond: "STATUS > 1", v: "NotReady"},
+		"missing": {cond: "BLEE == 1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := ParseCondition(u.cond)
+			assert.Nil(t, err)
+			v, ok := c.Match(h, ff)
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.v, v)
+		})
+	}
+}
+
+func TestNotifierEvaluate(t *testing.T) {
+	nn := config.NewNotifications()
+	nn.Rules = []config.NotifyRule{{Name: "crashy", GVR: "v1/pods", Condition: "RS > 3"}}
+	n, err := NewNotifier(nn)
+	assert.Nil(t, err)
+	var l notifyListener
+	n.AddListener(&l)
+
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "RS"}}
+	row := func(id, rs string) render.Row {
+		return render.Row{ID: id, Fields: render.Fields{id, rs}}
+	}
+	r := n.rules[0]
+
+	n.evaluate(r, h, []render.Row{row("default/p1", "4"), row("default/p2", "0")})
+	assert.Equal(t, []string{"crashy: pods default/p1 RS=4"}, l.messages)
+
+	n.evaluate(r, h, []render.Row{row("default/p1", "5"), row("default/p2", "0")})
+	assert.Equal(t, 1, len(l.messages))
+
+	n.evaluate(r, h, []render.Row{row("default/p1", "0")})
+	n.evaluate(r, h, []render.Row{row("default/p1", "6")})
+	assert.Equal(t, 2, len(l.messages))
+}
+
+func TestNotifierEvaluateConcurrent(t *testing.T) {
+	nn := config.NewNotifications()
+	nn.Rules = []config.NotifyRule{
+		{Name: "crashy", GVR: "v1/pods", Condition: "RS > 3"},
+		{Name: "restarts", GVR: "v1/pods", Condition: "RS > 1"},
+	}
+	n, err := NewNotifier(nn)
+	assert.Nil(t, err)
+
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "RS"}}
+	rows := []render.Row{{ID: "default/p1", Fields: render.Fields{"p1", "4"}}}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		for _, r := range n.rules {
+			wg.Add(1)
+			go func(r *notifyRule) {
+				defer wg.Done()
+				n.evaluate(r, h, rows)
+			}(r)
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, 2, len(n.fired))
+}
+
+func TestNotifierMatchLog(t *testing.T) {
+	nn := config.NewNotifications()
+	nn.LogAlerts = []config.LogAlert{
+		{Name: "panics", Pattern: `panic:`, Beep: true},
+		{Name: "oom", Pattern: `OOM`, GVR: "v1/pods", Path: "default/p1"},
+	}
+	n, err := NewNotifier(nn)
+	assert.Nil(t, err)
+	assert.False(t, n.Empty())
+	var l notifyListener
+	n.AddListener(&l)
+
+	n.MatchLog("all good")
+	n.MatchLog("OOM killed")
+	assert.Equal(t, 0, len(l.messages))
+
+	n.MatchLog("panic: boom ")
+	assert.Equal(t, []string{"panics: panic: boom"}, l.messages)
+	assert.True(t, l.beep)
+
+	n.MatchLog("panic: again")
+	assert.Equal(t, 1, len(l.messages))
+
+	n.matchLog(n.logAlerts[1], "default/p1", "OOM killed")
+	assert.Equal(t, "oom: default/p1 OOM killed", l.messages[1])
+}
+
+func TestNewNotifierErrors(t *testing.T) {
+	uu := map[string]struct {
+		rule  config.NotifyRule
+		alert config.LogAlert
+		sinks map[string]config.NotifySink
+	}{
+		"badPattern": {
+			alert: config.LogAlert{Name: "a", Pattern: "("},
+		},
+		"badCond": {
+			rule: config.NotifyRule{Name: "r", GVR: "v1/pods", Condition: "RS"},
+		},
+		"noSink": {
+			rule: config.NotifyRule{Name: "r", GVR: "v1/pods", Condition: "RS > 1", Sinks: []string{"fred"}},
+		},
+		"badSink": {
+			rule:  config.NotifyRule{Name: "r", GVR: "v1/pods", Condition: "RS > 1", Sinks: []string{"fred"}},
+			sinks: map[string]config.NotifySink{"fred": {Kind: config.SlackSink}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			nn := config.NewNotifications()
+			if u.rule.Name != "" {
+				nn.Rules = []config.NotifyRule{u.rule}
+			}
+			if u.alert.Name != "" {
+				nn.LogAlerts = []config.LogAlert{u.alert}
+			}
+			for k, v := range u.sinks {
+				nn.Sinks[k] = v
+			}
+			_, err := NewNotifier(nn)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	uu := map[string]struct {
+		kind, e string
+	}{
+		"webhook": {kind: config.WebhookSink, e: "default/p1"},
+		"slack":   {kind: config.SlackSink, e: "crashy: boom"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var got map[string]interface{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&got))
+			}))
+			defer srv.Close()
+
+			s, err := NewSink(config.NotifySink{Kind: u.kind, URL: srv.URL})
+			assert.Nil(t, err)
+			assert.Nil(t, s.Notify(Notification{Rule: "crashy", Path: "default/p1", Message: "crashy: boom"}))
+			if u.kind == config.SlackSink {
+				assert.Equal(t, u.e, got["text"])
+			} else {
+				assert.Equal(t, u.e, got["path"])
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type notifyListener struct {
+	messages []string
+	beep     bool
+}
+
+func (l *notifyListener) Notified(n Notification) {
+	l.messages, l.beep = append(l.messages, n.Message), n.Beep
+}
+
+func TestAlertPods(t *testing.T) {
+	dp := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "fred"},
+			},
+		},
+	}}
+	po1, po2 := unstructured.Unstructured{}, unstructured.Unstructured{}
+	po1.SetUID("u1")
+	po2.SetUID("u2")
+
+	uu := map[string]struct {
+		gvr string
+		f   podsFactory
+		e   string
+		err bool
+	}{
+		"pod": {
+			f: podsFactory{o: &po1},
+			e: "u1",
+		},
+		"deployment": {
+			gvr: "apps/v1/deployments",
+			f:   podsFactory{o: &dp, pods: []runtime.Object{&po2, &po1}},
+			e:   "u1,u2",
+		},
+		"noSelector": {
+			gvr: "apps/v1/deployments",
+			f:   podsFactory{o: &po1},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := logAlert{LogAlert: config.LogAlert{GVR: u.gvr, Path: "default/fred"}}
+			pods, err := alertPods(u.f, &a)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, pods)
+		})
+	}
+}
+
+type podsFactory struct {
+	testFactory
+
+	o    runtime.Object
+	pods []runtime.Object
+}
+
+func (f podsFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	return f.o, nil
+}
+
+func (f podsFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.pods, nil
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const sinkTimeout = 10 * time.Second
+
+// Sink represents a notification destination.
+type Sink interface {
+	// Notify sends a notification.
+	Notify(Notification) error
+}
+
+// NewSink returns a sink for a given configuration.
+func NewSink(cfg config.NotifySink) (Sink, error) {
+	cfg, err := cfg.WithSecrets()
+	if err != nil {
+		return nil, err
+	}
+	switch cfg.Kind {
+	case config.DesktopSink:
+		return desktopSink{}, nil
+	case config.WebhookSink, config.SlackSink:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("%s sink requires an url", cfg.Kind)
+		}
+		return webhookSink{url: cfg.URL, slack: cfg.Kind == config.SlackSink}, nil
+	case config.ExecSink:
+		if cfg.Command == "" {
+			return nil, fmt.Errorf("%s sink requires a command", cfg.Kind)
+		}
+		return execSink{command: cfg.Command, args: cfg.Args}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink kind %q", cfg.Kind)
+	}
+}
+
+type desktopSink struct{}
+
+// Notify pops a desktop notification.
+func (desktopSink) Notify(n Notification) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("osascript", "-e", fmt.Sprintf("display notification %q with title %q", n.Message, "K9s"))
+	case "windows":
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	default:
+		cmd = exec.Command("notify-send", "K9s", n.Message)
+	}
+
+	return cmd.Run()
+}
+
+type webhookSink struct {
+	url   string
+	slack bool
+}
+
+// Notify posts a notification.
+func (w webhookSink) Notify(n Notification) error {
+	var payload interface{} = n
+	if w.slack {
+		payload = map[string]string{"text": n.Message}
+	}
+	bb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	c := http.Client{Timeout: sinkTimeout}
+	resp, err := c.Post(w.url, "application/json", bytes.NewReader(bb))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s returned %s", w.url, resp.Status)
+	}
+
+	return nil
+}
+
+type execSink struct {
+	command string
+	args    []string
+}
+
+// Notify runs a command with the notification in its environment.
+func (e execSink) Notify(n Notification) error {
+	cmd := exec.Command(e.command, e.args...)
+	cmd.Env = append(os.Environ(),
+		"K9S_RULE="+n.Rule,
+		"K9S_GVR="+n.GVR,
+		"K9S_PATH="+n.Path,
+		"K9S_MESSAGE="+n.Message,
+		"K9S_LINK="+n.Link,
+	)
+
+	return cmd.Run()
+}
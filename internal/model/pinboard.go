@@ -0,0 +1,108 @@
+package model
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+)
+
+// PinboardListener represents a pinboard listener.
+type PinboardListener interface {
+	// PinboardChanged notifies the pinned resources status changed.
+	PinboardChanged([]render.PinRes)
+
+	// PinTransitioned notifies a pinned resource readiness flipped.
+	PinTransitioned(render.PinRes)
+}
+
+// Pinboard tracks the readiness of pinned resources. Pins are refreshed off
+// the UI thread so the board works off its own copy of the pins.
+type Pinboard struct {
+	mx        sync.Mutex
+	factory   dao.Factory
+	pins      []config.Pin
+	ready     map[string]bool
+	listeners []PinboardListener
+}
+
+// NewPinboard returns a new pinboard.
+func NewPinboard(f dao.Factory) *Pinboard {
+	return &Pinboard{factory: f, ready: make(map[string]bool)}
+}
+
+// AddListener adds a pinboard listener.
+func (p *Pinboard) AddListener(l PinboardListener) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.listeners = append(p.listeners, l)
+}
+
+// Reset clears out the previous states.
+func (p *Pinboard) Reset(f dao.Factory) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.factory, p.ready = f, make(map[string]bool)
+}
+
+// SetPins tracks a copy of the given pins.
+func (p *Pinboard) SetPins(pins []config.Pin) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.pins = append([]config.Pin(nil), pins...)
+}
+
+// Pins returns a snapshot of the tracked pins.
+func (p *Pinboard) Pins() []config.Pin {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return append([]config.Pin(nil), p.pins...)
+}
+
+// Refresh updates the pinned resources status.
+func (p *Pinboard) Refresh() {
+	p.mx.Lock()
+	f, pins := p.factory, p.pins
+	p.mx.Unlock()
+
+	p.update(dao.PinStates(f, pins))
+}
+
+func (p *Pinboard) update(rr []render.PinRes) {
+	p.mx.Lock()
+	ready := make(map[string]bool, len(rr))
+	var flipped []render.PinRes
+	for _, r := range rr {
+		id := client.FQN(r.GVR, r.Path)
+		ready[id] = r.Ready
+		if prev, ok := p.ready[id]; ok && prev != r.Ready {
+			flipped = append(flipped, r)
+		}
+	}
+	p.ready = ready
+	ll := append([]PinboardListener(nil), p.listeners...)
+	p.mx.Unlock()
+
+	for _, r := range flipped {
+		fireTransitioned(ll, r)
+	}
+	fireChanged(ll, rr)
+}
+
+func fireChanged(ll []PinboardListener, rr []render.PinRes) {
+	for _, l := range ll {
+		l.PinboardChanged(rr)
+	}
+}
+
+func fireTransitioned(ll []PinboardListener, r render.PinRes) {
+	for _, l := range ll {
+		l.PinTransitioned(r)
+	}
+}
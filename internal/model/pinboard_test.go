@@ -0,0 +1,57 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinboardUpdate(t *testing.T) {
+	p := NewPinboard(nil)
+	var l pinListener
+	p.AddListener(&l)
+
+	dp := render.PinRes{GVR: "apps/v1/deployments", Path: "default/fred", Ready: true, Status: "1/1"}
+	no := render.PinRes{GVR: "v1/nodes", Path: "n1", Ready: true, Status: "Ready"}
+	p.update([]render.PinRes{dp, no})
+	assert.Equal(t, 1, l.changed)
+	assert.Equal(t, 0, len(l.flipped))
+
+	no.Ready, no.Status = false, "NotReady"
+	p.update([]render.PinRes{dp, no})
+	assert.Equal(t, []render.PinRes{no}, l.flipped)
+
+	p.update([]render.PinRes{dp, no})
+	assert.Equal(t, 1, len(l.flipped))
+	assert.Equal(t, 3, l.changed)
+}
+
+func TestPinboardPins(t *testing.T) {
+	p := NewPinboard(nil)
+	pins := []config.Pin{{GVR: "v1/pods", Path: "default/p1"}, {GVR: "v1/nodes", Path: "n1"}}
+	p.SetPins(pins)
+	pins[0].Path = "default/p2"
+
+	ss := p.Pins()
+	assert.Equal(t, "default/p1", ss[0].Path)
+	ss[1].Path = "n2"
+	assert.Equal(t, "n1", p.Pins()[1].Path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type pinListener struct {
+	changed int
+	flipped []render.PinRes
+}
+
+func (l *pinListener) PinboardChanged([]render.PinRes) {
+	l.changed++
+}
+
+func (l *pinListener) PinTransitioned(r render.PinRes) {
+	l.flipped = append(l.flipped, r)
+}
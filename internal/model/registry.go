@@ -55,6 +55,90 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
 	},
+	"deprecations": {
+		DAO:      &dao.Deprecation{},
+		Renderer: &render.Deprecation{},
+	},
+	"upgrade": {
+		DAO:      &dao.Upgrade{},
+		Renderer: &render.Upgrade{},
+	},
+	"controlplane": {
+		DAO:      &dao.ControlPlane{},
+		Renderer: &render.ControlPlane{},
+	},
+	"configs": {
+		DAO:      &dao.ConfigCheck{},
+		Renderer: &render.ConfigCheck{},
+	},
+	"stats": {
+		DAO:      &dao.Stats{},
+		Renderer: &render.Stats{},
+	},
+	"pdbcoverage": {
+		DAO:      &dao.PDBCoverage{},
+		Renderer: &render.PDBCoverage{},
+	},
+	"podsecurity": {
+		DAO:      &dao.PodSecurity{},
+		Renderer: &render.PodSecurity{},
+	},
+	"find": {
+		DAO:      &dao.Find{},
+		Renderer: &render.Find{},
+	},
+	"children": {
+		DAO:      &dao.Children{},
+		Renderer: &render.Related{},
+	},
+	"related": {
+		DAO:      &dao.Related{},
+		Renderer: &render.Related{},
+	},
+	"applications": {
+		DAO:      &dao.Application{},
+		Renderer: &render.Application{},
+	},
+	"images": {
+		DAO:      &dao.Image{},
+		Renderer: &render.Image{},
+	},
+	"rollouts": {
+		DAO:      &dao.Rollout{},
+		Renderer: &render.Rollout{},
+	},
+	"audit": {
+		DAO:      &dao.Audit{},
+		Renderer: &render.Audit{},
+	},
+	"timelines": {
+		DAO:      &dao.Timeline{},
+		Renderer: &render.Timeline{},
+	},
+	"ordinals": {
+		DAO:      &dao.Ordinal{},
+		Renderer: &render.Ordinal{},
+	},
+	"nodepods": {
+		DAO:      &dao.NodePods{},
+		Renderer: &render.NodePods{},
+	},
+	"satokens": {
+		DAO:      &dao.SAToken{},
+		Renderer: &render.SAToken{},
+	},
+	"pinboard": {
+		DAO:      &dao.Pin{},
+		Renderer: &render.Pin{},
+	},
+	"tasks": {
+		DAO:      &dao.Task{},
+		Renderer: &render.Task{},
+	},
+	"sessions": {
+		DAO:      &dao.ExecSession{},
+		Renderer: &render.Session{},
+	},
 
 	// Core...
 	"v1/endpoints": {
@@ -81,6 +165,7 @@ var Registry = map[string]ResourceMeta{
 		TreeRenderer: &xray.Service{},
 	},
 	"v1/serviceaccounts": {
+		DAO:      &dao.ServiceAccount{},
 		Renderer: &render.ServiceAccount{},
 	},
 	"v1/persistentvolumes": {
@@ -167,6 +252,24 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
+	// Admission...
+	"admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.Webhook{},
+	},
+	"admissionregistration.k8s.io/v1beta1/mutatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.Webhook{},
+	},
+	"admissionregistration.k8s.io/v1/validatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.Webhook{},
+	},
+	"admissionregistration.k8s.io/v1/mutatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.Webhook{},
+	},
+
 	// RBAC...
 	"rbac.authorization.k8s.io/v1/clusterroles": {
 		DAO:      &dao.Rbac{},
@@ -182,3 +285,44 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.RoleBinding{},
 	},
 }
+
+func init() {
+	for _, gvr := range dao.FluxGVRs {
+		Registry[gvr] = ResourceMeta{
+			DAO:      &dao.Flux{},
+			Renderer: &render.Flux{},
+		}
+	}
+	for _, gvr := range dao.ArgoGVRs {
+		Registry[gvr] = ResourceMeta{
+			DAO:      &dao.Argo{},
+			Renderer: &render.Argo{},
+		}
+	}
+	for _, gvr := range dao.VPAGVRs {
+		Registry[gvr] = ResourceMeta{
+			DAO:      &dao.VerticalPodAutoscaler{},
+			Renderer: &render.VerticalPodAutoscaler{},
+		}
+	}
+	for _, v := range dao.IstioVersions {
+		Registry["networking.istio.io/"+v+"/virtualservices"] = ResourceMeta{
+			DAO:      &dao.Traffic{},
+			Renderer: &render.VirtualService{},
+		}
+		Registry["networking.istio.io/"+v+"/destinationrules"] = ResourceMeta{
+			DAO:      &dao.Traffic{},
+			Renderer: &render.DestinationRule{},
+		}
+		Registry["networking.istio.io/"+v+"/gateways"] = ResourceMeta{
+			DAO:      &dao.Traffic{},
+			Renderer: &render.IstioGateway{},
+		}
+	}
+	for _, v := range dao.GatewayAPIVersions {
+		Registry["gateway.networking.k8s.io/"+v+"/httproutes"] = ResourceMeta{
+			DAO:      &dao.Traffic{},
+			Renderer: &render.HTTPRoute{},
+		}
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -11,6 +12,9 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -28,6 +32,8 @@ type TableListener interface {
 
 // Table represents a table model.
 type Table struct {
+	Grouper
+
 	gvr         string
 	namespace   string
 	data        *render.TableData
@@ -35,6 +41,8 @@ type Table struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	instance    string
+	deprecated  map[string]bool
+	k8sVersion  string
 }
 
 // NewTable returns a new table model.
@@ -88,7 +96,7 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 }
 
 // Delete deletes a resource.
-func (t *Table) Delete(ctx context.Context, path string, cascade, force bool) error {
+func (t *Table) Delete(ctx context.Context, path string, opts dao.DeleteOptions) error {
 	meta, err := t.getMeta(ctx)
 	if err != nil {
 		return err
@@ -99,7 +107,7 @@ func (t *Table) Delete(ctx context.Context, path string, cascade, force bool) er
 		return fmt.Errorf("no nuker for %q", meta.DAO.GVR())
 	}
 
-	return nuker.Delete(path, cascade, force)
+	return nuker.Delete(path, opts)
 }
 
 // Describe describes a given resource.
@@ -214,7 +222,22 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 	if client.IsClusterScoped(t.namespace) {
 		ns = client.AllNamespaces
 	}
-	return a.List(ctx, ns)
+	nss, _ := ctx.Value(internal.KeyNamespaces).([]string)
+	if client.IsClusterScoped(t.namespace) || !client.IsAllNamespaces(ns) || len(nss) == 0 {
+		return a.List(ctx, ns)
+	}
+
+	// Namespace selectors are served off the cluster wide watch namespace index.
+	var oo []runtime.Object
+	for _, ns := range nss {
+		ll, err := a.List(ctx, ns)
+		if err != nil {
+			return oo, err
+		}
+		oo = append(oo, ll...)
+	}
+
+	return oo, nil
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
@@ -233,34 +256,28 @@ func (t *Table) reconcile(ctx context.Context) error {
 		log.Error().Err(err).Msg("Reconcile failed to list resource")
 	}
 
-	var rows render.Rows
-	if len(oo) > 0 {
-		if _, ok := meta.Renderer.(*render.Generic); ok {
-			table, ok := oo[0].(*metav1beta1.Table)
-			if !ok {
-				return fmt.Errorf("expecting a meta table but got %T", oo[0])
-			}
-			rows = make(render.Rows, len(table.Rows))
-			if err := genericHydrate(t.namespace, table, rows, meta.Renderer); err != nil {
-				return err
-			}
-		} else {
-			rows = make(render.Rows, len(oo))
-			if err := hydrate(t.namespace, oo, rows, meta.Renderer); err != nil {
-				return err
-			}
-		}
+	start := time.Now()
+	rows, err := renderRows(t.namespace, oo, meta.Renderer)
+	render.Renders.Record(t.gvr, time.Since(start), err != nil)
+	if err != nil {
+		return err
 	}
+	t.badgeDeprecated(ctx, oo, rows)
 
 	t.data.Mutex.Lock()
 	defer t.data.Mutex.Unlock()
 	// if labelSelector in place might as well clear the model data.
 	sel, ok := ctx.Value(internal.KeyLabels).(string)
-	if ok && sel != "" {
+	nss, _ := ctx.Value(internal.KeyNamespaces).([]string)
+	if ok && sel != "" || len(nss) > 0 {
 		t.data.Clear()
 	}
+	header := meta.Renderer.Header(t.namespace)
+	if cc := render.CustomColumns[t.gvr]; len(cc) > 0 {
+		header = customize(header, cc, oo, rows)
+	}
 	t.data.Update(rows)
-	t.data.Namespace, t.data.Header = t.namespace, meta.Renderer.Header(t.namespace)
+	t.data.Namespace, t.data.Header = t.namespace, header
 
 	return nil
 }
@@ -307,6 +324,23 @@ func (t *Table) fireTableLoadFailed(err error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func renderRows(ns string, oo []runtime.Object, re Renderer) (render.Rows, error) {
+	if len(oo) == 0 {
+		return nil, nil
+	}
+	if _, ok := re.(*render.Generic); ok {
+		table, ok := oo[0].(*metav1beta1.Table)
+		if !ok {
+			return nil, fmt.Errorf("expecting a meta table but got %T", oo[0])
+		}
+		rows := make(render.Rows, len(table.Rows))
+		return rows, genericHydrate(ns, table, rows, re)
+	}
+	rows := make(render.Rows, len(oo))
+
+	return rows, hydrate(ns, oo, rows, re)
+}
+
 func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error {
 	for i, o := range oo {
 		if err := re.Render(o, ns, &rr[i]); err != nil {
@@ -317,6 +351,122 @@ func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error
 	return nil
 }
 
+// BadgeDeprecated flags rows for resources applied with an api version
+// deprecated or removed on the current server.
+func (t *Table) badgeDeprecated(ctx context.Context, oo []runtime.Object, rr render.Rows) {
+	if len(oo) == 0 || len(oo) != len(rr) {
+		return
+	}
+	u, ok := oo[0].(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	if _, ok := client.DeprecatedKinds()[u.GetKind()]; !ok {
+		t.deprecated = nil
+		return
+	}
+	version := t.serverVersion(ctx)
+	if version == "" {
+		return
+	}
+
+	dd := make(map[string]bool, len(oo))
+	for i, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		key := string(u.GetUID()) + "@" + u.GetResourceVersion()
+		dep, ok := t.deprecated[key]
+		if !ok {
+			dep = isDeprecated(u, version)
+		}
+		dd[key], rr[i].Deprecated = dep, dep
+	}
+	t.deprecated = dd
+}
+
+func (t *Table) serverVersion(ctx context.Context) string {
+	if t.k8sVersion != "" {
+		return t.k8sVersion
+	}
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok || factory.Client() == nil {
+		return ""
+	}
+	info, err := factory.Client().ServerVersion()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to fetch server version")
+		return ""
+	}
+	t.k8sVersion = info.GitVersion
+
+	return t.k8sVersion
+}
+
+func isDeprecated(u *unstructured.Unstructured, version string) bool {
+	dep, ok := dao.DeprecationFor(u)
+	if !ok {
+		return false
+	}
+	switch dep.Status(version) {
+	case client.DeprecationDeprecated, client.DeprecationRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Customize decorates rows with user defined label or annotation columns.
+func customize(h render.HeaderRow, cc []render.CustomColumn, oo []runtime.Object, rr render.Rows) render.HeaderRow {
+	idx := render.CustomColumnIndex(h)
+	mm := objectMetas(oo)
+	for i := range rr {
+		var ll, aa map[string]string
+		if i < len(mm) && mm[i] != nil {
+			ll, aa = mm[i].GetLabels(), mm[i].GetAnnotations()
+		}
+		rr[i].Fields = render.CustomFields(rr[i].Fields, cc, idx, ll, aa)
+	}
+
+	return render.CustomHeaders(h, cc, idx)
+}
+
+func objectMetas(oo []runtime.Object) []metav1.Object {
+	if len(oo) == 1 {
+		if table, ok := oo[0].(*metav1beta1.Table); ok {
+			mm := make([]metav1.Object, 0, len(table.Rows))
+			for _, row := range table.Rows {
+				var m metav1.PartialObjectMetadata
+				if err := json.Unmarshal(row.Object.Raw, &m); err != nil {
+					mm = append(mm, nil)
+					continue
+				}
+				mm = append(mm, &m)
+			}
+			return mm
+		}
+	}
+
+	mm := make([]metav1.Object, 0, len(oo))
+	for _, o := range oo {
+		switch r := o.(type) {
+		case *render.PodWithMetrics:
+			o = r.Raw
+		case *render.NodeWithMetrics:
+			o = r.Raw
+		}
+		m, err := meta.Accessor(o)
+		if err != nil {
+			mm = append(mm, nil)
+			continue
+		}
+		mm = append(mm, m)
+	}
+
+	return mm
+}
+
 func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Renderer) error {
 	gr, ok := re.(*render.Generic)
 	if !ok {
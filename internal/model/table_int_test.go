@@ -32,7 +32,7 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 15, len(data.Header))
+	assert.Equal(t, 17, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
@@ -110,7 +110,7 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 14, len(rr[0].Fields))
+	assert.Equal(t, 16, len(rr[0].Fields))
 }
 
 func TestTableGenericHydrate(t *testing.T) {
@@ -140,6 +140,38 @@ func TestTableGenericHydrate(t *testing.T) {
 	assert.Equal(t, 2, len(rr[0].Fields))
 }
 
+func TestTableCustomize(t *testing.T) {
+	cc := []render.CustomColumn{
+		{Name: "APP", Label: "app"},
+		{Name: "RESTARTED", Annotation: "kubectl.kubernetes.io/restartedAt"},
+	}
+	oo := []runtime.Object{&render.PodWithMetrics{Raw: load(t, "p1")}}
+	rr := make([]render.Row, 1)
+	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
+
+	h := customize(render.Pod{}.Header("blee"), cc, oo, rr)
+	assert.Equal(t, 18, len(h))
+	assert.Equal(t, "APP", h[15].Name)
+	assert.Equal(t, "AGE", h[17].Name)
+	assert.Equal(t, 18, len(rr[0].Fields))
+	assert.Equal(t, "nginx", rr[0].Fields[15])
+	assert.Equal(t, "2019-12-31T12:26:47-07:00", rr[0].Fields[16])
+}
+
+func TestTableCustomizeGeneric(t *testing.T) {
+	tt := metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{{Name: "c1"}},
+		Rows: []metav1beta1.TableRow{
+			{Cells: []interface{}{"fred"}, Object: runtime.RawExtension{Raw: raw(t, "p1")}},
+		},
+	}
+	rr := render.Rows{{Fields: render.Fields{"fred"}}}
+
+	h := customize(render.HeaderRow{{Name: "C1"}}, []render.CustomColumn{{Name: "APP", Label: "app"}}, []runtime.Object{&tt}, rr)
+	assert.Equal(t, []string{"C1", "APP"}, h.Columns())
+	assert.Equal(t, render.Fields{"fred", "nginx"}, rr[0].Fields)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -231,3 +263,68 @@ func (a *accessor) Init(_ dao.Factory, gvr client.GVR) {
 func (a *accessor) GVR() string {
 	return a.gvr.String()
 }
+
+func TestBadgeDeprecated(t *testing.T) {
+	uu := map[string]struct {
+		apiVersion, kind, version string
+		e                         bool
+	}{
+		"current": {
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			version:    "v1.22.0",
+		},
+		"deprecated": {
+			apiVersion: "extensions/v1beta1",
+			kind:       "Ingress",
+			version:    "v1.16.0",
+			e:          true,
+		},
+		"upcoming": {
+			apiVersion: "extensions/v1beta1",
+			kind:       "Ingress",
+			version:    "v1.13.0",
+		},
+		"unknownVersion": {
+			apiVersion: "extensions/v1beta1",
+			kind:       "Ingress",
+		},
+		"otherKind": {
+			apiVersion: "v1",
+			kind:       "Pod",
+			version:    "v1.22.0",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{}
+			o.SetAPIVersion(u.apiVersion)
+			o.SetKind(u.kind)
+			o.SetName("fred")
+			rr := render.Rows{{Fields: render.Fields{"fred", "1"}}}
+			ta := NewTable("fred")
+			ta.k8sVersion = u.version
+			ta.badgeDeprecated(context.Background(), []runtime.Object{&o}, rr)
+			assert.Equal(t, u.e, rr[0].Deprecated)
+			assert.Equal(t, render.Fields{"fred", "1"}, rr[0].Fields)
+		})
+	}
+}
+
+func TestBadgeDeprecatedCached(t *testing.T) {
+	o := unstructured.Unstructured{}
+	o.SetAPIVersion("apps/v1")
+	o.SetKind("Deployment")
+	o.SetUID("u1")
+	o.SetResourceVersion("1")
+	ta := NewTable("apps/v1/deployments")
+	ta.k8sVersion = "v1.22.0"
+	ta.deprecated = map[string]bool{"u1@1": true, "u2@1": true}
+
+	rr := render.Rows{{Fields: render.Fields{"fred"}}}
+	ta.badgeDeprecated(context.Background(), []runtime.Object{&o}, rr)
+	assert.True(t, rr[0].Deprecated)
+	assert.Equal(t, map[string]bool{"u1@1": true}, ta.deprecated)
+}
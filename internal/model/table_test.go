@@ -32,13 +32,38 @@ func TestTableRefresh(t *testing.T) {
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
 	ta.Refresh(ctx)
 	data := ta.Peek()
-	assert.Equal(t, 15, len(data.Header))
+	assert.Equal(t, 17, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 	assert.Equal(t, 1, l.count)
 	assert.Equal(t, 0, l.errs)
 }
 
+func TestTableRefreshNamespaces(t *testing.T) {
+	ta := model.NewTable("v1/pods")
+	ta.SetNamespace(client.NamespaceAll)
+
+	l := tableListener{}
+	ta.AddListener(&l)
+	f := makeTableFactory()
+	p2 := mustLoad("p1")
+	p2.SetNamespace("fred")
+	f.nss = map[string][]runtime.Object{
+		"default": {mustLoad("p1")},
+		"fred":    {p2},
+		"blee":    {mustLoad("p1")},
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyNamespaces, []string{"default", "fred"})
+	ta.Refresh(ctx)
+	data := ta.Peek()
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, "default/nginx-7fb78fb6d8-2w75j", data.RowEvents[0].Row.ID)
+	assert.Equal(t, "fred/nginx-7fb78fb6d8-2w75j", data.RowEvents[1].Row.ID)
+	assert.Equal(t, 0, l.errs)
+}
+
 func TestTableNS(t *testing.T) {
 	ta := model.NewTable("v1/pods")
 	ta.SetNamespace("blee")
@@ -80,6 +105,7 @@ func (l *tableListener) TableLoadFailed(error) {
 
 type tableFactory struct {
 	rows []runtime.Object
+	nss  map[string][]runtime.Object
 }
 
 var _ dao.Factory = tableFactory{}
@@ -94,6 +120,9 @@ func (f tableFactory) Get(gvr, path string, wait bool, sel labels.Selector) (run
 	return nil, nil
 }
 func (f tableFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	if f.nss != nil {
+		return f.nss[ns], nil
+	}
 	if len(f.rows) > 0 {
 		return f.rows, nil
 	}
@@ -3,7 +3,6 @@ package model
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -278,15 +277,12 @@ func (t *Tree) getMeta(ctx context.Context, gvr string) (ResourceMeta, error) {
 // Helpers...
 
 func rxFilter(q, path string) bool {
-	rx := regexp.MustCompile(`(?i)` + q)
-
-	tokens := strings.Split(path, "::")
-	for _, t := range tokens {
-		if rx.MatchString(t) {
-			return true
-		}
+	f, err := NewFilter(q)
+	if err != nil {
+		return true
 	}
-	return false
+
+	return f.MatchAny(strings.Split(path, "::"))
 }
 
 func treeHydrate(ctx context.Context, ns string, oo []runtime.Object, re TreeRenderer) error {
@@ -21,9 +21,6 @@ const (
 	k9sUA     = "k9s/"
 )
 
-// K9sBenchDir directory to store K9s Benchmark files.
-var K9sBenchDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-bench-%s", config.MustK9sUser()))
-
 // Benchmark puts a workload under load.
 type Benchmark struct {
 	canceled bool
@@ -33,6 +30,10 @@ type Benchmark struct {
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, error) {
+	cfg, err := cfg.WithSecrets()
+	if err != nil {
+		return nil, err
+	}
 	b := Benchmark{config: cfg}
 	if err := b.init(base, version); err != nil {
 		return nil, err
@@ -91,20 +92,19 @@ func (b *Benchmark) Canceled() bool {
 }
 
 // Run starts a benchmark,
-func (b *Benchmark) Run(cluster string, done func()) {
+func (b *Benchmark) Run(dir string, done func()) {
 	buff := new(bytes.Buffer)
 	b.worker.Writer = buff
 	b.worker.Run()
 	if !b.canceled {
-		if err := b.save(cluster, buff); err != nil {
+		if err := b.save(dir, buff); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
 		}
 	}
 	done()
 }
 
-func (b *Benchmark) save(cluster string, r io.Reader) error {
-	dir := filepath.Join(K9sBenchDir, cluster)
+func (b *Benchmark) save(dir string, r io.Reader) error {
 	if err := os.MkdirAll(dir, 0744); err != nil {
 		return err
 	}
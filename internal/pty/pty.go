@@ -0,0 +1,30 @@
+// Package pty runs interactive commands attached to pseudo terminals.
+package pty
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+const pollRate = 100 * time.Millisecond
+
+// ErrUnsupported indicates pseudo terminals are not available on this platform.
+var ErrUnsupported = errors.New("pseudo terminals are not supported on this platform")
+
+// Terminal represents the controlling side of a pseudo terminal. Closing it
+// hangs up the attached command.
+type Terminal interface {
+	io.ReadWriteCloser
+
+	// Resize resizes the terminal. Zero sizes are ignored.
+	Resize(cols, rows uint16) error
+}
+
+func runInherited(cmd *exec.Cmd) error {
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	return cmd.Run()
+}
@@ -0,0 +1,104 @@
+package pty
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// Start runs a command attached to a new pseudo terminal and returns the
+// terminal master side.
+func Start(cmd *exec.Cmd, cols, rows uint16) (Terminal, error) {
+	ptm, pts, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer pts.Close()
+	if err := setSize(ptm, cols, rows); err != nil {
+		_ = ptm.Close()
+		return nil, err
+	}
+
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = pts, pts, pts
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	if err := cmd.Start(); err != nil {
+		_ = ptm.Close()
+		return nil, err
+	}
+
+	return &master{File: ptm}, nil
+}
+
+// Run runs an interactive command attached to the current terminal.
+func Run(cmd *exec.Cmd) error {
+	return runInherited(cmd)
+}
+
+type master struct {
+	*os.File
+}
+
+// Resize resizes the terminal.
+func (m *master) Resize(cols, rows uint16) error {
+	return setSize(m.File, cols, rows)
+}
+
+// open allocates a new pseudo terminal pair. The master side is non
+// blocking so closing it interrupts pending reads.
+func open() (*os.File, *os.File, error) {
+	fd, err := unix.Open("/dev/ptmx", unix.O_RDWR|unix.O_NOCTTY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		_ = unix.Close(fd)
+		return nil, nil, err
+	}
+	n, err := unix.IoctlGetInt(fd, unix.TIOCGPTN)
+	if err != nil {
+		_ = unix.Close(fd)
+		return nil, nil, err
+	}
+	ptm := os.NewFile(uintptr(fd), "/dev/ptmx")
+	pts, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		_ = ptm.Close()
+		return nil, nil, err
+	}
+
+	return ptm, pts, nil
+}
+
+func setSize(f *os.File, cols, rows uint16) error {
+	if cols == 0 || rows == 0 {
+		return nil
+	}
+	raw, err := f.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var ioErr error
+	err = raw.Control(func(fd uintptr) {
+		ioErr = unix.IoctlSetWinsize(int(fd), unix.TIOCSWINSZ, &unix.Winsize{
+			Row: rows,
+			Col: cols,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return ioErr
+}
+
+// Hangup signals the command process group its terminal went away.
+func Hangup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return unix.Kill(-cmd.Process.Pid, unix.SIGHUP)
+}
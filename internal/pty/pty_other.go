@@ -0,0 +1,27 @@
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package pty
+
+import (
+	"os/exec"
+)
+
+// Start runs a command attached to a new pseudo terminal.
+func Start(*exec.Cmd, uint16, uint16) (Terminal, error) {
+	return nil, ErrUnsupported
+}
+
+// Run runs an interactive command attached to the current terminal.
+func Run(cmd *exec.Cmd) error {
+	return runInherited(cmd)
+}
+
+// Hangup signals the command its terminal went away.
+func Hangup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return cmd.Process.Kill()
+}
@@ -0,0 +1,367 @@
+package pty
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	procThreadAttributePseudoConsole = 0x00020016
+	extendedStartupInfoPresent       = 0x00080000
+	resizeRate                       = 250 * time.Millisecond
+)
+
+// The pseudo console api ships with Windows 10 1809 and later.
+var (
+	kernel32                              = windows.NewLazySystemDLL("kernel32.dll")
+	procCreatePseudoConsole               = kernel32.NewProc("CreatePseudoConsole")
+	procResizePseudoConsole               = kernel32.NewProc("ResizePseudoConsole")
+	procClosePseudoConsole                = kernel32.NewProc("ClosePseudoConsole")
+	procInitializeProcThreadAttributeList = kernel32.NewProc("InitializeProcThreadAttributeList")
+	procUpdateProcThreadAttribute         = kernel32.NewProc("UpdateProcThreadAttribute")
+	procDeleteProcThreadAttributeList     = kernel32.NewProc("DeleteProcThreadAttributeList")
+)
+
+type startupInfoEx struct {
+	windows.StartupInfo
+	attrs *byte
+}
+
+// ConPTY represents a Windows pseudo console.
+type conPTY struct {
+	hpc  windows.Handle
+	in   *os.File
+	out  *os.File
+	once sync.Once
+}
+
+// Start runs a command attached to a new pseudo console and returns its
+// controlling side.
+func Start(cmd *exec.Cmd, cols, rows uint16) (Terminal, error) {
+	if err := procCreatePseudoConsole.Find(); err != nil {
+		return nil, ErrUnsupported
+	}
+	if cols == 0 || rows == 0 {
+		cols, rows = 80, 25
+	}
+
+	var inR, inW, outR, outW windows.Handle
+	if err := windows.CreatePipe(&inR, &inW, nil, 0); err != nil {
+		return nil, err
+	}
+	if err := windows.CreatePipe(&outR, &outW, nil, 0); err != nil {
+		closeHandles(inR, inW)
+		return nil, err
+	}
+	var hpc windows.Handle
+	r, _, _ := procCreatePseudoConsole.Call(coord(cols, rows), uintptr(inR), uintptr(outW), 0, uintptr(unsafe.Pointer(&hpc)))
+	// The console duplicates its ends of the pipes.
+	closeHandles(inR, outW)
+	if r != 0 {
+		closeHandles(inW, outR)
+		return nil, fmt.Errorf("pseudo console creation failed (0x%x)", r)
+	}
+	c := conPTY{
+		hpc: hpc,
+		in:  os.NewFile(uintptr(inW), "conpty-in"),
+		out: os.NewFile(uintptr(outR), "conpty-out"),
+	}
+
+	proc, err := spawn(cmd, hpc)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	go func() {
+		// Closing the console once the command exits flushes its output and
+		// ends pending reads as a unix terminal master does.
+		_, _ = windows.WaitForSingleObject(proc, windows.INFINITE)
+		_ = windows.CloseHandle(proc)
+		c.closeConsole()
+	}()
+
+	return &c, nil
+}
+
+// Read reads the console output.
+func (c *conPTY) Read(bb []byte) (int, error) {
+	return c.out.Read(bb)
+}
+
+// Write writes to the console input.
+func (c *conPTY) Write(bb []byte) (int, error) {
+	return c.in.Write(bb)
+}
+
+// Resize resizes the console.
+func (c *conPTY) Resize(cols, rows uint16) error {
+	if cols == 0 || rows == 0 {
+		return nil
+	}
+	if r, _, _ := procResizePseudoConsole.Call(uintptr(c.hpc), coord(cols, rows)); r != 0 {
+		return fmt.Errorf("pseudo console resize failed (0x%x)", r)
+	}
+
+	return nil
+}
+
+// Close closes the console, terminating the attached command.
+func (c *conPTY) Close() error {
+	c.closeConsole()
+	_ = c.in.Close()
+
+	return c.out.Close()
+}
+
+func (c *conPTY) closeConsole() {
+	c.once.Do(func() {
+		_, _, _ = procClosePseudoConsole.Call(uintptr(c.hpc))
+	})
+}
+
+// Run runs an interactive command through a pseudo console attached to the
+// current console so colors and resizes pass through. Older Windows without
+// pseudo consoles run the command on the current console.
+func Run(cmd *exec.Cmd) error {
+	cols, rows, err := TermSize(os.Stdout)
+	if err != nil {
+		return runInherited(cmd)
+	}
+	tty, err := Start(cmd, cols, rows)
+	if err == ErrUnsupported {
+		return runInherited(cmd)
+	}
+	if err != nil {
+		return err
+	}
+	defer tty.Close()
+	restore := enableVT(os.Stdout)
+	defer restore()
+
+	done, fwd, copied := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		_, _ = io.Copy(os.Stdout, tty)
+		close(copied)
+	}()
+	go func() {
+		_, _ = forward(os.Stdin, tty, -1, done)
+		close(fwd)
+	}()
+	go watchSize(tty, cols, rows, done)
+
+	err = cmd.Wait()
+	<-copied
+	close(done)
+	<-fwd
+
+	return err
+}
+
+// Hangup terminates the command.
+func Hangup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return cmd.Process.Kill()
+}
+
+// Forward copies the raw console input to w until the detach key is typed
+// or done is closed. It returns true when detached.
+func Forward(in *os.File, w io.Writer, detach byte, done <-chan struct{}) (bool, error) {
+	restore := enableVT(os.Stdout)
+	defer restore()
+
+	return forward(in, w, int(detach), done)
+}
+
+// TermSize returns the dimensions of the given console.
+func TermSize(f *os.File) (uint16, uint16, error) {
+	cols, rows, err := terminal.GetSize(int(f.Fd()))
+
+	return uint16(cols), uint16(rows), err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Forward copies the console input in raw mode. A negative detach key
+// disables detaching.
+func forward(in *os.File, w io.Writer, detach int, done <-chan struct{}) (bool, error) {
+	h := windows.Handle(in.Fd())
+	state, err := terminal.MakeRaw(int(h))
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = terminal.Restore(int(h), state)
+	}()
+	var mode uint32
+	if err := windows.GetConsoleMode(h, &mode); err != nil {
+		return false, err
+	}
+	// Virtual terminal input encodes special keys as escape sequences.
+	if err := windows.SetConsoleMode(h, mode|windows.ENABLE_VIRTUAL_TERMINAL_INPUT); err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = windows.SetConsoleMode(h, mode)
+	}()
+
+	buff := make([]byte, 1024)
+	for {
+		select {
+		case <-done:
+			return false, nil
+		default:
+		}
+		ev, err := windows.WaitForSingleObject(h, uint32(pollRate/time.Millisecond))
+		if err != nil {
+			return false, err
+		}
+		if ev != windows.WAIT_OBJECT_0 {
+			continue
+		}
+		n, err := in.Read(buff)
+		if err != nil {
+			return false, err
+		}
+		for i := 0; i < n; i++ {
+			if int(buff[i]) == detach {
+				_, err := w.Write(buff[:i])
+				return true, err
+			}
+		}
+		if _, err := w.Write(buff[:n]); err != nil {
+			return false, err
+		}
+	}
+}
+
+// WatchSize tracks the console size since Windows has no resize signal.
+func watchSize(t Terminal, cols, rows uint16, done <-chan struct{}) {
+	ticker := time.NewTicker(resizeRate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c, r, err := TermSize(os.Stdout)
+			if err != nil || (c == cols && r == rows) {
+				continue
+			}
+			cols, rows = c, r
+			_ = t.Resize(cols, rows)
+		}
+	}
+}
+
+// EnableVT lets the console interpret the escape sequences of the command
+// output.
+func enableVT(f *os.File) func() {
+	h := windows.Handle(f.Fd())
+	var mode uint32
+	if err := windows.GetConsoleMode(h, &mode); err != nil {
+		return func() {}
+	}
+	if err := windows.SetConsoleMode(h, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
+		return func() {}
+	}
+
+	return func() {
+		_ = windows.SetConsoleMode(h, mode)
+	}
+}
+
+func spawn(cmd *exec.Cmd, hpc windows.Handle) (windows.Handle, error) {
+	var size uintptr
+	_, _, _ = procInitializeProcThreadAttributeList.Call(0, 1, 0, uintptr(unsafe.Pointer(&size)))
+	attrs := make([]byte, size)
+	if r, _, err := procInitializeProcThreadAttributeList.Call(uintptr(unsafe.Pointer(&attrs[0])), 1, 0, uintptr(unsafe.Pointer(&size))); r == 0 {
+		return 0, err
+	}
+	defer func() {
+		_, _, _ = procDeleteProcThreadAttributeList.Call(uintptr(unsafe.Pointer(&attrs[0])))
+	}()
+	if r, _, err := procUpdateProcThreadAttribute.Call(uintptr(unsafe.Pointer(&attrs[0])), 0, procThreadAttributePseudoConsole, uintptr(hpc), unsafe.Sizeof(hpc), 0, 0); r == 0 {
+		return 0, err
+	}
+
+	si := startupInfoEx{attrs: &attrs[0]}
+	si.Cb = uint32(unsafe.Sizeof(si))
+	// Keeps the command off the K9s standard handles.
+	si.Flags = windows.STARTF_USESTDHANDLES
+	line, err := windows.UTF16PtrFromString(commandLine(cmd))
+	if err != nil {
+		return 0, err
+	}
+	var dir *uint16
+	if cmd.Dir != "" {
+		if dir, err = windows.UTF16PtrFromString(cmd.Dir); err != nil {
+			return 0, err
+		}
+	}
+	var pi windows.ProcessInformation
+	flags := uint32(extendedStartupInfoPresent | windows.CREATE_UNICODE_ENVIRONMENT)
+	err = windows.CreateProcess(nil, line, nil, nil, false, flags, envBlock(cmd.Env), dir, &si.StartupInfo, &pi)
+	runtime.KeepAlive(attrs)
+	if err != nil {
+		return 0, err
+	}
+	_ = windows.CloseHandle(pi.Thread)
+	if cmd.Process, err = os.FindProcess(int(pi.ProcessId)); err != nil {
+		_ = windows.TerminateProcess(pi.Process, 1)
+		_ = windows.CloseHandle(pi.Process)
+		return 0, err
+	}
+
+	return pi.Process, nil
+}
+
+func commandLine(cmd *exec.Cmd) string {
+	args := make([]string, 0, len(cmd.Args))
+	args = append(args, windows.EscapeArg(cmd.Path))
+	for _, a := range cmd.Args[1:] {
+		args = append(args, windows.EscapeArg(a))
+	}
+
+	return strings.Join(args, " ")
+}
+
+// EnvBlock returns a unicode environment block or nil to inherit the K9s
+// environment.
+func envBlock(env []string) *uint16 {
+	if env == nil {
+		return nil
+	}
+	var block []uint16
+	for _, e := range env {
+		block = append(block, utf16.Encode([]rune(e))...)
+		block = append(block, 0)
+	}
+	block = append(block, 0)
+
+	return &block[0]
+}
+
+func closeHandles(hh ...windows.Handle) {
+	for _, h := range hh {
+		_ = windows.CloseHandle(h)
+	}
+}
+
+func coord(cols, rows uint16) uintptr {
+	return uintptr(cols) | uintptr(rows)<<16
+}
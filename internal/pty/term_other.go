@@ -0,0 +1,20 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package pty
+
+import (
+	"io"
+	"os"
+)
+
+// Forward copies the raw terminal input to w until the detach key is
+// typed or done is closed. It returns true when detached.
+func Forward(*os.File, io.Writer, byte, <-chan struct{}) (bool, error) {
+	return false, ErrUnsupported
+}
+
+// TermSize returns the dimensions of the given terminal.
+func TermSize(*os.File) (uint16, uint16, error) {
+	return 0, 0, ErrUnsupported
+}
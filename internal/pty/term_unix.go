@@ -0,0 +1,62 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package pty
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/sys/unix"
+)
+
+// Forward copies the raw terminal input to w until the detach key is
+// typed or done is closed. It returns true when detached.
+func Forward(in *os.File, w io.Writer, detach byte, done <-chan struct{}) (bool, error) {
+	fd := int(in.Fd())
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = terminal.Restore(fd, state)
+	}()
+
+	buff := make([]byte, 1024)
+	ff := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+	for {
+		select {
+		case <-done:
+			return false, nil
+		default:
+		}
+		n, err := unix.Poll(ff, int(pollRate/time.Millisecond))
+		if err == unix.EINTR || n == 0 {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if n, err = unix.Read(fd, buff); err != nil {
+			return false, err
+		}
+		for i := 0; i < n; i++ {
+			if buff[i] == detach {
+				_, err := w.Write(buff[:i])
+				return true, err
+			}
+		}
+		if _, err := w.Write(buff[:n]); err != nil {
+			return false, err
+		}
+	}
+}
+
+// TermSize returns the dimensions of the given terminal.
+func TermSize(f *os.File) (uint16, uint16, error) {
+	cols, rows, err := terminal.GetSize(int(f.Fd()))
+
+	return uint16(cols), uint16(rows), err
+}
@@ -0,0 +1,314 @@
+package remote
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/pty"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultSSHAddress represents the default ssh listener address.
+const DefaultSSHAddress = ":2222"
+
+// Config represents a remote server configuration.
+type Config struct {
+	// Address to listen on.
+	Address string
+
+	// HostKey is the path to the server private key. It is generated
+	// when missing.
+	HostKey string
+
+	// AuthorizedKeys is the path to the public keys allowed to log in.
+	AuthorizedKeys string
+
+	// Command and Args spawn a K9s session for each connection.
+	Command string
+	Args    []string
+}
+
+// Server serves K9s sessions over ssh. Each connection gets its own K9s
+// process attached to a pseudo terminal, so the cluster credentials never
+// leave the host.
+type Server struct {
+	config   Config
+	sshCfg   *ssh.ServerConfig
+	listener net.Listener
+	mx       sync.Mutex
+}
+
+// NewServer returns a new ssh server.
+func NewServer(cfg Config) (*Server, error) {
+	if cfg.AuthorizedKeys == "" {
+		return nil, errors.New("an authorized keys file is required")
+	}
+	keys, err := LoadAuthorizedKeys(cfg.AuthorizedKeys)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := LoadHostKey(cfg.HostKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sshCfg := ssh.ServerConfig{PublicKeyCallback: keyCallback(keys)}
+	sshCfg.AddHostKey(signer)
+
+	return &Server{config: cfg, sshCfg: &sshCfg}, nil
+}
+
+// ListenAndServe accepts connections until the server is closed.
+func (s *Server) ListenAndServe() error {
+	l, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return err
+	}
+	s.mx.Lock()
+	s.listener = l
+	s.mx.Unlock()
+	log.Info().Msgf("K9s ssh server listening on %s", l.Addr())
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handle(conn)
+	}
+}
+
+// Close stops accepting connections.
+func (s *Server) Close() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Close()
+}
+
+func (s *Server) handle(c net.Conn) {
+	conn, chans, reqs, err := ssh.NewServerConn(c, s.sshCfg)
+	if err != nil {
+		log.Warn().Err(err).Msgf("SSH handshake failed from %s", c.RemoteAddr())
+		return
+	}
+	defer conn.Close()
+	log.Info().Msgf("SSH login %s from %s (%s)", conn.User(), conn.RemoteAddr(), conn.Permissions.Extensions[fingerprintExt])
+
+	go ssh.DiscardRequests(reqs)
+	for nc := range chans {
+		if nc.ChannelType() != "session" {
+			_ = nc.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		ch, creqs, err := nc.Accept()
+		if err != nil {
+			log.Error().Err(err).Msg("SSH channel accept failed")
+			continue
+		}
+		go s.session(conn.User(), ch, creqs)
+	}
+}
+
+func (s *Server) session(user string, ch ssh.Channel, reqs <-chan *ssh.Request) {
+	defer ch.Close()
+
+	var (
+		term = "xterm-256color"
+		size winSize
+		tty  pty.Terminal
+	)
+	for req := range reqs {
+		switch req.Type {
+		case "pty-req":
+			term, size = parsePtyReq(req.Payload)
+			_ = req.Reply(true, nil)
+		case "window-change":
+			size = parseWinSize(req.Payload)
+			if tty != nil {
+				if err := tty.Resize(uint16(size.Cols), uint16(size.Rows)); err != nil {
+					log.Warn().Err(err).Msg("SSH window resize")
+				}
+			}
+		case "shell":
+			if tty != nil {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			var err error
+			if tty, err = s.spawn(user, term, size, ch); err != nil {
+				log.Error().Err(err).Msg("SSH session spawn failed")
+				_, _ = fmt.Fprintf(ch.Stderr(), "K9s session failed: %s\r\n", err)
+				_ = req.Reply(false, nil)
+				return
+			}
+			_ = req.Reply(true, nil)
+		default:
+			// Exec, subsystems and env are not allowed. The session only ever
+			// runs K9s.
+			_ = req.Reply(false, nil)
+		}
+	}
+	if tty != nil {
+		_ = tty.Close()
+	}
+}
+
+func (s *Server) spawn(user, term string, size winSize, ch ssh.Channel) (pty.Terminal, error) {
+	cmd := exec.Command(s.config.Command, s.config.Args...)
+	cmd.Env = append(os.Environ(), "TERM="+term, config.RemoteUserEnv+"="+user)
+	tty, err := pty.Start(cmd, uint16(size.Cols), uint16(size.Rows))
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_, _ = io.Copy(tty, ch)
+	}()
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(ch, tty)
+		close(done)
+	}()
+	go func() {
+		status := 0
+		if err := cmd.Wait(); err != nil {
+			status = 1
+			if e, ok := err.(*exec.ExitError); ok {
+				status = e.ExitCode()
+			}
+		}
+		// Drain the terminal before reporting, the copy ends once the
+		// process is gone.
+		<-done
+		log.Info().Msgf("SSH session %s ended (%d)", user, status)
+		_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{uint32(status)}))
+		_ = ch.Close()
+	}()
+
+	return tty, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+const fingerprintExt = "fingerprint"
+
+type winSize struct {
+	Cols, Rows uint32
+}
+
+// LoadAuthorizedKeys loads public keys in authorized_keys format.
+func LoadAuthorizedKeys(path string) (map[string]struct{}, error) {
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{})
+	for i, l := range bytes.Split(bb, []byte("\n")) {
+		l = bytes.TrimSpace(l)
+		if len(l) == 0 || l[0] == '#' {
+			continue
+		}
+		k, _, _, _, err := ssh.ParseAuthorizedKey(l)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid authorized key %s:%d", path, i+1)
+			continue
+		}
+		keys[string(k.Marshal())] = struct{}{}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no authorized keys found in %s", path)
+	}
+
+	return keys, nil
+}
+
+// LoadHostKey loads the server private key, generating one if needed.
+func LoadHostKey(path string) (ssh.Signer, error) {
+	bb, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		if bb, err = generateHostKey(path); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.ParsePrivateKey(bb)
+}
+
+func generateHostKey(path string) ([]byte, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	bb := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Generated ssh host key %s", path)
+
+	return bb, ioutil.WriteFile(path, bb, 0600)
+}
+
+func keyCallback(keys map[string]struct{}) func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+	return func(c ssh.ConnMetadata, k ssh.PublicKey) (*ssh.Permissions, error) {
+		if _, ok := keys[string(k.Marshal())]; !ok {
+			return nil, fmt.Errorf("unknown public key for %s", c.User())
+		}
+
+		return &ssh.Permissions{
+			Extensions: map[string]string{fingerprintExt: ssh.FingerprintSHA256(k)},
+		}, nil
+	}
+}
+
+func parsePtyReq(bb []byte) (string, winSize) {
+	var req struct {
+		Term                      string
+		Cols, Rows, Width, Height uint32
+		Modes                     string
+	}
+	if err := ssh.Unmarshal(bb, &req); err != nil {
+		return "xterm-256color", winSize{}
+	}
+
+	return req.Term, winSize{Cols: req.Cols, Rows: req.Rows}
+}
+
+func parseWinSize(bb []byte) winSize {
+	if len(bb) < 8 {
+		return winSize{}
+	}
+
+	return winSize{
+		Cols: binary.BigEndian.Uint32(bb),
+		Rows: binary.BigEndian.Uint32(bb[4:]),
+	}
+}
@@ -0,0 +1,206 @@
+package remote
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestLoadAuthorizedKeys(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	k1, k2 := newPubKey(t), newPubKey(t)
+	uu := map[string]struct {
+		content string
+		count   int
+		err     bool
+	}{
+		"single": {
+			content: string(ssh.MarshalAuthorizedKey(k1)),
+			count:   1,
+		},
+		"many": {
+			content: "# team\n" + string(ssh.MarshalAuthorizedKey(k1)) + string(ssh.MarshalAuthorizedKey(k2)),
+			count:   2,
+		},
+		"badLine": {
+			content: string(ssh.MarshalAuthorizedKey(k1)) + "ssh-ed25519 garbage\n" + string(ssh.MarshalAuthorizedKey(k2)),
+			count:   2,
+		},
+		"empty": {
+			content: "# nobody\n",
+			err:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path := filepath.Join(dir, k)
+			assert.Nil(t, ioutil.WriteFile(path, []byte(u.content), 0600))
+			kk, err := LoadAuthorizedKeys(path)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.count, len(kk))
+		})
+	}
+}
+
+func TestLoadHostKey(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "keys", "host")
+	s1, err := LoadHostKey(path)
+	assert.Nil(t, err)
+	s2, err := LoadHostKey(path)
+	assert.Nil(t, err)
+	assert.Equal(t, s1.PublicKey().Marshal(), s2.PublicKey().Marshal())
+
+	fi, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+}
+
+func TestKeyCallback(t *testing.T) {
+	k1, k2 := newPubKey(t), newPubKey(t)
+	cb := keyCallback(map[string]struct{}{string(k1.Marshal()): {}})
+
+	p, err := cb(nil, k1)
+	assert.Nil(t, err)
+	assert.Equal(t, ssh.FingerprintSHA256(k1), p.Extensions[fingerprintExt])
+
+	_, err = cb(connMeta("fred"), k2)
+	assert.NotNil(t, err)
+}
+
+func TestParseWinSize(t *testing.T) {
+	uu := map[string]struct {
+		payload []byte
+		e       winSize
+	}{
+		"ok":    {payload: ssh.Marshal(winSize{Cols: 120, Rows: 40}), e: winSize{Cols: 120, Rows: 40}},
+		"short": {payload: []byte{0, 1}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, parseWinSize(u.payload))
+		})
+	}
+}
+
+func TestServerSession(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("pty sessions require linux")
+	}
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	signer, err := ssh.NewSignerFromKey(pk)
+	assert.Nil(t, err)
+	keys := filepath.Join(dir, "authorized_keys")
+	assert.Nil(t, ioutil.WriteFile(keys, ssh.MarshalAuthorizedKey(signer.PublicKey()), 0600))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := l.Addr().String()
+	l.Close()
+
+	s, err := NewServer(Config{
+		Address:        addr,
+		HostKey:        filepath.Join(dir, "host"),
+		AuthorizedKeys: keys,
+		Command:        "echo",
+		Args:           []string{"woof"},
+	})
+	assert.Nil(t, err)
+	go func() { _ = s.ListenAndServe() }()
+	defer s.Close()
+
+	var c *ssh.Client
+	for i := 0; i < 50; i++ {
+		if c, err = ssh.Dial("tcp", addr, clientConfig(signer)); err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Nil(t, err)
+	defer c.Close()
+
+	sess, err := c.NewSession()
+	assert.Nil(t, err)
+	assert.Nil(t, sess.RequestPty("xterm", 40, 120, ssh.TerminalModes{}))
+	assert.NotNil(t, sess.Run("rm -rf /"))
+
+	sess, err = c.NewSession()
+	assert.Nil(t, err)
+	assert.Nil(t, sess.RequestPty("xterm", 40, 120, ssh.TerminalModes{}))
+	var out bytes.Buffer
+	sess.Stdout = &out
+	assert.Nil(t, sess.Shell())
+	assert.Nil(t, sess.Wait())
+	assert.Equal(t, "woof", strings.TrimSpace(out.String()))
+
+	_, err = ssh.Dial("tcp", addr, clientConfig(newSigner(t)))
+	assert.NotNil(t, err)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type connMeta string
+
+func (c connMeta) User() string        { return string(c) }
+func (connMeta) SessionID() []byte     { return nil }
+func (connMeta) ClientVersion() []byte { return nil }
+func (connMeta) ServerVersion() []byte { return nil }
+func (connMeta) RemoteAddr() net.Addr  { return nil }
+func (connMeta) LocalAddr() net.Addr   { return nil }
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "k9s-remote")
+	assert.Nil(t, err)
+
+	return dir
+}
+
+func newSigner(t *testing.T) ssh.Signer {
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	s, err := ssh.NewSignerFromKey(pk)
+	assert.Nil(t, err)
+
+	return s
+}
+
+func newPubKey(t *testing.T) ssh.PublicKey {
+	return newSigner(t).PublicKey()
+}
+
+func clientConfig(s ssh.Signer) *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User:            "fred",
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(s)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         time.Second,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/gdamore/tcell"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +27,7 @@ func (Alias) Header(ns string) HeaderRow {
 		Header{Name: "RESOURCE"},
 		Header{Name: "COMMAND"},
 		Header{Name: "APIGROUP"},
+		Header{Name: "ARGS"},
 	}
 }
 
@@ -38,12 +40,13 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 	}
 
 	r.ID = a.GVR
-	gvr := client.NewGVR(a.GVR)
-	res, grp := gvr.RG()
+	raw, args := config.SplitAlias(a.GVR)
+	res, grp := client.NewGVR(raw).RG()
 	r.Fields = append(r.Fields,
 		res,
 		strings.Join(a.Aliases, ","),
 		grp,
+		strings.Join(args, " "),
 	)
 
 	return nil
@@ -54,6 +57,7 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 
 // AliasRes represents an alias resource.
 type AliasRes struct {
+	// GVR tracks the alias expansion, ie a gvr and optional command arguments.
 	GVR     string
 	Aliases []string
 }
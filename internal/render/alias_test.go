@@ -46,6 +46,7 @@ func TestAliasHeader(t *testing.T) {
 		render.Header{Name: "RESOURCE"},
 		render.Header{Name: "COMMAND"},
 		render.Header{Name: "APIGROUP"},
+		render.Header{Name: "ARGS"},
 	}
 
 	var a render.Alias
@@ -63,7 +64,20 @@ func TestAliasRender(t *testing.T) {
 
 	var r render.Row
 	assert.Nil(t, a.Render(o, "fred/v1/blee", &r))
-	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred"}}, r)
+	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred", ""}}, r)
+}
+
+func TestAliasRenderArgs(t *testing.T) {
+	a := render.Alias{}
+
+	o := render.AliasRes{
+		GVR:     "v1/pods prod -l app=web",
+		Aliases: []string{"prodweb"},
+	}
+
+	var r render.Row
+	assert.Nil(t, a.Render(o, "aliases", &r))
+	assert.Equal(t, render.Row{ID: "v1/pods prod -l app=web", Fields: render.Fields{"pods", "prodweb", "", "prod -l app=web"}}, r)
 }
 
 func BenchmarkAlias(b *testing.B) {
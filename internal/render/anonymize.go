@@ -0,0 +1,173 @@
+package render
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strings"
+)
+
+// Anonymous masks resource names, namespaces, addresses and image
+// registries with stable pseudonyms when set.
+var Anonymous bool
+
+var (
+	pseudoAdjectives = []string{
+		"amber", "brave", "calm", "dusty", "eager", "fuzzy", "gentle", "happy",
+		"icy", "jolly", "keen", "lucky", "misty", "noble", "proud", "quiet",
+	}
+	pseudoNouns = []string{
+		"badger", "comet", "dingo", "falcon", "gecko", "heron", "ibex", "koala",
+		"lynx", "marmot", "newt", "otter", "panda", "raven", "tapir", "walrus",
+	}
+	wellKnownNamespaces = map[string]struct{}{
+		"default":         {},
+		"kube-system":     {},
+		"kube-public":     {},
+		"kube-node-lease": {},
+	}
+)
+
+// AnonymizeRows returns masked copies of the rows based on the header
+// columns. Row IDs are preserved so actions still target real resources.
+func AnonymizeRows(h HeaderRow, rr RowEvents) RowEvents {
+	mm := make(RowEvents, 0, len(rr))
+	for _, re := range rr {
+		re = re.Clone()
+		for col := range re.Row.Fields {
+			if col >= len(h) {
+				break
+			}
+			re.Row.Fields[col] = AnonymizeField(h[col].Name, re.Row.Fields[col])
+			if col < len(re.Deltas) && re.Deltas[col] != "" {
+				re.Deltas[col] = AnonymizeField(h[col].Name, re.Deltas[col])
+			}
+		}
+		mm = append(mm, re)
+	}
+
+	return mm
+}
+
+// AnonymizeField masks a field value given its column name.
+func AnonymizeField(col, s string) string {
+	switch col {
+	case "NAMESPACE":
+		return AnonymizeNamespace(s)
+	case "NAME", "NODE", "NOMINATED NODE":
+		return AnonymizeName(s)
+	case "IP", "CLUSTER-IP", "EXTERNAL-IP", "INTERNAL-IP", "SOURCE-IP", "ENDPOINTS", "ADDRESS", "HOST", "HOSTS", "HOSTNAMES":
+		return mapTokens(s, AnonymizeAddress)
+	case "IMAGE", "IMAGES":
+		return mapTokens(s, AnonymizeImage)
+	default:
+		return s
+	}
+}
+
+// AnonymizePath masks a namespace/name resource path.
+func AnonymizePath(path string) string {
+	if path == "" {
+		return path
+	}
+	tokens := strings.Split(path, "/")
+	if len(tokens) == 1 {
+		return AnonymizeName(path)
+	}
+
+	return AnonymizeNamespace(tokens[0]) + "/" + AnonymizeName(strings.Join(tokens[1:], "/"))
+}
+
+// AnonymizeNamespace masks a namespace. Well known namespaces are kept.
+func AnonymizeNamespace(ns string) string {
+	if unset(ns) {
+		return ns
+	}
+	if _, ok := wellKnownNamespaces[ns]; ok {
+		return ns
+	}
+
+	return "ns-" + pseudonym(ns)
+}
+
+// AnonymizeName masks a resource name.
+func AnonymizeName(n string) string {
+	if unset(n) {
+		return n
+	}
+
+	return pseudonym(n)
+}
+
+// AnonymizeAddress masks an ip, ip:port or host name.
+func AnonymizeAddress(s string) string {
+	if unset(s) || strings.HasPrefix(s, "<") {
+		return s
+	}
+	if host, port, err := net.SplitHostPort(s); err == nil {
+		return net.JoinHostPort(AnonymizeAddress(host), port)
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		h := pseudoHash(s)
+		if ip.To4() == nil {
+			return fmt.Sprintf("fd00::%x:%x", h>>16, h&0xffff)
+		}
+		return fmt.Sprintf("10.%d.%d.%d", byte(h>>16), byte(h>>8), byte(h))
+	}
+
+	return pseudonym(s) + ".example.com"
+}
+
+// AnonymizeImage masks an image registry, keeping the image name and tag.
+func AnonymizeImage(img string) string {
+	tokens := strings.Split(img, "/")
+	if len(tokens) < 2 || !isRegistry(tokens[0]) {
+		return img
+	}
+
+	return "registry-" + pseudonym(strings.Join(tokens[:len(tokens)-1], "/")) + ".example.com/" + tokens[len(tokens)-1]
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func unset(s string) bool {
+	return s == "" || s == NAValue || s == MissingValue
+}
+
+func isRegistry(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
+
+// MapTokens masks each value in a comma separated list. Trailing text such as
+// "+ 3 more..." is left as is.
+func mapTokens(s string, f func(string) string) string {
+	tokens := strings.Split(s, ",")
+	for i, t := range tokens {
+		t = strings.TrimSpace(t)
+		if j := strings.Index(t, " "); j > 0 {
+			tokens[i] = f(t[:j]) + t[j:]
+			continue
+		}
+		tokens[i] = f(t)
+	}
+
+	return strings.Join(tokens, ",")
+}
+
+func pseudoHash(s string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+
+	return h.Sum32()
+}
+
+// Pseudonym returns a stable readable alias for a value.
+func pseudonym(s string) string {
+	h := pseudoHash(s)
+	return fmt.Sprintf("%s-%s-%04x",
+		pseudoAdjectives[h%uint32(len(pseudoAdjectives))],
+		pseudoNouns[(h>>4)%uint32(len(pseudoNouns))],
+		h>>16,
+	)
+}
@@ -0,0 +1,50 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizeField(t *testing.T) {
+	uu := map[string]struct {
+		col, s, e string
+	}{
+		"name":      {col: "NAME", s: "fred", e: "icy-gecko-98b3"},
+		"none":      {col: "NODE", s: render.MissingValue, e: render.MissingValue},
+		"namespace": {col: "NAMESPACE", s: "fred", e: "ns-icy-gecko-98b3"},
+		"wellKnown": {col: "NAMESPACE", s: "kube-system", e: "kube-system"},
+		"ip":        {col: "IP", s: "10.1.2.3", e: "10.135.217.10"},
+		"endpoints": {col: "ENDPOINTS", s: "10.1.2.3:80,10.1.2.4:80 + 3 more...", e: "10.135.217.10:80,10.135.209.43:80 + 3 more..."},
+		"pending":   {col: "EXTERNAL-IP", s: "<pending>", e: "<pending>"},
+		"host":      {col: "HOSTS", s: "lb.acme.com", e: "calm-badger-3c55.example.com"},
+		"registry":  {col: "IMAGES", s: "gcr.io/proj/nginx:1.19,nginx:1.2", e: "registry-happy-badger-0cec.example.com/nginx:1.19,nginx:1.2"},
+		"unmasked":  {col: "STATUS", s: "Running", e: "Running"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.AnonymizeField(u.col, u.s))
+		})
+	}
+}
+
+func TestAnonymizePath(t *testing.T) {
+	assert.Equal(t, "ns-icy-gecko-98b3/icy-gecko-98b3", render.AnonymizePath("fred/fred"))
+	assert.Equal(t, "icy-gecko-98b3", render.AnonymizePath("fred"))
+	assert.Equal(t, "", render.AnonymizePath(""))
+}
+
+func TestAnonymizeRows(t *testing.T) {
+	h := render.HeaderRow{{Name: "NAMESPACE"}, {Name: "NAME"}, {Name: "STATUS"}}
+	rr := render.RowEvents{
+		{Row: render.Row{ID: "fred/blee", Fields: render.Fields{"fred", "blee", "Running"}}},
+	}
+
+	mm := render.AnonymizeRows(h, rr)
+	assert.Equal(t, "fred/blee", mm[0].Row.ID)
+	assert.Equal(t, render.Fields{"ns-icy-gecko-98b3", render.AnonymizeName("blee"), "Running"}, mm[0].Row.Fields)
+	assert.Equal(t, render.Fields{"fred", "blee", "Running"}, rr[0].Row.Fields)
+}
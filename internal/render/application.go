@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Application renders workloads grouped by application to screen.
+type Application struct{}
+
+// ColorerFunc colors a resource row.
+func (Application) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		readyCol, versionsCol := 2, 4
+		if client.IsAllNamespaces(ns) {
+			readyCol++
+			versionsCol++
+		}
+		tokens := strings.Split(re.Row.Fields[readyCol], "/")
+		if len(tokens) == 2 && tokens[0] != tokens[1] {
+			return ErrColor
+		}
+		if strings.Contains(re.Row.Fields[versionsCol], ",") {
+			return ModColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Application) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "WORKLOADS"},
+		Header{Name: "READY", Align: tview.AlignRight},
+		Header{Name: "RESTARTS", Align: tview.AlignRight},
+		Header{Name: "VERSIONS"},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (Application) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(ApplicationRes)
+	if !ok {
+		return fmt.Errorf("expected ApplicationRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(a.Namespace, a.Name)
+	r.Fields = make(Fields, 0, 6)
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, a.Namespace)
+	}
+	r.Fields = append(r.Fields,
+		a.Name,
+		missing(strings.Join(a.Workloads, ",")),
+		strconv.Itoa(a.Ready)+"/"+strconv.Itoa(a.Pods),
+		strconv.Itoa(a.Restarts),
+		missing(strings.Join(a.Versions, ",")),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ApplicationRes represents workloads and pods sharing an application label.
+type ApplicationRes struct {
+	Namespace, Name string
+	Workloads       []string
+	Pods, Ready     int
+	Restarts        int
+	Versions        []string
+}
+
+// GetObjectKind returns a schema object.
+func (ApplicationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a ApplicationRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,47 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.ApplicationRes
+		ns string
+		e  render.Fields
+	}{
+		"namespaced": {
+			o: render.ApplicationRes{
+				Namespace: "default",
+				Name:      "fred",
+				Workloads: []string{"Deployment/fred", "StatefulSet/fred-db"},
+				Pods:      3,
+				Ready:     2,
+				Restarts:  5,
+				Versions:  []string{"1.0", "1.1"},
+			},
+			ns: "default",
+			e:  render.Fields{"fred", "Deployment/fred,StatefulSet/fred-db", "2/3", "5", "1.0,1.1"},
+		},
+		"allNS": {
+			o:  render.ApplicationRes{Namespace: "default", Name: "fred"},
+			ns: "",
+			e:  render.Fields{"default", "fred", render.MissingValue, "0/0", "0", render.MissingValue},
+		},
+	}
+
+	var re render.Application
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, u.ns, &r))
+			assert.Equal(t, "default/fred", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, len(re.Header(u.ns)), len(r.Fields))
+		})
+	}
+}
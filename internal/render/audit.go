@@ -0,0 +1,112 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AuditTimeFormat represents a sortable audit event time.
+const AuditTimeFormat = "2006-01-02 15:04:05.000"
+
+// Audit renders audit events to screen.
+type Audit struct{}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if code, err := strconv.Atoi(re.Row.Fields[7]); err == nil && code >= 400 {
+			return ErrColor
+		}
+		switch re.Row.Fields[2] {
+		case "create":
+			return AddColor
+		case "delete", "deletecollection":
+			return KillColor
+		case "update", "patch":
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "TIME"},
+		Header{Name: "USER"},
+		Header{Name: "VERB"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "STAGE"},
+		Header{Name: "CODE", Align: tview.AlignRight},
+		Header{Name: "SOURCE-IP"},
+		Header{Name: "URI"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Audit) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expected AuditRes, but got %T", o)
+	}
+
+	code := NAValue
+	if a.Code > 0 {
+		code = strconv.Itoa(a.Code)
+	}
+	r.ID = a.ID
+	r.Fields = Fields{
+		a.Time.Format(AuditTimeFormat),
+		missing(a.User),
+		a.Verb,
+		missing(a.Resource),
+		missing(a.Namespace),
+		missing(a.Name),
+		a.Stage,
+		code,
+		missing(a.SourceIP),
+		a.URI,
+		toAge(metav1.NewTime(a.Time)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditRes represents an audit event.
+type AuditRes struct {
+	ID        string
+	Time      time.Time
+	User      string
+	Verb      string
+	Resource  string
+	Namespace string
+	Name      string
+	Stage     string
+	Code      int
+	SourceIP  string
+	URI       string
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
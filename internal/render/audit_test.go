@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	at := time.Date(2020, 3, 1, 14, 32, 0, 123e6, time.Local)
+	uu := map[string]struct {
+		o render.AuditRes
+		e render.Fields
+	}{
+		"full": {
+			o: render.AuditRes{ID: "a1:ResponseComplete", Time: at, User: "bob", Verb: "delete", Resource: "pods", Namespace: "default", Name: "fred", Stage: "ResponseComplete", Code: 200, SourceIP: "10.0.0.1", URI: "/api/v1/namespaces/default/pods/fred"},
+			e: render.Fields{"2020-03-01 14:32:00.123", "bob", "delete", "pods", "default", "fred", "ResponseComplete", "200", "10.0.0.1", "/api/v1/namespaces/default/pods/fred"},
+		},
+		"nonResource": {
+			o: render.AuditRes{ID: "a2:RequestReceived", Time: at, User: "bob", Verb: "get", Stage: "RequestReceived", URI: "/healthz"},
+			e: render.Fields{"2020-03-01 14:32:00.123", "bob", "get", render.MissingValue, render.MissingValue, render.MissingValue, "RequestReceived", render.NAValue, render.MissingValue, "/healthz"},
+		},
+	}
+
+	var re render.Audit
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields[:10])
+			assert.Equal(t, len(re.Header("")), len(r.Fields))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ConfigCheck renders K9s configuration files problems to screen.
+type ConfigCheck struct{}
+
+// ColorerFunc colors a resource row.
+func (ConfigCheck) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return ErrColor
+	}
+}
+
+// Header returns a header row.
+func (ConfigCheck) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "FILE"},
+		Header{Name: "LINE", Align: tview.AlignRight},
+		Header{Name: "PROBLEM"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ConfigCheck) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(ConfigCheckRes)
+	if !ok {
+		return fmt.Errorf("expected ConfigCheckRes, but got %T", o)
+	}
+
+	line := NAValue
+	if c.Line > 0 {
+		line = strconv.Itoa(c.Line)
+	}
+	r.ID = fmt.Sprintf("%s:%d:%d", c.File, c.Line, c.Index)
+	r.Fields = Fields{
+		c.File,
+		line,
+		c.Message,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ConfigCheckRes represents a configuration file problem.
+type ConfigCheckRes struct {
+	config.Diagnostic
+
+	Index int
+}
+
+// GetObjectKind returns a schema object.
+func (ConfigCheckRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ConfigCheckRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+
+	k9sconfig "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigCheckRender(t *testing.T) {
+	uu := map[string]struct {
+		d  k9sconfig.Diagnostic
+		id string
+		e  render.Fields
+	}{
+		"line": {
+			d:  k9sconfig.Diagnostic{File: "/k9s/plugin.yml", Line: 3, Message: "boom"},
+			id: "/k9s/plugin.yml:3:1",
+			e:  render.Fields{"/k9s/plugin.yml", "3", "boom"},
+		},
+		"noLine": {
+			d:  k9sconfig.Diagnostic{File: "/k9s/plugin.yml", Message: "boom"},
+			id: "/k9s/plugin.yml:0:1",
+			e:  render.Fields{"/k9s/plugin.yml", "n/a", "boom"},
+		},
+	}
+
+	var re render.ConfigCheck
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(3)
+			assert.Nil(t, re.Render(render.ConfigCheckRes{Index: 1, Diagnostic: u.d}, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// HealthOK indicates a control plane check passed.
+	HealthOK = "OK"
+
+	// HealthFailed indicates a control plane check failed.
+	HealthFailed = "Failed"
+)
+
+const (
+	// SourceComponent tracks component statuses checks.
+	SourceComponent = "component"
+
+	// SourceReadyz tracks api server readiness checks.
+	SourceReadyz = "readyz"
+
+	// SourceLivez tracks api server liveness checks.
+	SourceLivez = "livez"
+
+	// SourceFlag tracks api server flags.
+	SourceFlag = "flag"
+
+	// SourceFeatureGate tracks api server feature gates.
+	SourceFeatureGate = "feature-gate"
+)
+
+// ControlPlane renders control plane components health to screen.
+type ControlPlane struct{}
+
+// ColorerFunc colors a resource row.
+func (ControlPlane) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[2] {
+		case HealthFailed:
+			return ErrColor
+		case HealthOK:
+			return StdColor
+		default:
+			return CompletedColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (ControlPlane) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "SOURCE"},
+		Header{Name: "NAME"},
+		Header{Name: "STATUS"},
+		Header{Name: "DETAIL"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ControlPlane) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(ControlPlaneRes)
+	if !ok {
+		return fmt.Errorf("expected ControlPlaneRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(c.Source, c.Name)
+	r.Fields = Fields{
+		c.Source,
+		c.Name,
+		missing(c.Status),
+		c.Detail,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ControlPlaneRes represents a control plane health check or setting.
+type ControlPlaneRes struct {
+	Source, Name   string
+	Status, Detail string
+}
+
+// GetObjectKind returns a schema object.
+func (ControlPlaneRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ControlPlaneRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,65 @@
+package render
+
+// CustomColumn represents a user defined column extracting a resource label
+// or annotation value.
+type CustomColumn struct {
+	Name       string
+	Label      string
+	Annotation string
+}
+
+// Value returns the column value for the given labels and annotations.
+func (c CustomColumn) Value(labels, annotations map[string]string) string {
+	var (
+		v  string
+		ok bool
+	)
+	if c.Annotation != "" {
+		v, ok = annotations[c.Annotation]
+	}
+	if !ok && c.Label != "" {
+		v, ok = labels[c.Label]
+	}
+	if !ok || v == "" {
+		return MissingValue
+	}
+
+	return v
+}
+
+// CustomColumns tracks user defined columns keyed by resource gvr.
+var CustomColumns map[string][]CustomColumn
+
+// CustomColumnIndex returns where custom columns go, ahead of the age column if any.
+func CustomColumnIndex(h HeaderRow) int {
+	if h.AgeCol(len(h) - 1) {
+		return len(h) - 1
+	}
+
+	return len(h)
+}
+
+// CustomHeaders inserts custom columns headers at the given index.
+func CustomHeaders(h HeaderRow, cc []CustomColumn, index int) HeaderRow {
+	hh := make(HeaderRow, 0, len(h)+len(cc))
+	hh = append(hh, h[:index]...)
+	for _, c := range cc {
+		hh = append(hh, Header{Name: c.Name})
+	}
+
+	return append(hh, h[index:]...)
+}
+
+// CustomFields inserts custom columns values at the given index.
+func CustomFields(ff Fields, cc []CustomColumn, index int, labels, annotations map[string]string) Fields {
+	if index > len(ff) {
+		index = len(ff)
+	}
+	nf := make(Fields, 0, len(ff)+len(cc))
+	nf = append(nf, ff[:index]...)
+	for _, c := range cc {
+		nf = append(nf, c.Value(labels, annotations))
+	}
+
+	return append(nf, ff[index:]...)
+}
@@ -0,0 +1,46 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomColumnValue(t *testing.T) {
+	ll := map[string]string{"team": "blue"}
+	aa := map[string]string{"example.com/sha": "1234abc", "team": "red"}
+
+	uu := map[string]struct {
+		c render.CustomColumn
+		e string
+	}{
+		"label":      {c: render.CustomColumn{Name: "TEAM", Label: "team"}, e: "blue"},
+		"annotation": {c: render.CustomColumn{Name: "SHA", Annotation: "example.com/sha"}, e: "1234abc"},
+		"precedence": {c: render.CustomColumn{Name: "TEAM", Label: "team", Annotation: "team"}, e: "red"},
+		"fallback":   {c: render.CustomColumn{Name: "TEAM", Label: "team", Annotation: "owner"}, e: "blue"},
+		"missing":    {c: render.CustomColumn{Name: "OWNER", Label: "owner"}, e: render.MissingValue},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.c.Value(ll, aa))
+		})
+	}
+}
+
+func TestCustomColumnsInsert(t *testing.T) {
+	cc := []render.CustomColumn{{Name: "TEAM", Label: "team"}, {Name: "SHA", Annotation: "sha"}}
+
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "READY"}, {Name: "AGE"}}
+	idx := render.CustomColumnIndex(h)
+	assert.Equal(t, 2, idx)
+	assert.Equal(t, []string{"NAME", "READY", "TEAM", "SHA", "AGE"}, render.CustomHeaders(h, cc, idx).Columns())
+
+	ff := render.CustomFields(render.Fields{"fred", "1/1", "2m"}, cc, idx, map[string]string{"team": "blue"}, nil)
+	assert.Equal(t, render.Fields{"fred", "1/1", "blue", render.MissingValue, "2m"}, ff)
+
+	h = render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}}
+	assert.Equal(t, 2, render.CustomColumnIndex(h))
+}
@@ -1,5 +1,10 @@
 package render
 
+import "time"
+
+// DeltaHighlight tracks how long changed cells remain highlighted.
+var DeltaHighlight = 5 * time.Second
+
 // DeltaRow represents a collection of row detlas between old and new row.
 type DeltaRow []string
 
@@ -44,3 +49,51 @@ func (d DeltaRow) Clone() DeltaRow {
 
 	return res
 }
+
+// ----------------------------------------------------------------------------
+
+// ChangeRow tracks when each row cell last changed.
+type ChangeRow []time.Time
+
+// NewChangeRow records the cells changed by a delta, carrying over previous
+// changes still being highlighted.
+func NewChangeRow(prev ChangeRow, d DeltaRow, now time.Time) ChangeRow {
+	var cc ChangeRow
+	for i, v := range d {
+		var at time.Time
+		switch {
+		case v != "":
+			at = now
+		case i < len(prev) && now.Sub(prev[i]) < DeltaHighlight:
+			at = prev[i]
+		default:
+			continue
+		}
+		if cc == nil {
+			cc = make(ChangeRow, len(d))
+		}
+		cc[i] = at
+	}
+
+	return cc
+}
+
+// Changed checks if a given cell changed recently.
+func (c ChangeRow) Changed(col int, now time.Time) bool {
+	if col >= len(c) || c[col].IsZero() {
+		return false
+	}
+
+	return now.Sub(c[col]) < DeltaHighlight
+}
+
+// Clone returns a changes copy.
+func (c ChangeRow) Clone() ChangeRow {
+	if c == nil {
+		return nil
+	}
+	res := make(ChangeRow, len(c))
+	copy(res, c)
+
+	return res
+}
@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -88,3 +89,20 @@ func TestDeltaBlank(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeRow(t *testing.T) {
+	now := time.Now()
+	cc := render.NewChangeRow(nil, render.DeltaRow{"", "1", ""}, now)
+	assert.False(t, cc.Changed(0, now))
+	assert.True(t, cc.Changed(1, now))
+	assert.False(t, cc.Changed(5, now))
+
+	later := now.Add(time.Second)
+	cc = render.NewChangeRow(cc, render.DeltaRow{"a", "", ""}, later)
+	assert.True(t, cc.Changed(0, later))
+	assert.True(t, cc.Changed(1, later))
+
+	expired := now.Add(render.DeltaHighlight)
+	assert.False(t, cc.Changed(1, expired))
+	assert.Nil(t, render.NewChangeRow(cc, render.DeltaRow{"", "", ""}, expired.Add(time.Second)))
+}
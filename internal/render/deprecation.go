@@ -0,0 +1,92 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Deprecation renders resources using deprecated api versions to screen.
+type Deprecation struct{}
+
+// ColorerFunc colors a resource row.
+func (Deprecation) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[7] {
+		case client.DeprecationRemoved:
+			return ErrColor
+		case client.DeprecationDeprecated:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Deprecation) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "API VERSION"},
+		Header{Name: "REPLACEMENT"},
+		Header{Name: "DEPRECATED"},
+		Header{Name: "REMOVED"},
+		Header{Name: "STATUS"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Deprecation) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DeprecationRes)
+	if !ok {
+		return fmt.Errorf("expected DeprecationRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(d.Path)
+	if client.IsClusterScoped(rns) {
+		rns = NAValue
+	}
+	status := d.Deprecation.Status(d.ServerVersion)
+	if status == "" {
+		status = NAValue
+	}
+	r.ID = client.FQN(d.GVR, d.Path)
+	r.Fields = Fields{
+		rns,
+		n,
+		client.NewGVR(d.GVR).R(),
+		d.Deprecation.APIVersion,
+		d.Deprecation.Replacement,
+		d.Deprecation.DeprecatedIn,
+		d.Deprecation.RemovedIn,
+		status,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DeprecationRes represents a resource using a deprecated api version.
+type DeprecationRes struct {
+	GVR           string
+	Path          string
+	Deprecation   client.Deprecation
+	ServerVersion string
+}
+
+// GetObjectKind returns a schema object.
+func (DeprecationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DeprecationRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationRender(t *testing.T) {
+	d, _ := client.DeprecationFor("extensions/v1beta1", "Ingress")
+	o := render.DeprecationRes{
+		GVR:           "extensions/v1beta1/ingresses",
+		Path:          "default/fred",
+		Deprecation:   d,
+		ServerVersion: "v1.16.0",
+	}
+
+	var re render.Deprecation
+	r := render.NewRow(8)
+	assert.Nil(t, re.Render(o, "", &r))
+
+	assert.Equal(t, "extensions/v1beta1/ingresses/default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "ingresses", "extensions/v1beta1", "networking.k8s.io/v1", "1.14", "1.22", "Deprecated"}, r.Fields)
+}
@@ -0,0 +1,95 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ExtendedResources lists extended resources (ie nvidia.com/gpu, hugepages-2Mi)
+// shown as optional node and pod columns.
+var ExtendedResources []string
+
+// ExtendedHeader returns the column header for an extended resource.
+func ExtendedHeader(res string) string {
+	if i := strings.LastIndex(res, "/"); i >= 0 {
+		res = res[i+1:]
+	}
+
+	return strings.ToUpper(res)
+}
+
+func extendedHeaders() HeaderRow {
+	hh := make(HeaderRow, 0, len(ExtendedResources))
+	for _, res := range ExtendedResources {
+		hh = append(hh, Header{Name: ExtendedHeader(res), Align: tview.AlignRight})
+	}
+
+	return hh
+}
+
+// PodExtendedRequests sums up a pod containers extended resources requests.
+// Init containers run sequentially so only their largest request counts.
+func PodExtendedRequests(spec v1.PodSpec) v1.ResourceList {
+	rl := make(v1.ResourceList, len(ExtendedResources))
+	for _, res := range ExtendedResources {
+		name := v1.ResourceName(res)
+		var total resource.Quantity
+		for _, co := range spec.Containers {
+			if q, ok := containerRequest(co, name); ok {
+				total.Add(q)
+			}
+		}
+		for _, co := range spec.InitContainers {
+			if q, ok := containerRequest(co, name); ok && q.Cmp(total) > 0 {
+				total = q
+			}
+		}
+		rl[name] = total
+	}
+
+	return rl
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// containerRequest returns a container extended resource request, which
+// defaults to its limit when not set.
+func containerRequest(co v1.Container, name v1.ResourceName) (resource.Quantity, bool) {
+	if q, ok := co.Resources.Requests[name]; ok {
+		return q, true
+	}
+	q, ok := co.Resources.Limits[name]
+
+	return q, ok
+}
+
+func podExtended(spec v1.PodSpec) Fields {
+	rl := PodExtendedRequests(spec)
+	ff := make(Fields, 0, len(ExtendedResources))
+	for _, res := range ExtendedResources {
+		q := rl[v1.ResourceName(res)]
+		ff = append(ff, q.String())
+	}
+
+	return ff
+}
+
+func nodeExtended(alloc, requested v1.ResourceList) Fields {
+	ff := make(Fields, 0, len(ExtendedResources))
+	for _, res := range ExtendedResources {
+		name := v1.ResourceName(res)
+		a, ok := alloc[name]
+		if !ok || a.IsZero() {
+			ff = append(ff, NAValue)
+			continue
+		}
+		r := requested[name]
+		ff = append(ff, r.String()+"/"+a.String())
+	}
+
+	return ff
+}
@@ -0,0 +1,65 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestExtendedHeader(t *testing.T) {
+	uu := map[string]string{
+		"nvidia.com/gpu": "GPU",
+		"hugepages-2Mi":  "HUGEPAGES-2MI",
+	}
+
+	for k, e := range uu {
+		assert.Equal(t, e, render.ExtendedHeader(k))
+	}
+}
+
+func TestPodExtendedRequests(t *testing.T) {
+	render.ExtendedResources = []string{"nvidia.com/gpu", "hugepages-2Mi"}
+	defer func() { render.ExtendedResources = nil }()
+
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{
+			{Resources: v1.ResourceRequirements{Limits: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}}},
+		},
+		Containers: []v1.Container{
+			{Resources: v1.ResourceRequirements{Limits: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}}},
+			{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("2"),
+				"hugepages-2Mi":  resource.MustParse("1Gi"),
+			}}},
+		},
+	}
+
+	rl := render.PodExtendedRequests(spec)
+	gpu, hp := rl["nvidia.com/gpu"], rl["hugepages-2Mi"]
+	assert.Equal(t, "4", gpu.String())
+	assert.Equal(t, "1Gi", hp.String())
+}
+
+func TestNodeRenderExtended(t *testing.T) {
+	render.ExtendedResources = []string{"nvidia.com/gpu", "cpu"}
+	defer func() { render.ExtendedResources = nil }()
+
+	pom := render.NodeWithMetrics{
+		Raw:       load(t, "no"),
+		MX:        makeNodeMX("n1", "10m", "10Mi"),
+		Requested: v1.ResourceList{"cpu": resource.MustParse("2")},
+	}
+
+	var no render.Node
+	h := no.Header("")
+	assert.Equal(t, 18, len(h))
+	assert.Equal(t, "GPU", h[15].Name)
+	assert.Equal(t, "AGE", h[17].Name)
+
+	r := render.NewRow(18)
+	assert.Nil(t, no.Render(&pom, "", &r))
+	assert.Equal(t, render.Fields{render.NAValue, "2/4"}, r.Fields[15:17])
+}
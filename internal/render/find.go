@@ -0,0 +1,76 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Find renders resources matching a search pattern to screen.
+type Find struct{}
+
+// ColorerFunc colors a resource row.
+func (Find) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (Find) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "MATCH"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Find) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(FindRes)
+	if !ok {
+		return fmt.Errorf("expected FindRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(f.Path)
+	if client.IsClusterScoped(rns) {
+		rns = NAValue
+	}
+	r.ID = client.FQN(f.GVR, f.Path)
+	r.Fields = Fields{
+		rns,
+		n,
+		client.NewGVR(f.GVR).R(),
+		f.Match,
+		toAge(f.Created),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// FindRes represents a resource matching a search pattern.
+type FindRes struct {
+	GVR     string
+	Path    string
+	Match   string
+	Created metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (FindRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f FindRes) DeepCopyObject() runtime.Object {
+	return f
+}
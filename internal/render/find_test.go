@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.FindRes
+		id string
+		e  render.Fields
+	}{
+		"namespaced": {
+			o:  render.FindRes{GVR: "v1/services", Path: "default/payments-cache", Match: "name"},
+			id: "v1/services/default/payments-cache",
+			e:  render.Fields{"default", "payments-cache", "services", "name"},
+		},
+		"cluster": {
+			o:  render.FindRes{GVR: "v1/nodes", Path: "-/n1", Match: "label:app=fred"},
+			id: "v1/nodes/-/n1",
+			e:  render.Fields{render.NAValue, "n1", "nodes", "label:app=fred"},
+		},
+	}
+
+	var re render.Find
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(5)
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:4])
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ArgoSynced indicates an Argo application is in sync with its source.
+	ArgoSynced = "Synced"
+
+	// ArgoOutOfSync indicates an Argo application drifted from its source.
+	ArgoOutOfSync = "OutOfSync"
+
+	// ArgoHealthy indicates an Argo application is healthy.
+	ArgoHealthy = "Healthy"
+
+	// ArgoProgressing indicates an Argo application is progressing.
+	ArgoProgressing = "Progressing"
+)
+
+// Flux renders a Flux reconciled resource (Kustomization, HelmRelease, Sources) to screen.
+type Flux struct{}
+
+// ColorerFunc colors a resource row.
+func (Flux) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+
+		readyCol := 1
+		if client.IsAllNamespaces(ns) {
+			readyCol++
+		}
+		if strings.TrimSpace(re.Row.Fields[readyCol+3]) == "true" {
+			return CompletedColor
+		}
+		switch strings.TrimSpace(re.Row.Fields[readyCol]) {
+		case "True":
+			return StdColor
+		case "False":
+			return ErrColor
+		default:
+			return ModColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Flux) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "READY"},
+		Header{Name: "STATUS"},
+		Header{Name: "REVISION"},
+		Header{Name: "SUSPENDED"},
+		Header{Name: "MESSAGE"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (f Flux) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	}
+
+	ready := FluxReadyCondition(raw)
+	suspended, _, _ := unstructured.NestedBool(raw.Object, "spec", "suspend")
+
+	r.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	r.Fields = make(Fields, 0, len(f.Header(ns)))
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, raw.GetNamespace())
+	}
+	r.Fields = append(r.Fields,
+		raw.GetName(),
+		na(ready.Status),
+		na(ready.Reason),
+		na(fluxRevision(raw)),
+		boolToStr(suspended),
+		ready.Message,
+		toAge(raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// Argo renders an ArgoCD application to screen.
+type Argo struct{}
+
+// ColorerFunc colors a resource row.
+func (Argo) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+
+		syncCol := 2
+		if client.IsAllNamespaces(ns) {
+			syncCol++
+		}
+		sync, health := strings.TrimSpace(re.Row.Fields[syncCol]), strings.TrimSpace(re.Row.Fields[syncCol+1])
+		switch {
+		case health != ArgoHealthy && health != ArgoProgressing:
+			return ErrColor
+		case sync != ArgoSynced || health == ArgoProgressing:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Argo) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "PROJECT"},
+		Header{Name: "SYNC"},
+		Header{Name: "HEALTH"},
+		Header{Name: "REVISION"},
+		Header{Name: "MESSAGE"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (a Argo) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	}
+
+	project, _, _ := unstructured.NestedString(raw.Object, "spec", "project")
+	sync, _, _ := unstructured.NestedString(raw.Object, "status", "sync", "status")
+	health, _, _ := unstructured.NestedString(raw.Object, "status", "health", "status")
+	rev, _, _ := unstructured.NestedString(raw.Object, "status", "sync", "revision")
+
+	r.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	r.Fields = make(Fields, 0, len(a.Header(ns)))
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, raw.GetNamespace())
+	}
+	r.Fields = append(r.Fields,
+		raw.GetName(),
+		na(project),
+		na(sync),
+		na(health),
+		na(shortRevision(rev)),
+		ArgoMessage(raw),
+		toAge(raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Condition represents a status condition.
+type Condition struct {
+	Type, Status, Reason, Message string
+}
+
+// FluxReadyCondition returns the Ready condition of a Flux resource if any.
+func FluxReadyCondition(raw *unstructured.Unstructured) Condition {
+	for _, c := range conditions(raw) {
+		if c.Type == "Ready" {
+			return c
+		}
+	}
+
+	return Condition{}
+}
+
+// ArgoMessage returns the most relevant error message for an Argo application.
+// Failed sync operations take precedence over application conditions.
+func ArgoMessage(raw *unstructured.Unstructured) string {
+	phase, _, _ := unstructured.NestedString(raw.Object, "status", "operationState", "phase")
+	if phase == "Failed" || phase == "Error" {
+		msg, _, _ := unstructured.NestedString(raw.Object, "status", "operationState", "message")
+		return msg
+	}
+	if cc := conditions(raw); len(cc) > 0 {
+		return cc[0].Message
+	}
+
+	return ""
+}
+
+func conditions(raw *unstructured.Unstructured) []Condition {
+	cc, _, _ := unstructured.NestedSlice(raw.Object, "status", "conditions")
+	res := make([]Condition, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var cond Condition
+		cond.Type, _ = m["type"].(string)
+		cond.Status, _ = m["status"].(string)
+		cond.Reason, _ = m["reason"].(string)
+		cond.Message, _ = m["message"].(string)
+		res = append(res, cond)
+	}
+
+	return res
+}
+
+func fluxRevision(raw *unstructured.Unstructured) string {
+	if rev, ok, _ := unstructured.NestedString(raw.Object, "status", "lastAppliedRevision"); ok && rev != "" {
+		return shortRevision(rev)
+	}
+	rev, _, _ := unstructured.NestedString(raw.Object, "status", "artifact", "revision")
+
+	return shortRevision(rev)
+}
+
+// shortRevision trims git sha revisions (ie main@sha1:xxx or main/xxx) to a readable size.
+func shortRevision(rev string) string {
+	const shaSize = 7
+
+	sep := strings.LastIndexAny(rev, ":/")
+	sha := rev[sep+1:]
+	if len(sha) <= shaSize {
+		return rev
+	}
+
+	return rev[:sep+1] + sha[:shaSize]
+}
@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFluxRender(t *testing.T) {
+	var f render.Flux
+	r := render.NewRow(7)
+
+	assert.Nil(t, f.Render(load(t, "flux_ks"), "flux-system", &r))
+	assert.Equal(t, "flux-system/apps", r.ID)
+	assert.Equal(t, render.Fields{"apps", "False", "BuildFailed", "main@sha1:4f5b7c0", "false", "kustomize build failed: missing resource"}, r.Fields[:6])
+}
+
+func TestArgoRender(t *testing.T) {
+	var a render.Argo
+	r := render.NewRow(8)
+
+	assert.Nil(t, a.Render(load(t, "argo_app"), "", &r))
+	assert.Equal(t, "argocd/guestbook", r.ID)
+	assert.Equal(t, render.Fields{"argocd", "guestbook", "default", "OutOfSync", "Degraded", "53e28ff", "one or more objects failed to apply"}, r.Fields[:7])
+}
@@ -15,6 +15,20 @@ import (
 
 const megaByte = 1024 * 1024
 
+// DefaultAgeLayout represents the default absolute age time layout.
+const DefaultAgeLayout = "2006-01-02 15:04:05"
+
+var (
+	// AbsoluteAge shows ages as timestamps instead of durations when set.
+	AbsoluteAge bool
+
+	// AgeLayout tracks the absolute age time layout.
+	AgeLayout = DefaultAgeLayout
+
+	// AgeLocation tracks the absolute age timezone.
+	AgeLocation = time.Local
+)
+
 // ToMB converts bytes to megabytes.
 func ToMB(v int64) float64 {
 	return float64(v) / megaByte
@@ -140,6 +154,9 @@ func toAgeHuman(s string) string {
 	if err != nil {
 		return NAValue
 	}
+	if AbsoluteAge {
+		return time.Now().Add(-d).In(AgeLocation).Format(AgeLayout)
+	}
 
 	return duration.HumanDuration(d)
 }
@@ -77,6 +77,17 @@ func TestToAgeHuma(t *testing.T) {
 	}
 }
 
+func TestToAgeAbsolute(t *testing.T) {
+	defer func(abs bool, l string, loc *time.Location) {
+		AbsoluteAge, AgeLayout, AgeLocation = abs, l, loc
+	}(AbsoluteAge, AgeLayout, AgeLocation)
+
+	AbsoluteAge, AgeLayout, AgeLocation = true, "2006-01-02 15:04", time.UTC
+	at := time.Now().Add(-90 * time.Minute)
+	assert.Equal(t, at.UTC().Format("2006-01-02 15:04"), toAgeHuman(toAge(metav1.Time{Time: at})))
+	assert.Equal(t, NAValue, toAgeHuman("fred"))
+}
+
 func TestJoin(t *testing.T) {
 	uu := map[string]struct {
 		i []string
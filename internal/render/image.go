@@ -0,0 +1,78 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Image renders running container images to screen.
+type Image struct{}
+
+// ColorerFunc colors a resource row.
+func (Image) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[2] != "1" {
+			return ModColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Image) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "IMAGE"},
+		Header{Name: "TAG"},
+		Header{Name: "VERSIONS", Align: tview.AlignRight},
+		Header{Name: "CONTAINERS", Align: tview.AlignRight},
+		Header{Name: "WORKLOADS"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Image) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(ImageRes)
+	if !ok {
+		return fmt.Errorf("expected ImageRes, but got %T", o)
+	}
+
+	r.ID = i.Image + ":" + i.Tag
+	r.Fields = Fields{
+		i.Image,
+		i.Tag,
+		strconv.Itoa(i.Versions),
+		strconv.Itoa(i.Containers),
+		missing(strings.Join(i.Workloads, ",")),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ImageRes represents a running container image version.
+type ImageRes struct {
+	Image, Tag string
+	// Versions tracks how many distinct tags of this image are running.
+	Versions   int
+	Containers int
+	Workloads  []string
+}
+
+// GetObjectKind returns a schema object.
+func (ImageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i ImageRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.ImageRes
+		e render.Fields
+	}{
+		"single": {
+			o: render.ImageRes{Image: "nginx", Tag: "1.19", Versions: 1, Containers: 3, Workloads: []string{"Deployment/fred"}},
+			e: render.Fields{"nginx", "1.19", "1", "3", "Deployment/fred"},
+		},
+		"mixed": {
+			o: render.ImageRes{Image: "nginx", Tag: "1.18", Versions: 2, Containers: 1},
+			e: render.Fields{"nginx", "1.18", "2", "1", render.MissingValue},
+		},
+	}
+
+	var re render.Image
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Image+":"+u.o.Tag, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
+
+func TestImageColorer(t *testing.T) {
+	var re render.Image
+	f := re.ColorerFunc()
+
+	assert.Equal(t, render.StdColor, f("", render.RowEvent{Row: render.Row{Fields: render.Fields{"nginx", "1.19", "1", "3", ""}}}))
+	assert.Equal(t, render.ModColor, f("", render.RowEvent{Row: render.Row{Fields: render.Fields{"nginx", "1.19", "2", "3", ""}}}))
+}
@@ -16,6 +16,14 @@ import (
 const (
 	labelNodeRolePrefix = "node-role.kubernetes.io/"
 	nodeLabelRole       = "kubernetes.io/role"
+
+	// LabelOS tracks a node operating system.
+	LabelOS = "kubernetes.io/os"
+	// LabelArch tracks a node architecture.
+	LabelArch = "kubernetes.io/arch"
+
+	labelBetaOS   = "beta.kubernetes.io/os"
+	labelBetaArch = "beta.kubernetes.io/arch"
 )
 
 // Node renders a K8s Node to screen.
@@ -28,7 +36,7 @@ func (Node) ColorerFunc() ColorerFunc {
 
 // Header returns a header row.
 func (Node) Header(_ string) HeaderRow {
-	return HeaderRow{
+	h := HeaderRow{
 		Header{Name: "NAME"},
 		Header{Name: "STATUS"},
 		Header{Name: "ROLE"},
@@ -42,8 +50,12 @@ func (Node) Header(_ string) HeaderRow {
 		Header{Name: "%MEM", Align: tview.AlignRight},
 		Header{Name: "ACPU", Align: tview.AlignRight},
 		Header{Name: "AMEM", Align: tview.AlignRight},
-		Header{Name: "AGE", Decorator: AgeDecorator},
+		Header{Name: "OS"},
+		Header{Name: "ARCH"},
 	}
+	h = append(h, extendedHeaders()...)
+
+	return append(h, Header{Name: "AGE", Decorator: AgeDecorator})
 }
 
 // Render renders a K8s resource to screen.
@@ -68,6 +80,7 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	iIP, eIP = missing(iIP), missing(eIP)
 
 	c, a, p := gatherNodeMX(&no, oo.MX)
+	os, arch := NodePlatform(&no)
 
 	sta := make([]string, 10)
 	status(no.Status, no.Spec.Unschedulable, sta)
@@ -90,8 +103,11 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		p.mem,
 		a.cpu,
 		a.mem,
-		toAge(no.ObjectMeta.CreationTimestamp),
+		check(os, NAValue),
+		check(arch, NAValue),
 	)
+	r.Fields = append(r.Fields, nodeExtended(no.Status.Allocatable, oo.Requested)...)
+	r.Fields = append(r.Fields, toAge(no.ObjectMeta.CreationTimestamp))
 
 	return nil
 }
@@ -103,6 +119,8 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 type NodeWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.NodeMetrics
+	// Requested tracks the extended resources requested by the node pods.
+	Requested v1.ResourceList
 }
 
 // GetObjectKind returns a schema object.
@@ -143,6 +161,29 @@ func gatherNodeMX(no *v1.Node, mx *mv1beta1.NodeMetrics) (c metric, a metric, p
 	return
 }
 
+// NodePlatform returns a node operating system and architecture.
+func NodePlatform(no *v1.Node) (string, string) {
+	os, arch := no.Status.NodeInfo.OperatingSystem, no.Status.NodeInfo.Architecture
+	if v, ok := selectorValue(no.Labels, LabelOS, labelBetaOS); ok {
+		os = v
+	}
+	if v, ok := selectorValue(no.Labels, LabelArch, labelBetaArch); ok {
+		arch = v
+	}
+
+	return os, arch
+}
+
+func selectorValue(m map[string]string, keys ...string) (string, bool) {
+	for _, k := range keys {
+		if v, ok := m[k]; ok && v != "" {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
 func nodeRoles(node *v1.Node, res []string) {
 	index := 0
 	for k, v := range node.Labels {
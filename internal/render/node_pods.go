@@ -0,0 +1,125 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// NodePodTotal tags the row summing up the node pods usage.
+	NodePodTotal = "TOTAL"
+
+	// NodePodAllocatable tags the row holding the node allocatable.
+	NodePodAllocatable = "ALLOCATABLE"
+
+	// nodePodPressure flags a node pods usage above this allocatable percentage.
+	nodePodPressure = 90
+)
+
+// NodePods renders the pods running on a node along with their kubelet stats.
+type NodePods struct{}
+
+// ColorerFunc colors a resource row.
+func (NodePods) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[1] {
+		case NodePodTotal:
+			cpu, _ := strconv.Atoi(re.Row.Fields[5])
+			mem, _ := strconv.Atoi(re.Row.Fields[6])
+			if cpu >= nodePodPressure || mem >= nodePodPressure {
+				return ErrColor
+			}
+			return HighlightColor
+		case NodePodAllocatable:
+			return HighlightColor
+		}
+		switch re.Row.Fields[2] {
+		case "Terminating":
+			return KillColor
+		case "Pending", "Unknown":
+			return ModColor
+		case "Failed":
+			return ErrColor
+		case "Succeeded":
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (NodePods) Header(ns string) HeaderRow {
+	h := HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "STATUS"},
+		Header{Name: "CPU", Align: tview.AlignRight},
+		Header{Name: "MEM", Align: tview.AlignRight},
+		Header{Name: "%CPU/A", Align: tview.AlignRight},
+		Header{Name: "%MEM/A", Align: tview.AlignRight},
+	}
+
+	return append(h, extendedHeaders()...)
+}
+
+// Render renders a K8s resource to screen.
+func (NodePods) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(NodePodRes)
+	if !ok {
+		return fmt.Errorf("expected NodePodRes, but got %T", o)
+	}
+
+	cpu, mem, pcpu, pmem := NAValue, NAValue, NAValue, NAValue
+	if p.HasStats {
+		cpu, mem = ToMillicore(p.CPU), ToMi(float64(p.MEM)/(1024*1024))
+		pcpu = AsPerc(toPerc(float64(p.CPU), float64(p.AllocCPU)))
+		pmem = AsPerc(toPerc(float64(p.MEM), float64(p.AllocMEM)))
+	}
+	if p.Summary != "" {
+		r.ID = p.Summary
+		r.Fields = Fields{"", p.Summary, "", cpu, mem, pcpu, pmem}
+	} else {
+		rns, n := client.Namespaced(p.Path)
+		r.ID = client.FQN("v1/pods", p.Path)
+		r.Fields = Fields{rns, n, p.Phase, cpu, mem, pcpu, pmem}
+	}
+	for _, res := range ExtendedResources {
+		q := p.Extended[v1.ResourceName(res)]
+		r.Fields = append(r.Fields, q.String())
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodePodRes represents a pod kubelet usage against its node allocatable.
+// Summary rows carry the node total usage or allocatable.
+type NodePodRes struct {
+	Path, Phase        string
+	Summary            string
+	HasStats           bool
+	CPU, MEM           int64
+	AllocCPU, AllocMEM int64
+	// Extended tracks requested or allocatable extended resources.
+	Extended v1.ResourceList
+}
+
+// GetObjectKind returns a schema object.
+func (NodePodRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p NodePodRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodePodsRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.NodePodRes
+		id string
+		e  render.Fields
+	}{
+		"stats": {
+			o:  render.NodePodRes{Path: "default/p1", Phase: "Running", HasStats: true, CPU: 500, MEM: 512 * 1024 * 1024, AllocCPU: 2000, AllocMEM: 2048 * 1024 * 1024},
+			id: "v1/pods/default/p1",
+			e:  render.Fields{"default", "p1", "Running", "500", "512", "25", "25"},
+		},
+		"noStats": {
+			o:  render.NodePodRes{Path: "default/p2", Phase: "Pending", AllocCPU: 2000, AllocMEM: 1024},
+			id: "v1/pods/default/p2",
+			e:  render.Fields{"default", "p2", "Pending", render.NAValue, render.NAValue, render.NAValue, render.NAValue},
+		},
+		"total": {
+			o:  render.NodePodRes{Summary: render.NodePodTotal, HasStats: true, CPU: 1900, MEM: 1024 * 1024 * 1024, AllocCPU: 2000, AllocMEM: 2048 * 1024 * 1024},
+			id: render.NodePodTotal,
+			e:  render.Fields{"", render.NodePodTotal, "", "1900", "1024", "95", "50"},
+		},
+	}
+
+	var re render.NodePods
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -21,8 +21,8 @@ func TestNodeRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "minikube", r.ID)
-	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "10", "10", "0", "0", "4000", "7874"}
-	assert.Equal(t, e, r.Fields[:13])
+	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "10", "10", "0", "0", "4000", "7874", "linux", "amd64"}
+	assert.Equal(t, e, r.Fields[:15])
 }
 
 func BenchmarkNodeRender(b *testing.B) {
@@ -0,0 +1,92 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Ordinal renders statefulset ordinals to screen.
+type Ordinal struct{}
+
+// ColorerFunc colors a resource row.
+func (Ordinal) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch {
+		case re.Row.Fields[3] == "Terminating":
+			return KillColor
+		case re.Row.Fields[3] == "Missing" || re.Row.Fields[5] == "Missing" || re.Row.Fields[5] == "Lost":
+			return ErrColor
+		case re.Row.Fields[3] == "Pending" || re.Row.Fields[5] == "Pending":
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Ordinal) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "ORDINAL"},
+		Header{Name: "STATUS"},
+		Header{Name: "CLAIM"},
+		Header{Name: "CLAIM STATUS"},
+		Header{Name: "VOLUME"},
+		Header{Name: "CAPACITY"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Ordinal) Render(o interface{}, ns string, r *Row) error {
+	od, ok := o.(OrdinalRes)
+	if !ok {
+		return fmt.Errorf("expected OrdinalRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(od.Path)
+	r.ID = client.FQN("v1/pods", od.Path)
+	if od.Claim != "" {
+		r.ID = client.FQN("v1/persistentvolumeclaims", client.FQN(rns, od.Claim))
+	}
+	r.Fields = Fields{
+		rns,
+		n,
+		strconv.Itoa(od.Ordinal),
+		od.PodStatus,
+		missing(od.Claim),
+		missing(od.ClaimStatus),
+		missing(od.Volume),
+		missing(od.Capacity),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OrdinalRes represents a statefulset ordinal pod and one of its claims.
+type OrdinalRes struct {
+	Path               string
+	Ordinal            int
+	PodStatus          string
+	Claim, ClaimStatus string
+	Volume, Capacity   string
+}
+
+// GetObjectKind returns a schema object.
+func (OrdinalRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (o OrdinalRes) DeepCopyObject() runtime.Object {
+	return o
+}
@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinalRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.OrdinalRes
+		id string
+		e  render.Fields
+	}{
+		"claim": {
+			o:  render.OrdinalRes{Path: "default/web-0", Ordinal: 0, PodStatus: "Running", Claim: "data-web-0", ClaimStatus: "Bound", Volume: "pv0", Capacity: "1Gi"},
+			id: "v1/persistentvolumeclaims/default/data-web-0",
+			e:  render.Fields{"default", "web-0", "0", "Running", "data-web-0", "Bound", "pv0", "1Gi"},
+		},
+		"noClaim": {
+			o:  render.OrdinalRes{Path: "default/web-1", Ordinal: 1, PodStatus: "Missing"},
+			id: "v1/pods/default/web-1",
+			e:  render.Fields{"default", "web-1", "1", "Missing", render.MissingValue, render.MissingValue, render.MissingValue, render.MissingValue},
+		},
+	}
+
+	var re render.Ordinal
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// CoverageNone indicates a workload is not covered by any disruption budget.
+	CoverageNone = "NoPDB"
+
+	// CoverageBlocked indicates a workload disruption budget allows no disruptions.
+	CoverageBlocked = "Blocked"
+
+	// CoverageOK indicates a workload can be safely disrupted.
+	CoverageOK = "OK"
+)
+
+// PDBCoverage renders workloads disruption budget coverage to screen.
+type PDBCoverage struct{}
+
+// ColorerFunc colors a resource row.
+func (PDBCoverage) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		riskCol := 5
+		if client.IsAllNamespaces(ns) {
+			riskCol++
+		}
+		switch re.Row.Fields[riskCol] {
+		case CoverageNone:
+			return ErrColor
+		case CoverageBlocked:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (PDBCoverage) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "KIND"},
+		Header{Name: "REPLICAS", Align: tview.AlignRight},
+		Header{Name: "PDB"},
+		Header{Name: "ALLOWED DISRUPTIONS", Align: tview.AlignRight},
+		Header{Name: "RISK"},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (PDBCoverage) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(PDBCoverageRes)
+	if !ok {
+		return fmt.Errorf("expected PDBCoverageRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(c.Path)
+	allowed := NAValue
+	if len(c.PDBs) > 0 {
+		allowed = strconv.Itoa(int(c.Allowed))
+	}
+	r.ID = client.FQN(c.GVR, c.Path)
+	r.Fields = make(Fields, 0, 7)
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, rns)
+	}
+	r.Fields = append(r.Fields,
+		n,
+		c.Kind,
+		strconv.Itoa(int(c.Replicas)),
+		missing(strings.Join(c.PDBs, ",")),
+		allowed,
+		c.Risk(),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PDBCoverageRes represents a workload and its disruption budgets.
+type PDBCoverageRes struct {
+	GVR      string
+	Path     string
+	Kind     string
+	Replicas int32
+	PDBs     []string
+	Allowed  int32
+}
+
+// Risk returns the workload eviction risk.
+func (c PDBCoverageRes) Risk() string {
+	switch {
+	case len(c.PDBs) == 0:
+		return CoverageNone
+	case c.Allowed == 0:
+		return CoverageBlocked
+	default:
+		return CoverageOK
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (PDBCoverageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c PDBCoverageRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPDBCoverageRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.PDBCoverageRes
+		ns  string
+		e   render.Fields
+	}{
+		"uncovered": {
+			res: render.PDBCoverageRes{GVR: "apps/v1/deployments", Path: "default/fred", Kind: "Deployment", Replicas: 3},
+			ns:  "",
+			e:   render.Fields{"default", "fred", "Deployment", "3", "<none>", "n/a", render.CoverageNone},
+		},
+		"blocked": {
+			res: render.PDBCoverageRes{GVR: "apps/v1/statefulsets", Path: "default/db", Kind: "StatefulSet", Replicas: 1, PDBs: []string{"db"}},
+			ns:  "default",
+			e:   render.Fields{"db", "StatefulSet", "1", "db", "0", render.CoverageBlocked},
+		},
+		"ok": {
+			res: render.PDBCoverageRes{GVR: "apps/v1/deployments", Path: "default/web", Kind: "Deployment", Replicas: 4, PDBs: []string{"a", "b"}, Allowed: 1},
+			ns:  "default",
+			e:   render.Fields{"web", "Deployment", "4", "a,b", "1", render.CoverageOK},
+		},
+	}
+
+	var p render.PDBCoverage
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.res, u.ns, &r))
+			assert.Equal(t, u.res.GVR+"/"+u.res.Path, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Pin renders pinned resources to screen.
+type Pin struct{}
+
+// ColorerFunc colors a resource row.
+func (Pin) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+		if r.Row.Fields[3] != "true" {
+			c = ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Pin) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "READY"},
+		Header{Name: "STATUS"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Pin) Render(o interface{}, _ string, r *Row) error {
+	p, ok := o.(PinRes)
+	if !ok {
+		return fmt.Errorf("expected PinRes, but got %T", o)
+	}
+
+	ns, n := client.Namespaced(p.Path)
+	if ns == "" {
+		ns = NAValue
+	}
+	r.ID = client.FQN(p.GVR, p.Path)
+	r.Fields = Fields{
+		client.NewGVR(p.GVR).R(),
+		ns,
+		n,
+		boolToStr(p.Ready),
+		p.Status,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PinRes represents a pinned resource status.
+type PinRes struct {
+	GVR, Path string
+	Ready     bool
+	Status    string
+}
+
+// GetObjectKind returns a schema object.
+func (PinRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PinRes) DeepCopyObject() runtime.Object {
+	return p
+}
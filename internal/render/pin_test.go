@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.PinRes
+		id string
+		e  render.Fields
+	}{
+		"namespaced": {
+			o:  render.PinRes{GVR: "apps/v1/deployments", Path: "default/fred", Ready: true, Status: "1/1"},
+			id: "apps/v1/deployments/default/fred",
+			e:  render.Fields{"deployments", "default", "fred", "true", "1/1"},
+		},
+		"cluster": {
+			o:  render.PinRes{GVR: "v1/nodes", Path: "n1", Status: "NotReady"},
+			id: "v1/nodes/n1",
+			e:  render.Fields{"nodes", "n/a", "n1", "false", "NotReady"},
+		},
+	}
+
+	var p render.Pin
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -71,7 +71,7 @@ func (Pod) Header(ns string) HeaderRow {
 		h = append(h, Header{Name: "NAMESPACE"})
 	}
 
-	return append(h,
+	h = append(h,
 		Header{Name: "NAME"},
 		Header{Name: "READY"},
 		Header{Name: "STATUS"},
@@ -85,8 +85,12 @@ func (Pod) Header(ns string) HeaderRow {
 		Header{Name: "IP"},
 		Header{Name: "NODE"},
 		Header{Name: "QOS"},
-		Header{Name: "AGE", Decorator: AgeDecorator},
+		Header{Name: "OS"},
+		Header{Name: "ARCH"},
 	)
+	h = append(h, extendedHeaders()...)
+
+	return append(h, Header{Name: "AGE", Decorator: AgeDecorator})
 }
 
 // Render renders a K8s resource to screen.
@@ -105,6 +109,7 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 	ss := po.Status.ContainerStatuses
 	cr, _, rc := p.Statuses(ss)
 	c, perc := p.gatherPodMX(&po, pwm.MX)
+	os, arch := pwm.platform(&po)
 
 	r.ID = client.MetaFQN(po.ObjectMeta)
 	r.Fields = make(Fields, 0, len(p.Header(ns)))
@@ -125,8 +130,11 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
-		toAge(po.ObjectMeta.CreationTimestamp),
+		na(os),
+		na(arch),
 	)
+	r.Fields = append(r.Fields, podExtended(po.Spec)...)
+	r.Fields = append(r.Fields, toAge(po.ObjectMeta.CreationTimestamp))
 
 	return nil
 }
@@ -138,6 +146,22 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 type PodWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.PodMetrics
+	// OS and Arch track the pod node platform if known.
+	OS, Arch string
+}
+
+// platform returns the pod operating system and architecture, falling back
+// to the pod node selector when its node platform is unknown.
+func (p *PodWithMetrics) platform(po *v1.Pod) (string, string) {
+	os, arch := p.OS, p.Arch
+	if v, ok := selectorValue(po.Spec.NodeSelector, LabelOS, labelBetaOS); ok && os == "" {
+		os = v
+	}
+	if v, ok := selectorValue(po.Spec.NodeSelector, LabelArch, labelBetaArch); ok && arch == "" {
+		arch = v
+	}
+
+	return os, arch
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,136 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of pod security risks.
+const (
+	// SecurityPrivileged indicates a pod can access its host.
+	SecurityPrivileged = "Privileged"
+
+	// SecurityElevated indicates a pod runs as root or with added capabilities.
+	SecurityElevated = "Elevated"
+
+	// SecurityNoSeccomp indicates a pod runs unconfined.
+	SecurityNoSeccomp = "NoSeccomp"
+
+	// SecurityOK indicates a pod follows security best practices.
+	SecurityOK = "OK"
+)
+
+// PodSecurity renders pods security posture to screen.
+type PodSecurity struct{}
+
+// ColorerFunc colors a resource row.
+func (PodSecurity) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		riskCol := 7
+		if client.IsAllNamespaces(ns) {
+			riskCol++
+		}
+		switch re.Row.Fields[riskCol] {
+		case SecurityPrivileged:
+			return ErrColor
+		case SecurityElevated, SecurityNoSeccomp:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (PodSecurity) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "ROOT"},
+		Header{Name: "PRIVILEGED"},
+		Header{Name: "HOSTNET"},
+		Header{Name: "HOSTPID"},
+		Header{Name: "CAPS"},
+		Header{Name: "SECCOMP"},
+		Header{Name: "RISK"},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (PodSecurity) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(PodSecurityRes)
+	if !ok {
+		return fmt.Errorf("expected PodSecurityRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(s.Path)
+	r.ID = client.FQN("v1/pods", s.Path)
+	r.Fields = make(Fields, 0, 9)
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, rns)
+	}
+	r.Fields = append(r.Fields,
+		n,
+		boolToStr(s.RunAsRoot),
+		missing(strings.Join(s.Privileged, ",")),
+		boolToStr(s.HostNetwork),
+		boolToStr(s.HostPID),
+		missing(strings.Join(s.Caps, ",")),
+		boolToStr(s.Seccomp),
+		s.Risk(),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PodSecurityRes represents a pod security posture.
+type PodSecurityRes struct {
+	Path                 string
+	RunAsRoot            bool
+	Privileged           []string
+	HostNetwork, HostPID bool
+	Caps                 []string
+	Seccomp              bool
+}
+
+// IsPrivileged checks if a pod runs privileged containers or shares its host
+// network or process namespaces.
+func (s PodSecurityRes) IsPrivileged() bool {
+	return len(s.Privileged) > 0 || s.HostNetwork || s.HostPID
+}
+
+// Risk returns the pod security risk.
+func (s PodSecurityRes) Risk() string {
+	switch {
+	case s.IsPrivileged():
+		return SecurityPrivileged
+	case s.RunAsRoot || len(s.Caps) > 0:
+		return SecurityElevated
+	case !s.Seccomp:
+		return SecurityNoSeccomp
+	default:
+		return SecurityOK
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (PodSecurityRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s PodSecurityRes) DeepCopyObject() runtime.Object {
+	return s
+}
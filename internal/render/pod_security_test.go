@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodSecurityRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.PodSecurityRes
+		e render.Fields
+	}{
+		"ok": {
+			o: render.PodSecurityRes{Path: "default/p1", Seccomp: true},
+			e: render.Fields{"p1", "false", render.MissingValue, "false", "false", render.MissingValue, "true", render.SecurityOK},
+		},
+		"noSeccomp": {
+			o: render.PodSecurityRes{Path: "default/p1"},
+			e: render.Fields{"p1", "false", render.MissingValue, "false", "false", render.MissingValue, "false", render.SecurityNoSeccomp},
+		},
+		"elevated": {
+			o: render.PodSecurityRes{Path: "default/p1", RunAsRoot: true, Caps: []string{"NET_ADMIN"}, Seccomp: true},
+			e: render.Fields{"p1", "true", render.MissingValue, "false", "false", "NET_ADMIN", "true", render.SecurityElevated},
+		},
+		"privileged": {
+			o: render.PodSecurityRes{Path: "default/p1", Privileged: []string{"c1", "c2"}, HostPID: true},
+			e: render.Fields{"p1", "false", "c1,c2", "false", "true", render.MissingValue, "false", render.SecurityPrivileged},
+		},
+	}
+
+	var re render.PodSecurity
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "default", &r))
+			assert.Equal(t, "v1/pods/default/p1", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -74,6 +74,20 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:14])
 }
 
+func TestPodRenderPlatform(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:  load(t, "po"),
+		MX:   makePodMX("nginx", "10m", "10Mi"),
+		OS:   "windows",
+		Arch: "amd64",
+	}
+
+	var po render.Pod
+	r := render.NewRow(16)
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, render.Fields{"windows", "amd64"}, r.Fields[14:16])
+}
+
 func BenchmarkPodRender(b *testing.B) {
 	pom := render.PodWithMetrics{
 		Raw: load(b, "po"),
@@ -4,9 +4,32 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestPortForwardColorer(t *testing.T) {
+	uu := map[string]struct {
+		status string
+		e      tcell.Color
+	}{
+		"active":       {status: "Active", e: tcell.ColorSkyblue},
+		"reconnecting": {status: "Reconnecting", e: render.ModColor},
+		"dead":         {status: "Dead", e: render.ErrColor},
+	}
+
+	var p render.PortForward
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := render.RowEvent{Row: render.Row{
+				Fields: render.Fields{"ns", "fred", "co", "p1", "url", "1", "1", "ctx1", u.status, "0", "2m"},
+			}}
+			assert.Equal(t, u.e, p.ColorerFunc()("", re))
+		})
+	}
+}
+
 func TestPortForwardRender(t *testing.T) {
 	var p render.PortForward
 	var r render.Row
@@ -21,7 +44,7 @@ func TestPortForwardRender(t *testing.T) {
 	}
 
 	assert.Nil(t, p.Render(o, "fred", &r))
-	assert.Equal(t, "blee/fred", r.ID)
+	assert.Equal(t, "ctx1|blee/fred", r.ID)
 	assert.Equal(t, render.Fields{
 		"blee",
 		"fred",
@@ -30,6 +53,9 @@ func TestPortForwardRender(t *testing.T) {
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
+		"ctx1",
+		"Active",
+		"1Ki",
 		"2m",
 	}, r.Fields)
 }
@@ -42,6 +68,10 @@ func (f fwd) Path() string {
 	return "blee/fred"
 }
 
+func (f fwd) Context() string {
+	return "ctx1"
+}
+
 func (f fwd) Container() string {
 	return "co"
 }
@@ -54,6 +84,14 @@ func (f fwd) Active() bool {
 	return true
 }
 
+func (f fwd) Status() string {
+	return "Active"
+}
+
+func (f fwd) Transferred() int64 {
+	return 1024
+}
+
 func (f fwd) Age() string {
 	return "2m"
 }
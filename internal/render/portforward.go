@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -15,6 +17,9 @@ type Forwarder interface {
 	// Path returns a resource FQN.
 	Path() string
 
+	// Context returns the kube context owning the forward.
+	Context() string
+
 	// Container returns a container name.
 	Container() string
 
@@ -24,17 +29,44 @@ type Forwarder interface {
 	// Active returns forwarder current state.
 	Active() bool
 
+	// Status returns the forwarder health.
+	Status() string
+
+	// Transferred returns the number of bytes forwarded.
+	Transferred() int64
+
 	// Age returns forwarder age.
 	Age() string
 }
 
+const (
+	// ForwardActive tracks a forward serving connections.
+	ForwardActive = "Active"
+	// ForwardReconnecting tracks a forward looking for its target.
+	ForwardReconnecting = "Reconnecting"
+	// ForwardDead tracks a forward that gave up.
+	ForwardDead = "Dead"
+
+	forwardStatusCol = 8
+)
+
 // PortForward renders a portforwards to screen.
 type PortForward struct{}
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() ColorerFunc {
 	return func(ns string, re RowEvent) tcell.Color {
-		return tcell.ColorSkyblue
+		if len(re.Row.Fields) <= forwardStatusCol {
+			return tcell.ColorSkyblue
+		}
+		switch re.Row.Fields[forwardStatusCol] {
+		case ForwardReconnecting:
+			return ModColor
+		case ForwardDead:
+			return ErrColor
+		default:
+			return tcell.ColorSkyblue
+		}
 	}
 }
 
@@ -48,6 +80,9 @@ func (PortForward) Header(ns string) HeaderRow {
 		Header{Name: "URL"},
 		Header{Name: "C"},
 		Header{Name: "N"},
+		Header{Name: "CONTEXT"},
+		Header{Name: "STATUS"},
+		Header{Name: "BYTES", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -62,7 +97,7 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 	ports := strings.Split(pf.Ports()[0], ":")
 	ns, n := client.Namespaced(pf.Path())
 
-	r.ID = pf.Path()
+	r.ID = client.ContextPath(pf.Context(), pf.Path())
 	r.Fields = Fields{
 		ns,
 		trimContainer(n),
@@ -71,6 +106,9 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0]),
 		asNum(pf.Config.C),
 		asNum(pf.Config.N),
+		pf.Context(),
+		pf.Status(),
+		resource.NewQuantity(pf.Transferred(), resource.BinarySI).String(),
 		pf.Age(),
 	}
 
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Related renders resources related to a given resource to screen.
+type Related struct{}
+
+// ColorerFunc colors a resource row.
+func (Related) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (Related) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "RELATION"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Related) Render(o interface{}, ns string, r *Row) error {
+	rel, ok := o.(RelatedRes)
+	if !ok {
+		return fmt.Errorf("expected RelatedRes, but got %T", o)
+	}
+
+	rns, n := client.Namespaced(rel.Path)
+	if rns == "" || client.IsClusterScoped(rns) {
+		rns = NAValue
+	}
+	age := NAValue
+	if !rel.Created.IsZero() {
+		age = toAge(rel.Created)
+	}
+	r.ID = client.FQN(rel.GVR, rel.Path)
+	r.Fields = Fields{
+		rns,
+		n,
+		client.NewGVR(rel.GVR).R(),
+		rel.Relation,
+		age,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RelatedRes represents a resource related to another.
+type RelatedRes struct {
+	GVR      string
+	Path     string
+	Relation string
+	Created  metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (RelatedRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RelatedRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,23 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelatedRender(t *testing.T) {
+	o := render.RelatedRes{
+		GVR:      "apps/v1/replicasets",
+		Path:     "default/fred-5d8f",
+		Relation: "child",
+	}
+
+	var re render.Related
+	r := render.NewRow(5)
+	assert.Nil(t, re.Render(o, "", &r))
+
+	assert.Equal(t, "apps/v1/replicasets/default/fred-5d8f", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred-5d8f", "replicasets", "child"}, r.Fields[:4])
+}
@@ -0,0 +1,94 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Rollout renders the rollout state of workload pods to screen.
+type Rollout struct{}
+
+// ColorerFunc colors a resource row.
+func (Rollout) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[6] {
+		case "Stuck":
+			return ErrColor
+		case "Outdated":
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Rollout) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "NODE"},
+		Header{Name: "ORDINAL"},
+		Header{Name: "REVISION"},
+		Header{Name: "READY"},
+		Header{Name: "STATUS"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Rollout) Render(o interface{}, ns string, r *Row) error {
+	ro, ok := o.(RolloutRes)
+	if !ok {
+		return fmt.Errorf("expected RolloutRes, but got %T", o)
+	}
+
+	ordinal := NAValue
+	if ro.Ordinal >= 0 {
+		ordinal = strconv.Itoa(ro.Ordinal)
+	}
+	rns, n := client.Namespaced(ro.Path)
+	r.ID = client.FQN("v1/pods", ro.Path)
+	r.Fields = Fields{
+		rns,
+		n,
+		missing(ro.Node),
+		ordinal,
+		missing(ro.Revision),
+		boolToStr(ro.Ready),
+		ro.Status,
+		toAge(ro.Created),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RolloutRes represents the rollout state of a workload pod.
+type RolloutRes struct {
+	Path     string
+	Node     string
+	Ordinal  int
+	Revision string
+	Ready    bool
+	Status   string
+	Created  metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (RolloutRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RolloutRes) DeepCopyObject() runtime.Object {
+	return r
+}
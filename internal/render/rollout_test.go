@@ -0,0 +1,36 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.RolloutRes
+		e render.Fields
+	}{
+		"sts": {
+			o: render.RolloutRes{Path: "default/web-1", Node: "n1", Ordinal: 1, Revision: "web-abc", Ready: true, Status: "Updated"},
+			e: render.Fields{"default", "web-1", "n1", "1", "web-abc", "true", "Updated"},
+		},
+		"ds": {
+			o: render.RolloutRes{Path: "default/fluentd-x1", Ordinal: -1, Status: "Stuck"},
+			e: render.Fields{"default", "fluentd-x1", render.MissingValue, render.NAValue, render.MissingValue, "false", "Stuck"},
+		},
+	}
+
+	var re render.Rollout
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, "v1/pods/"+u.o.Path, r.ID)
+			assert.Equal(t, u.e, r.Fields[:7])
+			assert.Equal(t, len(re.Header("")), len(r.Fields))
+		})
+	}
+}
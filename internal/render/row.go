@@ -23,8 +23,9 @@ func (f Fields) Clone() Fields {
 
 // Row represents a colllection of columns.
 type Row struct {
-	ID     string
-	Fields Fields
+	ID         string
+	Fields     Fields
+	Deprecated bool
 }
 
 // NewRow returns a new row with initialized fields.
@@ -35,8 +36,9 @@ func NewRow(cols int) Row {
 // Clone copies a row.
 func (r Row) Clone() Row {
 	return Row{
-		ID:     r.ID,
-		Fields: r.Fields.Clone(),
+		ID:         r.ID,
+		Fields:     r.Fields.Clone(),
+		Deprecated: r.Deprecated,
 	}
 }
 
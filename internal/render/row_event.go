@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
@@ -30,9 +31,11 @@ type ResEvent int
 
 // RowEvent tracks resource instance events.
 type RowEvent struct {
-	Kind   ResEvent
-	Row    Row
-	Deltas DeltaRow
+	Kind    ResEvent
+	Row     Row
+	Deltas  DeltaRow
+	Changes ChangeRow
+	Since   time.Time
 }
 
 // NewRowEvent returns a new row event.
@@ -55,12 +58,19 @@ func NewDeltaRowEvent(row Row, delta DeltaRow) RowEvent {
 // Clone returns a rowevent deep copy.
 func (r RowEvent) Clone() RowEvent {
 	return RowEvent{
-		Kind:   r.Kind,
-		Row:    r.Row.Clone(),
-		Deltas: r.Deltas.Clone(),
+		Kind:    r.Kind,
+		Row:     r.Row.Clone(),
+		Deltas:  r.Deltas.Clone(),
+		Changes: r.Changes.Clone(),
+		Since:   r.Since,
 	}
 }
 
+// Fresh checks if the row appeared recently.
+func (r RowEvent) Fresh(now time.Time) bool {
+	return r.Kind == EventAdd && !r.Since.IsZero() && now.Sub(r.Since) < RowDecay
+}
+
 // Diff returns true if the row changed.
 func (r RowEvent) Diff(re RowEvent) bool {
 	if r.Kind != re.Kind {
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SAToken renders service account tokens to screen.
+type SAToken struct{}
+
+// ColorerFunc colors a resource row.
+func (SAToken) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+		if strings.HasSuffix(strings.TrimSpace(r.Row.Fields[0]), "(*)") {
+			c = HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (SAToken) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "SERVICEACCOUNT"},
+		Header{Name: "PATH"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SAToken) Render(o interface{}, _ string, r *Row) error {
+	t, ok := o.(SATokenRes)
+	if !ok {
+		return fmt.Errorf("expected SATokenRes, but got %T", o)
+	}
+
+	name := client.FQN(t.Namespace, t.Name)
+	if t.Active {
+		name += "(*)"
+	}
+	r.ID = t.Path
+	r.Fields = Fields{
+		name,
+		t.Namespace,
+		t.Name,
+		t.Path,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SATokenRes represents a service account token.
+type SATokenRes struct {
+	client.SAToken
+	Active bool
+}
+
+// GetObjectKind returns a schema object.
+func (SATokenRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t SATokenRes) DeepCopyObject() runtime.Object {
+	return t
+}
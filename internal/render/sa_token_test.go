@@ -0,0 +1,36 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSATokenRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.SATokenRes
+		e render.Fields
+	}{
+		"active": {
+			o: render.SATokenRes{SAToken: client.SAToken{Path: "/t/fred", Namespace: "default", Name: "fred"}, Active: true},
+			e: render.Fields{"default/fred(*)", "default", "fred", "/t/fred"},
+		},
+		"inactive": {
+			o: render.SATokenRes{SAToken: client.SAToken{Path: "/t/fred", Namespace: "default", Name: "fred"}},
+			e: render.Fields{"default/fred", "default", "fred", "/t/fred"},
+		},
+	}
+
+	var re render.SAToken
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, "/t/fred", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -33,6 +37,8 @@ var AgeDecorator = func(a string) string {
 func (ScreenDump) Header(ns string) HeaderRow {
 	return HeaderRow{
 		Header{Name: "NAME"},
+		Header{Name: "KIND"},
+		Header{Name: "SIZE", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -45,8 +51,16 @@ func (b ScreenDump) Render(o interface{}, ns string, r *Row) error {
 	}
 
 	r.ID = filepath.Join(f.Dir, f.File.Name())
+	name := f.File.Name()
+	if f.Root != "" {
+		if rel, err := filepath.Rel(f.Root, r.ID); err == nil {
+			name = rel
+		}
+	}
 	r.Fields = Fields{
-		f.File.Name(),
+		name,
+		dumpKind(name),
+		resource.NewQuantity(f.File.Size(), resource.BinarySI).String(),
 		timeToAge(f.File.ModTime()),
 	}
 
@@ -56,6 +70,29 @@ func (b ScreenDump) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// dumpKind returns the kind of dump a file holds.
+func dumpKind(name string) string {
+	if strings.HasPrefix(name, config.BenchDumpDir+string(filepath.Separator)) {
+		return "benchmark"
+	}
+	switch filepath.Ext(name) {
+	case ".csv":
+		return "table"
+	case ".log":
+		return "logs"
+	case ".yml", ".yaml":
+		return "yaml"
+	case ".pprof":
+		return "profile"
+	case ".md":
+		return "keymap"
+	case ".html", ".svg":
+		return "screenshot"
+	default:
+		return NAValue
+	}
+}
+
 func timeToAge(timestamp time.Time) string {
 	return time.Since(timestamp).String()
 }
@@ -64,6 +101,7 @@ func timeToAge(timestamp time.Time) string {
 type FileRes struct {
 	File os.FileInfo
 	Dir  string
+	Root string
 }
 
 // GetObjectKind returns a schema object.
@@ -21,6 +21,26 @@ func TestScreenDumpRender(t *testing.T) {
 	assert.Equal(t, "fred/blee/bob", r.ID)
 	assert.Equal(t, render.Fields{
 		"bob",
+		"n/a",
+		"100",
+	}, r.Fields[:len(r.Fields)-1])
+}
+
+func TestScreenDumpRenderNested(t *testing.T) {
+	var s render.ScreenDump
+	var r render.Row
+	o := render.FileRes{
+		File: fileInfo{},
+		Dir:  "fred/benchmarks",
+		Root: "fred",
+	}
+
+	assert.Nil(t, s.Render(o, "fred", &r))
+	assert.Equal(t, "fred/benchmarks/bob", r.ID)
+	assert.Equal(t, render.Fields{
+		"benchmarks/bob",
+		"benchmark",
+		"100",
 	}, r.Fields[:len(r.Fields)-1])
 }
 
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Session renders exec sessions to screen.
+type Session struct{}
+
+// ColorerFunc colors a resource row.
+func (Session) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+		if r.Row.Fields[4] == "true" {
+			c = HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Session) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "ID", Align: tview.AlignRight},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "POD"},
+		Header{Name: "CONTAINER"},
+		Header{Name: "ATTACHED"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Session) Render(o interface{}, _ string, r *Row) error {
+	s, ok := o.(SessionRes)
+	if !ok {
+		return fmt.Errorf("expected SessionRes, but got %T", o)
+	}
+
+	ns, po := client.Namespaced(s.Path)
+	r.ID = strconv.Itoa(s.ID)
+	r.Fields = Fields{
+		r.ID,
+		ns,
+		po,
+		s.Container,
+		boolToStr(s.Attached),
+		timeToAge(s.Started),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SessionRes represents an exec session.
+type SessionRes struct {
+	ID              int
+	Path, Container string
+	Attached        bool
+	Started         time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (SessionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SessionRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -0,0 +1,115 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Renders tracks rendering durations per resource.
+var Renders = client.NewCallStats()
+
+const (
+	// StatRuntime tracks k9s process stats.
+	StatRuntime = "runtime"
+
+	// StatInformer tracks active informers.
+	StatInformer = "informer"
+
+	// StatAPI tracks api server requests.
+	StatAPI = "api"
+
+	// StatRender tracks resource renders.
+	StatRender = "render"
+)
+
+// Stats renders k9s own resource usage to screen.
+type Stats struct{}
+
+// ColorerFunc colors a resource row.
+func (Stats) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[4] != "0" && re.Row.Fields[4] != "" {
+			return ErrColor
+		}
+		if re.Row.Fields[0] == StatRuntime {
+			return HighlightColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Stats) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "KIND"},
+		Header{Name: "NAME"},
+		Header{Name: "COUNT", Align: tview.AlignRight},
+		Header{Name: "RATE/S", Align: tview.AlignRight},
+		Header{Name: "ERRORS", Align: tview.AlignRight},
+		Header{Name: "AVG", Align: tview.AlignRight},
+		Header{Name: "MAX", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Stats) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(StatsRes)
+	if !ok {
+		return fmt.Errorf("expected StatsRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(s.Kind, s.Name)
+	r.Fields = Fields{
+		s.Kind,
+		s.Name,
+		s.Value,
+		"",
+		"",
+		"",
+		"",
+	}
+	if s.Kind != StatAPI && s.Kind != StatRender {
+		return nil
+	}
+	r.Fields[3] = fmt.Sprintf("%.2f", s.Rate)
+	r.Fields[4] = strconv.Itoa(s.Errors)
+	r.Fields[5] = roundDuration(s.Avg)
+	r.Fields[6] = roundDuration(s.Max)
+
+	return nil
+}
+
+func roundDuration(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// StatsRes represents a k9s resource usage stat.
+type StatsRes struct {
+	Kind, Name, Value string
+	Rate              float64
+	Errors            int
+	Avg, Max          time.Duration
+}
+
+// GetObjectKind returns a schema object.
+func (StatsRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s StatsRes) DeepCopyObject() runtime.Object {
+	return s
+}
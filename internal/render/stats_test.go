@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsRender(t *testing.T) {
+	uu := map[string]struct {
+		s render.StatsRes
+		e render.Row
+	}{
+		"runtime": {
+			s: render.StatsRes{Kind: render.StatRuntime, Name: "heap", Value: "10Mi"},
+			e: render.Row{
+				ID:     "runtime/heap",
+				Fields: render.Fields{"runtime", "heap", "10Mi", "", "", "", ""},
+			},
+		},
+		"api": {
+			s: render.StatsRes{
+				Kind:   render.StatAPI,
+				Name:   "GET v1/pods",
+				Value:  "10",
+				Rate:   0.5,
+				Errors: 1,
+				Avg:    1500 * time.Microsecond,
+				Max:    12 * time.Millisecond,
+			},
+			e: render.Row{
+				ID:     "api/GET v1/pods",
+				Fields: render.Fields{"api", "GET v1/pods", "10", "0.50", "1", "2ms", "12ms"},
+			},
+		},
+	}
+
+	var s render.Stats
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, s.Render(u.s, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
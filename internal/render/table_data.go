@@ -2,8 +2,12 @@ package render
 
 import (
 	"sync"
+	"time"
 )
 
+// RowDecay tracks how long new rows stay marked and deleted rows linger.
+var RowDecay = 5 * time.Second
+
 // TableData tracks a K8s resource for tabular display.
 type TableData struct {
 	Header    HeaderRow
@@ -34,6 +38,7 @@ func cloneTable(t TableData) TableData {
 // Update computes row deltas and update the table data.
 func (t *TableData) Update(rows Rows) {
 	empty := len(t.RowEvents) == 0
+	now := time.Now()
 	kk := make([]string, 0, len(rows))
 	var blankDelta DeltaRow
 	for _, row := range rows {
@@ -44,20 +49,62 @@ func (t *TableData) Update(rows Rows) {
 		}
 
 		if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-			delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
+			prev := t.RowEvents[index]
+			if prev.Kind == EventDelete {
+				t.RowEvents[index] = NewRowEvent(EventAdd, row)
+				t.RowEvents[index].Since = now
+				continue
+			}
+			delta := NewDeltaRow(prev.Row, row, t.Header.HasAge())
+			changes := NewChangeRow(prev.Changes, delta, now)
 			if delta.IsBlank() {
-				t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
-				t.RowEvents[index].Row = row
+				if !prev.Fresh(now) {
+					t.RowEvents[index].Kind = EventUnchanged
+				}
+				t.RowEvents[index].Deltas, t.RowEvents[index].Row = blankDelta, row
 			} else {
 				t.RowEvents[index] = NewDeltaRowEvent(row, delta)
 			}
+			t.RowEvents[index].Changes = changes
 			continue
 		}
-		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
+		re := NewRowEvent(EventAdd, row)
+		re.Since = now
+		t.RowEvents = append(t.RowEvents, re)
 	}
 
 	if !empty {
+		t.decay(kk, now)
+	}
+}
+
+// Decay marks rows gone from the latest update as deleted and drops the
+// ones deleted past their decay.
+func (t *TableData) decay(kk []string, now time.Time) {
+	if RowDecay <= 0 {
 		t.Delete(kk)
+		return
+	}
+
+	keep := make(map[string]struct{}, len(kk))
+	for _, k := range kk {
+		keep[k] = struct{}{}
+	}
+	var victims []string
+	for i, re := range t.RowEvents {
+		if _, ok := keep[re.Row.ID]; ok {
+			continue
+		}
+		switch {
+		case re.Kind != EventDelete:
+			t.RowEvents[i].Kind, t.RowEvents[i].Since = EventDelete, now
+			t.RowEvents[i].Deltas, t.RowEvents[i].Changes = nil, nil
+		case now.Sub(re.Since) >= RowDecay:
+			victims = append(victims, re.Row.ID)
+		}
+	}
+	for _, id := range victims {
+		t.RowEvents = t.RowEvents.Delete(id)
 	}
 }
 
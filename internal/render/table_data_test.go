@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -51,3 +52,55 @@ func TestTableDataDelete(t *testing.T) {
 	}
 
 }
+
+func TestTableDataUpdateChanges(t *testing.T) {
+	var table render.TableData
+	table.Header = render.HeaderRow{{Name: "NAME"}, {Name: "RESTARTS"}, {Name: "AGE"}}
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a", "0", "1m"}}})
+	assert.Nil(t, table.RowEvents[0].Changes)
+
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a", "1", "1m"}}})
+	assert.Equal(t, render.EventUpdate, table.RowEvents[0].Kind)
+	now := time.Now()
+	assert.False(t, table.RowEvents[0].Changes.Changed(0, now))
+	assert.True(t, table.RowEvents[0].Changes.Changed(1, now))
+
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a", "1", "2m"}}})
+	assert.Equal(t, render.EventUnchanged, table.RowEvents[0].Kind)
+	assert.True(t, table.RowEvents[0].Changes.Changed(1, time.Now()))
+}
+
+func TestTableDataUpdateDecay(t *testing.T) {
+	defer func(d time.Duration) { render.RowDecay = d }(render.RowDecay)
+	render.RowDecay = 50 * time.Millisecond
+
+	var table render.TableData
+	table.Header = render.HeaderRow{{Name: "NAME"}, {Name: "AGE"}}
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a", "1m"}}})
+	assert.False(t, table.RowEvents[0].Fresh(time.Now()))
+
+	table.Update(render.Rows{{ID: "B", Fields: render.Fields{"b", "1m"}}})
+	assert.Equal(t, 2, len(table.RowEvents))
+	assert.Equal(t, render.EventDelete, table.RowEvents[0].Kind)
+	assert.True(t, table.RowEvents[1].Fresh(time.Now()))
+
+	table.Update(render.Rows{{ID: "B", Fields: render.Fields{"b", "1m"}}})
+	assert.Equal(t, 2, len(table.RowEvents))
+	assert.Equal(t, render.EventAdd, table.RowEvents[1].Kind)
+
+	time.Sleep(render.RowDecay)
+	table.Update(render.Rows{{ID: "B", Fields: render.Fields{"b", "1m"}}})
+	assert.Equal(t, 1, len(table.RowEvents))
+	assert.Equal(t, "B", table.RowEvents[0].Row.ID)
+	assert.Equal(t, render.EventUnchanged, table.RowEvents[0].Kind)
+}
+
+func TestTableDataUpdateRevive(t *testing.T) {
+	var table render.TableData
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a"}}, {ID: "B", Fields: render.Fields{"b"}}})
+	table.Update(render.Rows{{ID: "B", Fields: render.Fields{"b"}}})
+	assert.Equal(t, render.EventDelete, table.RowEvents[0].Kind)
+
+	table.Update(render.Rows{{ID: "A", Fields: render.Fields{"a"}}, {ID: "B", Fields: render.Fields{"b"}}})
+	assert.True(t, table.RowEvents[0].Fresh(time.Now()))
+}
@@ -0,0 +1,69 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Task renders in flight operations to screen.
+type Task struct{}
+
+// ColorerFunc colors a resource row.
+func (Task) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Task) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "ID", Align: tview.AlignRight},
+		Header{Name: "KIND"},
+		Header{Name: "TARGET"},
+		Header{Name: "DESCRIPTION"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Task) Render(o interface{}, _ string, r *Row) error {
+	t, ok := o.(TaskRes)
+	if !ok {
+		return fmt.Errorf("expected TaskRes, but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(t.ID)
+	r.Fields = Fields{
+		r.ID,
+		t.Kind,
+		t.Target,
+		t.Description,
+		timeToAge(t.Started),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TaskRes represents an in flight operation.
+type TaskRes struct {
+	ID                        int
+	Kind, Target, Description string
+	Started                   time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (TaskRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TaskRes) DeepCopyObject() runtime.Object {
+	return t
+}
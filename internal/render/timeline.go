@@ -0,0 +1,103 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TimelineTimeFormat represents a sortable timeline entry time.
+const TimelineTimeFormat = "2006-01-02 15:04:05"
+
+// Timeline renders a resource timeline to screen.
+type Timeline struct{}
+
+// ColorerFunc colors a resource row.
+func (Timeline) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch {
+		case re.Row.Fields[2] == "Warning":
+			return ErrColor
+		case re.Row.Fields[1] == "Revision":
+			return AddColor
+		case strings.HasSuffix(re.Row.Fields[2], "=False"):
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Timeline) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "TIME"},
+		Header{Name: "SOURCE"},
+		Header{Name: "TYPE"},
+		Header{Name: "REASON"},
+		Header{Name: "MESSAGE"},
+		Header{Name: "COUNT", Align: tview.AlignRight},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Timeline) Render(o interface{}, ns string, r *Row) error {
+	tl, ok := o.(TimelineRes)
+	if !ok {
+		return fmt.Errorf("expected TimelineRes, but got %T", o)
+	}
+
+	count := NAValue
+	if tl.Count > 0 {
+		count = strconv.Itoa(int(tl.Count))
+	}
+	r.ID = client.FQN(tl.GVR, tl.Path)
+	if tl.Key != "" {
+		r.ID += "#" + tl.Key
+	}
+	r.Fields = Fields{
+		tl.Time.Format(TimelineTimeFormat),
+		tl.Source,
+		missing(tl.Type),
+		missing(tl.Reason),
+		tl.Message,
+		count,
+		toAge(tl.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TimelineRes represents a resource timeline entry.
+type TimelineRes struct {
+	GVR     string
+	Path    string
+	Key     string
+	Time    metav1.Time
+	Source  string
+	Type    string
+	Reason  string
+	Message string
+	Count   int32
+}
+
+// GetObjectKind returns a schema object.
+func (TimelineRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TimelineRes) DeepCopyObject() runtime.Object {
+	return t
+}
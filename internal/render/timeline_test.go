@@ -0,0 +1,42 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTimelineRender(t *testing.T) {
+	at := metav1.NewTime(time.Date(2020, 3, 1, 14, 32, 5, 0, time.Local))
+	uu := map[string]struct {
+		o  render.TimelineRes
+		id string
+		e  render.Fields
+	}{
+		"event": {
+			o:  render.TimelineRes{GVR: "v1/events", Path: "default/fred.1", Time: at, Source: "Event", Type: "Warning", Reason: "BackOff", Message: "blee", Count: 3},
+			id: "v1/events/default/fred.1",
+			e:  render.Fields{"2020-03-01 14:32:05", "Event", "Warning", "BackOff", "blee", "3"},
+		},
+		"condition": {
+			o:  render.TimelineRes{GVR: "apps/v1/deployments", Path: "default/fred", Key: "Available", Time: at, Source: "Condition", Type: "Available=True"},
+			id: "apps/v1/deployments/default/fred#Available",
+			e:  render.Fields{"2020-03-01 14:32:05", "Condition", "Available=True", render.MissingValue, "", render.NAValue},
+		},
+	}
+
+	var re render.Timeline
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, re.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+			assert.Equal(t, len(re.Header("")), len(r.Fields))
+		})
+	}
+}
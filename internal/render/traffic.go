@@ -0,0 +1,430 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const clusterDomain = ".svc.cluster.local"
+
+// VirtualService renders an Istio VirtualService to screen.
+type VirtualService struct{}
+
+// ColorerFunc colors a resource row.
+func (VirtualService) ColorerFunc() ColorerFunc {
+	return brokenRefColorer(4)
+}
+
+// Header returns a header row.
+func (VirtualService) Header(ns string) HeaderRow {
+	return withNS(ns,
+		Header{Name: "NAME"},
+		Header{Name: "GATEWAYS"},
+		Header{Name: "HOSTS"},
+		Header{Name: "DESTINATIONS"},
+		Header{Name: "BROKEN", Align: tview.AlignRight},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (v VirtualService) Render(o interface{}, ns string, r *Row) error {
+	rr, ok := o.(*RouteWithRefs)
+	if !ok {
+		return fmt.Errorf("Expected RouteWithRefs, but got %T", o)
+	}
+
+	gg, _, _ := unstructured.NestedStringSlice(rr.Raw.Object, "spec", "gateways")
+	hh, _, _ := unstructured.NestedStringSlice(rr.Raw.Object, "spec", "hosts")
+	dd := ToVSDestinations(rr.Raw)
+
+	r.ID = client.FQN(rr.Raw.GetNamespace(), rr.Raw.GetName())
+	r.Fields = appendNS(ns, rr.Raw.GetNamespace(),
+		rr.Raw.GetName(),
+		missing(strings.Join(gg, ",")),
+		missing(strings.Join(hh, ",")),
+		missing(joinDestinations(dd)),
+		strconv.Itoa(rr.Refs.BrokenCount(dd)),
+		toAge(rr.Raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// DestinationRule renders an Istio DestinationRule to screen.
+type DestinationRule struct{}
+
+// ColorerFunc colors a resource row.
+func (DestinationRule) ColorerFunc() ColorerFunc {
+	return brokenRefColorer(3)
+}
+
+// Header returns a header row.
+func (DestinationRule) Header(ns string) HeaderRow {
+	return withNS(ns,
+		Header{Name: "NAME"},
+		Header{Name: "HOST"},
+		Header{Name: "SUBSETS"},
+		Header{Name: "BROKEN", Align: tview.AlignRight},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (d DestinationRule) Render(o interface{}, ns string, r *Row) error {
+	rr, ok := o.(*RouteWithRefs)
+	if !ok {
+		return fmt.Errorf("Expected RouteWithRefs, but got %T", o)
+	}
+
+	host, _, _ := unstructured.NestedString(rr.Raw.Object, "spec", "host")
+	dd := []RouteDestination{{Namespace: rr.Raw.GetNamespace(), Host: host}}
+
+	r.ID = client.FQN(rr.Raw.GetNamespace(), rr.Raw.GetName())
+	r.Fields = appendNS(ns, rr.Raw.GetNamespace(),
+		rr.Raw.GetName(),
+		host,
+		missing(strings.Join(ToDRSubsets(rr.Raw), ",")),
+		strconv.Itoa(rr.Refs.BrokenCount(dd)),
+		toAge(rr.Raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// IstioGateway renders an Istio Gateway to screen.
+type IstioGateway struct{}
+
+// ColorerFunc colors a resource row.
+func (IstioGateway) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (IstioGateway) Header(ns string) HeaderRow {
+	return withNS(ns,
+		Header{Name: "NAME"},
+		Header{Name: "SELECTOR"},
+		Header{Name: "SERVERS"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (IstioGateway) Render(o interface{}, ns string, r *Row) error {
+	rr, ok := o.(*RouteWithRefs)
+	if !ok {
+		return fmt.Errorf("Expected RouteWithRefs, but got %T", o)
+	}
+
+	sel, _, _ := unstructured.NestedStringMap(rr.Raw.Object, "spec", "selector")
+	ss, _, _ := unstructured.NestedSlice(rr.Raw.Object, "spec", "servers")
+	servers := make([]string, 0, len(ss))
+	for _, s := range ss {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		port, _, _ := unstructured.NestedInt64(m, "port", "number")
+		proto, _, _ := unstructured.NestedString(m, "port", "protocol")
+		hh, _, _ := unstructured.NestedStringSlice(m, "hosts")
+		servers = append(servers, fmt.Sprintf("%s:%d/%s", strings.Join(hh, ","), port, proto))
+	}
+
+	r.ID = client.FQN(rr.Raw.GetNamespace(), rr.Raw.GetName())
+	r.Fields = appendNS(ns, rr.Raw.GetNamespace(),
+		rr.Raw.GetName(),
+		missing(toSelector(sel)),
+		missing(strings.Join(servers, " ")),
+		toAge(rr.Raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// HTTPRoute renders a Gateway API HTTPRoute to screen.
+type HTTPRoute struct{}
+
+// ColorerFunc colors a resource row.
+func (HTTPRoute) ColorerFunc() ColorerFunc {
+	return brokenRefColorer(4)
+}
+
+// Header returns a header row.
+func (HTTPRoute) Header(ns string) HeaderRow {
+	return withNS(ns,
+		Header{Name: "NAME"},
+		Header{Name: "PARENTS"},
+		Header{Name: "HOSTNAMES"},
+		Header{Name: "BACKENDS"},
+		Header{Name: "BROKEN", Align: tview.AlignRight},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (h HTTPRoute) Render(o interface{}, ns string, r *Row) error {
+	rr, ok := o.(*RouteWithRefs)
+	if !ok {
+		return fmt.Errorf("Expected RouteWithRefs, but got %T", o)
+	}
+
+	pp, _, _ := unstructured.NestedSlice(rr.Raw.Object, "spec", "parentRefs")
+	parents := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if m, ok := p.(map[string]interface{}); ok {
+			n, _ := m["name"].(string)
+			parents = append(parents, n)
+		}
+	}
+	hh, _, _ := unstructured.NestedStringSlice(rr.Raw.Object, "spec", "hostnames")
+	dd := ToHTTPRouteBackends(rr.Raw)
+
+	r.ID = client.FQN(rr.Raw.GetNamespace(), rr.Raw.GetName())
+	r.Fields = appendNS(ns, rr.Raw.GetNamespace(),
+		rr.Raw.GetName(),
+		missing(strings.Join(parents, ",")),
+		missing(strings.Join(hh, ",")),
+		missing(joinDestinations(dd)),
+		strconv.Itoa(rr.Refs.BrokenCount(dd)),
+		toAge(rr.Raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RouteWithRefs represents a traffic resource and the cluster resources
+// it may reference.
+type RouteWithRefs struct {
+	Raw  *unstructured.Unstructured
+	Refs TrafficRefs
+}
+
+// GetObjectKind returns a schema object.
+func (r *RouteWithRefs) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *RouteWithRefs) DeepCopyObject() runtime.Object {
+	return r
+}
+
+// TrafficRefs tracks known services and destination rule subsets keyed by
+// service fully qualified name.
+type TrafficRefs struct {
+	Services map[string]struct{}
+	Subsets  map[string][]string
+}
+
+// Check returns an issue for a given destination or an empty string if
+// the destination resolves.
+func (t TrafficRefs) Check(d RouteDestination) string {
+	svc, ok := d.Service()
+	if !ok {
+		return ""
+	}
+	if _, ok := t.Services[svc]; !ok {
+		return "service " + svc + " not found"
+	}
+	if d.Subset == "" {
+		return ""
+	}
+	for _, s := range t.Subsets[svc] {
+		if s == d.Subset {
+			return ""
+		}
+	}
+
+	return "subset " + d.Subset + " not found for " + svc
+}
+
+// BrokenCount returns the number of destinations that do not resolve.
+func (t TrafficRefs) BrokenCount(dd []RouteDestination) int {
+	var count int
+	for _, d := range dd {
+		if t.Check(d) != "" {
+			count++
+		}
+	}
+
+	return count
+}
+
+// RouteDestination represents a traffic destination.
+type RouteDestination struct {
+	Namespace string
+	Host      string
+	Subset    string
+	Port      int64
+}
+
+// Service returns the destination in cluster service fully qualified name.
+// External hosts are not resolved.
+func (r RouteDestination) Service() (string, bool) {
+	h := strings.TrimSuffix(strings.TrimSuffix(r.Host, clusterDomain), ".svc")
+	tokens := strings.Split(h, ".")
+	switch {
+	case len(tokens) == 1 && !strings.Contains(h, "*"):
+		return client.FQN(r.Namespace, h), true
+	case len(tokens) == 2 && h != r.Host:
+		return client.FQN(tokens[1], tokens[0]), true
+	default:
+		return "", false
+	}
+}
+
+func (r RouteDestination) String() string {
+	s := r.Host
+	if r.Subset != "" {
+		s += "/" + r.Subset
+	}
+	if r.Port != 0 {
+		s += ":" + strconv.Itoa(int(r.Port))
+	}
+
+	return s
+}
+
+// ToVSDestinations extracts all route destinations from a VirtualService.
+func ToVSDestinations(raw *unstructured.Unstructured) []RouteDestination {
+	var dd []RouteDestination
+	for _, kind := range []string{"http", "tcp", "tls"} {
+		rr, _, _ := unstructured.NestedSlice(raw.Object, "spec", kind)
+		for _, r := range rr {
+			m, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			routes, _, _ := unstructured.NestedSlice(m, "route")
+			if mirror, ok, _ := unstructured.NestedMap(m, "mirror"); ok {
+				routes = append(routes, map[string]interface{}{"destination": mirror})
+			}
+			for _, route := range routes {
+				rm, ok := route.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				d := RouteDestination{Namespace: raw.GetNamespace()}
+				d.Host, _, _ = unstructured.NestedString(rm, "destination", "host")
+				d.Subset, _, _ = unstructured.NestedString(rm, "destination", "subset")
+				d.Port, _, _ = unstructured.NestedInt64(rm, "destination", "port", "number")
+				dd = appendDestination(dd, d)
+			}
+		}
+	}
+
+	return dd
+}
+
+// ToHTTPRouteBackends extracts all service backends from a Gateway API HTTPRoute.
+func ToHTTPRouteBackends(raw *unstructured.Unstructured) []RouteDestination {
+	var dd []RouteDestination
+	rr, _, _ := unstructured.NestedSlice(raw.Object, "spec", "rules")
+	for _, r := range rr {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		bb, _, _ := unstructured.NestedSlice(m, "backendRefs")
+		for _, b := range bb {
+			bm, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if kind, ok := bm["kind"].(string); ok && kind != "Service" {
+				continue
+			}
+			d := RouteDestination{Namespace: raw.GetNamespace()}
+			if ns, ok := bm["namespace"].(string); ok {
+				d.Namespace = ns
+			}
+			d.Host, _ = bm["name"].(string)
+			d.Port, _, _ = unstructured.NestedInt64(bm, "port")
+			dd = appendDestination(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// ToDRSubsets returns a DestinationRule subset names.
+func ToDRSubsets(raw *unstructured.Unstructured) []string {
+	ss, _, _ := unstructured.NestedSlice(raw.Object, "spec", "subsets")
+	res := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if m, ok := s.(map[string]interface{}); ok {
+			n, _ := m["name"].(string)
+			res = append(res, n)
+		}
+	}
+
+	return res
+}
+
+func appendDestination(dd []RouteDestination, d RouteDestination) []RouteDestination {
+	for _, v := range dd {
+		if v == d {
+			return dd
+		}
+	}
+
+	return append(dd, d)
+}
+
+func joinDestinations(dd []RouteDestination) string {
+	ss := make([]string, 0, len(dd))
+	for _, d := range dd {
+		ss = append(ss, d.String())
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
+
+func brokenRefColorer(col int) ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+		idx := col
+		if client.IsAllNamespaces(ns) {
+			idx++
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) != "0" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+func withNS(ns string, hh ...Header) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h, hh...)
+}
+
+func appendNS(ns, rns string, ff ...string) Fields {
+	if !client.IsAllNamespaces(ns) {
+		return ff
+	}
+
+	return append(Fields{rns}, ff...)
+}
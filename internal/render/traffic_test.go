@@ -0,0 +1,62 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualServiceRender(t *testing.T) {
+	refs := render.TrafficRefs{
+		Services: map[string]struct{}{"default/reviews": {}},
+		Subsets:  map[string][]string{"default/reviews": {"v1", "v2"}},
+	}
+
+	var v render.VirtualService
+	r := render.NewRow(6)
+	assert.Nil(t, v.Render(&render.RouteWithRefs{Raw: load(t, "vs"), Refs: refs}, "default", &r))
+
+	assert.Equal(t, "default/reviews", r.ID)
+	assert.Equal(t, render.Fields{
+		"reviews",
+		"bookinfo-gateway",
+		"reviews",
+		"db.example.com,ratings.prod.svc.cluster.local,reviews.default.svc.cluster.local/v3:9080,reviews/v1",
+		"2",
+	}, r.Fields[:5])
+}
+
+func TestHTTPRouteRender(t *testing.T) {
+	refs := render.TrafficRefs{
+		Services: map[string]struct{}{"default/store-v1": {}},
+	}
+
+	var h render.HTTPRoute
+	r := render.NewRow(7)
+	assert.Nil(t, h.Render(&render.RouteWithRefs{Raw: load(t, "httproute"), Refs: refs}, "", &r))
+
+	assert.Equal(t, render.Fields{"default", "store", "public", "store.example.com", "store-v1:8080,store-v2:8080", "1"}, r.Fields[:6])
+}
+
+func TestRouteDestinationService(t *testing.T) {
+	uu := map[string]struct {
+		host, e string
+		ok      bool
+	}{
+		"short":    {host: "reviews", e: "default/reviews", ok: true},
+		"svc":      {host: "reviews.prod.svc", e: "prod/reviews", ok: true},
+		"fqdn":     {host: "reviews.prod.svc.cluster.local", e: "prod/reviews", ok: true},
+		"external": {host: "api.example.com"},
+		"wildcard": {host: "*"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc, ok := render.RouteDestination{Namespace: "default", Host: u.host}.Service()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, svc)
+		})
+	}
+}
@@ -23,6 +23,12 @@ const (
 
 	// PodInitializing represents a pod initializing status.
 	PodInitializing = "PodInitializing"
+
+	// Evicted represents a pod evicted status.
+	Evicted = "Evicted"
+
+	// Failed represents a pod failed status.
+	Failed = "Failed"
 )
 
 const (
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// UpgradeReady indicates a check does not prevent an upgrade.
+	UpgradeReady = "Ready"
+
+	// UpgradeWarn indicates a check should be reviewed prior to an upgrade.
+	UpgradeWarn = "Warn"
+
+	// UpgradeBlocked indicates a check must be fixed prior to an upgrade.
+	UpgradeBlocked = "Blocked"
+)
+
+const (
+	// CheckSummary tracks the upgrade readiness summary.
+	CheckSummary = "Summary"
+
+	// CheckControlPlane tracks the api server version.
+	CheckControlPlane = "ControlPlane"
+
+	// CheckKubelet tracks a node kubelet version skew.
+	CheckKubelet = "Kubelet"
+
+	// CheckAPI tracks resources using deprecated api versions.
+	CheckAPI = "API"
+)
+
+// Upgrade renders a cluster upgrade readiness report to screen.
+type Upgrade struct{}
+
+// ColorerFunc colors a resource row.
+func (Upgrade) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[4] {
+		case UpgradeBlocked:
+			return ErrColor
+		case UpgradeWarn:
+			return ModColor
+		}
+		if re.Row.Fields[0] == CheckSummary {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Upgrade) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "CHECK"},
+		Header{Name: "NAME"},
+		Header{Name: "VERSION"},
+		Header{Name: "TARGET"},
+		Header{Name: "STATUS"},
+		Header{Name: "DETAIL"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Upgrade) Render(o interface{}, ns string, r *Row) error {
+	u, ok := o.(UpgradeRes)
+	if !ok {
+		return fmt.Errorf("expected UpgradeRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(u.Check, u.Name)
+	r.Fields = Fields{
+		u.Check,
+		u.Name,
+		missing(u.Version),
+		missing(u.Target),
+		u.Status,
+		u.Detail,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// UpgradeRes represents an upgrade readiness check.
+type UpgradeRes struct {
+	Check, Name     string
+	Version, Target string
+	Status, Detail  string
+}
+
+// GetObjectKind returns a schema object.
+func (UpgradeRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (u UpgradeRes) DeepCopyObject() runtime.Object {
+	return u
+}
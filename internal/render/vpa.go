@@ -0,0 +1,121 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// VerticalPodAutoscaler renders a VPA to screen.
+type VerticalPodAutoscaler struct{}
+
+// ColorerFunc colors a resource row.
+func (VerticalPodAutoscaler) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+
+		providedCol := 4
+		if client.IsAllNamespaces(ns) {
+			providedCol++
+		}
+		if strings.TrimSpace(re.Row.Fields[providedCol]) != "True" {
+			return ModColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (VerticalPodAutoscaler) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "TARGET"},
+		Header{Name: "MODE"},
+		Header{Name: "RECOMMENDATIONS"},
+		Header{Name: "PROVIDED"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (v VerticalPodAutoscaler) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	}
+
+	kind, _, _ := unstructured.NestedString(raw.Object, "spec", "targetRef", "kind")
+	name, _, _ := unstructured.NestedString(raw.Object, "spec", "targetRef", "name")
+	mode, _, _ := unstructured.NestedString(raw.Object, "spec", "updatePolicy", "updateMode")
+	if mode == "" {
+		mode = "Auto"
+	}
+	var provided string
+	for _, c := range conditions(raw) {
+		if c.Type == "RecommendationProvided" {
+			provided = c.Status
+		}
+	}
+	rr := ToVPARecommendations(raw)
+	recs := make([]string, 0, len(rr))
+	for _, rec := range rr {
+		recs = append(recs, fmt.Sprintf("%s=%s/%s", rec.Container, na(rec.Target["cpu"]), na(rec.Target["memory"])))
+	}
+
+	r.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	r.Fields = make(Fields, 0, len(v.Header(ns)))
+	if client.IsAllNamespaces(ns) {
+		r.Fields = append(r.Fields, raw.GetNamespace())
+	}
+	r.Fields = append(r.Fields,
+		raw.GetName(),
+		kind+"/"+name,
+		mode,
+		missing(strings.Join(recs, ",")),
+		na(provided),
+		toAge(raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// VPARecommendation represents a container resources recommendation.
+type VPARecommendation struct {
+	Container            string
+	Target, Lower, Upper map[string]string
+}
+
+// ToVPARecommendations extracts container recommendations from a VPA.
+func ToVPARecommendations(raw *unstructured.Unstructured) []VPARecommendation {
+	cc, _, _ := unstructured.NestedSlice(raw.Object, "status", "recommendation", "containerRecommendations")
+	rr := make([]VPARecommendation, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var r VPARecommendation
+		r.Container, _ = m["containerName"].(string)
+		r.Target, _, _ = unstructured.NestedStringMap(m, "target")
+		r.Lower, _, _ = unstructured.NestedStringMap(m, "lowerBound")
+		r.Upper, _, _ = unstructured.NestedStringMap(m, "upperBound")
+		rr = append(rr, r)
+	}
+
+	return rr
+}
@@ -0,0 +1,17 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPARender(t *testing.T) {
+	var v render.VerticalPodAutoscaler
+	r := render.NewRow(6)
+
+	assert.Nil(t, v.Render(load(t, "vpa"), "default", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"fred", "Deployment/fred", "Off", "app=250m/256Mi", "True"}, r.Fields[:5])
+}
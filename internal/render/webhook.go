@@ -0,0 +1,202 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Webhook renders a K8s Validating or Mutating webhook configuration to screen.
+type Webhook struct{}
+
+// ColorerFunc colors a resource row.
+func (Webhook) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+
+		if strings.TrimSpace(r.Row.Fields[4]) != "0" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Webhook) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "WEBHOOKS", Align: tview.AlignRight},
+		Header{Name: "FAILURE POLICY"},
+		Header{Name: "SERVICES"},
+		Header{Name: "UNHEALTHY", Align: tview.AlignRight},
+		Header{Name: "NS SELECTOR"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (w Webhook) Render(o interface{}, ns string, r *Row) error {
+	wwe, ok := o.(*WebhookWithEndpoints)
+	if !ok {
+		return fmt.Errorf("Expected WebhookWithEndpoints, but got %T", o)
+	}
+
+	meta, hooks, err := ToWebhooks(wwe.Raw)
+	if err != nil {
+		return err
+	}
+
+	pp, ss, sels := make([]string, 0, len(hooks)), make([]string, 0, len(hooks)), make([]string, 0, len(hooks))
+	var unhealthy int
+	for _, h := range hooks {
+		pp = appendUniq(pp, h.FailurePolicy)
+		if h.Service != "" {
+			ss = appendUniq(ss, h.Service)
+		}
+		if h.NSSelector != "" {
+			sels = appendUniq(sels, h.NSSelector)
+		}
+		if !h.Healthy(wwe.Ready) {
+			unhealthy++
+		}
+	}
+
+	r.ID = client.MetaFQN(meta)
+	r.Fields = Fields{
+		meta.Name,
+		strconv.Itoa(len(hooks)),
+		missing(strings.Join(pp, ",")),
+		missing(strings.Join(ss, ",")),
+		strconv.Itoa(unhealthy),
+		missing(strings.Join(sels, ",")),
+		toAge(meta.CreationTimestamp),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WebhookWithEndpoints represents a webhook configuration and the ready
+// endpoints count of its backing services.
+type WebhookWithEndpoints struct {
+	Raw   *unstructured.Unstructured
+	Ready map[string]int
+}
+
+// GetObjectKind returns a schema object.
+func (w *WebhookWithEndpoints) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w *WebhookWithEndpoints) DeepCopyObject() runtime.Object {
+	return w
+}
+
+// WebhookInfo represents a single admission webhook.
+type WebhookInfo struct {
+	Name          string
+	FailurePolicy string
+	Service       string
+	URL           string
+	NSSelector    string
+	ObjSelector   string
+	Rules         []string
+}
+
+// Healthy checks if the webhook backing service has ready endpoints.
+// Url based webhooks are assumed healthy since they live outside the cluster.
+func (w WebhookInfo) Healthy(ready map[string]int) bool {
+	if w.Service == "" {
+		return true
+	}
+	svc := strings.Split(w.Service, ":")[0]
+
+	return ready[svc] > 0
+}
+
+// Critical checks if an unhealthy webhook will reject admission requests.
+func (w WebhookInfo) Critical(ready map[string]int) bool {
+	return !w.Healthy(ready) && w.FailurePolicy == string(v1beta1.Fail)
+}
+
+// ToWebhooks extracts webhook information from a webhook configuration.
+// Validating and mutating configurations share the same layout so the
+// validating flavor is used to decode both.
+func ToWebhooks(raw *unstructured.Unstructured) (metav1.ObjectMeta, []WebhookInfo, error) {
+	var cfg v1beta1.ValidatingWebhookConfiguration
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cfg); err != nil {
+		return cfg.ObjectMeta, nil, err
+	}
+
+	hh := make([]WebhookInfo, 0, len(cfg.Webhooks))
+	for _, w := range cfg.Webhooks {
+		policy := string(v1beta1.Ignore)
+		if w.FailurePolicy != nil {
+			policy = string(*w.FailurePolicy)
+		}
+		info := WebhookInfo{
+			Name:          w.Name,
+			FailurePolicy: policy,
+		}
+		if w.NamespaceSelector != nil && !isEmptySelector(w.NamespaceSelector) {
+			info.NSSelector = asSelector(w.NamespaceSelector)
+		}
+		if w.ObjectSelector != nil && !isEmptySelector(w.ObjectSelector) {
+			info.ObjSelector = asSelector(w.ObjectSelector)
+		}
+		if s := w.ClientConfig.Service; s != nil {
+			info.Service = client.FQN(s.Namespace, s.Name)
+			if s.Port != nil {
+				info.Service += ":" + strconv.Itoa(int(*s.Port))
+			}
+		}
+		if w.ClientConfig.URL != nil {
+			info.URL = *w.ClientConfig.URL
+		}
+		for _, r := range w.Rules {
+			info.Rules = append(info.Rules, toWebhookRule(r))
+		}
+		hh = append(hh, info)
+	}
+
+	return cfg.ObjectMeta, hh, nil
+}
+
+func toWebhookRule(r v1beta1.RuleWithOperations) string {
+	oo := make([]string, 0, len(r.Operations))
+	for _, o := range r.Operations {
+		oo = append(oo, string(o))
+	}
+
+	return strings.Join(oo, ",") + " " + strings.Join(r.Resources, ",")
+}
+
+func isEmptySelector(s *metav1.LabelSelector) bool {
+	return len(s.MatchLabels) == 0 && len(s.MatchExpressions) == 0
+}
+
+func appendUniq(ss []string, s string) []string {
+	for _, v := range ss {
+		if v == s {
+			return ss
+		}
+	}
+
+	return append(ss, s)
+}
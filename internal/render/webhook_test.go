@@ -0,0 +1,50 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRender(t *testing.T) {
+	uu := map[string]struct {
+		ready map[string]int
+		e     render.Fields
+	}{
+		"healthy": {
+			ready: map[string]int{"default/blee": 2},
+			e:     render.Fields{"fred", "2", "Fail,Ignore", "default/blee:443", "0", "fred=enabled"},
+		},
+		"no-endpoints": {
+			ready: map[string]int{},
+			e:     render.Fields{"fred", "2", "Fail,Ignore", "default/blee:443", "1", "fred=enabled"},
+		},
+	}
+
+	var w render.Webhook
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(7)
+			assert.Nil(t, w.Render(&render.WebhookWithEndpoints{Raw: load(t, "vwh"), Ready: u.ready}, "", &r))
+
+			assert.Equal(t, "-/fred", r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
+}
+
+func TestToWebhooks(t *testing.T) {
+	_, hh, err := render.ToWebhooks(load(t, "vwh"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(hh))
+	assert.Equal(t, "default/blee:443", hh[0].Service)
+	assert.Equal(t, []string{"CREATE,UPDATE deployments"}, hh[0].Rules)
+	assert.True(t, hh[0].Critical(map[string]int{}))
+	assert.False(t, hh[0].Critical(map[string]int{"default/blee": 1}))
+	assert.Equal(t, "https://fred.io/validate", hh[1].URL)
+	assert.Equal(t, "", hh[1].NSSelector)
+	assert.True(t, hh[1].Healthy(map[string]int{}))
+}
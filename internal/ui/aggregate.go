@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TotalLabel labels the aggregate footer row.
+const TotalLabel = "TOTAL"
+
+// Aggregate sums up numeric, ratio (ie 1/2) or quantity columns across the
+// given rows. Non numeric, percentage and age columns are left blank.
+func Aggregate(header render.HeaderRow, ee render.RowEvents) render.Fields {
+	ff := make(render.Fields, len(header))
+	var count int
+	for _, e := range ee {
+		if e.Kind != render.EventDelete {
+			count++
+		}
+	}
+	for col, h := range header {
+		if header.AgeCol(col) || strings.HasPrefix(h.Name, "%") {
+			continue
+		}
+		vv := make([]string, 0, len(ee))
+		for _, e := range ee {
+			if e.Kind == render.EventDelete || col >= len(e.Row.Fields) {
+				continue
+			}
+			if v := e.Row.Fields[col]; !blankCell(v) {
+				vv = append(vv, v)
+			}
+		}
+		if total, ok := sum(vv); ok {
+			ff[col] = total
+		}
+	}
+	if len(ff) > 0 {
+		ff[0] = TotalLabel + "(" + strconv.Itoa(count) + ")"
+	}
+
+	return ff
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func blankCell(s string) bool {
+	return s == "" || s == render.NAValue || s == render.MissingValue
+}
+
+func sum(vv []string) (string, bool) {
+	if len(vv) == 0 {
+		return "", false
+	}
+	if s, ok := sumInts(vv); ok {
+		return s, true
+	}
+	if s, ok := sumFloats(vv); ok {
+		return s, true
+	}
+	if s, ok := sumRatios(vv); ok {
+		return s, true
+	}
+
+	return sumQuantities(vv)
+}
+
+func sumInts(vv []string) (string, bool) {
+	var total int64
+	for _, v := range vv {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", false
+		}
+		total += i
+	}
+
+	return strconv.FormatInt(total, 10), true
+}
+
+func sumFloats(vv []string) (string, bool) {
+	var total float64
+	for _, v := range vv {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return "", false
+		}
+		total += f
+	}
+
+	return strconv.FormatFloat(total, 'f', -1, 64), true
+}
+
+func sumRatios(vv []string) (string, bool) {
+	var num, den int64
+	for _, v := range vv {
+		tokens := strings.Split(v, "/")
+		if len(tokens) != 2 {
+			return "", false
+		}
+		n, err := strconv.ParseInt(tokens[0], 10, 64)
+		if err != nil {
+			return "", false
+		}
+		d, err := strconv.ParseInt(tokens[1], 10, 64)
+		if err != nil {
+			return "", false
+		}
+		num, den = num+n, den+d
+	}
+
+	return strconv.FormatInt(num, 10) + "/" + strconv.FormatInt(den, 10), true
+}
+
+func sumQuantities(vv []string) (string, bool) {
+	var total resource.Quantity
+	for _, v := range vv {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return "", false
+		}
+		total.Add(q)
+	}
+
+	return total.String(), true
+}
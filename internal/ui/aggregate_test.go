@@ -0,0 +1,30 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregate(t *testing.T) {
+	h := render.HeaderRow{
+		{Name: "NAME"},
+		{Name: "READY"},
+		{Name: "RESTARTS"},
+		{Name: "CPU"},
+		{Name: "%CPU/R"},
+		{Name: "CAPACITY"},
+		{Name: "IP"},
+		{Name: "AGE"},
+	}
+	ee := render.RowEvents{
+		{Row: render.Row{Fields: render.Fields{"p1", "1/1", "3", "100", "50", "1Gi", "10.0.0.1", "1m"}}},
+		{Row: render.Row{Fields: render.Fields{"p2", "0/2", "0", "0.5", "20", "512Mi", "10.0.0.2", "2m"}}},
+		{Row: render.Row{Fields: render.Fields{"p3", "1/1", "2", render.NAValue, "10", render.MissingValue, "10.0.0.3", "3m"}}},
+		{Kind: render.EventDelete, Row: render.Row{Fields: render.Fields{"p4", "1/1", "20", "100", "10", "1Gi", "10.0.0.4", "4m"}}},
+	}
+
+	assert.Equal(t, render.Fields{"TOTAL(3)", "2/4", "5", "100.5", "", "1536Mi", "", ""}, ui.Aggregate(h, ee))
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"os"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/tview"
@@ -17,6 +19,9 @@ type App struct {
 	actions KeyActions
 	views   map[string]tview.Primitive
 	cmdBuff *CmdBuff
+	keys    *KeyProfile
+	vim     *VimMotions
+	rec     *Recorder
 }
 
 // NewApp returns a new app.
@@ -26,6 +31,8 @@ func NewApp(context string) *App {
 		actions:     make(KeyActions),
 		Main:        NewPages(),
 		cmdBuff:     NewCmdBuff(':', CommandBuff),
+		keys:        NewKeyProfile(config.DefaultKeyProfile),
+		vim:         NewVimMotions(false),
 	}
 	a.ReloadStyles(context)
 
@@ -168,13 +175,74 @@ func (a *App) Views() map[string]tview.Primitive {
 	return a.views
 }
 
+// KeyProfile returns the active key profile.
+func (a *App) KeyProfile() *KeyProfile {
+	return a.keys
+}
+
+// SetKeyProfile switches the key bindings profile.
+func (a *App) SetKeyProfile(name string) error {
+	if err := a.keys.Set(name); err != nil {
+		return err
+	}
+	a.Menu().SetKeyProfile(a.keys)
+
+	return nil
+}
+
+// VimMotions returns the vim mode translator.
+func (a *App) VimMotions() *VimMotions {
+	return a.vim
+}
+
+// SetVimMode toggles vim style navigation.
+func (a *App) SetVimMode(b bool) {
+	a.vim.SetEnabled(b)
+	a.Menu().SetVimMotions(a.vim)
+}
+
+// Beep rings the terminal bell. The bell is queued on the event loop so it
+// never interleaves with a screen draw.
+func (a *App) Beep() {
+	a.QueueUpdate(func() {
+		_, _ = os.Stdout.WriteString("\a")
+	})
+}
+
+// SetRecorder records the session screen frames and keystrokes.
+func (a *App) SetRecorder(r *Recorder) {
+	a.rec = r
+	a.SetAfterDrawFunc(r.Frame)
+}
+
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a.rec != nil {
+		a.rec.Key(evt)
+	}
+	if _, ok := a.GetFocus().(*Terminal); ok {
+		return evt
+	}
+	if _, ok := a.GetFocus().(*tview.InputField); !ok && !a.InCmdMode() {
+		if evt = a.keys.Map(evt); evt == nil {
+			return nil
+		}
+		var follow *tcell.EventKey
+		if evt, follow = a.vim.Map(evt, a.focusBinds); evt == nil {
+			return nil
+		}
+		if follow != nil {
+			a.QueueEvent(follow)
+		}
+	}
 	key := evt.Key()
 	if key == tcell.KeyRune {
 		if a.cmdBuff.IsActive() && evt.Modifiers() == tcell.ModNone {
 			a.cmdBuff.Add(evt.Rune())
 			return nil
 		}
+		if _, ok := a.GetFocus().(*tview.InputField); ok {
+			return evt
+		}
 		key = asKey(evt)
 	}
 
@@ -185,6 +253,19 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (a *App) focusBinds(k tcell.Key) bool {
+	if _, ok := a.actions[k]; ok {
+		return true
+	}
+	c, ok := a.GetFocus().(interface{ Actions() KeyActions })
+	if !ok {
+		return false
+	}
+	_, ok = c.Actions()[k]
+
+	return ok
+}
+
 func (a *App) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !a.CmdBuff().IsActive() {
 		return evt
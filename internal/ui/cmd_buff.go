@@ -7,6 +7,8 @@ const (
 	CommandBuff BufferKind = 1 << iota
 	// FilterBuff indicates a search buffer.
 	FilterBuff
+	// JumpBuff indicates a row jump buffer.
+	JumpBuff
 )
 
 type (
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -15,6 +16,7 @@ type Command struct {
 	*tview.TextView
 
 	activated bool
+	kind      BufferKind
 	icon      rune
 	text      string
 	styles    *config.Styles
@@ -62,6 +64,12 @@ func (c *Command) update(s string) {
 
 func (c *Command) write(s string) {
 	fmt.Fprintf(c, defaultPrompt, c.icon, s)
+	if c.kind != FilterBuff {
+		return
+	}
+	if err := model.ValidateFilter(s); err != nil {
+		fmt.Fprintf(c, "  [red::]%s", tview.Escape(err.Error()))
+	}
 }
 
 // ----------------------------------------------------------------------------
@@ -78,7 +86,7 @@ func (c *Command) BufferActive(f bool, k BufferKind) {
 		c.SetBorder(true)
 		c.SetTextColor(c.styles.FgColor())
 		c.SetBorderColor(colorFor(k))
-		c.icon = iconFor(k)
+		c.icon, c.kind = iconFor(k), k
 		// c.reset()
 		c.activate()
 	} else {
@@ -92,6 +100,8 @@ func colorFor(k BufferKind) tcell.Color {
 	switch k {
 	case CommandBuff:
 		return tcell.ColorAqua
+	case JumpBuff:
+		return tcell.ColorOrange
 	default:
 		return tcell.ColorSeaGreen
 	}
@@ -101,6 +111,8 @@ func iconFor(k BufferKind) rune {
 	switch k {
 	case CommandBuff:
 		return '🐶'
+	case JumpBuff:
+		return '🦴'
 	default:
 		return '🐩'
 	}
@@ -42,3 +42,17 @@ func TestCmdMode(t *testing.T) {
 		assert.Equal(t, f, v.InCmdMode())
 	}
 }
+
+func TestCmdFilterError(t *testing.T) {
+	v := ui.NewCommand(config.NewStyles())
+
+	buff := ui.NewCmdBuff('/', ui.FilterBuff)
+	buff.AddListener(v)
+	buff.SetActive(true)
+	buff.Set("(fred")
+
+	assert.Equal(t, "🐩> (fred  invalid filter: missing closing ) `(fred`", v.GetText(true))
+
+	buff.Add(')')
+	assert.Equal(t, "🐩> (fred)", v.GetText(true))
+}
@@ -20,10 +20,11 @@ type synchronizer interface {
 
 // Configurator represents an application configurationa.
 type Configurator struct {
-	skinFile string
-	Config   *config.Config
-	Styles   *config.Styles
-	Bench    *config.Bench
+	skinFile  string
+	benchFile string
+	Config    *config.Config
+	Styles    *config.Styles
+	Bench     *config.Bench
 }
 
 // HasSkins returns true if a skin file was located.
@@ -67,17 +68,23 @@ func (c *Configurator) StylesUpdater(ctx context.Context, s synchronizer) error
 	return w.Add(c.skinFile)
 }
 
-// InitBench load benchmark configuration if any.
-func (c *Configurator) InitBench(cluster string) {
+// InitBench load a context benchmark configuration if any.
+func (c *Configurator) InitBench(context, cluster string) {
 	var err error
-	if c.Bench, err = config.NewBench(BenchConfig(cluster)); err != nil {
-		log.Info().Msg("No benchmark config file found, using defaults.")
+	c.benchFile = BenchConfig(context, cluster)
+	if c.Bench, err = config.NewBench(c.benchFile); err != nil {
+		log.Info().Msgf("No benchmark config file found for context %q, using defaults.", context)
 	}
 }
 
+// BenchFile returns the benchmarks configuration file of the current context.
+func (c *Configurator) BenchFile() string {
+	return c.benchFile
+}
+
 // BenchConfig location of the benchmarks configuration file.
-func BenchConfig(cluster string) string {
-	return filepath.Join(config.K9sHome, config.K9sBench+"-"+cluster+".yml")
+func BenchConfig(context, cluster string) string {
+	return config.BenchFile(context, cluster)
 }
 
 // RefreshStyles load for skin configuration changes.
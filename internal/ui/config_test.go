@@ -13,7 +13,11 @@ import (
 
 func TestBenchConfig(t *testing.T) {
 	config.K9sHome = "/tmp/blee"
-	assert.Equal(t, "/tmp/blee/bench-fred.yml", ui.BenchConfig("fred"))
+	assert.Equal(t, "/tmp/blee/bench-fred.yml", ui.BenchConfig("fred", ""))
+
+	config.K9sHome = filepath.Join("..", "config", "test_assets")
+	assert.Equal(t, filepath.Join(config.K9sHome, "bench-fred.yml"), ui.BenchConfig("ctx1", "fred"))
+	assert.Equal(t, filepath.Join(config.K9sHome, "bench-ctx1.yml"), ui.BenchConfig("ctx1", "blee"))
 }
 
 func TestConfiguratorRefreshStyle(t *testing.T) {
@@ -31,7 +35,7 @@ func TestInitBench(t *testing.T) {
 	config.K9sHome = filepath.Join("..", "config", "test_assets")
 
 	cfg := ui.Configurator{}
-	cfg.InitBench("fred")
+	cfg.InitBench("fred", "")
 
 	assert.NotNil(t, cfg.Bench)
 	assert.Equal(t, 2, cfg.Bench.Benchmarks.Defaults.C)
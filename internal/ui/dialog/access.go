@@ -0,0 +1,41 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/mattn/go-runewidth"
+)
+
+const accessKey = "access"
+
+// ShowAccessDenied pops a dialog explaining a missing permission and the RBAC role granting it.
+func ShowAccessDenied(p *ui.Pages, msg, snippet string) {
+	v := tview.NewTextView()
+	v.SetBorder(true)
+	v.SetBorderPadding(0, 0, 1, 1)
+	v.SetTitle(" <Access Denied> ")
+	v.SetTitleColor(tcell.ColorOrangeRed)
+	v.SetTextColor(tcell.ColorAqua)
+	v.SetText(msg + "\n\n" + snippet)
+	v.SetDoneFunc(func(tcell.Key) {
+		DismissAccessDenied(p)
+	})
+
+	lines := append([]string{msg}, strings.Split(snippet, "\n")...)
+	width := 0
+	for _, l := range lines {
+		if w := runewidth.StringWidth(l) + 4; w > width {
+			width = w
+		}
+	}
+	p.AddPage(accessKey, centered(v, width, len(lines)+3), true, false)
+	p.ShowPage(accessKey)
+}
+
+// DismissAccessDenied dismiss the access denied dialog.
+func DismissAccessDenied(p *ui.Pages) {
+	p.RemovePage(accessKey)
+}
@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessDeniedDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowAccessDenied(p, "missing create pods/exec in ns fred", "kind: Role")
+
+	d := p.GetPrimitive(accessKey).(*tview.Flex)
+	assert.NotNil(t, d)
+
+	DismissAccessDenied(p)
+	assert.Nil(t, p.GetPrimitive(accessKey))
+}
@@ -0,0 +1,46 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const apiProxyKey = "apiProxy"
+
+// ShowAPIProxy pops a dialog to pick a port and a path to fetch through the
+// api server proxy.
+func ShowAPIProxy(p *ui.Pages, path, port string, okFn func(port, subPath string)) {
+	f := newExecForm()
+
+	subPath := "/"
+	f.AddInputField("Port:", port, 20, nil, func(p string) {
+		port = p
+	})
+	f.AddInputField("Path:", subPath, 40, nil, func(p string) {
+		subPath = p
+	})
+	f.AddButton("OK", func() {
+		if strings.TrimSpace(port) == "" {
+			return
+		}
+		DismissAPIProxy(p)
+		okFn(stripPort(strings.TrimSpace(port)), strings.TrimSpace(subPath))
+	})
+	f.AddButton("Cancel", func() {
+		DismissAPIProxy(p)
+	})
+
+	modal := tview.NewModalForm("<API Proxy "+path+">", f)
+	modal.SetDoneFunc(func(int, string) {
+		DismissAPIProxy(p)
+	})
+	p.AddPage(apiProxyKey, modal, false, false)
+	p.ShowPage(apiProxyKey)
+}
+
+// DismissAPIProxy dismiss the api proxy dialog.
+func DismissAPIProxy(p *ui.Pages) {
+	p.RemovePage(apiProxyKey)
+}
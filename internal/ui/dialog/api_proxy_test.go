@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIProxyDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowAPIProxy(p, "default/fred", "80", func(port, subPath string) {})
+
+	d := p.GetPrimitive(apiProxyKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissAPIProxy(p)
+	assert.Nil(t, p.GetPrimitive(apiProxyKey))
+}
@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const copyKey = "copy"
+
+// ShowCopy pops a dialog to pick a namespace and context to copy a resource to,
+// along with a conflict policy. The current context must be listed first.
+// Cluster scoped resources pass an empty namespace and skip the namespace field.
+func ShowCopy(p *ui.Pages, path, ns string, contexts, conflicts []string, okFn func(ns, ctx, conflict string)) {
+	f := newExecForm()
+
+	ctx, conflict := contexts[0], conflicts[0]
+	if ns != "" {
+		f.AddInputField("Namespace:", ns, 30, nil, func(n string) {
+			ns = n
+		})
+	}
+	f.AddDropDown("Context:", contexts, 0, func(c string, _ int) {
+		ctx = c
+	})
+	f.AddDropDown("On Conflict:", conflicts, 0, func(c string, _ int) {
+		conflict = c
+	})
+	f.AddButton("OK", func() {
+		DismissCopy(p)
+		okFn(ns, ctx, conflict)
+	})
+	f.AddButton("Cancel", func() {
+		DismissCopy(p)
+	})
+
+	modal := tview.NewModalForm("<Copy "+path+">", f)
+	modal.SetDoneFunc(func(int, string) {
+		DismissCopy(p)
+	})
+	p.AddPage(copyKey, modal, false, false)
+	p.ShowPage(copyKey)
+}
+
+// DismissCopy dismiss the copy dialog.
+func DismissCopy(p *ui.Pages) {
+	p.RemovePage(copyKey)
+}
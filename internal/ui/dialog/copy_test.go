@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowCopy(p, "default/fred", "default", []string{"ctx1", "ctx2"}, []string{"skip", "overwrite"}, func(_, _, _ string) {})
+
+	d := p.GetPrimitive(copyKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissCopy(p)
+	assert.Nil(t, p.GetPrimitive(copyKey))
+}
@@ -1,21 +1,32 @@
 package dialog
 
 import (
+	"strconv"
+
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const deleteKey = "delete"
 
+// Propagations tracks the available deletion propagation policies.
+var Propagations = []string{
+	string(metav1.DeletePropagationBackground),
+	string(metav1.DeletePropagationForeground),
+	string(metav1.DeletePropagationOrphan),
+}
+
 type (
-	okFunc     func(cascade, force bool)
+	okFunc     func(opts dao.DeleteOptions)
 	cancelFunc func()
 )
 
 // ShowDelete pops a resource deletion dialog.
 func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	cascade, force := true, false
+	opts := dao.NewDeleteOptions()
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -23,18 +34,18 @@ func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
 		SetLabelColor(tcell.ColorAqua).
 		SetFieldTextColor(tcell.ColorOrange)
-	f.AddCheckbox("Cascade:", cascade, func(checked bool) {
-		cascade = checked
+	f.AddDropDown("Propagation:", Propagations, 0, func(p string, _ int) {
+		opts.Propagation = metav1.DeletionPropagation(p)
 	})
-	f.AddCheckbox("Force:", force, func(checked bool) {
-		force = checked
+	f.AddInputField("Grace Period:", "", 6, isGrace, func(g string) {
+		opts.GracePeriod = toGrace(g)
 	})
 	f.AddButton("Cancel", func() {
 		dismissDelete(pages)
 		cancel()
 	})
 	f.AddButton("OK", func() {
-		ok(cascade, force)
+		ok(opts)
 		dismissDelete(pages)
 		cancel()
 	})
@@ -53,3 +64,22 @@ func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
 func dismissDelete(pages *ui.Pages) {
 	pages.RemovePage(deleteKey)
 }
+
+// isGrace accepts blank, ie resource default, or positive grace periods.
+func isGrace(text string, _ rune) bool {
+	if text == "" {
+		return true
+	}
+	_, err := strconv.ParseUint(text, 10, 32)
+
+	return err == nil
+}
+
+func toGrace(text string) int64 {
+	g, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return dao.DefaultGrace
+	}
+
+	return g
+}
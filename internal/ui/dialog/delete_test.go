@@ -3,6 +3,7 @@ package dialog
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
@@ -11,9 +12,8 @@ import (
 func TestDeleteDialog(t *testing.T) {
 	p := ui.NewPages()
 
-	okFunc := func(c, f bool) {
-		assert.True(t, c)
-		assert.True(t, f)
+	okFunc := func(opts dao.DeleteOptions) {
+		assert.Equal(t, dao.NewDeleteOptions(), opts)
 	}
 	caFunc := func() {
 		assert.True(t, true)
@@ -26,3 +26,25 @@ func TestDeleteDialog(t *testing.T) {
 	dismissDelete(p)
 	assert.Nil(t, p.GetPrimitive(deleteKey))
 }
+
+func TestDeleteGrace(t *testing.T) {
+	uu := map[string]struct {
+		text  string
+		ok    bool
+		grace int64
+	}{
+		"blank":    {text: "", ok: true, grace: dao.DefaultGrace},
+		"now":      {text: "0", ok: true, grace: 0},
+		"secs":     {text: "30", ok: true, grace: 30},
+		"negative": {text: "-1", ok: false, grace: -1},
+		"toast":    {text: "1s", ok: false, grace: dao.DefaultGrace},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, isGrace(u.text, 0))
+			assert.Equal(t, u.grace, toGrace(u.text))
+		})
+	}
+}
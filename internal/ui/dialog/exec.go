@@ -0,0 +1,143 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const execKey = "exec"
+
+// Signals tracks signals that can be sent to a container main process.
+var Signals = []string{"TERM", "HUP", "INT", "USR1", "USR2", "KILL"}
+
+// ShowExec pops a dialog prompting for a command to run in a container.
+func ShowExec(p *ui.Pages, container string, okFn func(cmd string)) {
+	f := newExecForm()
+
+	var cmd string
+	f.AddInputField("Command:", cmd, 40, nil, func(c string) {
+		cmd = c
+	})
+	f.AddButton("OK", func() {
+		if strings.TrimSpace(cmd) == "" {
+			return
+		}
+		DismissExec(p)
+		okFn(cmd)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExec(p)
+	})
+
+	showExec(p, "<Exec "+container+">", f)
+}
+
+// ShowSignal pops a dialog to pick a signal to send to a container main process.
+func ShowSignal(p *ui.Pages, container string, okFn func(sig string)) {
+	f := newExecForm()
+
+	sig := Signals[0]
+	f.AddDropDown("Signal:", Signals, 0, func(s string, _ int) {
+		sig = s
+	})
+	f.AddButton("OK", func() {
+		DismissExec(p)
+		okFn(sig)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExec(p)
+	})
+
+	showExec(p, "<Signal "+container+" PID 1>", f)
+}
+
+// ShowNetCheck pops a dialog prompting for a network check to run from a pod.
+func ShowNetCheck(p *ui.Pages, path string, kinds []string, okFn func(kind, target string, netshoot bool)) {
+	f := newExecForm()
+
+	var (
+		kind, target string
+		netshoot     bool
+	)
+	if len(kinds) > 0 {
+		kind = kinds[0]
+	}
+	f.AddDropDown("Check:", kinds, 0, func(k string, _ int) {
+		kind = k
+	})
+	f.AddInputField("Target:", target, 40, nil, func(t string) {
+		target = t
+	})
+	f.AddCheckbox("Netshoot:", netshoot, func(b bool) {
+		netshoot = b
+	})
+	f.AddButton("OK", func() {
+		if strings.TrimSpace(target) == "" {
+			return
+		}
+		DismissExec(p)
+		okFn(kind, strings.TrimSpace(target), netshoot)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExec(p)
+	})
+
+	showExec(p, "<Net Test "+path+">", f)
+}
+
+// ShowProxy pops a dialog to start a proxy tunneled through a pod.
+func ShowProxy(p *ui.Pages, path, address string, okFn func(address string, relay bool)) {
+	f := newExecForm()
+
+	var relay bool
+	f.AddInputField("Address:", address, 30, nil, func(a string) {
+		address = a
+	})
+	f.AddCheckbox("Relay Pod:", relay, func(b bool) {
+		relay = b
+	})
+	f.AddButton("OK", func() {
+		if strings.TrimSpace(address) == "" {
+			return
+		}
+		DismissExec(p)
+		okFn(strings.TrimSpace(address), relay)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExec(p)
+	})
+
+	showExec(p, "<Proxy "+path+">", f)
+}
+
+// DismissExec dismiss the exec dialog.
+func DismissExec(p *ui.Pages) {
+	p.RemovePage(execKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newExecForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
+
+func showExec(p *ui.Pages, title string, f *tview.Form) {
+	modal := tview.NewModalForm(title, f)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissExec(p)
+	})
+	p.AddPage(execKey, modal, false, false)
+	p.ShowPage(execKey)
+}
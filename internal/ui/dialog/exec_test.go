@@ -0,0 +1,57 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowExec(p, "fred", func(cmd string) {})
+
+	d := p.GetPrimitive(execKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissExec(p)
+	assert.Nil(t, p.GetPrimitive(execKey))
+}
+
+func TestSignalDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowSignal(p, "fred", func(sig string) {})
+
+	d := p.GetPrimitive(execKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissExec(p)
+	assert.Nil(t, p.GetPrimitive(execKey))
+}
+
+func TestNetCheckDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowNetCheck(p, "default/fred", []string{"dns", "http"}, func(kind, target string, netshoot bool) {})
+
+	d := p.GetPrimitive(execKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissExec(p)
+	assert.Nil(t, p.GetPrimitive(execKey))
+}
+
+func TestProxyDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowProxy(p, "default/fred", "localhost:1080", func(address string, relay bool) {})
+
+	d := p.GetPrimitive(execKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissExec(p)
+	assert.Nil(t, p.GetPrimitive(execKey))
+}
@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const forceDeleteKey = "forceDelete"
+
+// ShowForceDelete pops a dialog to force delete a resource stuck terminating,
+// listing its finalizers.
+func ShowForceDelete(p *ui.Pages, path string, finalizers []string, okFn func(clearFinalizers bool)) {
+	f := newExecForm()
+
+	var clear bool
+	if len(finalizers) > 0 {
+		f.AddCheckbox("Clear Finalizers:", clear, func(checked bool) {
+			clear = checked
+		})
+	}
+	f.AddButton("Cancel", func() {
+		DismissForceDelete(p)
+	})
+	f.AddButton("OK", func() {
+		DismissForceDelete(p)
+		okFn(clear)
+	})
+
+	msg := "Force delete " + path + " with no grace period?"
+	if len(finalizers) > 0 {
+		msg += "\nFinalizers: " + strings.Join(finalizers, ", ")
+	}
+	modal := tview.NewModalForm("<Force Delete>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		DismissForceDelete(p)
+	})
+	p.AddPage(forceDeleteKey, modal, false, false)
+	p.ShowPage(forceDeleteKey)
+}
+
+// DismissForceDelete dismiss the force delete dialog.
+func DismissForceDelete(p *ui.Pages) {
+	p.RemovePage(forceDeleteKey)
+}
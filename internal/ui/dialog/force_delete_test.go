@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceDeleteDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowForceDelete(p, "default/fred", []string{"blee/cleanup"}, func(bool) {})
+
+	d := p.GetPrimitive(forceDeleteKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissForceDelete(p)
+	assert.Nil(t, p.GetPrimitive(forceDeleteKey))
+}
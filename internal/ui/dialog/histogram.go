@@ -0,0 +1,49 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/mattn/go-runewidth"
+)
+
+const histogramKey = "histogram"
+
+// ShowHistogram pops a dialog listing a column distribution as bars.
+func ShowHistogram(p *ui.Pages, title string, bars []string) {
+	v := tview.NewTextView()
+	v.SetBorder(true)
+	v.SetBorderPadding(0, 0, 1, 1)
+	v.SetTitle(" <" + title + "> ")
+	v.SetTextColor(tcell.ColorAqua)
+	v.SetText(strings.Join(bars, "\n"))
+	v.SetDoneFunc(func(tcell.Key) {
+		DismissHistogram(p)
+	})
+
+	width := runewidth.StringWidth(title) + 6
+	for _, b := range bars {
+		if w := runewidth.StringWidth(b) + 4; w > width {
+			width = w
+		}
+	}
+	p.AddPage(histogramKey, centered(v, width, len(bars)+2), true, false)
+	p.ShowPage(histogramKey)
+}
+
+// DismissHistogram dismiss the histogram dialog.
+func DismissHistogram(p *ui.Pages) {
+	p.RemovePage(histogramKey)
+}
+
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowHistogram(p, "NODE", []string{"n1 ██ 2 (100%)"})
+
+	d := p.GetPrimitive(histogramKey).(*tview.Flex)
+	assert.NotNil(t, d)
+
+	DismissHistogram(p)
+	assert.Nil(t, p.GetPrimitive(histogramKey))
+}
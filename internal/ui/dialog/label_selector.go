@@ -0,0 +1,114 @@
+package dialog
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+const (
+	labelSelectorKey = "labelSelector"
+
+	// MaxLabelPairs tracks the maximum number of labels offered by the
+	// label selector dialog.
+	MaxLabelPairs = 20
+)
+
+// ShowLabelSelector pops a dialog to compose a label selector by toggling
+// key=value pairs. Pairs present in the current selector are pre-checked.
+func ShowLabelSelector(p *ui.Pages, pairs []string, current string, okFn func(sel string)) {
+	f := newExecForm()
+
+	if len(pairs) > MaxLabelPairs {
+		pairs = pairs[:MaxLabelPairs]
+	}
+	checked := selectedPairs(current)
+	picks := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		i := i
+		picks[i] = checked[pair]
+		f.AddCheckbox(pair+":", picks[i], func(c bool) {
+			picks[i] = c
+		})
+	}
+	f.AddButton("Cancel", func() {
+		DismissLabelSelector(p)
+	})
+	f.AddButton("Apply", func() {
+		DismissLabelSelector(p)
+		var pp []string
+		for _, i := range picked(picks) {
+			pp = append(pp, pairs[i])
+		}
+		okFn(labelSelector(pp))
+	})
+
+	modal := tview.NewModalForm("<Label Selector>", f)
+	modal.SetText("Pick the labels to select")
+	modal.SetDoneFunc(func(int, string) {
+		DismissLabelSelector(p)
+	})
+	p.AddPage(labelSelectorKey, modal, false, false)
+	p.ShowPage(labelSelectorKey)
+}
+
+// DismissLabelSelector dismiss the label selector dialog.
+func DismissLabelSelector(p *ui.Pages) {
+	p.RemovePage(labelSelectorKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// LabelSelector composes key=value pairs into a selector. Several values for
+// the same key are or'ed.
+func labelSelector(pairs []string) string {
+	vals := make(map[string][]string)
+	var keys []string
+	for _, p := range pairs {
+		tokens := strings.SplitN(p, "=", 2)
+		if len(tokens) != 2 {
+			continue
+		}
+		if _, ok := vals[tokens[0]]; !ok {
+			keys = append(keys, tokens[0])
+		}
+		vals[tokens[0]] = append(vals[tokens[0]], tokens[1])
+	}
+	sort.Strings(keys)
+
+	ss := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if vv := vals[k]; len(vv) == 1 {
+			ss = append(ss, k+"="+vv[0])
+		} else {
+			sort.Strings(vv)
+			ss = append(ss, k+" in ("+strings.Join(vv, ",")+")")
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func selectedPairs(sel string) map[string]bool {
+	pp := make(map[string]bool)
+	s, err := labels.Parse(sel)
+	if err != nil {
+		return pp
+	}
+	rr, _ := s.Requirements()
+	for _, r := range rr {
+		switch r.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			for _, v := range r.Values().List() {
+				pp[r.Key()+"="+v] = true
+			}
+		}
+	}
+
+	return pp
+}
@@ -0,0 +1,59 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelSelectorDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowLabelSelector(p, []string{"app=fred", "tier=web"}, "app=fred", func(string) {})
+
+	d := p.GetPrimitive(labelSelectorKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissLabelSelector(p)
+	assert.Nil(t, p.GetPrimitive(labelSelectorKey))
+}
+
+func TestLabelSelector(t *testing.T) {
+	uu := map[string]struct {
+		pairs []string
+		e     string
+	}{
+		"none":   {},
+		"single": {pairs: []string{"app=fred"}, e: "app=fred"},
+		"multi":  {pairs: []string{"tier=web", "app=fred"}, e: "app=fred,tier=web"},
+		"in":     {pairs: []string{"app=fred", "tier=web", "app=blee"}, e: "app in (blee,fred),tier=web"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, labelSelector(u.pairs))
+		})
+	}
+}
+
+func TestSelectedPairs(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   map[string]bool
+	}{
+		"none":    {e: map[string]bool{}},
+		"invalid": {sel: "app in (", e: map[string]bool{}},
+		"equals":  {sel: "app=fred,tier!=db", e: map[string]bool{"app=fred": true}},
+		"in":      {sel: "app in (blee,fred)", e: map[string]bool{"app=blee": true, "app=fred": true}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, selectedPairs(u.sel))
+		})
+	}
+}
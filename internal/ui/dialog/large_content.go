@@ -0,0 +1,40 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const largeContentKey = "largeContent"
+
+// ShowLargeContent pops a dialog offering to save content too large to be
+// rendered inline to a file instead.
+func ShowLargeContent(p *ui.Pages, path, size string, saveFn, viewFn func()) {
+	f := newExecForm()
+	f.AddButton("Save", func() {
+		DismissLargeContent(p)
+		saveFn()
+	})
+	f.AddButton("View", func() {
+		DismissLargeContent(p)
+		viewFn()
+	})
+	f.AddButton("Cancel", func() {
+		DismissLargeContent(p)
+	})
+
+	modal := tview.NewModalForm("<Large Content>", f)
+	modal.SetText(fmt.Sprintf("%s is %s and may take a while to render.\nSave it to a file instead?", path, size))
+	modal.SetDoneFunc(func(int, string) {
+		DismissLargeContent(p)
+	})
+	p.AddPage(largeContentKey, modal, false, false)
+	p.ShowPage(largeContentKey)
+}
+
+// DismissLargeContent dismiss the large content dialog.
+func DismissLargeContent(p *ui.Pages) {
+	p.RemovePage(largeContentKey)
+}
@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeContentDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowLargeContent(p, "fred/blee", "3Mi", func() {}, func() {})
+
+	d := p.GetPrimitive(largeContentKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissLargeContent(p)
+	assert.Nil(t, p.GetPrimitive(largeContentKey))
+}
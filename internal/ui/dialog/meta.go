@@ -0,0 +1,62 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const metaKey = "meta"
+
+// ShowMeta pops a dialog to pick finalizers and owner references to remove.
+// The ok callback receives the indexes of the picked entries.
+func ShowMeta(p *ui.Pages, path string, finalizers, owners []string, okFn func(finalizers, owners []int)) {
+	f := newExecForm()
+
+	pickedF, pickedO := make([]bool, len(finalizers)), make([]bool, len(owners))
+	for i, fin := range finalizers {
+		i := i
+		f.AddCheckbox("Finalizer "+fin+":", false, func(checked bool) {
+			pickedF[i] = checked
+		})
+	}
+	for i, o := range owners {
+		i := i
+		f.AddCheckbox("Owner "+o+":", false, func(checked bool) {
+			pickedO[i] = checked
+		})
+	}
+	f.AddButton("Cancel", func() {
+		DismissMeta(p)
+	})
+	f.AddButton("Remove", func() {
+		DismissMeta(p)
+		okFn(picked(pickedF), picked(pickedO))
+	})
+
+	modal := tview.NewModalForm("<Finalizers/Owners "+path+">", f)
+	modal.SetText("Pick the finalizers and owner references to remove")
+	modal.SetDoneFunc(func(int, string) {
+		DismissMeta(p)
+	})
+	p.AddPage(metaKey, modal, false, false)
+	p.ShowPage(metaKey)
+}
+
+// DismissMeta dismiss the meta dialog.
+func DismissMeta(p *ui.Pages) {
+	p.RemovePage(metaKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func picked(bb []bool) []int {
+	var ii []int
+	for i, b := range bb {
+		if b {
+			ii = append(ii, i)
+		}
+	}
+
+	return ii
+}
@@ -0,0 +1,26 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowMeta(p, "default/fred", []string{"blee/cleanup"}, []string{"ReplicaSet/fred-abc"}, func(_, _ []int) {})
+
+	d := p.GetPrimitive(metaKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissMeta(p)
+	assert.Nil(t, p.GetPrimitive(metaKey))
+}
+
+func TestPicked(t *testing.T) {
+	assert.Equal(t, []int{0, 2}, picked([]bool{true, false, true}))
+	assert.Nil(t, picked([]bool{false}))
+}
@@ -0,0 +1,43 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const ordinalKey = "ordinal"
+
+// ShowOrdinal pops a dialog to pick a statefulset ordinal pod to delete.
+func ShowOrdinal(p *ui.Pages, sts string, pods []string, okFn func(index int, force bool)) {
+	f := newExecForm()
+
+	var (
+		index int
+		force bool
+	)
+	f.AddDropDown("Pod:", pods, 0, func(_ string, i int) {
+		index = i
+	})
+	f.AddCheckbox("Force:", force, func(checked bool) {
+		force = checked
+	})
+	f.AddButton("OK", func() {
+		DismissOrdinal(p)
+		okFn(index, force)
+	})
+	f.AddButton("Cancel", func() {
+		DismissOrdinal(p)
+	})
+
+	modal := tview.NewModalForm("<Delete Ordinal "+sts+">", f)
+	modal.SetDoneFunc(func(int, string) {
+		DismissOrdinal(p)
+	})
+	p.AddPage(ordinalKey, modal, false, false)
+	p.ShowPage(ordinalKey)
+}
+
+// DismissOrdinal dismiss the ordinal dialog.
+func DismissOrdinal(p *ui.Pages) {
+	p.RemovePage(ordinalKey)
+}
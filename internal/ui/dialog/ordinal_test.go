@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinalDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowOrdinal(p, "default/web", []string{"web-0 [Running]", "web-1 [Terminating]"}, func(int, bool) {})
+
+	d := p.GetPrimitive(ordinalKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissOrdinal(p)
+	assert.Nil(t, p.GetPrimitive(ordinalKey))
+}
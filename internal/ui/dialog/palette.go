@@ -0,0 +1,106 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	paletteKey    = "palette"
+	paletteWidth  = 60
+	paletteHeight = 20
+)
+
+// PaletteItem represents an action listed in the palette.
+type PaletteItem struct {
+	Key         string
+	Description string
+}
+
+// String returns the text the palette matches against.
+func (p PaletteItem) String() string {
+	return p.Key + " " + p.Description
+}
+
+// ShowPalette pops a palette listing the given actions. Typing narrows the
+// list using a fuzzy match and enter runs the selected action.
+func ShowPalette(p *ui.Pages, title string, items []PaletteItem, okFn func(PaletteItem)) {
+	list := tview.NewList().ShowSecondaryText(false)
+	var matches []int
+	refresh := func(q string) {
+		list.Clear()
+		matches = paletteMatches(items, q)
+		for _, i := range matches {
+			list.AddItem(fmt.Sprintf("%-10s %s", items[i].Key, items[i].Description), "", 0, nil)
+		}
+	}
+
+	input := tview.NewInputField().SetLabel("> ")
+	input.SetChangedFunc(refresh)
+	input.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyUp:
+			if c := list.GetCurrentItem(); c > 0 {
+				list.SetCurrentItem(c - 1)
+			}
+		case tcell.KeyDown:
+			if c := list.GetCurrentItem(); c < list.GetItemCount()-1 {
+				list.SetCurrentItem(c + 1)
+			}
+		case tcell.KeyEnter:
+			DismissPalette(p)
+			if len(matches) > 0 {
+				okFn(items[matches[list.GetCurrentItem()]])
+			}
+		case tcell.KeyEscape:
+			DismissPalette(p)
+		default:
+			return evt
+		}
+		return nil
+	})
+	refresh("")
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow)
+	flex.AddItem(input, 1, 0, true)
+	flex.AddItem(list, 0, 1, false)
+	flex.SetBorder(true)
+	flex.SetTitle(title)
+
+	p.AddPage(paletteKey, centered(flex, paletteWidth, paletteHeight), true, false)
+	p.ShowPage(paletteKey)
+}
+
+// DismissPalette dismiss the actions palette.
+func DismissPalette(p *ui.Pages) {
+	p.RemovePage(paletteKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PaletteMatches returns the indexes of the items matching the query, best
+// matches first.
+func paletteMatches(items []PaletteItem, q string) []int {
+	ii := make([]int, 0, len(items))
+	if q == "" {
+		for i := range items {
+			ii = append(ii, i)
+		}
+		return ii
+	}
+
+	ss := make([]string, 0, len(items))
+	for _, it := range items {
+		ss = append(ss, it.String())
+	}
+	for _, m := range fuzzy.Find(q, ss) {
+		ii = append(ii, m.Index)
+	}
+
+	return ii
+}
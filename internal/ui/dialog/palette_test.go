@@ -0,0 +1,43 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowPalette(p, "<Actions>", []PaletteItem{{Key: "d", Description: "Describe"}}, func(PaletteItem) {})
+	assert.NotNil(t, p.GetPrimitive(paletteKey))
+
+	DismissPalette(p)
+	assert.Nil(t, p.GetPrimitive(paletteKey))
+}
+
+func TestPaletteMatches(t *testing.T) {
+	items := []PaletteItem{
+		{Key: "d", Description: "Describe"},
+		{Key: "ctrl-d", Description: "Delete"},
+		{Key: "l", Description: "Logs"},
+	}
+
+	uu := map[string]struct {
+		q string
+		e []int
+	}{
+		"all":   {e: []int{0, 1, 2}},
+		"fuzzy": {q: "lgs", e: []int{2}},
+		"key":   {q: "ctrl", e: []int{1}},
+		"none":  {q: "zorg", e: []int{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, paletteMatches(items, u.q))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const podGoneKey = "podGone"
+
+// ShowPodGone pops a dialog offering to resume logs on a replacement pod or
+// on all the pods of its owner once the logged pod is gone.
+func ShowPodGone(p *ui.Pages, path, successor, owner string, switchFn, ownerFn func()) {
+	f := newExecForm()
+
+	msg := fmt.Sprintf("Pod %s is gone.", path)
+	if successor != "" {
+		msg += fmt.Sprintf("\nSwitch to %s?", successor)
+		f.AddButton("Switch", func() {
+			DismissPodGone(p)
+			switchFn()
+		})
+	}
+	if owner != "" {
+		msg += fmt.Sprintf("\nOr tail all %s pods?", owner)
+		f.AddButton("Owner", func() {
+			DismissPodGone(p)
+			ownerFn()
+		})
+	}
+	f.AddButton("Cancel", func() {
+		DismissPodGone(p)
+	})
+
+	modal := tview.NewModalForm("<Pod Gone>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		DismissPodGone(p)
+	})
+	p.AddPage(podGoneKey, modal, false, false)
+	p.ShowPage(podGoneKey)
+}
+
+// DismissPodGone dismiss the pod gone dialog.
+func DismissPodGone(p *ui.Pages) {
+	p.RemovePage(podGoneKey)
+}
@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodGoneDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowPodGone(p, "default/web-1", "default/web-2", "default/web", func() {}, func() {})
+
+	d := p.GetPrimitive(podGoneKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissPodGone(p)
+	assert.Nil(t, p.GetPrimitive(podGoneKey))
+}
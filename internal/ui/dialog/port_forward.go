@@ -12,6 +12,12 @@ const portForwardKey = "portforward"
 
 // ShowPortForward pops a port forwarding configuration dialog.
 func ShowPortForward(p *ui.Pages, port string, okFn func(address, lport, cport string)) {
+	ShowTargetPortForward(p, "Pod", port, okFn)
+}
+
+// ShowTargetPortForward pops a port forwarding configuration dialog for a
+// given kind of resource.
+func ShowTargetPortForward(p *ui.Pages, kind, port string, okFn func(address, lport, port string)) {
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -21,7 +27,7 @@ func ShowPortForward(p *ui.Pages, port string, okFn func(address, lport, cport s
 		SetFieldTextColor(tcell.ColorOrange)
 
 	p1, p2, address := port, port, "localhost"
-	f.AddInputField("Pod Port:", p1, 20, nil, func(p string) {
+	f.AddInputField(kind+" Port:", p1, 20, nil, func(p string) {
 		p1 = p
 	})
 	f.AddInputField("Local Port:", p2, 20, nil, func(p string) {
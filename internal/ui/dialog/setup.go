@@ -0,0 +1,78 @@
+package dialog
+
+import (
+	"strconv"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const setupKey = "setup"
+
+// SetupFunc applies the first run setup choices.
+type SetupFunc func(s config.Setup)
+
+// ShowSetup pops the first run setup wizard.
+func ShowSetup(pages *ui.Pages, defaults config.Setup, contexts, skins []string, ok SetupFunc, cancel cancelFunc) {
+	s := defaults
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddDropDown("Context:", contexts, indexOf(contexts, s.Context), func(c string, _ int) {
+		s.Context = c
+	})
+	f.AddInputField("Namespace:", s.Namespace, 30, nil, func(ns string) {
+		s.Namespace = ns
+	})
+	f.AddDropDown("Skin:", skins, indexOf(skins, s.Skin), func(sk string, _ int) {
+		s.Skin = sk
+	})
+	f.AddCheckbox("Read Only:", s.ReadOnly, func(b bool) {
+		s.ReadOnly = b
+	})
+	f.AddInputField("Refresh Rate:", strconv.Itoa(s.RefreshRate), 4, tview.InputFieldInteger, func(r string) {
+		if n, err := strconv.Atoi(r); err == nil {
+			s.RefreshRate = n
+		}
+	})
+	f.AddCheckbox("Sample Plugins/HotKeys:", s.Samples, func(b bool) {
+		s.Samples = b
+	})
+	f.AddButton("Skip", func() {
+		dismissSetup(pages)
+		cancel()
+	})
+	f.AddButton("Save", func() {
+		dismissSetup(pages)
+		ok(s)
+	})
+
+	modal := tview.NewModalForm("<Welcome to K9s>", f)
+	modal.SetText("Let's setup your K9s configuration...")
+	modal.SetDoneFunc(func(int, string) {
+		dismissSetup(pages)
+		cancel()
+	})
+	pages.AddPage(setupKey, modal, false, false)
+	pages.ShowPage(setupKey)
+}
+
+func dismissSetup(pages *ui.Pages) {
+	pages.RemovePage(setupKey)
+}
+
+func indexOf(ss []string, s string) int {
+	for i, v := range ss {
+		if v == s {
+			return i
+		}
+	}
+
+	return 0
+}
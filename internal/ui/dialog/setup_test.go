@@ -0,0 +1,29 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(s config.Setup) {}
+	caFunc := func() {}
+	ShowSetup(p, config.Setup{Context: "c2", Skin: "stock", RefreshRate: 2}, []string{"c1", "c2"}, []string{"stock"}, okFunc, caFunc)
+
+	d := p.GetPrimitive(setupKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissSetup(p)
+	assert.Nil(t, p.GetPrimitive(setupKey))
+}
+
+func TestIndexOf(t *testing.T) {
+	assert.Equal(t, 1, indexOf([]string{"a", "b"}, "b"))
+	assert.Equal(t, 0, indexOf([]string{"a", "b"}, "c"))
+}
@@ -0,0 +1,42 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const windowsShellKey = "windowsShell"
+
+// WindowsShells lists the shells offered for windows containers.
+var WindowsShells = []string{"powershell", "cmd"}
+
+// ShowWindowsShell pops a dialog warning sh is not available on a windows
+// container and offering a windows shell instead.
+func ShowWindowsShell(p *ui.Pages, path string, okFn func(shell string)) {
+	f := newExecForm()
+	for _, sh := range WindowsShells {
+		shell := sh
+		f.AddButton(shell, func() {
+			DismissWindowsShell(p)
+			okFn(shell)
+		})
+	}
+	f.AddButton("Cancel", func() {
+		DismissWindowsShell(p)
+	})
+
+	modal := tview.NewModalForm("<Windows Container>", f)
+	modal.SetText(fmt.Sprintf("%s runs on a windows node and has no sh.\nOpen a windows shell instead?", path))
+	modal.SetDoneFunc(func(int, string) {
+		DismissWindowsShell(p)
+	})
+	p.AddPage(windowsShellKey, modal, false, false)
+	p.ShowPage(windowsShellKey)
+}
+
+// DismissWindowsShell dismiss the windows shell dialog.
+func DismissWindowsShell(p *ui.Pages) {
+	p.RemovePage(windowsShellKey)
+}
@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowsShellDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowWindowsShell(p, "default/iis-1", func(string) {})
+
+	d := p.GetPrimitive(windowsShellKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissWindowsShell(p)
+	assert.Nil(t, p.GetPrimitive(windowsShellKey))
+}
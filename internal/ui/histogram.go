@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	// HistogramBarWidth tracks the width of the largest histogram bar.
+	HistogramBarWidth = 30
+
+	maxBucketName = 30
+	barGlyph      = "█"
+)
+
+// Bucket tracks how many rows share a given column value.
+type Bucket struct {
+	Name  string
+	Count int
+}
+
+// Histogram computes the distribution of a column values across the given
+// rows. Buckets are sorted by descending counts.
+func Histogram(ee render.RowEvents, col int) []Bucket {
+	counts := make(map[string]int)
+	for _, e := range ee {
+		if e.Kind == render.EventDelete {
+			continue
+		}
+		var v string
+		if col >= 0 && col < len(e.Row.Fields) {
+			v = e.Row.Fields[col]
+		}
+		if v == "" {
+			v = render.MissingValue
+		}
+		counts[v]++
+	}
+
+	bb := make([]Bucket, 0, len(counts))
+	for k, v := range counts {
+		bb = append(bb, Bucket{Name: k, Count: v})
+	}
+	sort.Slice(bb, func(i, j int) bool {
+		if bb[i].Count == bb[j].Count {
+			return bb[i].Name < bb[j].Name
+		}
+		return bb[i].Count > bb[j].Count
+	})
+
+	return bb
+}
+
+// HistogramBars renders buckets as horizontal bars scaled to width.
+func HistogramBars(bb []Bucket, width int) []string {
+	var total, max, pad int
+	for _, b := range bb {
+		total += b.Count
+		if b.Count > max {
+			max = b.Count
+		}
+		if n := runewidth.StringWidth(Truncate(b.Name, maxBucketName)); n > pad {
+			pad = n
+		}
+	}
+
+	ll := make([]string, 0, len(bb))
+	for _, b := range bb {
+		size := b.Count * width / max
+		if size == 0 {
+			size = 1
+		}
+		ll = append(ll, fmt.Sprintf("%s %s%s %d (%d%%)",
+			runewidth.FillRight(Truncate(b.Name, maxBucketName), pad),
+			strings.Repeat(barGlyph, size),
+			strings.Repeat(" ", width-size),
+			b.Count, b.Count*100/total,
+		))
+	}
+
+	return ll
+}
+
+// Histogram returns the distribution of the sorted column across the
+// visible rows along with the column name.
+func (t *Table) Histogram() (string, []Bucket) {
+	data := t.GetFilteredData()
+	if t.sortCol.index < 0 || t.sortCol.index >= len(data.Header) {
+		return "", nil
+	}
+
+	return data.Header[t.sortCol.index].Name, Histogram(data.RowEvents, t.sortCol.index)
+}
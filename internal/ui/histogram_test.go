@@ -0,0 +1,38 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	ee := render.RowEvents{
+		{Row: render.Row{ID: "a", Fields: render.Fields{"a", "n1"}}},
+		{Row: render.Row{ID: "b", Fields: render.Fields{"b", "n2"}}},
+		{Row: render.Row{ID: "c", Fields: render.Fields{"c", "n2"}}},
+		{Row: render.Row{ID: "d", Fields: render.Fields{"d", ""}}},
+		{Kind: render.EventDelete, Row: render.Row{ID: "e", Fields: render.Fields{"e", "n1"}}},
+	}
+
+	bb := ui.Histogram(ee, 1)
+	assert.Equal(t, []ui.Bucket{
+		{Name: "n2", Count: 2},
+		{Name: render.MissingValue, Count: 1},
+		{Name: "n1", Count: 1},
+	}, bb)
+}
+
+func TestHistogramBars(t *testing.T) {
+	bb := []ui.Bucket{
+		{Name: "node-1", Count: 3},
+		{Name: "n2", Count: 1},
+	}
+
+	assert.Equal(t, []string{
+		"node-1 ██████ 3 (75%)",
+		"n2     ██     1 (25%)",
+	}, ui.HistogramBars(bb, 6))
+}
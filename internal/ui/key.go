@@ -10,6 +10,10 @@ func initKeys() {
 	tcell.KeyNames[tcell.Key(KeyHelp)] = "?"
 	tcell.KeyNames[tcell.Key(KeySlash)] = "/"
 	tcell.KeyNames[tcell.Key(KeySpace)] = "space"
+	tcell.KeyNames[tcell.Key(KeyQuote)] = "'"
+	tcell.KeyNames[tcell.Key(KeyLeftBracket)] = "["
+	tcell.KeyNames[tcell.Key(KeyRightBracket)] = "]"
+	tcell.KeyNames[tcell.Key(KeyBacktick)] = "`"
 
 	initNumbKeys()
 	initStdKeys()
@@ -73,10 +77,14 @@ const (
 	KeyX
 	KeyY
 	KeyZ
-	KeyHelp  = 63
-	KeySlash = 47
-	KeyColon = 58
-	KeySpace = 32
+	KeyHelp         = 63
+	KeySlash        = 47
+	KeyColon        = 58
+	KeySpace        = 32
+	KeyQuote        = 39
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
+	KeyBacktick     = 96
 )
 
 // Define Shift Keys
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+)
+
+// LeaderKey prefixes printable alternatives to control keys.
+const LeaderKey = '\\'
+
+// KeyProfile translates printable key sequences into control keys for
+// terminals that swallow them (tmux, mosh, web terminals...).
+// Using the printable profile, <\>a stands for <ctrl-a>, <\>[ for <esc>
+// and <\><space> for <ctrl-space>. Hitting the leader twice emits it.
+type KeyProfile struct {
+	name    string
+	pending bool
+}
+
+// NewKeyProfile returns a new key profile.
+func NewKeyProfile(name string) *KeyProfile {
+	var k KeyProfile
+	_ = k.Set(name)
+
+	return &k
+}
+
+// Set switches the active profile.
+func (k *KeyProfile) Set(name string) error {
+	switch name {
+	case "", config.DefaultKeyProfile:
+		k.name = config.DefaultKeyProfile
+	case config.PrintableKeyProfile:
+		k.name = config.PrintableKeyProfile
+	default:
+		return fmt.Errorf("unknown key profile %q (%s|%s)", name, config.DefaultKeyProfile, config.PrintableKeyProfile)
+	}
+	k.pending = false
+
+	return nil
+}
+
+// Name returns the active profile name.
+func (k *KeyProfile) Name() string {
+	return k.name
+}
+
+// IsPrintable returns true if printable alternatives are enabled.
+func (k *KeyProfile) IsPrintable() bool {
+	return k.name == config.PrintableKeyProfile
+}
+
+// Map translates a key event. It returns nil when the event was swallowed.
+func (k *KeyProfile) Map(evt *tcell.EventKey) *tcell.EventKey {
+	if !k.IsPrintable() || evt.Key() != tcell.KeyRune {
+		k.pending = false
+		return evt
+	}
+	if !k.pending {
+		if evt.Rune() == LeaderKey {
+			k.pending = true
+			return nil
+		}
+		return evt
+	}
+
+	k.pending = false
+	key, ok := leaderKey(evt.Rune())
+	if !ok {
+		return evt
+	}
+	if key == tcell.KeyRune {
+		return tcell.NewEventKey(tcell.KeyRune, LeaderKey, tcell.ModNone)
+	}
+
+	mod := tcell.ModCtrl
+	if key == tcell.KeyEscape {
+		mod = tcell.ModNone
+	}
+
+	return tcell.NewEventKey(key, 0, mod)
+}
+
+// Mnemonic returns a key mnemonic as typed in the active profile.
+func (k *KeyProfile) Mnemonic(s string) string {
+	if !k.IsPrintable() {
+		return s
+	}
+	l := strings.ToLower(s)
+	switch {
+	case l == "esc" || l == "escape":
+		return string(LeaderKey) + "["
+	case strings.HasPrefix(l, "ctrl-") && len(l) == len("ctrl-")+1:
+		return string(LeaderKey) + l[len(l)-1:]
+	case l == "ctrl-space":
+		return string(LeaderKey) + "space"
+	}
+
+	return s
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func leaderKey(r rune) (tcell.Key, bool) {
+	switch {
+	case r == LeaderKey:
+		return tcell.KeyRune, true
+	case r == '[':
+		return tcell.KeyEscape, true
+	case r == ' ':
+		return tcell.KeyCtrlSpace, true
+	case r >= 'a' && r <= 'z':
+		return tcell.KeyCtrlA + tcell.Key(r-'a'), true
+	case r >= 'A' && r <= 'Z':
+		return tcell.KeyCtrlA + tcell.Key(r-'A'), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,104 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyProfileSet(t *testing.T) {
+	k := ui.NewKeyProfile("")
+	assert.Equal(t, config.DefaultKeyProfile, k.Name())
+
+	assert.Nil(t, k.Set(config.PrintableKeyProfile))
+	assert.True(t, k.IsPrintable())
+	assert.NotNil(t, k.Set("blee"))
+	assert.True(t, k.IsPrintable())
+}
+
+func TestKeyProfileMap(t *testing.T) {
+	uu := map[string]struct {
+		profile string
+		rr      []rune
+		key     tcell.Key
+		r       rune
+	}{
+		"default": {
+			profile: config.DefaultKeyProfile,
+			rr:      []rune{'\\', 'd'},
+			key:     tcell.KeyRune,
+			r:       'd',
+		},
+		"plain": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'d'},
+			key:     tcell.KeyRune,
+			r:       'd',
+		},
+		"ctrl": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'\\', 'd'},
+			key:     tcell.KeyCtrlD,
+		},
+		"esc": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'\\', '['},
+			key:     tcell.KeyEscape,
+		},
+		"space": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'\\', ' '},
+			key:     tcell.KeyCtrlSpace,
+		},
+		"leader": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'\\', '\\'},
+			key:     tcell.KeyRune,
+			r:       '\\',
+		},
+		"unmapped": {
+			profile: config.PrintableKeyProfile,
+			rr:      []rune{'\\', '1'},
+			key:     tcell.KeyRune,
+			r:       '1',
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kp := ui.NewKeyProfile(u.profile)
+			var evt *tcell.EventKey
+			for _, r := range u.rr {
+				evt = kp.Map(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+			}
+			assert.NotNil(t, evt)
+			assert.Equal(t, u.key, evt.Key())
+			if u.key == tcell.KeyRune {
+				assert.Equal(t, u.r, evt.Rune())
+			}
+		})
+	}
+}
+
+func TestKeyProfileMnemonic(t *testing.T) {
+	uu := map[string]struct {
+		profile, m, e string
+	}{
+		"default":   {config.DefaultKeyProfile, "Ctrl-D", "Ctrl-D"},
+		"ctrl":      {config.PrintableKeyProfile, "Ctrl-D", `\d`},
+		"esc":       {config.PrintableKeyProfile, "Esc", `\[`},
+		"space":     {config.PrintableKeyProfile, "Ctrl-Space", `\space`},
+		"printable": {config.PrintableKeyProfile, "Shift-C", "Shift-C"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.NewKeyProfile(u.profile).Mnemonic(u.m))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 )
@@ -26,6 +27,8 @@ type Menu struct {
 	*tview.Table
 
 	styles *config.Styles
+	keys   *KeyProfile
+	vim    *VimMotions
 }
 
 // NewMenu returns a new menu.
@@ -46,6 +49,16 @@ func (m *Menu) StylesChanged(s *config.Styles) {
 	m.SetBackgroundColor(s.BgColor())
 }
 
+// SetKeyProfile sets the key profile used to display key mnemonics.
+func (m *Menu) SetKeyProfile(k *KeyProfile) {
+	m.keys = k
+}
+
+// SetVimMotions sets the vim motions used to display key mnemonics.
+func (m *Menu) SetVimMotions(v *VimMotions) {
+	m.vim = v
+}
+
 // StackPushed notifies a component was added.
 func (m *Menu) StackPushed(c model.Component) {
 	m.HydrateMenu(c.Hints())
@@ -153,7 +166,17 @@ func (m *Menu) formatMenu(h model.MenuHint, size int) string {
 	}
 	i, err := strconv.Atoi(h.Mnemonic)
 	if err == nil {
-		return formatNSMenu(i, h.Description, m.styles.Frame())
+		ns := h.Description
+		if render.Anonymous {
+			ns = render.AnonymizeNamespace(ns)
+		}
+		return formatNSMenu(i, ns, m.styles.Frame())
+	}
+	if m.vim != nil {
+		h.Mnemonic = m.vim.Mnemonic(h.Mnemonic)
+	}
+	if m.keys != nil {
+		h.Mnemonic = m.keys.Mnemonic(h.Mnemonic)
 	}
 
 	return formatPlainMenu(h, size, m.styles.Frame())
@@ -2,11 +2,9 @@ package ui
 
 import (
 	"strings"
-	"time"
 	"unicode"
 
 	"github.com/derailed/k9s/internal/render"
-	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // MaxyPad tracks uniform column padding.
@@ -27,7 +25,7 @@ func ComputeMaxColumns(pads MaxyPad, sortCol int, header render.HeaderRow, ee re
 	for _, e := range ee {
 		for index, field := range e.Row.Fields {
 			if header.AgeCol(index) {
-				field = toAgeHuman(field)
+				field = render.AgeDecorator(field)
 			}
 			width := len(field) + colPadding
 			if index < len(pads) && width > pads[index] {
@@ -60,12 +58,3 @@ func Pad(s string, width int) string {
 
 	return s + strings.Repeat(" ", width-len(s))
 }
-
-func toAgeHuman(s string) string {
-	d, err := time.ParseDuration(s)
-	if err != nil {
-		return "n/a"
-	}
-
-	return duration.HumanDuration(d)
-}
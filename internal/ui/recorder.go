@@ -0,0 +1,256 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+const (
+	castVersion = 2
+	castOutput  = "o"
+	castInput   = "i"
+
+	// Large screens dump big frames.
+	maxCastLine = 16 * 1024 * 1024
+)
+
+// CastHeader represents an asciicast v2 header.
+type CastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder records screen frames and keystrokes in the asciicast v2 format
+// so sessions can be replayed by K9s or asciinema.
+type Recorder struct {
+	w     io.Writer
+	start time.Time
+	last  string
+	mx    sync.Mutex
+}
+
+// NewRecorder returns a new session recorder.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// Frame records the current screen if it changed since the last frame.
+func (r *Recorder) Frame(s tcell.Screen) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	w, h := s.Size()
+	if r.start.IsZero() {
+		r.start = time.Now()
+		r.write(CastHeader{
+			Version:   castVersion,
+			Width:     w,
+			Height:    h,
+			Timestamp: r.start.Unix(),
+			Title:     "K9s",
+			Env:       map[string]string{"TERM": os.Getenv("TERM")},
+		})
+	}
+
+	frame := screenDump(s, w, h)
+	if frame == r.last {
+		return
+	}
+	r.last = frame
+	r.event(castOutput, frame)
+}
+
+// Key records a keystroke.
+func (r *Recorder) Key(evt *tcell.EventKey) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.start.IsZero() {
+		return
+	}
+	r.event(castInput, keyBytes(evt))
+}
+
+func (r *Recorder) event(kind, data string) {
+	r.write([]interface{}{time.Since(r.start).Seconds(), kind, data})
+}
+
+func (r *Recorder) write(o interface{}) {
+	bb, err := json.Marshal(o)
+	if err != nil {
+		return
+	}
+	_, _ = r.w.Write(append(bb, '\n'))
+}
+
+// Replay plays back the output of a recorded session. Speed accelerates or
+// slows down the playback.
+func Replay(in io.Reader, out io.Writer, speed float64) error {
+	if speed <= 0 {
+		return fmt.Errorf("invalid replay speed %g", speed)
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxCastLine)
+	if !scanner.Scan() {
+		return errors.New("empty recording")
+	}
+	var h CastHeader
+	if err := json.Unmarshal(scanner.Bytes(), &h); err != nil {
+		return fmt.Errorf("invalid recording header: %v", err)
+	}
+	if h.Version != castVersion {
+		return fmt.Errorf("unsupported recording version %d", h.Version)
+	}
+
+	var last float64
+	for scanner.Scan() {
+		var evt []interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &evt); err != nil || len(evt) != 3 {
+			return fmt.Errorf("invalid recording event %q", scanner.Text())
+		}
+		at, _ := evt[0].(float64)
+		kind, _ := evt[1].(string)
+		data, _ := evt[2].(string)
+		if kind != castOutput {
+			continue
+		}
+		if at > last {
+			time.Sleep(time.Duration((at - last) / speed * float64(time.Second)))
+			last = at
+		}
+		if _, err := io.WriteString(out, data); err != nil {
+			return err
+		}
+	}
+	_, _ = io.WriteString(out, "\x1b[0m\r\n")
+
+	return scanner.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+var ansiKeys = map[tcell.Key]string{
+	tcell.KeyUp:      "\x1b[A",
+	tcell.KeyDown:    "\x1b[B",
+	tcell.KeyRight:   "\x1b[C",
+	tcell.KeyLeft:    "\x1b[D",
+	tcell.KeyHome:    "\x1b[H",
+	tcell.KeyEnd:     "\x1b[F",
+	tcell.KeyPgUp:    "\x1b[5~",
+	tcell.KeyPgDn:    "\x1b[6~",
+	tcell.KeyDelete:  "\x1b[3~",
+	tcell.KeyInsert:  "\x1b[2~",
+	tcell.KeyBacktab: "\x1b[Z",
+	tcell.KeyF1:      "\x1bOP",
+	tcell.KeyF2:      "\x1bOQ",
+	tcell.KeyF3:      "\x1bOR",
+	tcell.KeyF4:      "\x1bOS",
+	tcell.KeyF5:      "\x1b[15~",
+	tcell.KeyF6:      "\x1b[17~",
+	tcell.KeyF7:      "\x1b[18~",
+	tcell.KeyF8:      "\x1b[19~",
+	tcell.KeyF9:      "\x1b[20~",
+	tcell.KeyF10:     "\x1b[21~",
+	tcell.KeyF11:     "\x1b[23~",
+	tcell.KeyF12:     "\x1b[24~",
+}
+
+func keyBytes(evt *tcell.EventKey) string {
+	k := evt.Key()
+	switch {
+	case k == tcell.KeyRune:
+		return string(evt.Rune())
+	case k < 256:
+		return string(rune(k))
+	}
+	if s, ok := ansiKeys[k]; ok {
+		return s
+	}
+
+	return "<" + evt.Name() + ">"
+}
+
+func screenDump(s tcell.Screen, w, h int) string {
+	var (
+		b    strings.Builder
+		last = tcell.StyleDefault
+	)
+	b.WriteString("\x1b[0m\x1b[H\x1b[2J")
+	for y := 0; y < h; y++ {
+		fmt.Fprintf(&b, "\x1b[%d;1H", y+1)
+		for x := 0; x < w; {
+			mainc, combc, style, width := s.GetContent(x, y)
+			if style != last {
+				b.WriteString(sgr(style))
+				last = style
+			}
+			if mainc == 0 {
+				mainc = ' '
+			}
+			b.WriteRune(mainc)
+			for _, c := range combc {
+				b.WriteRune(c)
+			}
+			if width < 1 {
+				width = 1
+			}
+			x += width
+		}
+	}
+	b.WriteString("\x1b[0m")
+
+	return b.String()
+}
+
+func sgr(s tcell.Style) string {
+	fg, bg, attr := s.Decompose()
+	codes := []string{"0"}
+	if attr&tcell.AttrBold != 0 {
+		codes = append(codes, "1")
+	}
+	if attr&tcell.AttrDim != 0 {
+		codes = append(codes, "2")
+	}
+	if attr&tcell.AttrUnderline != 0 {
+		codes = append(codes, "4")
+	}
+	if attr&tcell.AttrBlink != 0 {
+		codes = append(codes, "5")
+	}
+	if attr&tcell.AttrReverse != 0 {
+		codes = append(codes, "7")
+	}
+	codes = append(codes, sgrColor(fg, 38), sgrColor(bg, 48))
+
+	return "\x1b[" + strings.Join(codes, ";") + "m"
+}
+
+func sgrColor(c tcell.Color, base int) string {
+	switch {
+	case c == tcell.ColorDefault:
+		return fmt.Sprintf("%d", base+1)
+	case c >= 0 && c < 256:
+		return fmt.Sprintf("%d;5;%d", base, c)
+	}
+	r, g, b := c.RGB()
+	if r < 0 {
+		return fmt.Sprintf("%d", base+1)
+	}
+
+	return fmt.Sprintf("%d;2;%d;%d;%d", base, r, g, b)
+}
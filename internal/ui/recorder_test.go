@@ -0,0 +1,90 @@
+package ui_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	s := tcell.NewSimulationScreen("UTF-8")
+	assert.Nil(t, s.Init())
+	s.SetSize(10, 2)
+	s.SetContent(0, 0, 'k', nil, tcell.StyleDefault.Foreground(tcell.ColorRed))
+	s.SetContent(1, 0, '9', nil, tcell.StyleDefault.Bold(true))
+
+	var out bytes.Buffer
+	r := ui.NewRecorder(&out)
+	r.Key(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone))
+	r.Frame(s)
+	r.Frame(s)
+	r.Key(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone))
+	r.Key(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+	r.Key(tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl))
+
+	ll := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 5, len(ll))
+
+	var h ui.CastHeader
+	assert.Nil(t, json.Unmarshal([]byte(ll[0]), &h))
+	assert.Equal(t, ui.CastHeader{Version: 2, Width: 10, Height: 2, Timestamp: h.Timestamp, Title: "K9s", Env: h.Env}, h)
+
+	var frame []interface{}
+	assert.Nil(t, json.Unmarshal([]byte(ll[1]), &frame))
+	assert.Equal(t, "o", frame[1])
+	assert.Contains(t, frame[2], "\x1b[0;38;5;9;49mk\x1b[0;1;39;49m9")
+
+	ee := []string{"j", "\x1b[A", "\x04"}
+	for i, e := range ee {
+		var evt []interface{}
+		assert.Nil(t, json.Unmarshal([]byte(ll[i+2]), &evt))
+		assert.Equal(t, "i", evt[1])
+		assert.Equal(t, e, evt[2])
+	}
+}
+
+func TestReplay(t *testing.T) {
+	uu := map[string]struct {
+		cast, e string
+		err     bool
+	}{
+		"plain": {
+			cast: `{"version":2,"width":10,"height":2}
+[0.001,"o","hello"]
+[0.002,"i","j"]
+[0.003,"o"," world"]`,
+			e: "hello world\x1b[0m\r\n",
+		},
+		"empty": {
+			err: true,
+		},
+		"version": {
+			cast: `{"version":1,"width":10,"height":2}`,
+			err:  true,
+		},
+		"garbage": {
+			cast: `{"version":2,"width":10,"height":2}
+[0.001,"o"]`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			err := ui.Replay(strings.NewReader(u.cast), &out, 10)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, out.String())
+		})
+	}
+}
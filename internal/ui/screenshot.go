@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	svgCellWidth  = 8.4
+	svgCellHeight = 17
+	svgFontSize   = 14
+	screenFont    = "Menlo,Consolas,DejaVu Sans Mono,monospace"
+)
+
+// Screenshot represents a capture of the screen cells.
+type Screenshot struct {
+	Cells         []tcell.SimCell
+	Width, Height int
+	Fg, Bg        tcell.Color
+}
+
+// CaptureScreen draws a primitive onto a simulation screen and returns the
+// resulting cells. Default colors are resolved using fg and bg.
+func CaptureScreen(p tview.Primitive, fg, bg tcell.Color) (Screenshot, error) {
+	x, y, w, h := p.GetRect()
+	s := tcell.NewSimulationScreen("UTF-8")
+	if err := s.Init(); err != nil {
+		return Screenshot{}, err
+	}
+	defer s.Fini()
+	s.SetSize(x+w, y+h)
+	p.Draw(s)
+	s.Show()
+
+	cells, cw, ch := s.GetContents()
+	shot := Screenshot{
+		Cells:  make([]tcell.SimCell, len(cells)),
+		Width:  cw,
+		Height: ch,
+		Fg:     fg,
+		Bg:     bg,
+	}
+	copy(shot.Cells, cells)
+
+	return shot, nil
+}
+
+// HTML renders the screenshot as a standalone html page.
+func (s Screenshot) HTML(title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n", html.EscapeString(title))
+	fmt.Fprintf(&b, "<body style=\"margin:0;background:%s\">\n", s.hex(s.Bg, s.Bg))
+	fmt.Fprintf(&b, "<pre style=\"font-family:%s;font-size:13px;line-height:1.2;margin:0;padding:8px;color:%s;background:%s\">", screenFont, s.hex(s.Fg, s.Fg), s.hex(s.Bg, s.Bg))
+	for row := 0; row < s.Height; row++ {
+		for _, r := range s.runs(row) {
+			fg, bg := s.colors(r.style)
+			fmt.Fprintf(&b, "<span style=\"color:%s;background:%s%s\">%s</span>", fg, bg, cssAttrs(r.style), html.EscapeString(r.text))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("</pre>\n</body>\n</html>\n")
+
+	return b.String()
+}
+
+// SVG renders the screenshot as a svg image.
+func (s Screenshot) SVG() string {
+	w, h := float64(s.Width)*svgCellWidth, s.Height*svgCellHeight
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.0f\" height=\"%d\" font-family=\"%s\" font-size=\"%d\">\n", w, h, screenFont, svgFontSize)
+	fmt.Fprintf(&b, "<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n", s.hex(s.Bg, s.Bg))
+	for row := 0; row < s.Height; row++ {
+		y := row * svgCellHeight
+		for _, r := range s.runs(row) {
+			x, width := float64(r.col)*svgCellWidth, float64(r.width)*svgCellWidth
+			fg, bg := s.colors(r.style)
+			if bg != s.hex(s.Bg, s.Bg) {
+				fmt.Fprintf(&b, "<rect x=\"%.1f\" y=\"%d\" width=\"%.1f\" height=\"%d\" fill=\"%s\"/>\n", x, y, width, svgCellHeight, bg)
+			}
+			if strings.TrimSpace(r.text) == "" {
+				continue
+			}
+			fmt.Fprintf(&b, "<text x=\"%.1f\" y=\"%d\" fill=\"%s\"%s textLength=\"%.1f\" xml:space=\"preserve\">%s</text>\n",
+				x, y+svgCellHeight-4, fg, svgAttrs(r.style), width, html.EscapeString(r.text))
+		}
+	}
+	b.WriteString("</svg>\n")
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type cellRun struct {
+	col, width int
+	style      tcell.Style
+	text       string
+}
+
+// Runs groups a row cells sharing the same style.
+func (s Screenshot) runs(row int) []cellRun {
+	var (
+		rr  []cellRun
+		buf strings.Builder
+		cur cellRun
+	)
+	flush := func() {
+		if cur.width == 0 {
+			return
+		}
+		cur.text = buf.String()
+		rr = append(rr, cur)
+		buf.Reset()
+	}
+	for col := 0; col < s.Width; col++ {
+		c := s.Cells[row*s.Width+col]
+		if cur.width == 0 || c.Style != cur.style {
+			flush()
+			cur = cellRun{col: col, style: c.Style}
+		}
+		cur.width++
+		if len(c.Runes) == 0 {
+			buf.WriteRune(' ')
+			continue
+		}
+		buf.WriteString(string(c.Runes))
+	}
+	flush()
+
+	return rr
+}
+
+func (s Screenshot) colors(st tcell.Style) (string, string) {
+	fg, bg, attrs := st.Decompose()
+	if attrs&tcell.AttrReverse != 0 {
+		return s.hex(bg, s.Bg), s.hex(fg, s.Fg)
+	}
+
+	return s.hex(fg, s.Fg), s.hex(bg, s.Bg)
+}
+
+func (s Screenshot) hex(c, def tcell.Color) string {
+	if c == tcell.ColorDefault || c.Hex() < 0 {
+		c = def
+	}
+	if c.Hex() < 0 {
+		return "inherit"
+	}
+
+	return fmt.Sprintf("#%06x", c.Hex())
+}
+
+func cssAttrs(st tcell.Style) string {
+	_, _, attrs := st.Decompose()
+	var ss []string
+	if attrs&tcell.AttrBold != 0 {
+		ss = append(ss, "font-weight:bold")
+	}
+	if attrs&tcell.AttrUnderline != 0 {
+		ss = append(ss, "text-decoration:underline")
+	}
+	if attrs&tcell.AttrDim != 0 {
+		ss = append(ss, "opacity:0.6")
+	}
+	if len(ss) == 0 {
+		return ""
+	}
+
+	return ";" + strings.Join(ss, ";")
+}
+
+func svgAttrs(st tcell.Style) string {
+	_, _, attrs := st.Decompose()
+	var s string
+	if attrs&tcell.AttrBold != 0 {
+		s += ` font-weight="bold"`
+	}
+	if attrs&tcell.AttrUnderline != 0 {
+		s += ` text-decoration="underline"`
+	}
+	if attrs&tcell.AttrDim != 0 {
+		s += ` opacity="0.6"`
+	}
+
+	return s
+}
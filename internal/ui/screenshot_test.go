@@ -0,0 +1,31 @@
+package ui_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureScreen(t *testing.T) {
+	v := tview.NewTextView().SetDynamicColors(true)
+	v.SetText("[red::b]fred[-::-] <b>")
+	v.SetRect(0, 0, 10, 2)
+
+	shot, err := ui.CaptureScreen(v, tcell.ColorWhite, tcell.ColorBlack)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, shot.Width)
+	assert.Equal(t, 2, shot.Height)
+
+	html := shot.HTML("K9s <pods>")
+	assert.True(t, strings.Contains(html, "<title>K9s &lt;pods&gt;</title>"))
+	assert.True(t, strings.Contains(html, `color:#ff0000;background:#000000;font-weight:bold">fred</span>`))
+	assert.True(t, strings.Contains(html, "&lt;b&gt;"))
+
+	svg := shot.SVG()
+	assert.True(t, strings.HasPrefix(svg, "<svg "))
+	assert.True(t, strings.Contains(svg, `fill="#ff0000" font-weight="bold" textLength="33.6" xml:space="preserve">fred</text>`))
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -39,26 +40,33 @@ func (s *SelectTable) SelectFirstRow() {
 }
 
 // GetSelectedItems return currently marked or selected items names.
+// Deleted resources lingering in the table are skipped.
 func (s *SelectTable) GetSelectedItems() []string {
 	if len(s.marks) == 0 {
-		return []string{s.GetSelectedItem()}
+		if sel := s.GetSelectedItem(); sel != "" {
+			return []string{sel}
+		}
+		return nil
 	}
 
 	var items []string
 	for item := range s.marks {
-		items = append(items, item)
+		if !s.isGone(item) {
+			items = append(items, item)
+		}
 	}
 
 	return items
 }
 
-// GetSelectedItem returns the currently selected item name.
+// GetSelectedItem returns the currently selected item name or blank if the
+// selected resource was deleted.
 func (s *SelectTable) GetSelectedItem() string {
 	if s.GetSelectedRowIndex() == 0 || s.model.Empty() {
 		return ""
 	}
 	sel, ok := s.GetCell(s.GetSelectedRowIndex(), 0).GetReference().(string)
-	if !ok {
+	if !ok || s.isGone(sel) {
 		return ""
 	}
 	if s.selectedFn != nil {
@@ -67,6 +75,14 @@ func (s *SelectTable) GetSelectedItem() string {
 	return sel
 }
 
+// IsGone checks if a row tracks a deleted resource.
+func (s *SelectTable) isGone(id string) bool {
+	rr := s.model.Peek().RowEvents
+	idx, ok := rr.FindIndex(id)
+
+	return ok && rr[idx].Kind == render.EventDelete
+}
+
 // GetSelectedCell returns the content of a cell for the currently selected row.
 func (s *SelectTable) GetSelectedCell(col int) string {
 	return TrimCell(s, s.selectedRow, col)
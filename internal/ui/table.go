@@ -2,9 +2,9 @@ package ui
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"github.com/sahilm/fuzzy"
 )
 
 type (
@@ -22,6 +23,9 @@ type (
 	// DecorateFunc represents a row decorator.
 	DecorateFunc func(render.TableData) render.TableData
 
+	// SortFunc represents a sort change callback.
+	SortFunc func(col string, asc bool)
+
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
 )
@@ -34,10 +38,18 @@ type Table struct {
 	BaseTitle  string
 	Path       string
 	cmdBuff    *CmdBuff
+	jumpBuff   *CmdBuff
 	styles     *config.Styles
 	sortCol    SortColumn
+	sortName   string
+	sortFn     SortFunc
 	colorerFn  render.ColorerFunc
 	decorateFn DecorateFunc
+	footer     bool
+	scoped     bool
+	masked     bool
+	frozen     int
+	groupRows  map[int]string
 }
 
 // NewTable returns a new table view.
@@ -51,6 +63,7 @@ func NewTable(gvr string) *Table {
 		},
 		actions:   make(KeyActions),
 		cmdBuff:   NewCmdBuff('/', FilterBuff),
+		jumpBuff:  NewCmdBuff('\'', JumpBuff),
 		BaseTitle: gvr,
 		sortCol:   SortColumn{index: -1, colCount: 0, asc: true},
 	}
@@ -58,7 +71,7 @@ func NewTable(gvr string) *Table {
 
 // Init initializes the component.
 func (t *Table) Init(ctx context.Context) {
-	t.SetFixed(1, 0)
+	t.freeze()
 	t.SetBorder(true)
 	t.SetBorderAttributes(tcell.AttrBold)
 	t.SetBorderPadding(0, 0, 1, 1)
@@ -99,6 +112,11 @@ func (t *Table) SendKey(evt *tcell.EventKey) {
 }
 
 func (t *Table) filterInput(r rune) bool {
+	if t.jumpBuff.IsActive() {
+		t.jumpBuff.Add(r)
+		t.Jump(t.jumpBuff.String())
+		return true
+	}
 	if !t.cmdBuff.IsActive() {
 		return false
 	}
@@ -117,6 +135,26 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
+	if t.jumpBuff.IsActive() {
+		switch key {
+		case tcell.KeyEnter, tcell.KeyEscape:
+			t.jumpBuff.Reset()
+			return nil
+		case tcell.KeyBackspace, tcell.KeyBackspace2, tcell.KeyDelete:
+			t.jumpBuff.Delete()
+			t.Jump(t.jumpBuff.String())
+			return nil
+		}
+	}
+
+	if key == tcell.KeyEnter {
+		if g, ok := t.groupRows[t.GetSelectedRowIndex()]; ok {
+			t.model.ToggleCollapsed(g)
+			t.Refresh()
+			return nil
+		}
+	}
+
 	if key == tcell.KeyRune {
 		if t.filterInput(evt.Rune()) {
 			return nil
@@ -184,6 +222,7 @@ func (t *Table) doUpdate(data render.TableData) {
 	}
 
 	t.Clear()
+	t.freeze()
 	t.adjustSorter(data)
 	fg := config.AsColor(t.styles.Table().Header.FgColor)
 	bg := config.AsColor(t.styles.Table().Header.BgColor)
@@ -194,11 +233,45 @@ func (t *Table) doUpdate(data render.TableData) {
 		c.SetTextColor(fg)
 	}
 	data.RowEvents.Sort(data.Namespace, t.sortCol.index, t.sortCol.asc)
+	if render.Anonymous && t.masks() {
+		data.RowEvents = render.AnonymizeRows(data.Header, data.RowEvents)
+	}
 
 	pads := make(MaxyPad, len(data.Header))
 	ComputeMaxColumns(pads, t.sortCol.index, data.Header, data.RowEvents)
-	for i, r := range data.RowEvents {
-		t.buildRow(data.Namespace, i+1, r, data.Header, pads)
+	var totals render.Fields
+	if t.footer {
+		totals = Aggregate(data.Header, data.RowEvents)
+		for col, f := range totals {
+			if len(f)+1 > pads[col] {
+				pads[col] = len(f) + 1
+			}
+		}
+	}
+	now := time.Now()
+	row := 1
+	t.groupRows = make(map[int]string)
+	if gg := t.model.Group(data.Header, data.RowEvents); gg != nil {
+		for _, g := range gg {
+			t.buildGroupRow(row, g, data.Header)
+			t.groupRows[row] = g.Name
+			row++
+			if t.model.IsCollapsed(g.Name) {
+				continue
+			}
+			for _, r := range g.Events {
+				t.buildRow(data.Namespace, row, r, data.Header, pads, now)
+				row++
+			}
+		}
+	} else {
+		for _, r := range data.RowEvents {
+			t.buildRow(data.Namespace, row, r, data.Header, pads, now)
+			row++
+		}
+	}
+	if t.footer {
+		t.buildFooter(row, totals, data.Header, pads)
 	}
 	t.updateSelection(true)
 }
@@ -215,24 +288,70 @@ func (t *Table) SortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.E
 		default:
 			index = t.NameColIndex() + col
 		}
-		t.sortCol.asc = !t.sortCol.asc
-		if t.sortCol.index != index {
-			t.sortCol.asc = asc
-		}
-		t.sortCol.index = index
-		t.Refresh()
+		t.sortBy(index, asc)
 		return nil
 	}
 }
 
+// SortHeaderCmd designates a sorted column by header name.
+func (t *Table) SortHeaderCmd(name string, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		for i, h := range t.GetModel().Peek().Header {
+			if h.Name == name {
+				t.sortBy(i, asc)
+				return nil
+			}
+		}
+		return evt
+	}
+}
+
+func (t *Table) sortBy(index int, asc bool) {
+	t.sortCol.asc = !t.sortCol.asc
+	if t.sortCol.index != index {
+		t.sortCol.asc = asc
+	}
+	t.sortCol.index = index
+	t.Refresh()
+	t.fireSortChanged()
+}
+
 // SortInvertCmd reverses sorting order.
 func (t *Table) SortInvertCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.sortCol.asc = !t.sortCol.asc
 	t.Refresh()
+	t.fireSortChanged()
 
 	return nil
 }
 
+// SetSortFn registers a callback fired when the user changes the sort order.
+func (t *Table) SetSortFn(f SortFunc) {
+	t.sortFn = f
+}
+
+// SetSortHeader sorts by the named column once the table header is known.
+func (t *Table) SetSortHeader(name string, asc bool) {
+	t.sortName, t.sortCol.asc = name, asc
+}
+
+// SortHeader returns the sorted column name and order.
+func (t *Table) SortHeader() (string, bool) {
+	header := t.GetModel().Peek().Header
+	if t.sortCol.index < 0 || t.sortCol.index >= len(header) {
+		return "", t.sortCol.asc
+	}
+
+	return header[t.sortCol.index].Name, t.sortCol.asc
+}
+
+func (t *Table) fireSortChanged() {
+	if t.sortFn == nil {
+		return
+	}
+	t.sortFn(t.SortHeader())
+}
+
 func (t *Table) adjustSorter(data render.TableData) {
 	// Going from namespace to non namespace or vice-versa?
 	switch {
@@ -243,12 +362,21 @@ func (t *Table) adjustSorter(data render.TableData) {
 		t.sortCol.index--
 	}
 	t.sortCol.colCount = len(data.Header)
+	if t.sortName != "" && len(data.Header) > 0 {
+		for i, h := range data.Header {
+			if h.Name == t.sortName {
+				t.sortCol.index = i
+				break
+			}
+		}
+		t.sortName = ""
+	}
 	if t.sortCol.index < 0 {
 		t.sortCol.index = 0
 	}
 }
 
-func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.HeaderRow, pads MaxyPad) {
+func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.HeaderRow, pads MaxyPad, now time.Time) {
 	color := render.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
@@ -270,9 +398,24 @@ func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.Hea
 		c.SetExpansion(1)
 		c.SetAlign(header[col].Align)
 		c.SetTextColor(color(ns, re))
+		switch {
+		case re.Kind == render.EventDelete:
+			c.SetTextColor(render.KillColor)
+			c.SetAttributes(tcell.AttrDim)
+		case re.Fresh(now):
+			c.SetTextColor(render.AddColor)
+			c.SetAttributes(tcell.AttrBold)
+		}
+		if re.Changes.Changed(col, now) {
+			c.SetTextColor(config.AsColor(t.styles.Table().DeltaColor))
+			c.SetAttributes(tcell.AttrBold)
+		}
 		if marked {
 			c.SetTextColor(config.AsColor(t.styles.Table().MarkColor))
 		}
+		if re.Row.Deprecated && col == nameCol(ns) {
+			c.SetAttributes(tcell.AttrUnderline)
+		}
 		if col == 0 {
 			c.SetReference(re.Row.ID)
 		}
@@ -280,6 +423,65 @@ func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.Hea
 	}
 }
 
+func (t *Table) buildGroupRow(r int, g model.RowGroup, header render.HeaderRow) {
+	icon := "▾"
+	if t.model.IsCollapsed(g.Name) {
+		icon = "▸"
+	}
+	name := g.Name
+	if name == "" {
+		name = render.MissingValue
+	}
+	for col := range header {
+		var txt string
+		if col == 0 {
+			txt = fmt.Sprintf("%s %s (%d)", icon, name, len(g.Events))
+		}
+		c := tview.NewTableCell(txt)
+		c.SetExpansion(1)
+		c.SetTextColor(config.AsColor(t.styles.Table().Header.SorterColor))
+		c.SetAttributes(tcell.AttrBold)
+		t.SetCell(r, col, c)
+	}
+}
+
+// ToggleGroupBy groups rows by the sorted column or clears grouping.
+// Returns the grouped column if any.
+func (t *Table) ToggleGroupBy() string {
+	if t.model.GroupBy() != "" {
+		t.model.SetGroupBy("")
+		return ""
+	}
+	header := t.model.Peek().Header
+	if t.sortCol.index < 0 || t.sortCol.index >= len(header) {
+		return ""
+	}
+	t.model.SetGroupBy(header[t.sortCol.index].Name)
+
+	return t.model.GroupBy()
+}
+
+func (t *Table) buildFooter(r int, ff render.Fields, header render.HeaderRow, pads MaxyPad) {
+	for col, field := range ff {
+		if header[col].Align == tview.AlignLeft {
+			field = formatCell(field, pads[col])
+		}
+		c := tview.NewTableCell(field)
+		c.SetExpansion(1)
+		c.SetAlign(header[col].Align)
+		c.SetTextColor(config.AsColor(t.styles.Table().Header.FgColor))
+		c.SetAttributes(tcell.AttrBold)
+		c.SetSelectable(false)
+		t.SetCell(r, col, c)
+	}
+}
+
+// ToggleFooter shows or hides the column totals footer.
+func (t *Table) ToggleFooter() bool {
+	t.footer = !t.footer
+	return t.footer
+}
+
 // ClearMarks clear out marked items.
 func (t *Table) ClearMarks() {
 	t.SelectTable.ClearMarks()
@@ -292,10 +494,44 @@ func (t *Table) Refresh() {
 	t.Update(t.model.Peek())
 }
 
-// GetSelectedRow returns the entire selected row.
+// GetSelectedRow returns the entire selected row. Group and footer rows
+// yield a blank row.
 func (t *Table) GetSelectedRow() render.Row {
-	log.Debug().Msgf("INDEX %d", t.GetSelectedRowIndex())
-	return t.model.Peek().RowEvents[t.GetSelectedRowIndex()-1].Row
+	id, ok := t.GetCell(t.GetSelectedRowIndex(), 0).GetReference().(string)
+	if !ok {
+		return render.Row{}
+	}
+	rr := t.model.Peek().RowEvents
+	if i, ok := rr.FindIndex(id); ok {
+		return rr[i].Row
+	}
+
+	return render.Row{}
+}
+
+// SetFrozenColumns keeps the first n columns in view while scrolling
+// horizontally. The namespace column of all namespaces views is frozen
+// along. Zero unfreezes all columns.
+func (t *Table) SetFrozenColumns(n int) {
+	if n < 0 {
+		n = 0
+	}
+	t.frozen = n
+	t.freeze()
+}
+
+// FrozenColumns returns the number of frozen columns.
+func (t *Table) FrozenColumns() int {
+	return t.frozen
+}
+
+// freeze pins the header row and the frozen columns.
+func (t *Table) freeze() {
+	var cols int
+	if t.frozen > 0 {
+		cols = t.NameColIndex() + t.frozen
+	}
+	t.SetFixed(1, cols)
 }
 
 // NameColIndex returns the index of the resource name column.
@@ -316,21 +552,61 @@ func (t *Table) AddHeaderCell(col int, h render.Header) {
 }
 
 func (t *Table) filtered(data render.TableData) render.TableData {
-	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.String()) {
+	if t.cmdBuff.Empty() || IsServerSelector(t.cmdBuff.String()) {
 		return data
 	}
+	// Masked tables are filtered on what is shown so real names never match.
+	masked := data
+	if render.Anonymous && t.masks() {
+		masked.RowEvents = render.AnonymizeRows(data.Header, data.RowEvents)
+	}
 	q := t.cmdBuff.String()
 	if IsFuzzySelector(q) {
-		return fuzzyFilter(q[2:], t.NameColIndex(), data)
+		return keepRows(data, fuzzyFilter(q[2:], t.NameColIndex(), masked))
 	}
 
-	filtered, err := rxFilter(t.cmdBuff.String(), data)
+	// Invalid filters are reported by the prompt. Keep all rows meanwhile.
+	filtered, err := rxFilter(t.cmdBuff.String(), masked)
 	if err != nil {
-		log.Error().Err(errors.New("Invalid filter expression")).Msg("Regexp")
-		t.cmdBuff.Clear()
+		log.Debug().Err(err).Msg("Regexp")
 		return data
 	}
-	return filtered
+	return keepRows(data, filtered)
+}
+
+// JumpBuff returns the associated row jump buffer.
+func (t *Table) JumpBuff() *CmdBuff {
+	return t.jumpBuff
+}
+
+// Jump selects the row which name best fuzzy matches the query without
+// filtering the table. Returns false if no row matched.
+func (t *Table) Jump(q string) bool {
+	if q == "" {
+		return false
+	}
+	var (
+		rows  []int
+		names []string
+	)
+	for r := 1; r < t.GetRowCount(); r++ {
+		id, ok := t.GetCell(r, 0).GetReference().(string)
+		if !ok || id == "" {
+			continue
+		}
+		_, n := client.Namespaced(id)
+		if render.Anonymous && t.masks() {
+			n = render.AnonymizeName(n)
+		}
+		rows, names = append(rows, r), append(names, n)
+	}
+	mm := fuzzy.Find(q, names)
+	if len(mm) == 0 {
+		return false
+	}
+	t.SelectRow(rows[mm[0].Index], true)
+
+	return true
 }
 
 // SearchBuff returns the associated command buffer.
@@ -352,11 +628,31 @@ func (t *Table) UpdateTitle() {
 	t.SetTitle(t.styleTitle())
 }
 
+// ShowScope flags the table as listing Kubernetes resources so cluster
+// scoped listings are told apart from namespaced ones in the title.
+func (t *Table) ShowScope(b bool) {
+	t.scoped = b
+}
+
+// MaskRows flags a table listing resource identities so they get masked in
+// anonymous mode. Kubernetes resource listings always are.
+func (t *Table) MaskRows(b bool) {
+	t.masked = b
+}
+
+func (t *Table) masks() bool {
+	return t.scoped || t.masked
+}
+
 func (t *Table) styleTitle() string {
 	rc := t.GetRowCount()
 	if rc > 0 {
 		rc--
 	}
+	if t.footer && rc > 0 {
+		rc--
+	}
+	rc -= len(t.groupRows)
 
 	base := strings.Title(t.BaseTitle)
 	ns := t.GetModel().GetNamespace()
@@ -372,12 +668,18 @@ func (t *Table) styleTitle() string {
 			ns = path
 		}
 	}
+	if render.Anonymous && t.scoped && ns != client.ClusterScope && ns != client.NamespaceAll {
+		ns = render.AnonymizePath(ns)
+	}
 
 	buff := t.cmdBuff.String()
 	var title string
-	if ns == client.ClusterScope {
+	switch {
+	case ns == client.ClusterScope && t.scoped:
+		title = SkinTitle(fmt.Sprintf(ClusterTitleFmt, base, rc), t.styles.Frame())
+	case ns == client.ClusterScope:
 		title = SkinTitle(fmt.Sprintf(TitleFmt, base, rc), t.styles.Frame())
-	} else {
+	default:
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
 	if buff == "" {
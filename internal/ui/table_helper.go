@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
@@ -20,6 +22,9 @@ const (
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
+	// ClusterTitleFmt represents a cluster scoped resource view title.
+	ClusterTitleFmt = "[fg:bg:b] %s{[hilite:bg:b]cluster[fg:bg:-]}[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
+
 	// TitleFmt represents a standard view title.
 	TitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
@@ -38,6 +43,8 @@ var (
 	LableRx = regexp.MustCompile(`\A\-l`)
 
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
+
+	nsRx = regexp.MustCompile(`\A\-n\s`)
 )
 
 func mustExtractSyles(ctx context.Context) *config.Styles {
@@ -74,6 +81,27 @@ func IsFuzzySelector(s string) bool {
 	return fuzzyRx.MatchString(s)
 }
 
+// IsNamespaceSelector checks if query selects namespaces.
+func IsNamespaceSelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return nsRx.MatchString(s)
+}
+
+// IsServerSelector checks if query is served while listing resources rather
+// than by filtering the rendered rows.
+func IsServerSelector(s string) bool {
+	return IsLabelSelector(s) || IsNamespaceSelector(s)
+}
+
+// TrimNamespaceSelector extracts the namespaces of a namespace query.
+func TrimNamespaceSelector(s string) []string {
+	return strings.FieldsFunc(s[2:], func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
 // TrimLabelSelector extracts label query.
 func TrimLabelSelector(s string) string {
 	return strings.TrimSpace(s[2:])
@@ -103,6 +131,14 @@ func sortIndicator(col SortColumn, style config.Table, index int, name string) s
 	return fmt.Sprintf("%s[%s::b]%s[::]", name, style.Header.SorterColor, order)
 }
 
+func nameCol(ns string) int {
+	if client.IsAllNamespaces(ns) {
+		return 1
+	}
+
+	return 0
+}
+
 func formatCell(field string, padding int) string {
 	if IsASCII(field) {
 		return Pad(field, padding)
@@ -112,7 +148,7 @@ func formatCell(field string, padding int) string {
 }
 
 func rxFilter(q string, data render.TableData) (render.TableData, error) {
-	rx, err := regexp.Compile(`(?i)` + q)
+	f, err := model.NewFilter(q)
 	if err != nil {
 		return data, err
 	}
@@ -123,8 +159,8 @@ func rxFilter(q string, data render.TableData) (render.TableData, error) {
 		Namespace: data.Namespace,
 	}
 	for _, re := range data.RowEvents {
-		f := strings.Join(re.Row.Fields, " ")
-		if rx.MatchString(f) {
+		s := strings.Join(re.Row.Fields, " ")
+		if f.Match(s) {
 			filtered.RowEvents = append(filtered.RowEvents, re)
 		}
 	}
@@ -132,6 +168,24 @@ func rxFilter(q string, data render.TableData) (render.TableData, error) {
 	return filtered, nil
 }
 
+// KeepRows returns the original rows matching the filtered ones, in the
+// filtered order.
+func keepRows(data, filtered render.TableData) render.TableData {
+	ids := make(map[string]int, len(filtered.RowEvents))
+	for i, re := range filtered.RowEvents {
+		ids[re.Row.ID] = i
+	}
+	rr := make(render.RowEvents, len(filtered.RowEvents))
+	for _, re := range data.RowEvents {
+		if i, ok := ids[re.Row.ID]; ok {
+			rr[i] = re
+		}
+	}
+	filtered.RowEvents = rr
+
+	return filtered
+}
+
 func fuzzyFilter(q string, index int, data render.TableData) render.TableData {
 	var ss []string
 	for _, re := range data.RowEvents {
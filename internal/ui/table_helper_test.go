@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +41,64 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNamespaceSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"cool":     {"-n default,fred", true},
+		"noSpace":  {"-ndefault", false},
+		"dashWord": {"-nginx", false},
+		"label":    {"-l app=fred", false},
+		"plain":    {"default", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsNamespaceSelector(u.sel))
+		})
+	}
+}
+
+func TestTrimNamespaceSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   []string
+	}{
+		"single": {"-n default", []string{"default"}},
+		"commas": {"-n default,fred", []string{"default", "fred"}},
+		"spaces": {"-n default, fred blee", []string{"default", "fred", "blee"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, TrimNamespaceSelector(u.sel))
+		})
+	}
+}
+
+func TestKeepRows(t *testing.T) {
+	data := render.TableData{
+		Header: render.HeaderRow{render.Header{Name: "NAME"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"a"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"b"}}},
+			{Row: render.Row{ID: "c", Fields: render.Fields{"c"}}},
+		},
+	}
+	filtered := render.TableData{
+		Header: data.Header,
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "c", Fields: render.Fields{"masked-c"}}},
+			{Row: render.Row{ID: "a", Fields: render.Fields{"masked-a"}}},
+		},
+	}
+
+	kept := keepRows(data, filtered)
+	assert.Equal(t, 2, len(kept.RowEvents))
+	assert.Equal(t, "c", kept.RowEvents[0].Row.Fields[0])
+	assert.Equal(t, "a", kept.RowEvents[1].Row.Fields[0])
+}
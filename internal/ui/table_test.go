@@ -2,14 +2,17 @@ package ui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,10 +56,96 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableSelectionGone(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &goneModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	v.SelectRow(1, true)
+	v.ToggleMark()
+	v.SelectRow(2, true)
+	assert.Equal(t, "", v.GetSelectedItem())
+	v.ToggleMark()
+	assert.Equal(t, []string{"r1"}, v.GetSelectedItems())
+
+	v.ClearMarks()
+	assert.Nil(t, v.GetSelectedItems())
+}
+
+func TestTableGroupBy(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	assert.Equal(t, "a", v.ToggleGroupBy())
+	v.Refresh()
+	assert.Equal(t, 4, v.GetRowCount())
+	assert.Equal(t, "▾ blee (2)", v.GetCell(1, 0).Text)
+	v.SelectRow(1, true)
+	assert.Equal(t, "", v.GetSelectedItem())
+	assert.Equal(t, render.Row{}, v.GetSelectedRow())
+	v.SelectRow(3, true)
+	assert.Equal(t, "r2", v.GetSelectedRow().ID)
+	assert.Equal(t, "a", m.GroupBy())
+	v.SelectRow(1, true)
+
+	v.SendKey(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.True(t, m.IsCollapsed("blee"))
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "▸ blee (2)", v.GetCell(1, 0).Text)
+
+	assert.Equal(t, "", v.ToggleGroupBy())
+	v.Refresh()
+	assert.Equal(t, 3, v.GetRowCount())
+}
+
+func TestTableFooter(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+
+	assert.True(t, v.ToggleFooter())
+	v.Update(m.Peek())
+	assert.Equal(t, 4, v.GetRowCount())
+	assert.Equal(t, "TOTAL(2)", strings.TrimSpace(v.GetCell(3, 0).Text))
+}
+
+func TestTableFrozenColumns(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.SetFrozenColumns(1)
+	v.Update(m.Peek())
+
+	s := tcell.NewSimulationScreen("UTF-8")
+	assert.Nil(t, s.Init())
+	s.SetSize(20, 5)
+	v.SetRect(0, 0, 20, 5)
+	v.SetOffset(0, 2)
+	v.Draw(s)
+	s.Show()
+	assert.Equal(t, []string{"│", "blee", "fred", "│"}, strings.Fields(screenRow(s, 2, 20)))
+
+	v.SetFrozenColumns(-1)
+	assert.Equal(t, 0, v.FrozenColumns())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
-type testModel struct{}
+type testModel struct {
+	model.Grouper
+}
 
 var _ ui.Tabular = &testModel{}
 
@@ -71,7 +160,7 @@ func (t *testModel) Watch(context.Context)           {}
 func (t *testModel) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return nil, nil
 }
-func (t *testModel) Delete(ctx context.Context, path string, c, f bool) error {
+func (t *testModel) Delete(ctx context.Context, path string, opts dao.DeleteOptions) error {
 	return nil
 }
 func (t *testModel) Describe(context.Context, string) (string, error) {
@@ -83,6 +172,17 @@ func (t *testModel) ToYAML(ctx context.Context, path string) (string, error) {
 func (t *testModel) InNamespace(string) bool      { return true }
 func (t *testModel) SetRefreshRate(time.Duration) {}
 
+type goneModel struct {
+	testModel
+}
+
+func (t *goneModel) Peek() render.TableData {
+	data := makeTableData()
+	data.RowEvents[1].Kind = render.EventDelete
+
+	return data
+}
+
 func makeTableData() render.TableData {
 	t := render.NewTableData()
 	t.Namespace = ""
@@ -108,3 +208,55 @@ func makeTableData() render.TableData {
 
 	return *t
 }
+
+func TestTableJump(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	v.JumpBuff().SetActive(true)
+	v.SendKey(tcell.NewEventKey(tcell.KeyRune, '2', tcell.ModNone))
+	assert.Equal(t, "2", v.JumpBuff().String())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+	assert.Equal(t, 3, v.GetRowCount())
+
+	v.SendKey(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.False(t, v.JumpBuff().IsActive())
+	assert.True(t, v.JumpBuff().Empty())
+}
+
+func TestTableSortHeader(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+
+	var col string
+	v.SetSortFn(func(c string, asc bool) { col = c })
+	v.SetSortHeader("c", false)
+	v.Update(m.Peek())
+	name, asc := v.SortHeader()
+	assert.Equal(t, "c", name)
+	assert.False(t, asc)
+
+	v.SortInvertCmd(nil)
+	assert.Equal(t, "c", col)
+	_, asc = v.SortHeader()
+	assert.True(t, asc)
+}
+
+func screenRow(s tcell.SimulationScreen, y, width int) string {
+	cells, w, _ := s.GetContents()
+	var b strings.Builder
+	for x := 0; x < width; x++ {
+		for _, r := range cells[y*w+x].Runes {
+			b.WriteRune(r)
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"io"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Terminal presents a pseudo terminal screen. Keys typed while focused are
+// sent to the terminal input, except Ctrl-] which releases the focus.
+type Terminal struct {
+	*tview.Box
+
+	vt        *VTerm
+	input     io.Writer
+	resizeFn  func(cols, rows uint16)
+	releaseFn func()
+}
+
+// NewTerminal returns a new terminal.
+func NewTerminal() *Terminal {
+	t := Terminal{
+		Box: tview.NewBox(),
+		vt:  NewVTerm(80, 24),
+	}
+	t.SetBorder(true)
+	t.SetTitleAlign(tview.AlignCenter)
+
+	return &t
+}
+
+// Reset clears the terminal screen and input.
+func (t *Terminal) Reset(input io.Writer) {
+	t.input = input
+	t.vt.Reset()
+	t.vt.SetReply(input)
+}
+
+// Write feeds terminal output.
+func (t *Terminal) Write(bb []byte) (int, error) {
+	return t.vt.Write(bb)
+}
+
+// Screen returns the terminal screen.
+func (t *Terminal) Screen() *VTerm {
+	return t.vt
+}
+
+// SetResizeFn sets a function called when the terminal gets resized.
+func (t *Terminal) SetResizeFn(f func(cols, rows uint16)) {
+	t.resizeFn = f
+}
+
+// SetReleaseFn sets a function called when the terminal releases its focus.
+func (t *Terminal) SetReleaseFn(f func()) {
+	t.releaseFn = f
+}
+
+// Draw draws the terminal screen.
+func (t *Terminal) Draw(screen tcell.Screen) {
+	t.Box.Draw(screen)
+	x, y, w, h := t.GetInnerRect()
+	if w <= 0 || h <= 0 {
+		return
+	}
+	if cols, rows := t.vt.Size(); cols != w || rows != h {
+		t.vt.Resize(w, h)
+		if t.resizeFn != nil {
+			t.resizeFn(uint16(w), uint16(h))
+		}
+	}
+	t.vt.Draw(screen, x, y, t.HasFocus())
+}
+
+// InputHandler sends keys to the terminal input.
+func (t *Terminal) InputHandler() func(*tcell.EventKey, func(tview.Primitive)) {
+	return t.WrapInputHandler(func(evt *tcell.EventKey, _ func(tview.Primitive)) {
+		if evt.Key() == tcell.KeyCtrlRightSq {
+			if t.releaseFn != nil {
+				t.releaseFn()
+			}
+			return
+		}
+		s := terminalKey(evt)
+		if s == "" || t.input == nil {
+			return
+		}
+		if _, err := io.WriteString(t.input, s); err != nil {
+			log.Warn().Err(err).Msg("Terminal input")
+		}
+	})
+}
+
+func terminalKey(evt *tcell.EventKey) string {
+	var s string
+	switch k := evt.Key(); {
+	case k == tcell.KeyRune:
+		s = string(evt.Rune())
+	case k < 256:
+		s = string(rune(k))
+	default:
+		s = ansiKeys[k]
+	}
+	if s != "" && evt.Modifiers()&tcell.ModAlt != 0 {
+		return "\x1b" + s
+	}
+
+	return s
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -48,10 +49,29 @@ type Lister interface {
 	Describe(ctx context.Context, path string) (string, error)
 }
 
+// Groupable represents a model that buckets rows by a column value.
+type Groupable interface {
+	// GroupBy returns the grouped column name if any.
+	GroupBy() string
+
+	// SetGroupBy groups rows by a given column name.
+	SetGroupBy(string)
+
+	// ToggleCollapsed collapses or expands a group.
+	ToggleCollapsed(string)
+
+	// IsCollapsed checks if a group is collapsed.
+	IsCollapsed(string) bool
+
+	// Group buckets rows by the grouped column.
+	Group(render.HeaderRow, render.RowEvents) []model.RowGroup
+}
+
 // Tabular represents a tabular model.
 type Tabular interface {
 	Namespaceable
 	Lister
+	Groupable
 
 	SetInstance(string)
 
@@ -71,5 +91,5 @@ type Tabular interface {
 	AddListener(model.TableListener)
 
 	// Delete a resource.
-	Delete(ctx context.Context, path string, cascade, force bool) error
+	Delete(ctx context.Context, path string, opts dao.DeleteOptions) error
 }
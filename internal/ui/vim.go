@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// BoundFunc checks if a key is bound by the focused view.
+type BoundFunc func(tcell.Key) bool
+
+// VimMotions translates vim style keys into navigation keys.
+// j/k/h/l move around, gg/G jump to the top/bottom, ctrl-d/ctrl-u page
+// down/up, v toggles visual mode to mark rows while moving and x deletes.
+// Keys bound by the focused view take precedence over vim motions,
+// except ctrl-d/ctrl-u which always page.
+type VimMotions struct {
+	enabled  bool
+	pendingG bool
+	visual   bool
+}
+
+// NewVimMotions returns a new vim motions translator.
+func NewVimMotions(enabled bool) *VimMotions {
+	return &VimMotions{enabled: enabled}
+}
+
+// SetEnabled toggles vim mode.
+func (v *VimMotions) SetEnabled(b bool) {
+	v.enabled, v.pendingG, v.visual = b, false, false
+}
+
+// IsEnabled returns true if vim mode is on.
+func (v *VimMotions) IsEnabled() bool {
+	return v.enabled
+}
+
+// IsVisual returns true if visual mode is on.
+func (v *VimMotions) IsVisual() bool {
+	return v.visual
+}
+
+// Map translates a key event. It returns nil when the event was swallowed and
+// optionally a follow up event to be processed after the translated one.
+func (v *VimMotions) Map(evt *tcell.EventKey, bound BoundFunc) (*tcell.EventKey, *tcell.EventKey) {
+	if !v.enabled {
+		return evt, nil
+	}
+
+	switch evt.Key() {
+	case tcell.KeyCtrlD:
+		return v.move(tcell.KeyPgDn)
+	case tcell.KeyCtrlU:
+		return v.move(tcell.KeyPgUp)
+	case tcell.KeyEscape:
+		v.pendingG = false
+		if v.visual {
+			v.visual = false
+			return nil, nil
+		}
+		return evt, nil
+	case tcell.KeyRune:
+	default:
+		v.pendingG = false
+		return evt, nil
+	}
+
+	r := evt.Rune()
+	if v.pendingG {
+		v.pendingG = false
+		if r == 'g' {
+			return v.move(tcell.KeyHome)
+		}
+	}
+	if bound(tcell.Key(r)) {
+		return evt, nil
+	}
+
+	switch r {
+	case 'j':
+		return v.move(tcell.KeyDown)
+	case 'k':
+		return v.move(tcell.KeyUp)
+	case 'h':
+		return keyEvent(tcell.KeyLeft), nil
+	case 'l':
+		return keyEvent(tcell.KeyRight), nil
+	case 'G':
+		return v.move(tcell.KeyEnd)
+	case 'g':
+		v.pendingG = true
+		return nil, nil
+	case 'x':
+		if bound(tcell.KeyCtrlD) {
+			return tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl), nil
+		}
+	case 'v':
+		if !bound(KeySpace) {
+			return evt, nil
+		}
+		if v.visual = !v.visual; v.visual {
+			return markEvent(), nil
+		}
+		return nil, nil
+	}
+
+	return evt, nil
+}
+
+// Mnemonic returns a key mnemonic as typed in vim mode.
+func (v *VimMotions) Mnemonic(s string) string {
+	if v.enabled && strings.ToLower(s) == "ctrl-d" {
+		return "x"
+	}
+
+	return s
+}
+
+func (v *VimMotions) move(k tcell.Key) (*tcell.EventKey, *tcell.EventKey) {
+	if v.visual {
+		return keyEvent(k), markEvent()
+	}
+
+	return keyEvent(k), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func keyEvent(k tcell.Key) *tcell.EventKey {
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
+
+func markEvent() *tcell.EventKey {
+	return tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone)
+}
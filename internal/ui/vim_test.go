@@ -0,0 +1,125 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVimMotionsMap(t *testing.T) {
+	uu := map[string]struct {
+		enabled bool
+		bound   []tcell.Key
+		rr      []rune
+		key     tcell.Key
+		r       rune
+		follow  bool
+	}{
+		"disabled": {
+			rr:  []rune{'j'},
+			key: tcell.KeyRune,
+			r:   'j',
+		},
+		"down": {
+			enabled: true,
+			rr:      []rune{'j'},
+			key:     tcell.KeyDown,
+		},
+		"up": {
+			enabled: true,
+			rr:      []rune{'k'},
+			key:     tcell.KeyUp,
+		},
+		"top": {
+			enabled: true,
+			rr:      []rune{'g', 'g'},
+			key:     tcell.KeyHome,
+		},
+		"bottom": {
+			enabled: true,
+			rr:      []rune{'G'},
+			key:     tcell.KeyEnd,
+		},
+		"bound": {
+			enabled: true,
+			bound:   []tcell.Key{ui.KeyL},
+			rr:      []rune{'l'},
+			key:     tcell.KeyRune,
+			r:       'l',
+		},
+		"right": {
+			enabled: true,
+			rr:      []rune{'l'},
+			key:     tcell.KeyRight,
+		},
+		"delete": {
+			enabled: true,
+			bound:   []tcell.Key{tcell.KeyCtrlD},
+			rr:      []rune{'x'},
+			key:     tcell.KeyCtrlD,
+		},
+		"no-delete": {
+			enabled: true,
+			rr:      []rune{'x'},
+			key:     tcell.KeyRune,
+			r:       'x',
+		},
+		"visual": {
+			enabled: true,
+			bound:   []tcell.Key{ui.KeySpace},
+			rr:      []rune{'v', 'j'},
+			key:     tcell.KeyDown,
+			follow:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewVimMotions(u.enabled)
+			bound := func(k tcell.Key) bool {
+				for _, b := range u.bound {
+					if b == k {
+						return true
+					}
+				}
+				return false
+			}
+			var evt, follow *tcell.EventKey
+			for _, r := range u.rr {
+				evt, follow = v.Map(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone), bound)
+			}
+			assert.NotNil(t, evt)
+			assert.Equal(t, u.key, evt.Key())
+			if u.key == tcell.KeyRune {
+				assert.Equal(t, u.r, evt.Rune())
+			}
+			assert.Equal(t, u.follow, follow != nil)
+		})
+	}
+}
+
+func TestVimMotionsPaging(t *testing.T) {
+	v := ui.NewVimMotions(true)
+	bound := func(tcell.Key) bool { return true }
+
+	evt, _ := v.Map(tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl), bound)
+	assert.Equal(t, tcell.KeyPgDn, evt.Key())
+	evt, _ = v.Map(tcell.NewEventKey(tcell.KeyCtrlU, 0, tcell.ModCtrl), bound)
+	assert.Equal(t, tcell.KeyPgUp, evt.Key())
+}
+
+func TestVimMotionsVisualEscape(t *testing.T) {
+	v := ui.NewVimMotions(true)
+	bound := func(k tcell.Key) bool { return k == ui.KeySpace }
+
+	evt, _ := v.Map(tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone), bound)
+	assert.Equal(t, ' ', evt.Rune())
+	assert.True(t, v.IsVisual())
+
+	evt, _ = v.Map(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone), bound)
+	assert.Nil(t, evt)
+	assert.False(t, v.IsVisual())
+}
@@ -0,0 +1,615 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell"
+)
+
+const tabWidth = 8
+
+type vtState int
+
+const (
+	vtGround vtState = iota
+	vtEscape
+	vtCSI
+	vtOSC
+	vtOSCEscape
+	vtCharset
+)
+
+type vtCell struct {
+	r     rune
+	style tcell.Style
+}
+
+// VTerm emulates a VT100/xterm screen, enough to run shells and most
+// line based tools fed from a pseudo terminal.
+type VTerm struct {
+	cols, rows     int
+	cells, alt     [][]vtCell
+	cx, cy         int
+	savedX, savedY int
+	wrapNext       bool
+	hideCursor     bool
+	top, bottom    int
+	style          tcell.Style
+	state          vtState
+	params         strings.Builder
+	pending        []byte
+	reply          io.Writer
+	mx             sync.Mutex
+}
+
+// NewVTerm returns a new terminal screen.
+func NewVTerm(cols, rows int) *VTerm {
+	v := VTerm{style: tcell.StyleDefault}
+	v.Resize(cols, rows)
+
+	return &v
+}
+
+// SetReply sets the writer answering terminal queries ie cursor position.
+func (v *VTerm) SetReply(w io.Writer) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	v.reply = w
+}
+
+// Reset clears the screen and restores the terminal defaults.
+func (v *VTerm) Reset() {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.cells, v.alt = newCells(v.cols, v.rows), nil
+	v.cx, v.cy, v.savedX, v.savedY = 0, 0, 0, 0
+	v.top, v.bottom = 0, v.rows-1
+	v.style, v.state = tcell.StyleDefault, vtGround
+	v.wrapNext, v.hideCursor, v.pending = false, false, nil
+}
+
+// Size returns the screen dimensions.
+func (v *VTerm) Size() (int, int) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	return v.cols, v.rows
+}
+
+// Resize resizes the screen, preserving its content.
+func (v *VTerm) Resize(cols, rows int) {
+	if cols < 1 {
+		cols = 1
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.cells = resizeCells(v.cells, cols, rows)
+	if v.alt != nil {
+		v.alt = resizeCells(v.alt, cols, rows)
+	}
+	v.cols, v.rows = cols, rows
+	v.top, v.bottom = 0, rows-1
+	v.cx, v.cy = clamp(v.cx, 0, cols-1), clamp(v.cy, 0, rows-1)
+	v.wrapNext = false
+}
+
+// Cursor returns the cursor position.
+func (v *VTerm) Cursor() (int, int) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	return v.cx, v.cy
+}
+
+// Cell returns the content of a screen cell.
+func (v *VTerm) Cell(x, y int) (rune, tcell.Style) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	if y < 0 || y >= v.rows || x < 0 || x >= v.cols {
+		return ' ', tcell.StyleDefault
+	}
+	c := v.cells[y][x]
+
+	return c.r, c.style
+}
+
+// Line returns a screen line as text.
+func (v *VTerm) Line(y int) string {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	if y < 0 || y >= v.rows {
+		return ""
+	}
+	var b strings.Builder
+	for _, c := range v.cells[y] {
+		b.WriteRune(c.r)
+	}
+
+	return strings.TrimRight(b.String(), " ")
+}
+
+// Draw renders the screen at the given location.
+func (v *VTerm) Draw(s tcell.Screen, x, y int, cursor bool) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	for row := 0; row < v.rows; row++ {
+		for col := 0; col < v.cols; col++ {
+			c := v.cells[row][col]
+			s.SetContent(x+col, y+row, c.r, nil, c.style)
+		}
+	}
+	if cursor && !v.hideCursor {
+		s.ShowCursor(x+v.cx, y+v.cy)
+	}
+}
+
+// Write feeds terminal output to the screen.
+func (v *VTerm) Write(bb []byte) (int, error) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	n := len(bb)
+	if len(v.pending) > 0 {
+		bb = append(v.pending, bb...)
+		v.pending = nil
+	}
+	for len(bb) > 0 {
+		b := bb[0]
+		if v.state != vtGround || b < utf8.RuneSelf {
+			v.feed(b)
+			bb = bb[1:]
+			continue
+		}
+		if !utf8.FullRune(bb) {
+			v.pending = append(v.pending, bb...)
+			break
+		}
+		r, size := utf8.DecodeRune(bb)
+		v.put(r)
+		bb = bb[size:]
+	}
+
+	return n, nil
+}
+
+func (v *VTerm) feed(b byte) {
+	switch v.state {
+	case vtEscape:
+		v.escape(b)
+		return
+	case vtCSI:
+		if b >= 0x40 && b <= 0x7e {
+			v.state = vtGround
+			v.csi(b, v.params.String())
+			return
+		}
+		v.params.WriteByte(b)
+		return
+	case vtOSC:
+		switch b {
+		case 0x07:
+			v.state = vtGround
+		case 0x1b:
+			v.state = vtOSCEscape
+		}
+		return
+	case vtOSCEscape:
+		v.state = vtGround
+		return
+	case vtCharset:
+		v.state = vtGround
+		return
+	}
+
+	switch b {
+	case 0x1b:
+		v.state = vtEscape
+	case '\r':
+		v.cx, v.wrapNext = 0, false
+	case '\n', 0x0b, 0x0c:
+		v.lineFeed()
+	case '\b':
+		if v.cx > 0 {
+			v.cx--
+		}
+		v.wrapNext = false
+	case '\t':
+		v.cx = clamp((v.cx/tabWidth+1)*tabWidth, 0, v.cols-1)
+	case 0x07, 0x0e, 0x0f, 0x00:
+	default:
+		if b >= 0x20 {
+			v.put(rune(b))
+		}
+	}
+}
+
+func (v *VTerm) escape(b byte) {
+	v.state = vtGround
+	switch b {
+	case '[':
+		v.state = vtCSI
+		v.params.Reset()
+	case ']':
+		v.state = vtOSC
+	case '(', ')', '*', '+':
+		v.state = vtCharset
+	case '7':
+		v.savedX, v.savedY = v.cx, v.cy
+	case '8':
+		v.cx, v.cy = v.savedX, v.savedY
+	case 'D':
+		v.lineFeed()
+	case 'E':
+		v.cx = 0
+		v.lineFeed()
+	case 'M':
+		if v.cy == v.top {
+			v.scrollDown(1)
+		} else if v.cy > 0 {
+			v.cy--
+		}
+	case 'c':
+		v.style = tcell.StyleDefault
+		v.top, v.bottom = 0, v.rows-1
+		v.eraseDisplay(2)
+		v.cx, v.cy = 0, 0
+	}
+}
+
+func (v *VTerm) csi(final byte, params string) {
+	private := strings.HasPrefix(params, "?")
+	pp := parseParams(strings.TrimLeft(params, "?>="))
+	arg := func(i, def int) int {
+		if i < len(pp) && pp[i] > 0 {
+			return pp[i]
+		}
+		return def
+	}
+
+	v.wrapNext = false
+	switch final {
+	case 'A':
+		v.cy = clamp(v.cy-arg(0, 1), v.top, v.bottom)
+	case 'B', 'e':
+		v.cy = clamp(v.cy+arg(0, 1), v.top, v.bottom)
+	case 'C', 'a':
+		v.cx = clamp(v.cx+arg(0, 1), 0, v.cols-1)
+	case 'D':
+		v.cx = clamp(v.cx-arg(0, 1), 0, v.cols-1)
+	case 'E':
+		v.cx, v.cy = 0, clamp(v.cy+arg(0, 1), v.top, v.bottom)
+	case 'F':
+		v.cx, v.cy = 0, clamp(v.cy-arg(0, 1), v.top, v.bottom)
+	case 'G', '`':
+		v.cx = clamp(arg(0, 1)-1, 0, v.cols-1)
+	case 'd':
+		v.cy = clamp(arg(0, 1)-1, 0, v.rows-1)
+	case 'H', 'f':
+		v.cy, v.cx = clamp(arg(0, 1)-1, 0, v.rows-1), clamp(arg(1, 1)-1, 0, v.cols-1)
+	case 'J':
+		v.eraseDisplay(arg(0, 0))
+	case 'K':
+		v.eraseLine(arg(0, 0))
+	case 'L':
+		if v.cy >= v.top && v.cy <= v.bottom {
+			v.scrollRegion(v.cy, v.bottom, -arg(0, 1))
+		}
+	case 'M':
+		if v.cy >= v.top && v.cy <= v.bottom {
+			v.scrollRegion(v.cy, v.bottom, arg(0, 1))
+		}
+	case 'P':
+		v.deleteChars(arg(0, 1))
+	case '@':
+		v.insertChars(arg(0, 1))
+	case 'X':
+		line := v.cells[v.cy]
+		for i := v.cx; i < v.cx+arg(0, 1) && i < v.cols; i++ {
+			line[i] = v.blank()
+		}
+	case 'S':
+		v.scrollUp(arg(0, 1))
+	case 'T':
+		v.scrollDown(arg(0, 1))
+	case 'm':
+		v.sgr(pp)
+	case 'r':
+		top, bottom := arg(0, 1)-1, arg(1, v.rows)-1
+		if top < bottom && bottom < v.rows {
+			v.top, v.bottom = top, bottom
+			v.cx, v.cy = 0, 0
+		}
+	case 's':
+		v.savedX, v.savedY = v.cx, v.cy
+	case 'u':
+		v.cx, v.cy = v.savedX, v.savedY
+	case 'h', 'l':
+		if private {
+			v.mode(pp, final == 'h')
+		}
+	case 'n':
+		if arg(0, 0) == 6 {
+			v.answer(fmt.Sprintf("\x1b[%d;%dR", v.cy+1, v.cx+1))
+		}
+	case 'c':
+		if !private {
+			v.answer("\x1b[?1;2c")
+		}
+	}
+}
+
+func (v *VTerm) mode(pp []int, set bool) {
+	for _, p := range pp {
+		switch p {
+		case 25:
+			v.hideCursor = !set
+		case 47, 1047, 1049:
+			if set == (v.alt != nil) {
+				continue
+			}
+			if set {
+				v.savedX, v.savedY = v.cx, v.cy
+				v.alt, v.cells = v.cells, newCells(v.cols, v.rows)
+				continue
+			}
+			v.cells, v.alt = v.alt, nil
+			v.cx, v.cy = v.savedX, v.savedY
+		}
+	}
+}
+
+func (v *VTerm) answer(s string) {
+	if v.reply == nil {
+		return
+	}
+	w := v.reply
+	go func() {
+		_, _ = io.WriteString(w, s)
+	}()
+}
+
+func (v *VTerm) put(r rune) {
+	if v.wrapNext {
+		v.cx, v.wrapNext = 0, false
+		v.lineFeed()
+	}
+	v.cells[v.cy][v.cx] = vtCell{r: r, style: v.style}
+	if v.cx == v.cols-1 {
+		v.wrapNext = true
+		return
+	}
+	v.cx++
+}
+
+func (v *VTerm) lineFeed() {
+	v.wrapNext = false
+	if v.cy == v.bottom {
+		v.scrollUp(1)
+		return
+	}
+	if v.cy < v.rows-1 {
+		v.cy++
+	}
+}
+
+func (v *VTerm) scrollUp(n int) {
+	v.scrollRegion(v.top, v.bottom, n)
+}
+
+func (v *VTerm) scrollDown(n int) {
+	v.scrollRegion(v.top, v.bottom, -n)
+}
+
+// ScrollRegion shifts lines up (n > 0) or down (n < 0) within top and bottom.
+func (v *VTerm) scrollRegion(top, bottom, n int) {
+	height := bottom - top + 1
+	if n > height {
+		n = height
+	}
+	if n < -height {
+		n = -height
+	}
+	switch {
+	case n > 0:
+		copy(v.cells[top:bottom+1], v.cells[top+n:bottom+1])
+		for i := bottom - n + 1; i <= bottom; i++ {
+			v.cells[i] = v.blankLine()
+		}
+	case n < 0:
+		n = -n
+		copy(v.cells[top+n:bottom+1], v.cells[top:bottom+1-n])
+		for i := top; i < top+n; i++ {
+			v.cells[i] = v.blankLine()
+		}
+	}
+}
+
+func (v *VTerm) eraseDisplay(mode int) {
+	switch mode {
+	case 0:
+		v.eraseLine(0)
+		for y := v.cy + 1; y < v.rows; y++ {
+			v.cells[y] = v.blankLine()
+		}
+	case 1:
+		v.eraseLine(1)
+		for y := 0; y < v.cy; y++ {
+			v.cells[y] = v.blankLine()
+		}
+	default:
+		for y := 0; y < v.rows; y++ {
+			v.cells[y] = v.blankLine()
+		}
+	}
+}
+
+func (v *VTerm) eraseLine(mode int) {
+	from, to := v.cx, v.cols
+	switch mode {
+	case 1:
+		from, to = 0, v.cx+1
+	case 2:
+		from = 0
+	}
+	line := v.cells[v.cy]
+	for x := from; x < to && x < v.cols; x++ {
+		line[x] = v.blank()
+	}
+}
+
+func (v *VTerm) deleteChars(n int) {
+	line := v.cells[v.cy]
+	if n > v.cols-v.cx {
+		n = v.cols - v.cx
+	}
+	copy(line[v.cx:], line[v.cx+n:])
+	for x := v.cols - n; x < v.cols; x++ {
+		line[x] = v.blank()
+	}
+}
+
+func (v *VTerm) insertChars(n int) {
+	line := v.cells[v.cy]
+	if n > v.cols-v.cx {
+		n = v.cols - v.cx
+	}
+	copy(line[v.cx+n:], line[v.cx:v.cols-n])
+	for x := v.cx; x < v.cx+n; x++ {
+		line[x] = v.blank()
+	}
+}
+
+func (v *VTerm) sgr(pp []int) {
+	if len(pp) == 0 {
+		pp = []int{0}
+	}
+	for i := 0; i < len(pp); i++ {
+		switch p := pp[i]; {
+		case p == 0:
+			v.style = tcell.StyleDefault
+		case p == 1:
+			v.style = v.style.Bold(true)
+		case p == 2:
+			v.style = v.style.Dim(true)
+		case p == 4:
+			v.style = v.style.Underline(true)
+		case p == 5:
+			v.style = v.style.Blink(true)
+		case p == 7:
+			v.style = v.style.Reverse(true)
+		case p == 22:
+			v.style = v.style.Bold(false).Dim(false)
+		case p == 24:
+			v.style = v.style.Underline(false)
+		case p == 25:
+			v.style = v.style.Blink(false)
+		case p == 27:
+			v.style = v.style.Reverse(false)
+		case p >= 30 && p <= 37:
+			v.style = v.style.Foreground(tcell.Color(p - 30))
+		case p == 39:
+			v.style = v.style.Foreground(tcell.ColorDefault)
+		case p >= 40 && p <= 47:
+			v.style = v.style.Background(tcell.Color(p - 40))
+		case p == 49:
+			v.style = v.style.Background(tcell.ColorDefault)
+		case p >= 90 && p <= 97:
+			v.style = v.style.Foreground(tcell.Color(p - 90 + 8))
+		case p >= 100 && p <= 107:
+			v.style = v.style.Background(tcell.Color(p - 100 + 8))
+		case p == 38 || p == 48:
+			c, skip := extendedColor(pp[i+1:])
+			i += skip
+			if p == 38 {
+				v.style = v.style.Foreground(c)
+			} else {
+				v.style = v.style.Background(c)
+			}
+		}
+	}
+}
+
+func (v *VTerm) blank() vtCell {
+	_, bg, _ := v.style.Decompose()
+	return vtCell{r: ' ', style: tcell.StyleDefault.Background(bg)}
+}
+
+func (v *VTerm) blankLine() []vtCell {
+	line := make([]vtCell, v.cols)
+	for i := range line {
+		line[i] = v.blank()
+	}
+
+	return line
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func extendedColor(pp []int) (tcell.Color, int) {
+	switch {
+	case len(pp) >= 2 && pp[0] == 5:
+		return tcell.Color(pp[1]), 2
+	case len(pp) >= 4 && pp[0] == 2:
+		return tcell.NewRGBColor(int32(pp[1]), int32(pp[2]), int32(pp[3])), 4
+	}
+
+	return tcell.ColorDefault, len(pp)
+}
+
+func parseParams(s string) []int {
+	if s == "" {
+		return nil
+	}
+	ss := strings.Split(s, ";")
+	pp := make([]int, 0, len(ss))
+	for _, p := range ss {
+		n, _ := strconv.Atoi(p)
+		pp = append(pp, n)
+	}
+
+	return pp
+}
+
+func newCells(cols, rows int) [][]vtCell {
+	return resizeCells(nil, cols, rows)
+}
+
+func resizeCells(cc [][]vtCell, cols, rows int) [][]vtCell {
+	if len(cc) > rows {
+		cc = cc[len(cc)-rows:]
+	}
+	out := make([][]vtCell, rows)
+	for y := range out {
+		out[y] = make([]vtCell, cols)
+		for x := range out[y] {
+			out[y][x] = vtCell{r: ' ', style: tcell.StyleDefault}
+		}
+		if y < len(cc) {
+			copy(out[y], cc[y])
+		}
+	}
+
+	return out
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+
+	return v
+}
@@ -0,0 +1,280 @@
+package ui_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVTermWrite(t *testing.T) {
+	uu := map[string]struct {
+		in     string
+		lines  []string
+		cx, cy int
+	}{
+		"plain": {
+			in:    "hello",
+			lines: []string{"hello", "", ""},
+			cx:    5,
+		},
+		"newlines": {
+			in:    "a\r\nb\r\nc",
+			lines: []string{"a", "b", "c"},
+			cx:    1, cy: 2,
+		},
+		"scroll": {
+			in:    "a\r\nb\r\nc\r\nd",
+			lines: []string{"b", "c", "d"},
+			cx:    1, cy: 2,
+		},
+		"wrap": {
+			in:    "0123456789ab",
+			lines: []string{"0123456789", "ab", ""},
+			cx:    2, cy: 1,
+		},
+		"pendingWrap": {
+			in:    "0123456789\r\nx",
+			lines: []string{"0123456789", "x", ""},
+			cx:    1, cy: 1,
+		},
+		"backspace": {
+			in:    "abc\b\bX",
+			lines: []string{"aXc", "", ""},
+			cx:    2,
+		},
+		"tab": {
+			in:    "a\tb",
+			lines: []string{"a       b", "", ""},
+			cx:    9,
+		},
+		"cursorMove": {
+			in:    "\x1b[2;3Hx\x1b[Ay",
+			lines: []string{"   y", "  x", ""},
+			cx:    4,
+		},
+		"eraseLine": {
+			in:    "hello\x1b[3D\x1b[K",
+			lines: []string{"he", "", ""},
+			cx:    2,
+		},
+		"eraseDisplay": {
+			in:    "a\r\nb\x1b[2J",
+			lines: []string{"", "", ""},
+			cx:    1, cy: 1,
+		},
+		"deleteChars": {
+			in:    "abcdef\x1b[1;2H\x1b[2P",
+			lines: []string{"adef", "", ""},
+			cx:    1,
+		},
+		"insertChars": {
+			in:    "abc\x1b[1;2H\x1b[2@",
+			lines: []string{"a  bc", "", ""},
+			cx:    1,
+		},
+		"insertLines": {
+			in:    "a\r\nb\x1b[1;1H\x1b[L",
+			lines: []string{"", "a", "b"},
+		},
+		"deleteLines": {
+			in:    "a\r\nb\r\nc\x1b[1;1H\x1b[M",
+			lines: []string{"b", "c", ""},
+		},
+		"scrollRegion": {
+			in:    "a\r\nb\r\nc\x1b[1;2r\x1b[2;1H\ny",
+			lines: []string{"b", "y", "c"},
+			cx:    1, cy: 1,
+		},
+		"reverseIndex": {
+			in:    "a\x1bMb",
+			lines: []string{" b", "a", ""},
+			cx:    2,
+		},
+		"saveRestore": {
+			in:    "ab\x1b7\x1b[3;1Hz\x1b8c",
+			lines: []string{"abc", "", "z"},
+			cx:    3,
+		},
+		"altScreen": {
+			in:    "main\x1b[?1049hvi\x1b[?1049l",
+			lines: []string{"main", "", ""},
+			cx:    4,
+		},
+		"osc": {
+			in:    "\x1b]0;title\x07ok",
+			lines: []string{"ok", "", ""},
+			cx:    2,
+		},
+		"charset": {
+			in:    "\x1b(Bok",
+			lines: []string{"ok", "", ""},
+			cx:    2,
+		},
+		"utf8": {
+			in:    "h\xc3\xa9",
+			lines: []string{"hé", "", ""},
+			cx:    2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewVTerm(10, 3)
+			n, err := v.Write([]byte(u.in))
+
+			assert.Nil(t, err)
+			assert.Equal(t, len(u.in), n)
+			for i, l := range u.lines {
+				assert.Equal(t, l, v.Line(i))
+			}
+			cx, cy := v.Cursor()
+			assert.Equal(t, u.cx, cx)
+			assert.Equal(t, u.cy, cy)
+		})
+	}
+}
+
+func TestVTermSplitRune(t *testing.T) {
+	v := ui.NewVTerm(10, 1)
+	_, _ = v.Write([]byte("\xe2\x9c"))
+	_, _ = v.Write([]byte("\x93"))
+
+	assert.Equal(t, "✓", v.Line(0))
+}
+
+func TestVTermSGR(t *testing.T) {
+	uu := map[string]struct {
+		in     string
+		fg, bg tcell.Color
+		bold   bool
+	}{
+		"default": {
+			in: "x",
+			fg: tcell.ColorDefault, bg: tcell.ColorDefault,
+		},
+		"basic": {
+			in: "\x1b[1;31;42mx",
+			fg: tcell.ColorMaroon, bg: tcell.ColorGreen,
+			bold: true,
+		},
+		"bright": {
+			in: "\x1b[91;104mx",
+			fg: tcell.ColorRed, bg: tcell.ColorBlue,
+		},
+		"256": {
+			in: "\x1b[38;5;208mx",
+			fg: tcell.Color(208), bg: tcell.ColorDefault,
+		},
+		"rgb": {
+			in: "\x1b[48;2;1;2;3mx",
+			fg: tcell.ColorDefault, bg: tcell.NewRGBColor(1, 2, 3),
+		},
+		"reset": {
+			in: "\x1b[1;31m\x1b[0mx",
+			fg: tcell.ColorDefault, bg: tcell.ColorDefault,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewVTerm(10, 1)
+			_, _ = v.Write([]byte(u.in))
+			r, st := v.Cell(0, 0)
+			fg, bg, attrs := st.Decompose()
+
+			assert.Equal(t, 'x', r)
+			assert.Equal(t, u.fg, fg)
+			assert.Equal(t, u.bg, bg)
+			assert.Equal(t, u.bold, attrs&tcell.AttrBold != 0)
+		})
+	}
+}
+
+func TestVTermResize(t *testing.T) {
+	v := ui.NewVTerm(5, 3)
+	_, _ = v.Write([]byte("a\r\nb\r\nabcde"))
+	v.Resize(3, 2)
+
+	cols, rows := v.Size()
+	assert.Equal(t, 3, cols)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, "b", v.Line(0))
+	assert.Equal(t, "abc", v.Line(1))
+	cx, cy := v.Cursor()
+	assert.Equal(t, 2, cx)
+	assert.Equal(t, 1, cy)
+}
+
+func TestVTermReply(t *testing.T) {
+	var b safeBuffer
+	v := ui.NewVTerm(10, 3)
+	v.SetReply(&b)
+	_, _ = v.Write([]byte("ab\r\nc\x1b[6n"))
+
+	assert.Eventually(t, func() bool { return b.String() == "\x1b[2;2R" }, time.Second, 10*time.Millisecond)
+}
+
+func TestTerminalInput(t *testing.T) {
+	uu := map[string]struct {
+		evt *tcell.EventKey
+		e   string
+	}{
+		"rune":  {evt: tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone), e: "a"},
+		"enter": {evt: tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), e: "\r"},
+		"ctrlC": {evt: tcell.NewEventKey(tcell.KeyCtrlC, 0, tcell.ModCtrl), e: "\x03"},
+		"up":    {evt: tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone), e: "\x1b[A"},
+		"f5":    {evt: tcell.NewEventKey(tcell.KeyF5, 0, tcell.ModNone), e: "\x1b[15~"},
+		"alt":   {evt: tcell.NewEventKey(tcell.KeyRune, 'b', tcell.ModAlt), e: "\x1bb"},
+		"none":  {evt: tcell.NewEventKey(tcell.KeyF40, 0, tcell.ModNone), e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var b safeBuffer
+			term := ui.NewTerminal()
+			term.Reset(&b)
+			term.InputHandler()(u.evt, nil)
+
+			assert.Equal(t, u.e, b.String())
+		})
+	}
+}
+
+func TestTerminalRelease(t *testing.T) {
+	var (
+		b        safeBuffer
+		released bool
+	)
+	term := ui.NewTerminal()
+	term.Reset(&b)
+	term.SetReleaseFn(func() { released = true })
+	term.InputHandler()(tcell.NewEventKey(tcell.KeyCtrlRightSq, 0, tcell.ModCtrl), nil)
+
+	assert.True(t, released)
+	assert.Equal(t, "", b.String())
+}
+
+type safeBuffer struct {
+	b  bytes.Buffer
+	mx sync.Mutex
+}
+
+func (b *safeBuffer) Write(bb []byte) (int, error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	return b.b.Write(bb)
+}
+
+func (b *safeBuffer) String() string {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	return b.b.String()
+}
@@ -1,10 +1,14 @@
 package view
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -73,6 +77,35 @@ func hotKeyActions(r Runner, aa ui.KeyActions) {
 	}
 }
 
+func customColumnActions(b *Browser, aa ui.KeyActions) {
+	for _, c := range b.app.Config.K9s.CustomColumns[b.GVR()] {
+		if c.ShortCut == "" {
+			continue
+		}
+		key, err := asKey(c.ShortCut)
+		if err != nil {
+			log.Warn().Err(err).Msg("COLUMN Unable to map column shortcut to a key")
+			continue
+		}
+		if _, ok := aa[key]; ok {
+			log.Warn().Msgf("COLUMN Doh! you are trying to overide an existing command with column `%s", c.Name)
+			continue
+		}
+		aa[key] = ui.NewKeyAction("Sort "+c.Name, b.SortHeaderCmd(c.Name, true), false)
+	}
+}
+
+func customColumns(cc config.CustomColumns) map[string][]render.CustomColumn {
+	rr := make(map[string][]render.CustomColumn, len(cc))
+	for gvr, cols := range cc {
+		for _, c := range cols {
+			rr[gvr] = append(rr[gvr], render.CustomColumn{Name: c.Name, Label: c.Label, Annotation: c.Annotation})
+		}
+	}
+
+	return rr
+}
+
 func gotoCmd(r Runner, cmd string) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		if err := r.App().gotoResource(cmd, true); err != nil {
@@ -83,32 +116,78 @@ func gotoCmd(r Runner, cmd string) ui.ActionHandler {
 }
 
 func pluginActions(r Runner, aa ui.KeyActions) {
+	// Plugins run host commands so they are off in served sessions.
+	if config.IsServed() {
+		return
+	}
 	pp := config.NewPlugins()
 	if err := pp.Load(); err != nil {
 		return
 	}
 
 	for k, plugin := range pp.Plugin {
-		if !inScope(plugin.Scopes, r.Aliases()) {
-			continue
-		}
-		key, err := asKey(plugin.ShortCut)
-		if err != nil {
-			log.Warn().Err(err).Msg("Unable to map plugin shortcut to a key")
+		key, ok := pluginKey(r, aa, k, plugin)
+		if !ok {
 			continue
 		}
-		_, ok := aa[key]
-		if ok {
-			log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
+		aa[key] = ui.NewKeyAction(
+			plugin.Description,
+			execCmd(r, plugin.Command, plugin.Background, plugin.Args...),
+			true)
+	}
+
+	// Kubectl plugins may mutate anything so they are off in read only mode.
+	if len(pp.Kubectl.Allow) == 0 || r.App().Config.K9s.ReadOnly {
+		return
+	}
+	for k, plugin := range pp.Kubectl.Actions(r.App().kubectlBins) {
+		key, ok := pluginKey(r, aa, k, plugin)
+		if !ok {
 			continue
 		}
 		aa[key] = ui.NewKeyAction(
 			plugin.Description,
-			execCmd(r, plugin.Command, plugin.Background, plugin.Args...),
+			kubectlPluginCmd(r, k, plugin.Command, plugin.Args...),
 			true)
 	}
 }
 
+func pluginKey(r Runner, aa ui.KeyActions, name string, plugin config.Plugin) (tcell.Key, bool) {
+	if !inScope(plugin.Scopes, r.Aliases()) {
+		return 0, false
+	}
+	key, err := asKey(plugin.ShortCut)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to map plugin shortcut to a key")
+		return 0, false
+	}
+	if _, ok := aa[key]; ok {
+		log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", name)).Msg("Invalid shortcut")
+		r.App().Flash().Warnf("Plugin %s skipped. Shortcut %s is already in use", name, plugin.ShortCut)
+		return 0, false
+	}
+
+	return key, true
+}
+
+func pluginArgs(r Runner, ns string, args []string) ([]string, error) {
+	if r.EnvFn() == nil {
+		return nil, errors.New("no plugin env available for this view")
+	}
+	var (
+		env = r.EnvFn()()
+		aa  = make([]string, len(args))
+		err error
+	)
+	for i, a := range args {
+		if aa[i], err = env.envFor(ns, a); err != nil {
+			return nil, err
+		}
+	}
+
+	return aa, nil
+}
+
 func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := r.GetSelectedItem()
@@ -117,17 +196,10 @@ func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
 		}
 
 		ns, _ := client.Namespaced(path)
-		var (
-			env = r.EnvFn()()
-			aa  = make([]string, len(args))
-			err error
-		)
-		for i, a := range args {
-			aa[i], err = env.envFor(ns, a)
-			if err != nil {
-				log.Error().Err(err).Msg("Plugin Args match failed")
-				return nil
-			}
+		aa, err := pluginArgs(r, ns, args)
+		if err != nil {
+			log.Error().Err(err).Msg("Plugin Args match failed")
+			return nil
 		}
 		if run(true, r.App(), bin, bg, aa...) {
 			r.App().Flash().Info("Plugin command launched successfully!")
@@ -138,3 +210,47 @@ func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
 		return nil
 	}
 }
+
+// KubectlPluginCmd runs a kubectl plugin on the selected resource and
+// captures its output.
+func kubectlPluginCmd(r Runner, name, bin string, args ...string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := r.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		ns, _ := client.Namespaced(path)
+		aa, err := pluginArgs(r, ns, args)
+		if err != nil {
+			r.App().Flash().Errf("Plugin %s args failed %s", name, err)
+			return nil
+		}
+
+		app := r.App()
+		ctx, done := app.tasks.Track(context.Background(), dao.TaskExec, path, "kubectl "+name)
+		details := NewDetails(app, "kubectl "+name, path)
+		details.SetCancelFn(done)
+		if err := app.inject(details); err != nil {
+			done()
+			app.Flash().Err(err)
+			return nil
+		}
+
+		go func() {
+			defer done()
+			err := cmdStream(ctx, bin, aa, func(l string) {
+				app.QueueUpdateDraw(func() {
+					details.Append(l)
+				})
+			})
+			if err != nil && ctx.Err() == nil {
+				app.QueueUpdateDraw(func() {
+					details.Append(fmt.Sprintf("\n<command exited: %s>\n", err))
+				})
+			}
+		}()
+
+		return nil
+	}
+}
@@ -2,13 +2,19 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
 )
 
 const aliasTitle = "Aliases"
@@ -32,6 +38,26 @@ func NewAlias(gvr client.GVR) ResourceViewer {
 	return &a
 }
 
+// Init initializes the view and flags invalid or shadowing custom aliases.
+func (a *Alias) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	raw, err := ioutil.ReadFile(config.K9sAlias)
+	if err != nil {
+		return nil
+	}
+	shadowed, err := checkAliases(raw)
+	switch {
+	case err != nil:
+		a.App().Flash().Errf("Invalid aliases in %s: %s", config.K9sAlias, err)
+	case len(shadowed) > 0:
+		a.App().Flash().Warnf("Custom aliases shadow built ins: %s", strings.Join(shadowed, ", "))
+	}
+
+	return nil
+}
+
 func (a *Alias) aliasContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyAliases, a.App().command.alias)
 }
@@ -40,6 +66,7 @@ func (a *Alias) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Add(ui.KeyActions{
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, true),
+		ui.KeyE:        ui.NewKeyAction("Edit", a.editCmd, true),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd(0, true), false),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort Command", a.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftA:   ui.NewKeyAction("Sort ApiGroup", a.GetTable().SortColCmd(2, true), false),
@@ -62,3 +89,64 @@ func (a *Alias) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 	return evt
 }
+
+func (a *Alias) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	raw, err := ioutil.ReadFile(config.K9sAlias)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			a.App().Flash().Err(err)
+			return nil
+		}
+		raw = []byte("alias:\n")
+	}
+
+	var shadowed []string
+	applied, err := editManifest(a.App(), string(raw), func(edited []byte) error {
+		var err error
+		if shadowed, err = checkAliases(edited); err != nil {
+			return err
+		}
+		config.EnsurePath(config.K9sAlias, config.DefaultDirMod)
+		return ioutil.WriteFile(config.K9sAlias, edited, 0644)
+	})
+	switch {
+	case err != nil:
+		a.App().Flash().Errf("Alias edit failed %s", err)
+		return nil
+	case !applied:
+		a.App().Flash().Info("Edit canceled, no changes made")
+		return nil
+	}
+	if err := a.App().command.Reset(true); err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	a.GetTable().Refresh()
+	if len(shadowed) > 0 {
+		a.App().Flash().Warnf("Aliases reloaded. Shadowing built ins: %s", strings.Join(shadowed, ", "))
+		return nil
+	}
+	a.App().Flash().Info("Aliases reloaded")
+
+	return nil
+}
+
+// checkAliases validates an alias file and returns the built in aliases it shadows.
+func checkAliases(raw []byte) ([]string, error) {
+	var aa config.Aliases
+	if err := yaml.Unmarshal(raw, &aa); err != nil {
+		return nil, err
+	}
+	for k, v := range aa.Alias {
+		_, args := config.SplitAlias(v)
+		if _, err := cmdArgs(args); err != nil {
+			return nil, fmt.Errorf("alias %q: %w", k, err)
+		}
+	}
+	builtins, err := dao.BuiltinAliases()
+	if err != nil {
+		return nil, err
+	}
+
+	return dao.CheckAliases(aa.Alias, builtins)
+}
@@ -0,0 +1,46 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckAliases(t *testing.T) {
+	dao.RegisterMeta("fred/v1/blees", metav1.APIResource{Name: "blees", Kind: "Blee", Namespaced: true})
+
+	uu := map[string]struct {
+		raw string
+		err string
+	}{
+		"ok": {
+			raw: "alias:\n  pp: fred/v1/blees prod -l app=web\n",
+		},
+		"badYAML": {
+			raw: "alias: [",
+			err: "yaml: line 1: did not find expected node content",
+		},
+		"badArgs": {
+			raw: "alias:\n  pp: fred/v1/blees -l\n",
+			err: `alias "pp": missing label selector`,
+		},
+		"unknown": {
+			raw: "alias:\n  zz: v1/zorg\n",
+			err: `alias "zz": unknown resource "v1/zorg"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := checkAliases([]byte(u.raw))
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
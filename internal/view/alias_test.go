@@ -8,6 +8,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -23,7 +24,7 @@ func TestAliasNew(t *testing.T) {
 
 	assert.Nil(t, v.Init(makeContext()))
 	assert.Equal(t, "Aliases", v.Name())
-	assert.Equal(t, 5, len(v.Hints()))
+	assert.Equal(t, 6, len(v.Hints()))
 }
 
 func TestAliasSearch(t *testing.T) {
@@ -95,7 +96,9 @@ func (k ks) NamespaceNames(nn []v1.Namespace) []string {
 	return []string{"test"}
 }
 
-type testModel struct{}
+type testModel struct {
+	model.Grouper
+}
 
 var _ ui.Tabular = &testModel{}
 
@@ -110,7 +113,7 @@ func (t *testModel) Watch(context.Context)           {}
 func (t *testModel) Get(context.Context, string) (runtime.Object, error) {
 	return nil, nil
 }
-func (t *testModel) Delete(context.Context, string, bool, bool) error {
+func (t *testModel) Delete(context.Context, string, dao.DeleteOptions) error {
 	return nil
 }
 func (t *testModel) Describe(context.Context, string) (string, error) {
@@ -0,0 +1,78 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const apiProxyTimeout = 30 * time.Second
+
+// apiProxyCmd fetches a port of the selected service or pod through the api
+// server proxy.
+func apiProxyCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		app := v.App()
+		pp, err := dao.ForwardTargetPorts(app.factory, v.GVR(), path)
+		if err != nil {
+			app.Flash().Err(err)
+			return nil
+		}
+		var port string
+		if len(pp) > 0 {
+			port = pp[0]
+		}
+
+		dialog.ShowAPIProxy(app.Content.Pages, path, port, func(port, subPath string) {
+			runAPIProxy(app, v.GVR(), path, port, subPath)
+		})
+
+		return nil
+	}
+}
+
+// runAPIProxy fetches a resource port in the background and shows the
+// response.
+func runAPIProxy(app *App, gvr, path, port, subPath string) {
+	name := fmt.Sprintf("APIProxy %s:%s", path, port)
+	app.runJob(name, apiProxyTimeout, func(ctx context.Context, job *model.Job) error {
+		res, err := dao.APIProxyGet(ctx, app.Conn(), gvr, path, port, subPath)
+		if err != nil {
+			return err
+		}
+		app.QueueUpdateDraw(func() {
+			details := NewDetails(app, "APIProxy", path).Update(res.String())
+			if err := app.inject(details); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+		if res.Status >= 400 {
+			return fmt.Errorf("%s returned %d", res.URL, res.Status)
+		}
+
+		return nil
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func bindAPIProxy(v ResourceViewer, aa ui.KeyActions) {
+	ns := v.GetTable().GetModel().GetNamespace()
+	if userCan(v.App(), ns, client.NewGVR(v.GVR()+":proxy"), client.GetVerb) {
+		aa[ui.KeyW] = ui.NewKeyAction("API Proxy", apiProxyCmd(v), true)
+	} else {
+		aa.Delete(ui.KeyW)
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -26,6 +30,7 @@ const (
 	maxConRetry      = 5
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
+	configSaveDelay  = 2 * time.Second
 )
 
 // App represents an application view.
@@ -40,6 +45,17 @@ type App struct {
 	cancelFn     context.CancelFunc
 	conRetry     int
 	clusterModel *model.ClusterInfo
+	notifier     *model.Notifier
+	pinModel     *model.Pinboard
+	jobs         *model.Jobs
+	tasks        *dao.TaskManager
+	sessions     *dao.SessionManager
+	relays       *dao.ProxyRelays
+	logPrefix    *dao.LogPrefix
+	kubectlBins  map[string]string
+	saveTimer    *time.Timer
+	firstRun     bool
+	notifyLink   atomic.Value
 }
 
 // NewApp returns a K9s app instance.
@@ -47,16 +63,31 @@ func NewApp(cfg *config.Config) *App {
 	a := App{
 		App:     ui.NewApp(cfg.K9s.CurrentContext),
 		Content: NewPageStack(),
+		jobs:    model.NewJobs(),
+		tasks:   dao.NewTaskManager(),
+		relays:  dao.NewProxyRelays(),
 	}
 	a.Config = cfg
-	a.InitBench(cfg.K9s.CurrentCluster)
+	a.sessions = dao.NewSessionManager(a.tasks)
+	a.InitBench(cfg.K9s.CurrentContext, cfg.K9s.CurrentCluster)
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
 	a.Views()["clusterInfo"] = NewClusterInfo(&a)
+	a.Views()["pinBar"] = NewPinBar(&a)
+	a.Views()["jobsPane"] = NewJobsPane(&a)
+	a.Views()["shellPane"] = NewShellPane(&a)
+	a.Views()["tutorial"] = NewTutorial(&a)
+	a.Views()["eventsPane"] = NewEventsPane(&a)
+	a.jobs.AddListener(a.jobsPane())
 
 	return &a
 }
 
+// SetFirstRun flags the app to run the setup wizard on launch.
+func (a *App) SetFirstRun(b bool) {
+	a.firstRun = b
+}
+
 // ConOK checks the connection is cool, returns false otherwise.
 func (a *App) ConOK() bool {
 	return a.conRetry == 0
@@ -73,8 +104,20 @@ func (a *App) Init(version string, rate int) error {
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
 
+	a.kubectlBins = config.DiscoverKubectlPlugins()
 	a.App.Init()
 	a.bindKeys()
+	if err := a.SetKeyProfile(a.Config.K9s.KeyProfile); err != nil {
+		log.Warn().Err(err).Msg("Key profile")
+	}
+	a.SetVimMode(a.Config.K9s.VimMode)
+	render.ExtendedResources = a.Config.K9s.ExtendedResources
+	render.CustomColumns = customColumns(a.Config.K9s.CustomColumns)
+	a.initAge()
+	render.Anonymous = a.Config.K9s.GetAnonymize()
+	if d := a.Config.K9s.RowDecay; d != 0 {
+		render.RowDecay = time.Duration(d) * time.Second
+	}
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
@@ -91,16 +134,30 @@ func (a *App) Init(version string, rate int) error {
 	a.clusterModel.AddListener(a.statusIndicator())
 	a.clusterModel.Refresh()
 
+	a.pinModel = model.NewPinboard(a.factory)
+	a.pinModel.SetPins(a.Config.Pins())
+	a.pinModel.AddListener(a.pinBar())
+
 	a.command = NewCommand(a)
 	if err := a.command.Init(); err != nil {
 		return err
 	}
+	a.initNotifier()
 
 	a.clusterInfo().Init()
 
+	body := tview.NewFlex()
+	body.AddItem(a.Content, 0, 1, true)
+	body.AddItem(a.shellPane(), 0, 0, false)
+	a.shellPane().parent = body
+
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	main.AddItem(body, 0, 10, true)
+	main.AddItem(a.jobsPane(), 0, 0, false)
+	main.AddItem(a.pinBar(), 0, 0, false)
+	main.AddItem(a.eventsPane(), 0, 0, false)
+	main.AddItem(a.tutorial(), 0, 0, false)
 	main.AddItem(a.Crumbs(), 2, 1, false)
 	main.AddItem(a.Flash(), 2, 1, false)
 
@@ -111,12 +168,32 @@ func (a *App) Init(version string, rate int) error {
 	return nil
 }
 
+func (a *App) initAge() {
+	render.AbsoluteAge = a.Config.K9s.AbsoluteAge
+	if l := a.Config.K9s.AgeLayout; l != "" {
+		render.AgeLayout = l
+	}
+	loc, err := a.Config.K9s.AgeLocation()
+	if err != nil {
+		log.Warn().Err(err).Msg("Invalid age timezone")
+		return
+	}
+	render.AgeLocation = loc
+}
+
 func (a *App) bindKeys() {
 	a.AddActions(ui.KeyActions{
-		tcell.KeyCtrlH: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlH:       ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
+		ui.KeyHelp:           ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		tcell.KeyCtrlA:       ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlW:       ui.NewSharedKeyAction("Cancel Job", a.cancelJobCmd, false),
+		tcell.KeyCtrlRightSq: ui.NewSharedKeyAction("Shell Pane", a.shellPaneCmd, false),
+		ui.KeyLeftBracket:    ui.NewSharedKeyAction("Prev Namespace", a.prevNSCmd, false),
+		ui.KeyRightBracket:   ui.NewSharedKeyAction("Next Namespace", a.nextNSCmd, false),
+		tcell.KeyCtrlQ:       ui.NewSharedKeyAction("Actions", a.paletteCmd, false),
+		tcell.KeyCtrlV:       ui.NewSharedKeyAction("Screenshot", a.screenshotCmd, false),
+		ui.KeyBacktick:       ui.NewSharedKeyAction("Events Pane", a.eventsPaneCmd, false),
+		tcell.KeyEnter:       ui.NewKeyAction("Goto", a.gotoCmd, false),
 	})
 }
 
@@ -176,11 +253,43 @@ func (a *App) Resume() {
 	var ctx context.Context
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 	go a.clusterUpdater(ctx)
+	go a.jobs.Watch(ctx, jobsRate, jobsTTL)
+	if a.notifier != nil && !a.notifier.Empty() {
+		rate := time.Duration(a.Config.K9s.GetRefreshRate()) * time.Second
+		go a.notifier.Watch(context.WithValue(ctx, internal.KeyFactory, a.factory), rate)
+	}
 	if err := a.StylesUpdater(ctx, a); err != nil {
 		log.Error().Err(err).Msgf("Styles update failed")
 	}
 }
 
+func (a *App) initNotifier() {
+	nn := config.NewNotifications()
+	if err := nn.Load(); err != nil {
+		log.Debug().Err(err).Msg("No notification rules")
+		return
+	}
+	n, err := model.NewNotifier(nn)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid notification rules")
+		a.Flash().Errf("Invalid notification rules %s", err)
+		return
+	}
+	n.AddListener(a)
+	a.notifier = n
+}
+
+// Notified notifies a notification rule fired.
+func (a *App) Notified(n model.Notification) {
+	if n.Link != "" {
+		a.notifyLink.Store(n.Link)
+	}
+	a.Flash().Warn(n.Message)
+	if n.Beep {
+		a.Beep()
+	}
+}
+
 func (a *App) clusterUpdater(ctx context.Context) {
 	for {
 		select {
@@ -229,6 +338,20 @@ func (a *App) refreshCluster() {
 
 	// Update cluster info
 	a.clusterModel.Refresh()
+	a.refreshPins()
+	a.checkThrottles()
+}
+
+func (a *App) checkThrottles() {
+	rejected, delayed := client.Throttles.Drain()
+	if rejected == 0 && delayed == 0 {
+		return
+	}
+	a.Flash().Warnf("API throttling! %d requests rejected (429), %d delayed by K9s", rejected, delayed)
+}
+
+func (a *App) refreshPins() {
+	a.pinModel.Refresh()
 }
 
 func (a *App) switchNS(ns string) bool {
@@ -263,11 +386,15 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		if err := a.Config.Save(); err != nil {
 			log.Error().Err(err).Msg("Config save failed!")
 		}
+		cluster, _ := a.Conn().Config().CurrentClusterName()
+		a.InitBench(name, cluster)
 		a.Flash().Infof("Switching context to %s", name)
 		if err := a.gotoResource("pods", true); loadPods && err != nil {
 			a.Flash().Err(err)
 		}
 		a.clusterModel.Reset(a.factory)
+		a.pinModel.Reset(a.factory)
+		a.pinModel.SetPins(a.Config.Pins())
 		a.ReloadStyles(name)
 	}
 
@@ -281,7 +408,10 @@ func (a *App) initFactory(ns string) {
 
 // BailOut exists the application.
 func (a *App) BailOut() {
+	a.tasks.CancelAll()
+	a.relays.StopAll(a.Conn())
 	a.factory.Terminate()
+	a.factory.Forwarders().DeleteAll()
 	a.App.BailOut()
 }
 
@@ -293,6 +423,11 @@ func (a *App) Run() error {
 		<-time.After(splashDelay)
 		a.QueueUpdateDraw(func() {
 			a.Main.SwitchToPage("main")
+			if a.firstRun {
+				a.setup()
+				return
+			}
+			a.checkConfig()
 		})
 	}()
 
@@ -302,10 +437,33 @@ func (a *App) Run() error {
 	if err := a.Application.Run(); err != nil {
 		return err
 	}
+	a.flushConfig()
 
 	return nil
 }
 
+// SaveConfigLater persists the configuration once changes settle down.
+func (a *App) saveConfigLater() {
+	if a.saveTimer != nil {
+		a.saveTimer.Stop()
+	}
+	a.saveTimer = time.AfterFunc(configSaveDelay, func() {
+		a.QueueUpdate(a.flushConfig)
+	})
+}
+
+// FlushConfig saves the configuration if a save is pending.
+func (a *App) flushConfig() {
+	if a.saveTimer == nil {
+		return
+	}
+	a.saveTimer.Stop()
+	a.saveTimer = nil
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+}
+
 // Status reports a new app status for display.
 func (a *App) Status(l ui.FlashLevel, msg string) {
 	a.Flash().SetMessage(l, msg)
@@ -386,6 +544,39 @@ func (a *App) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	top := a.Content.Top()
+	if top == nil {
+		return evt
+	}
+	var aa ui.KeyActions
+	if v, ok := top.(Viewer); ok {
+		aa = v.Actions()
+	}
+	items, keys := paletteItems(a.GetActions(), aa)
+	title := "<Actions>"
+	if t, ok := top.(TableViewer); ok {
+		if sel := t.GetTable().GetSelectedItem(); sel != "" {
+			title = fmt.Sprintf("<Actions [%s]>", sel)
+		}
+	}
+	dialog.ShowPalette(a.Content.Pages, title, items, func(it dialog.PaletteItem) {
+		k := keys[it]
+		act, ok := a.GetActions()[k]
+		if !ok {
+			act, ok = aa[k]
+		}
+		if ok {
+			act.Action(paletteEvent(k))
+		}
+	})
+
+	return nil
+}
+
 func (a *App) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if _, ok := a.Content.GetPrimitive("main").(*Help); ok {
 		return evt
@@ -419,10 +610,41 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (a *App) prevNSCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.cycleNS(-1)
+	return nil
+}
+
+func (a *App) nextNSCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.cycleNS(1)
+	return nil
+}
+
+// cycleNS activates the next or previous favorite namespace.
+func (a *App) cycleNS(delta int) {
+	ns := nextNamespace(a.Config.FavNamespaces(), a.Config.ActiveNamespace(), delta)
+	if v, ok := a.Content.Top().(ResourceViewer); ok && v.SwitchNamespace(ns) {
+		return
+	}
+	if !a.switchNS(ns) {
+		return
+	}
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+	a.Flash().Infof("Namespace %s is now active!", ns)
+}
+
 func (a *App) viewResource(gvr, path string, clearStack bool) error {
 	return a.command.run(gvr, path, clearStack)
 }
 
+// lastNotifyLink returns the deep link of the latest notification if any.
+func (a *App) lastNotifyLink() string {
+	l, _ := a.notifyLink.Load().(string)
+	return l
+}
+
 func (a *App) gotoResource(cmd string, clearStack bool) error {
 	return a.command.run(cmd, "", clearStack)
 }
@@ -441,6 +663,26 @@ func (a *App) clusterInfo() *ClusterInfo {
 	return a.Views()["clusterInfo"].(*ClusterInfo)
 }
 
+func (a *App) pinBar() *PinBar {
+	return a.Views()["pinBar"].(*PinBar)
+}
+
+func (a *App) jobsPane() *JobsPane {
+	return a.Views()["jobsPane"].(*JobsPane)
+}
+
+func (a *App) eventsPane() *EventsPane {
+	return a.Views()["eventsPane"].(*EventsPane)
+}
+
+func (a *App) tutorial() *Tutorial {
+	return a.Views()["tutorial"].(*Tutorial)
+}
+
+func (a *App) shellPane() *ShellPane {
+	return a.Views()["shellPane"].(*ShellPane)
+}
+
 func (a *App) statusIndicator() *ui.StatusIndicator {
 	return a.Views()["statusIndicator"].(*ui.StatusIndicator)
 }
@@ -0,0 +1,58 @@
+package view
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Application presents workloads grouped by an application label.
+type Application struct {
+	ResourceViewer
+}
+
+// NewApplication returns a new viewer.
+func NewApplication(gvr client.GVR) ResourceViewer {
+	a := Application{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.appCtx)
+	a.GetTable().SetEnterFn(a.showApp)
+	a.GetTable().SetColorerFn(render.Application{}.ColorerFunc())
+
+	return &a
+}
+
+func (a *Application) appCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAppLabel, a.label())
+}
+
+func (a *Application) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Restarts", a.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Versions", a.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (a *Application) label() string {
+	if l := a.App().Config.K9s.AppLabel; l != "" {
+		return l
+	}
+
+	return dao.DefaultAppLabel
+}
+
+func (a *Application) showApp(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	if err := app.inject(NewFind(app, "^"+regexp.QuoteMeta(a.label()+"="+n)+"$")); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,123 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	auditUserCol = 1
+	auditVerbCol = 2
+)
+
+// Audit presents cluster audit events.
+type Audit struct {
+	ResourceViewer
+
+	filter dao.AuditFilter
+}
+
+// NewAudit returns a new viewer.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.auditCtx)
+	a.GetTable().SetSortCol(0, 0, false)
+	a.GetTable().SetEnterFn(describeResource)
+	a.GetTable().SetColorerFn(render.Audit{}.ColorerFunc())
+
+	return &a
+}
+
+// NewAuditFor returns a viewer listing the audit events of a resource.
+func NewAuditFor(gvr, path string) ResourceViewer {
+	a := NewAudit(client.NewGVR("audit")).(*Audit)
+	ns, n := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = ""
+	}
+	a.filter = dao.AuditFilter{Resource: client.NewGVR(gvr).R(), Namespace: ns, Name: n}
+
+	return a
+}
+
+func (a *Audit) auditCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyAuditCfg, a.App().Config.Audit())
+
+	return context.WithValue(ctx, internal.KeyAuditFilter, a.filter)
+}
+
+func (a *Audit) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Filter User", a.toggleFilterCmd(auditUserCol), true),
+		ui.KeyShiftV: ui.NewKeyAction("Filter Verb", a.toggleFilterCmd(auditVerbCol), true),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Time", a.GetTable().SortColCmd(0, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+// ToggleFilterCmd narrows events to the selected user or verb or clears the
+// filter if already set.
+func (a *Audit) toggleFilterCmd(col int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		field := &a.filter.User
+		if col == auditVerbCol {
+			field = &a.filter.Verb
+		}
+		if *field != "" {
+			*field = ""
+		} else {
+			if a.GetTable().GetSelectedItem() == "" {
+				return evt
+			}
+			*field = a.GetTable().GetSelectedCell(col)
+		}
+		a.Start()
+		a.App().Flash().Info(a.filterInfo())
+
+		return nil
+	}
+}
+
+func (a *Audit) filterInfo() string {
+	f, ff := a.filter, make([]string, 0, 3)
+	if f.Resource != "" {
+		ff = append(ff, "resource="+f.Resource+" "+client.FQN(f.Namespace, f.Name))
+	}
+	if f.User != "" {
+		ff = append(ff, "user="+f.User)
+	}
+	if f.Verb != "" {
+		ff = append(ff, "verb="+f.Verb)
+	}
+	if len(ff) == 0 {
+		return "Audit filters cleared"
+	}
+
+	return "Audit filter " + strings.Join(ff, " ")
+}
+
+func auditCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		if err := v.App().inject(NewAuditFor(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -2,8 +2,8 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -11,12 +11,16 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Browser represents a generic resource browser.
@@ -28,6 +32,8 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	fieldSel   string
+	filter     string
 }
 
 // NewBrowser returns a new browser.
@@ -45,6 +51,7 @@ func (b *Browser) Init(ctx context.Context) error {
 		return err
 	}
 	b.BaseTitle = b.meta.Kind
+	b.GetTable().ShowScope(dao.IsK8sMeta(b.meta))
 
 	if err = b.Table.Init(ctx); err != nil {
 		return err
@@ -60,6 +67,9 @@ func (b *Browser) Init(ctx context.Context) error {
 	if b.bindKeysFn != nil {
 		b.bindKeysFn(b.Actions())
 	}
+	for _, f := range b.extKeysFns {
+		f(b.Actions())
+	}
 	b.accessor, err = dao.AccessorFor(b.app.factory, b.gvr)
 	if err != nil {
 		return err
@@ -72,10 +82,43 @@ func (b *Browser) Init(ctx context.Context) error {
 	}
 	b.GetModel().AddListener(b)
 	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	if b.contextFn == nil {
+		b.restoreState()
+		b.SetSortFn(func(string, bool) { b.saveState() })
+	}
+	if b.filter != "" {
+		b.SearchBuff().Set(b.filter)
+	}
 
 	return nil
 }
 
+// restoreState reapplies the last filter and sort order used in this view.
+func (b *Browser) restoreState() {
+	s := b.app.Config.ViewState(b.GVR())
+	if col, asc := s.Sort(); col != "" {
+		b.SetSortHeader(col, asc)
+	}
+	if s.Filter != "" {
+		b.SearchBuff().Set(s.Filter)
+	}
+}
+
+// saveState persists the current filter and sort order of a top level view.
+func (b *Browser) saveState() {
+	if b.contextFn != nil {
+		return
+	}
+	col, asc := b.SortHeader()
+	s := config.ViewState{
+		Filter:     b.SearchBuff().String(),
+		SortColumn: config.NewSortColumn(col, asc),
+	}
+	if b.app.Config.SetViewState(b.GVR(), s) {
+		b.app.saveConfigLater()
+	}
+}
+
 func (b *Browser) bindKeys() {
 	b.Actions().Add(ui.KeyActions{
 		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
@@ -88,6 +131,16 @@ func (b *Browser) SetInstance(path string) {
 	b.GetModel().SetInstance(path)
 }
 
+// SetFieldSelector sets a server side field selector.
+func (b *Browser) SetFieldSelector(sel string) {
+	b.fieldSel = sel
+}
+
+// SetFilter sets an initial filter or label selector overriding the saved one.
+func (b *Browser) SetFilter(q string) {
+	b.filter = q
+}
+
 // Start initializes browser updates.
 func (b *Browser) Start() {
 	b.Stop()
@@ -142,6 +195,8 @@ func (b *Browser) TableDataChanged(data render.TableData) {
 	if !b.app.ConOK() {
 		return
 	}
+	// Warm up the user access rules off the ui thread.
+	b.userCan(client.GetVerb)
 
 	b.app.QueueUpdateDraw(func() {
 		b.refreshActions()
@@ -168,16 +223,9 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	ctx := b.defaultContext()
-	raw, err := b.GetModel().ToYAML(ctx, path)
-	if err != nil {
-		b.App().Flash().Errf("unable to get resource %q -- %s", b.gvr, err)
-		return nil
-	}
-
-	details := NewDetails(b.app, "YAML", path).Update(raw)
-	if err := b.App().inject(details); err != nil {
-		b.App().Flash().Err(err)
-	}
+	showYAML(b.app, path, func(context.Context) (string, error) {
+		return b.GetModel().ToYAML(ctx, path)
+	})
 
 	return nil
 }
@@ -191,8 +239,9 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	cmd := b.SearchBuff().String()
 	b.App().Flash().Info("Clearing filter...")
 	b.SearchBuff().Reset()
+	b.saveState()
 
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 	} else {
 		b.Refresh()
@@ -207,9 +256,10 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	b.SearchBuff().SetActive(false)
+	b.saveState()
 
 	cmd := b.SearchBuff().String()
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 		return nil
 	}
@@ -263,6 +313,207 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) deleteFilteredCmd(evt *tcell.EventKey) *tcell.EventKey {
+	q := b.SearchBuff().String()
+	if q == "" {
+		b.app.Flash().Warn("Delete filtered requires an active filter or label selector")
+		return nil
+	}
+	var paths []string
+	for _, re := range b.GetTable().GetFilteredData().RowEvents {
+		if re.Kind != render.EventDelete {
+			paths = append(paths, re.Row.ID)
+		}
+	}
+	if len(paths) == 0 {
+		b.app.Flash().Warnf("No %s matching %s", b.gvr.R(), q)
+		return nil
+	}
+
+	var sel string
+	if ui.IsLabelSelector(q) {
+		sel = ui.TrimLabelSelector(q)
+		if _, err := labels.Parse(sel); err != nil {
+			b.app.Flash().Errf("Invalid label selector %q: %s", sel, err)
+			return nil
+		}
+	}
+	cn, ok := b.accessor.(dao.CollectionNuker)
+	if !ok || sel == "" || !client.Can(b.meta.Verbs, client.DeleteCollectionVerb) {
+		cn = nil
+	}
+	if cn != nil {
+		if err := dryRunCollection(cn, paths, sel); err != nil {
+			b.app.Flash().Errf("Delete collection dry run failed with `%s", err)
+			return nil
+		}
+	}
+
+	b.Stop()
+	defer b.Start()
+	dialog.ShowDelete(b.app.Content.Pages, deletePreview(b.gvr.R(), q, paths, cn != nil), func(opts dao.DeleteOptions) {
+		b.ShowDeleted()
+		if cn == nil {
+			b.deletePaths(paths, opts)
+			return
+		}
+		b.deleteCollection(cn, paths, sel, opts)
+	}, func() {})
+
+	return nil
+}
+
+func (b *Browser) forceDeleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := b.app.factory.Get(b.GVR(), path, true, labels.Everything())
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		b.app.Flash().Errf("expecting *unstructured.Unstructured but got %T", o)
+		return nil
+	}
+	if !dao.IsTerminating(u) {
+		b.app.Flash().Warnf("%s is not terminating. Use delete instead", path)
+		return nil
+	}
+	nuker, ok := b.accessor.(dao.ForceNuker)
+	if !ok {
+		b.app.Flash().Errf("Invalid force nuker %T", b.accessor)
+		return nil
+	}
+
+	dialog.ShowForceDelete(b.app.Content.Pages, path, dao.FinalizersOf(u), func(clear bool) {
+		if !clear {
+			b.forceDelete(nuker, path, false)
+			return
+		}
+		msg := fmt.Sprintf("Clearing finalizers on %s skips the cleanup their controllers perform and may leak external resources. Proceed?", path)
+		dialog.ShowConfirm(b.app.Content.Pages, "<Confirm Clear Finalizers>", msg, func() {
+			b.forceDelete(nuker, path, true)
+		}, func() {})
+	})
+
+	return nil
+}
+
+func (b *Browser) metaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := b.app.factory.Get(b.GVR(), path, true, labels.Everything())
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		b.app.Flash().Errf("expecting *unstructured.Unstructured but got %T", o)
+		return nil
+	}
+	finalizers, refs := dao.FinalizersOf(u), u.GetOwnerReferences()
+	if len(finalizers) == 0 && len(refs) == 0 {
+		b.app.Flash().Infof("%s has no finalizers or owner references", path)
+		return nil
+	}
+	pruner, ok := b.accessor.(dao.MetaPruner)
+	if !ok {
+		b.app.Flash().Errf("Invalid meta pruner %T", b.accessor)
+		return nil
+	}
+
+	owners := make([]string, 0, len(refs))
+	for _, r := range refs {
+		owners = append(owners, r.Kind+"/"+r.Name)
+	}
+	dialog.ShowMeta(b.app.Content.Pages, path, finalizers, owners, func(ff, oo []int) {
+		fins := make([]string, 0, len(ff))
+		for _, i := range ff {
+			fins = append(fins, finalizers[i])
+		}
+		uids := make([]types.UID, 0, len(oo))
+		for _, i := range oo {
+			uids = append(uids, refs[i].UID)
+		}
+		if err := pruner.PruneMeta(path, fins, uids); err != nil {
+			b.app.Flash().Errf("Patch failed with `%s", err)
+			return
+		}
+		b.app.Flash().Infof("Removed %d finalizers and %d owner references from %s", len(fins), len(uids), path)
+	})
+
+	return nil
+}
+
+func (b *Browser) copyToCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	copier, ok := b.accessor.(dao.Copier)
+	if !ok {
+		b.app.Flash().Errf("Invalid copier %T", b.accessor)
+		return nil
+	}
+
+	cfg := b.app.Conn().Config()
+	current, err := cfg.CurrentContextName()
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	contexts := []string{current}
+	if cc, err := cfg.ContextNames(); err == nil {
+		sort.Strings(cc)
+		for _, c := range cc {
+			if c != current {
+				contexts = append(contexts, c)
+			}
+		}
+	}
+
+	var ns string
+	if b.meta.Namespaced {
+		ns, _ = client.Namespaced(path)
+	}
+	dialog.ShowCopy(b.app.Content.Pages, path, ns, contexts, dao.CopyConflicts, func(ns, ctx, conflict string) {
+		b.app.runJob("Copy "+path, 0, func(_ context.Context, job *model.Job) error {
+			job.SetStatus("copying to " + ctx)
+			dst, err := copier.Copy(path, dao.CopyOptions{Namespace: ns, Context: ctx, Conflict: conflict})
+			if err != nil {
+				return err
+			}
+			if dst == "" {
+				return fmt.Errorf("resource already exists in %s", ctx)
+			}
+			job.SetStatus("copied to " + dst)
+
+			return nil
+		})
+	})
+
+	return nil
+}
+
+func (b *Browser) forceDelete(nuker dao.ForceNuker, path string, clearFinalizers bool) {
+	b.ShowDeleted()
+	if err := nuker.ForceDelete(path, clearFinalizers); err != nil {
+		b.app.Flash().Errf("Force delete failed with `%s", err)
+		return
+	}
+	b.app.Flash().Infof("%s `%s force deleted", b.GVR(), path)
+	b.GetTable().DeleteMark(path)
+	b.refresh()
+}
+
 func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -273,29 +524,49 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+func (b *Browser) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
 		return evt
 	}
 
-	b.Stop()
-	defer b.Start()
-	{
-		ns, n := client.Namespaced(path)
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, b.meta.SingularName)
-		args = append(args, "-n", ns)
-		args = append(args, "--context", b.app.Config.K9s.CurrentContext)
-		if cfg := b.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
-			args = append(args, "--kubeconfig", *cfg)
+	gvr, fqn, err := dao.ControllerOf(b.app.factory, b.GVR(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if err := b.app.viewResource(client.NewGVR(gvr).R(), fqn, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) relatedCmd(gvr string) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
 		}
-		if !runK(true, b.app, append(args, n)...) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
+
+		if err := b.app.inject(NewRelated(client.NewGVR(gvr), b.GVR(), path)); err != nil {
+			b.app.Flash().Err(err)
 		}
+
+		return nil
+	}
+}
+
+func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
 	}
 
+	b.Stop()
+	defer b.Start()
+	editResource(b.app, b.GVR(), path)
+
 	return evt
 }
 
@@ -305,12 +576,24 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 		log.Error().Err(err).Msgf("Fail to switch namespace")
 		return nil
 	}
-	ns := b.namespaces[i]
+	b.SwitchNamespace(b.namespaces[i])
+
+	return nil
+}
 
+// SwitchNamespace lists the resource in a given namespace. Returns false
+// when the view is not namespaced.
+func (b *Browser) SwitchNamespace(ns string) bool {
+	if !b.meta.Namespaced || b.GetTable().Path != "" {
+		return false
+	}
 	auth, err := b.App().factory.Client().CanI(ns, b.GVR(), client.MonitorAccess)
 	if !auth {
+		if err == nil {
+			err = fmt.Errorf("user is not authorized to list %s in namespace %s", b.GVR(), ns)
+		}
 		b.App().Flash().Err(err)
-		return nil
+		return true
 	}
 
 	b.app.switchNS(ns)
@@ -327,7 +610,7 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 		log.Error().Err(err).Msg("Config save failed!")
 	}
 
-	return nil
+	return true
 }
 
 // ----------------------------------------------------------------------------
@@ -354,7 +637,10 @@ func (b *Browser) defaultContext() context.Context {
 	if ui.IsLabelSelector(b.SearchBuff().String()) {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.SearchBuff().String()))
 	}
-	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	if ui.IsNamespaceSelector(b.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyNamespaces, ui.TrimNamespaceSelector(b.SearchBuff().String()))
+	}
+	ctx = context.WithValue(ctx, internal.KeyFields, b.fieldSel)
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace((b.App().Config.ActiveNamespace())))
 
 	return ctx
@@ -370,29 +656,106 @@ func (b *Browser) refreshActions() {
 	if b.app.ConOK() {
 		b.namespaceActions(aa)
 
-		if client.Can(b.meta.Verbs, "edit") {
+		if client.Can(b.meta.Verbs, "edit") && b.userCan(client.UpdateVerb) && !config.IsServed() {
 			aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
+		} else {
+			b.Actions().Delete(ui.KeyE)
 		}
-		if client.Can(b.meta.Verbs, "delete") {
+		if client.Can(b.meta.Verbs, "delete") && b.userCan(client.DeleteVerb) {
 			aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
+			if dao.IsK8sMeta(b.meta) {
+				aa[tcell.KeyCtrlBackslash] = ui.NewKeyAction("Force Delete", b.forceDeleteCmd, true)
+				aa[ui.KeyShiftE] = ui.NewKeyAction("Delete Filtered", b.deleteFilteredCmd, true)
+			}
+		} else {
+			b.Actions().Delete(tcell.KeyCtrlD, tcell.KeyCtrlBackslash, ui.KeyShiftE)
+		}
+		if dao.IsK8sMeta(b.meta) && b.userCan(client.CreateVerb) {
+			aa[ui.KeyP] = ui.NewKeyAction("Copy To", b.copyToCmd, true)
+		} else {
+			b.Actions().Delete(ui.KeyP)
+		}
+		if client.Can(b.meta.Verbs, "patch") && dao.IsK8sMeta(b.meta) && b.userCan(client.PatchVerb) {
+			aa[ui.KeyF] = ui.NewKeyAction("Finalizers", b.metaCmd, true)
+		} else {
+			b.Actions().Delete(ui.KeyF)
 		}
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyO] = ui.NewKeyAction("Owner", b.ownerCmd, true)
+		aa[ui.KeyShiftO] = ui.NewKeyAction("Owned", b.relatedCmd("children"), true)
+		aa[ui.KeyI] = ui.NewKeyAction("Related", b.relatedCmd("related"), true)
+		aa[ui.KeyT] = ui.NewKeyAction("Timeline", timelineCmd(b), true)
+		if b.app.Config.Audit() != nil {
+			aa[ui.KeyShiftQ] = ui.NewKeyAction("Audit", auditCmd(b), true)
+		}
+		aa[ui.KeyB] = ui.NewKeyAction("Pin", b.pinCmd, true)
+		aa[ui.KeyShiftW] = ui.NewKeyAction("Label Selector", b.labelSelectorCmd, true)
 	}
 
 	pluginActions(b, aa)
 	hotKeyActions(b, aa)
+	customColumnActions(b, aa)
 	b.Actions().Add(aa)
 
 	if b.bindKeysFn != nil {
 		b.bindKeysFn(b.Actions())
 	}
+	for _, f := range b.extKeysFns {
+		f(b.Actions())
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
+// userCan checks if the user may use a verb on the browsed resource in the current namespace.
+func (b *Browser) userCan(verb string) bool {
+	if !dao.IsK8sMeta(b.meta) {
+		return true
+	}
+
+	return userCan(b.app, b.GetModel().GetNamespace(), b.gvr, verb)
+}
+
+func (b *Browser) labelSelectorCmd(evt *tcell.EventKey) *tcell.EventKey {
+	pairs, err := dao.LabelPairs(b.app.factory, b.gvr.String(), b.GetModel().GetNamespace())
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if len(pairs) == 0 {
+		b.app.Flash().Warn("No labels found")
+		return nil
+	}
+
+	var current string
+	if q := b.SearchBuff().String(); ui.IsLabelSelector(q) {
+		current = ui.TrimLabelSelector(q)
+	}
+	dialog.ShowLabelSelector(b.app.Content.Pages, pairs, current, func(sel string) {
+		if sel == "" {
+			b.SearchBuff().Reset()
+		} else {
+			b.SearchBuff().Set("-l " + sel)
+		}
+		b.Start()
+	})
+
+	return nil
+}
+
+func (b *Browser) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	togglePin(b.app, b.gvr.String(), path)
+
+	return nil
+}
+
 func (b *Browser) namespaceActions(aa ui.KeyActions) {
 	if !b.meta.Namespaced || b.GetTable().Path != "" {
 		return
@@ -425,7 +788,7 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
 				return
 			}
-			if err := nuker.Delete(sel, true, true); err != nil {
+			if err := nuker.Delete(sel, dao.ForceDeleteOptions()); err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.GetTable().DeleteMark(sel)
@@ -436,22 +799,100 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Content.Pages, msg, func(cascade, force bool) {
+	dialog.ShowDelete(b.app.Content.Pages, msg, func(opts dao.DeleteOptions) {
 		b.ShowDeleted()
 		if len(selections) > 1 {
 			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.gvr)
 		} else {
 			b.app.Flash().Infof("Delete resource %s %s", b.gvr, selections[0])
 		}
-		for _, sel := range selections {
-			if err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), sel)
-				b.app.factory.DeleteForwarder(sel)
-				b.GetTable().DeleteMark(sel)
+		b.deletePaths(selections, opts)
+	}, func() {})
+}
+
+func (b *Browser) deletePaths(paths []string, opts dao.DeleteOptions) {
+	desc := fmt.Sprintf("delete %d resource(s)", len(paths))
+	ctx, done := b.app.tasks.Track(b.defaultContext(), dao.TaskMutation, b.gvr.R(), desc)
+	go func() {
+		defer done()
+		for _, path := range paths {
+			if ctx.Err() != nil {
+				b.app.QueueUpdateDraw(func() {
+					b.app.Flash().Warnf("Delete %s canceled", b.gvr)
+				})
+				break
 			}
+			path := path
+			err := b.GetModel().Delete(ctx, path, opts)
+			b.app.QueueUpdateDraw(func() {
+				if err != nil {
+					b.app.Flash().Errf("Delete failed with `%s", err)
+					return
+				}
+				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), path)
+				b.app.factory.DeleteForwarder(path)
+				b.GetTable().DeleteMark(path)
+			})
 		}
-		b.refresh()
-	}, func() {})
+		b.app.QueueUpdateDraw(b.GetTable().Refresh)
+	}()
+}
+
+func (b *Browser) deleteCollection(cn dao.CollectionNuker, paths []string, sel string, opts dao.DeleteOptions) {
+	desc := fmt.Sprintf("delete %s matching %s", b.gvr.R(), sel)
+	ctx, done := b.app.tasks.Track(b.defaultContext(), dao.TaskMutation, b.gvr.R(), desc)
+	go func() {
+		defer done()
+		for _, ns := range dao.NamespacesOf(paths) {
+			if ctx.Err() != nil {
+				b.app.QueueUpdateDraw(func() {
+					b.app.Flash().Warnf("Delete %s canceled", b.gvr)
+				})
+				break
+			}
+			ns := ns
+			err := cn.DeleteCollection(ns, sel, opts)
+			b.app.QueueUpdateDraw(func() {
+				if err != nil {
+					b.app.Flash().Errf("Delete collection failed with `%s", err)
+					return
+				}
+				b.app.Flash().Infof("%s matching `%s deleted in %s", b.GVR(), sel, ns)
+			})
+		}
+		b.app.QueueUpdateDraw(b.GetTable().Refresh)
+	}()
+}
+
+// DryRunCollection validates a collection delete server side in all affected namespaces.
+func dryRunCollection(cn dao.CollectionNuker, paths []string, sel string) error {
+	opts := dao.NewDeleteOptions()
+	opts.DryRun = true
+	for _, ns := range dao.NamespacesOf(paths) {
+		if err := cn.DeleteCollection(ns, sel, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deletePreview lists the resources a filtered delete will remove.
+func deletePreview(kind, filter string, paths []string, collection bool) string {
+	const maxPreview = 5
+
+	mode := "one by one"
+	if collection {
+		mode = "as a collection"
+	}
+	msg := fmt.Sprintf("Delete %d %s matching %s %s?\n", len(paths), kind, filter, mode)
+	for i, p := range paths {
+		if i == maxPreview {
+			msg += fmt.Sprintf("\n...and %d more", len(paths)-maxPreview)
+			break
+		}
+		msg += "\n" + p
+	}
+
+	return msg
 }
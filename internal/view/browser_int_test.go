@@ -0,0 +1,70 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePreview(t *testing.T) {
+	uu := map[string]struct {
+		paths      []string
+		collection bool
+		e          string
+	}{
+		"one": {
+			paths: []string{"ns1/fred"},
+			e:     "Delete 1 pods matching -l app=fred one by one?\n\nns1/fred",
+		},
+		"collection": {
+			paths:      []string{"ns1/fred", "ns2/blee"},
+			collection: true,
+			e:          "Delete 2 pods matching -l app=fred as a collection?\n\nns1/fred\nns2/blee",
+		},
+		"truncated": {
+			paths: []string{"a/1", "a/2", "a/3", "a/4", "a/5", "a/6", "a/7"},
+			e:     "Delete 7 pods matching -l app=fred one by one?\n\na/1\na/2\na/3\na/4\na/5\n...and 2 more",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deletePreview("pods", "-l app=fred", u.paths, u.collection))
+		})
+	}
+}
+
+func TestDryRunCollection(t *testing.T) {
+	cn := collectionNuker{fail: "ns2"}
+
+	assert.Nil(t, cn.run([]string{"ns1/fred", "ns1/blee"}))
+	assert.Equal(t, []string{"ns1"}, cn.nss)
+	assert.Error(t, cn.run([]string{"ns1/fred", "ns2/blee"}))
+}
+
+// Helpers...
+
+type collectionNuker struct {
+	fail string
+	nss  []string
+}
+
+func (c *collectionNuker) run(paths []string) error {
+	c.nss = nil
+	return dryRunCollection(c, paths, "app=fred")
+}
+
+func (c *collectionNuker) DeleteCollection(ns, sel string, opts dao.DeleteOptions) error {
+	if !opts.DryRun {
+		return errors.New("expecting a dry run")
+	}
+	c.nss = append(c.nss, ns)
+	if ns == c.fail {
+		return errors.New("boom")
+	}
+
+	return nil
+}
@@ -48,6 +48,25 @@ func (c *ClusterInfo) layout() {
 	}
 }
 
+// contextInfo returns a context name along with the active config profile if any.
+func contextInfo(ctx string) string {
+	ctx = clusterName(ctx)
+	if config.K9sProfile == "" {
+		return ctx
+	}
+
+	return ctx + " (" + config.K9sProfile + ")"
+}
+
+// clusterName masks a context, cluster or user name in anonymous mode.
+func clusterName(n string) string {
+	if !render.Anonymous {
+		return n
+	}
+
+	return render.AnonymizeName(n)
+}
+
 func (c *ClusterInfo) sectionCell(t string) *tview.TableCell {
 	cell := tview.NewTableCell(t + ":")
 	cell.SetAlign(tview.AlignLeft)
@@ -71,11 +90,11 @@ func (c *ClusterInfo) infoCell(t string) *tview.TableCell {
 func (c *ClusterInfo) ClusterInfoUpdated(data model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
 		var row int
-		c.GetCell(row, 1).SetText(data.Context)
+		c.GetCell(row, 1).SetText(contextInfo(data.Context))
 		row++
-		c.GetCell(row, 1).SetText(data.Cluster)
+		c.GetCell(row, 1).SetText(clusterName(data.Cluster))
 		row++
-		c.GetCell(row, 1).SetText(data.User)
+		c.GetCell(row, 1).SetText(clusterName(data.User))
 		row++
 		c.GetCell(row, 1).SetText(data.K9sVer)
 		row++
@@ -93,11 +112,11 @@ func (c *ClusterInfo) ClusterInfoUpdated(data model.ClusterMeta) {
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
 		var row int
-		c.GetCell(row, 1).SetText(curr.Context)
+		c.GetCell(row, 1).SetText(contextInfo(curr.Context))
 		row++
-		c.GetCell(row, 1).SetText(curr.Cluster)
+		c.GetCell(row, 1).SetText(clusterName(curr.Cluster))
 		row++
-		c.GetCell(row, 1).SetText(curr.User)
+		c.GetCell(row, 1).SetText(clusterName(curr.User))
 		row++
 		c.GetCell(row, 1).SetText(curr.K9sVer)
 		row++
@@ -0,0 +1,17 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextInfo(t *testing.T) {
+	defer func(p string) { config.K9sProfile = p }(config.K9sProfile)
+
+	config.K9sProfile = ""
+	assert.Equal(t, "fred", contextInfo("fred"))
+	config.K9sProfile = "work"
+	assert.Equal(t, "fred (work)", contextInfo("fred"))
+}
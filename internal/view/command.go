@@ -4,13 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
@@ -106,8 +111,189 @@ func (c *Command) xrayCmd(cmd string) error {
 	return c.exec(cmd, "xrays", x, true)
 }
 
+func (c *Command) findCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return errors.New("You must specify a search pattern")
+	}
+
+	return c.app.inject(NewFind(c.app, strings.Join(tokens[1:], " ")))
+}
+
+func (c *Command) keysCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	profile := config.PrintableKeyProfile
+	if c.app.KeyProfile().IsPrintable() {
+		profile = config.DefaultKeyProfile
+	}
+	if len(tokens) > 1 {
+		profile = tokens[1]
+	}
+	if err := c.app.SetKeyProfile(profile); err != nil {
+		return err
+	}
+	if top := c.app.Content.Top(); top != nil {
+		c.app.Menu().HydrateMenu(top.Hints())
+	}
+	c.app.Flash().Infof("Key profile set to %s", profile)
+
+	return nil
+}
+
+func (c *Command) vimCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	on := !c.app.VimMotions().IsEnabled()
+	if len(tokens) > 1 {
+		switch tokens[1] {
+		case "on":
+			on = true
+		case "off":
+			on = false
+		default:
+			return fmt.Errorf("invalid vim mode %q (on|off)", tokens[1])
+		}
+	}
+	c.app.SetVimMode(on)
+	if top := c.app.Content.Top(); top != nil {
+		c.app.Menu().HydrateMenu(top.Hints())
+	}
+	state := "off"
+	if on {
+		state = "on"
+	}
+	c.app.Flash().Infof("Vim mode %s", state)
+
+	return nil
+}
+
+func (c *Command) anonymizeCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	on := !render.Anonymous
+	if len(tokens) > 1 {
+		switch tokens[1] {
+		case "on":
+			on = true
+		case "off":
+			on = false
+		default:
+			return fmt.Errorf("invalid anonymize mode %q (on|off)", tokens[1])
+		}
+	}
+	render.Anonymous = on
+	c.app.Config.K9s.OverrideAnonymize(on)
+	if v, ok := c.app.Content.Top().(Viewer); ok {
+		v.Refresh()
+	}
+	if on {
+		c.app.Flash().Info("Anonymous mode on. Names, namespaces, addresses and registries are masked")
+		return nil
+	}
+	c.app.Flash().Info("Anonymous mode off")
+
+	return nil
+}
+
+func (c *Command) tutorialCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	t := c.app.tutorial()
+	if len(tokens) == 1 {
+		t.Start()
+		return nil
+	}
+	if !t.IsActive() && tokens[1] != "off" {
+		return fmt.Errorf("no tutorial running. Start one with :tutorial")
+	}
+	switch tokens[1] {
+	case "next":
+		t.Next()
+	case "prev":
+		t.Prev()
+	case "off":
+		t.Stop()
+	default:
+		return fmt.Errorf("invalid tutorial command %q (next|prev|off)", tokens[1])
+	}
+
+	return nil
+}
+
+// deepLinkCmd jumps to the view or the object a deep link points to. Link
+// arguments are passed as is so filters and selectors may hold spaces.
+func (c *Command) deepLinkCmd(link string, clearStack bool) error {
+	l, err := model.ParseDeepLink(link)
+	if err != nil {
+		return err
+	}
+	gvr, v, err := c.viewMetaFor(l.Resource)
+	if err != nil {
+		return err
+	}
+	args := commandArgs{ns: l.Namespace, labelSel: l.Labels, rx: l.Filter}
+	if err := args.validate(); err != nil {
+		return err
+	}
+
+	return c.navigate(l.String(), l.Resource, gvr, v, args, l.Path(), clearStack)
+}
+
+// gotoLinkCmd jumps to a deep link or to the latest notification.
+func (c *Command) gotoLinkCmd(cmd string) error {
+	link := c.app.lastNotifyLink()
+	if tokens := strings.Fields(cmd); len(tokens) > 1 {
+		link = tokens[1]
+	}
+	if link == "" {
+		return errors.New("no notification to go to")
+	}
+
+	return c.deepLinkCmd(link, true)
+}
+
+func (c *Command) freezeCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	n := 1
+	if len(tokens) > 1 {
+		switch tokens[1] {
+		case "off":
+			n = 0
+		default:
+			var err error
+			if n, err = strconv.Atoi(tokens[1]); err != nil || n < 0 {
+				return fmt.Errorf("invalid frozen columns count %q (n|off)", tokens[1])
+			}
+		}
+	}
+	c.app.Config.K9s.FrozenColumns = n
+	if v, ok := c.app.Content.Top().(TableViewer); ok {
+		v.GetTable().SetFrozenColumns(n)
+	}
+	if n == 0 {
+		c.app.Flash().Info("Columns unfrozen")
+		return nil
+	}
+	c.app.Flash().Infof("Froze %d leading column(s)", n)
+
+	return nil
+}
+
+func (c *Command) kustomizeCmd(cmd string) error {
+	if config.IsServed() {
+		return config.ErrServed
+	}
+	tokens := strings.Fields(cmd)
+	dir := "."
+	if len(tokens) > 1 {
+		dir = tokens[1]
+	}
+
+	return c.app.inject(NewKustomize(c.app, dir))
+}
+
 // Exec the Command by showing associated display.
 func (c *Command) run(cmd, path string, clearStack bool) error {
+	if model.IsDeepLink(cmd) {
+		return c.deepLinkCmd(cmd, clearStack)
+	}
 	if c.specialCmd(cmd) {
 		return nil
 	}
@@ -125,19 +311,144 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 		view := c.componentFor(gvr, path, v)
 		return c.exec(cmd, gvr, view, clearStack)
 	default:
-		// checks if Command includes a namespace
-		ns := c.app.Config.ActiveNamespace()
-		if len(cmds) == 2 {
-			ns = cmds[1]
-		}
-		if !c.app.switchNS(ns) {
-			return fmt.Errorf("namespace switch failed for ns %q", ns)
+		args, err := cmdArgs(cmds[1:])
+		if err != nil {
+			return err
 		}
-		if !c.alias.Check(cmds[0]) {
-			return fmt.Errorf("Huh? `%s` Command not found", cmd)
+		return c.navigate(cmd, cmds[0], gvr, v, args, path, clearStack)
+	}
+}
+
+// navigate shows a resource view given its namespace, selectors and filter.
+func (c *Command) navigate(cmd, res, gvr string, v *MetaViewer, args commandArgs, path string, clearStack bool) error {
+	// checks if Command alias include a namespace, selectors or a filter
+	defs, err := cmdArgs(c.alias.Args(res))
+	if err != nil {
+		return fmt.Errorf("invalid alias `%s`: %w", res, err)
+	}
+	args = args.merge(defs)
+	ns := args.ns
+	if ns == "" {
+		ns = c.app.Config.ActiveNamespace()
+	}
+	if !c.app.switchNS(ns) {
+		return fmt.Errorf("namespace switch failed for ns %q", ns)
+	}
+	if !c.alias.Check(res) {
+		return fmt.Errorf("Huh? `%s` Command not found", cmd)
+	}
+	view := c.componentFor(gvr, path, v)
+	view.SetFieldSelector(args.fieldSel)
+	view.SetFilter(args.filter())
+
+	return c.exec(cmd, gvr, view, clearStack)
+}
+
+// commandArgs tracks a command namespace, selectors and filter.
+type commandArgs struct {
+	ns, fieldSel, labelSel, rx string
+}
+
+// filter returns the view filter, ie a label selector or a regex.
+func (a commandArgs) filter() string {
+	if a.labelSel != "" {
+		return "-l " + a.labelSel
+	}
+
+	return a.rx
+}
+
+// validate checks the label selector and the filter.
+func (a commandArgs) validate() error {
+	if a.labelSel != "" && a.rx != "" {
+		return errors.New("use either a label selector or a filter")
+	}
+	if a.labelSel == "" {
+		return nil
+	}
+	if _, err := labels.Parse(a.labelSel); err != nil {
+		return fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	return nil
+}
+
+// merge fills in unset arguments from the given defaults.
+// Filters and label selectors override each other.
+func (a commandArgs) merge(defs commandArgs) commandArgs {
+	if a.ns == "" {
+		a.ns = defs.ns
+	}
+	if a.fieldSel == "" {
+		a.fieldSel = defs.fieldSel
+	}
+	if a.labelSel == "" && a.rx == "" {
+		a.labelSel, a.rx = defs.labelSel, defs.rx
+	}
+
+	return a
+}
+
+// cmdArgs extracts an optional namespace, field selector, label selector and filter
+// from the command arguments. Arguments of the form field=value or field!=value are
+// collected into a server side field selector, `-l sel` sets a label selector
+// and `/rx` a filter. Namespaces may also be given kubectl style via `-n ns` or
+// `-A`.
+func cmdArgs(args []string) (commandArgs, error) {
+	var (
+		ca commandArgs
+		ff []string
+	)
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "":
+			continue
+		case a == "-l":
+			if i+1 == len(args) {
+				return ca, errors.New("missing label selector")
+			}
+			i++
+			ca.labelSel = args[i]
+		case strings.HasPrefix(a, "-l"):
+			ca.labelSel = a[2:]
+		case a == "-A", a == "--all-namespaces":
+			if ca.ns != "" {
+				return ca, fmt.Errorf("Huh? unexpected argument `%s`", a)
+			}
+			ca.ns = client.NamespaceAll
+		case a == "-n", a == "--namespace":
+			if i+1 == len(args) {
+				return ca, errors.New("missing namespace")
+			}
+			if ca.ns != "" {
+				return ca, fmt.Errorf("Huh? unexpected argument `%s`", a)
+			}
+			i++
+			ca.ns = args[i]
+		case strings.HasPrefix(a, "/"):
+			ca.rx = a[1:]
+		case strings.Contains(a, "="):
+			ff = append(ff, a)
+		case ca.ns != "":
+			return ca, fmt.Errorf("Huh? unexpected argument `%s`", a)
+		default:
+			ca.ns = a
 		}
-		return c.exec(cmd, gvr, c.componentFor(gvr, path, v), clearStack)
 	}
+	if err := ca.validate(); err != nil {
+		return ca, err
+	}
+	if len(ff) == 0 {
+		return ca, nil
+	}
+	sel, err := fields.ParseSelector(strings.Join(ff, ","))
+	if err != nil {
+		return ca, fmt.Errorf("invalid field selector: %w", err)
+	}
+	ca.fieldSel = sel.String()
+
+	return ca, nil
 }
 
 func (c *Command) defaultCmd() error {
@@ -166,6 +477,58 @@ func (c *Command) specialCmd(cmd string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "find":
+		if err := c.findCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "keys":
+		if err := c.keysCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "vim":
+		if err := c.vimCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "freeze":
+		if err := c.freezeCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "screenshot":
+		format := screenshotHTML
+		if tokens := strings.Fields(cmd); len(tokens) > 1 {
+			format = tokens[1]
+		}
+		c.app.saveScreenshot(format)
+		return true
+	case "anonymize":
+		if err := c.anonymizeCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "tutorial":
+		if err := c.tutorialCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "overview":
+		if err := c.exec(cmd, "overview", NewOverview(c.app), true); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "goto":
+		if err := c.gotoLinkCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "kz", "kustomize":
+		if err := c.kustomizeCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
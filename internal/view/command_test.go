@@ -0,0 +1,152 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdArgs(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		e    commandArgs
+		err  bool
+	}{
+		"none": {},
+		"ns": {
+			args: []string{"fred"},
+			e:    commandArgs{ns: "fred"},
+		},
+		"fields": {
+			args: []string{"spec.nodeName=node-1"},
+			e:    commandArgs{fieldSel: "spec.nodeName=node-1"},
+		},
+		"ns-fields": {
+			args: []string{"involvedObject.name=foo", "fred", "type!=Normal"},
+			e:    commandArgs{ns: "fred", fieldSel: "involvedObject.name=foo,type!=Normal"},
+		},
+		"empty": {
+			args: []string{"", "fred", ""},
+			e:    commandArgs{ns: "fred"},
+		},
+		"labels": {
+			args: []string{"fred", "-l", "app=web,tier!=db"},
+			e:    commandArgs{ns: "fred", labelSel: "app=web,tier!=db"},
+		},
+		"labels-inline": {
+			args: []string{"-lapp=web"},
+			e:    commandArgs{labelSel: "app=web"},
+		},
+		"filter": {
+			args: []string{"fred", "/web"},
+			e:    commandArgs{ns: "fred", rx: "web"},
+		},
+		"ns-flag": {
+			args: []string{"-n", "prod", "/api"},
+			e:    commandArgs{ns: "prod", rx: "api"},
+		},
+		"ns-long-flag": {
+			args: []string{"--namespace", "prod"},
+			e:    commandArgs{ns: "prod"},
+		},
+		"all-ns": {
+			args: []string{"-A", "-l", "app=web"},
+			e:    commandArgs{ns: "all", labelSel: "app=web"},
+		},
+		"missing-ns": {
+			args: []string{"-n"},
+			err:  true,
+		},
+		"ns-twice": {
+			args: []string{"fred", "-n", "prod"},
+			err:  true,
+		},
+		"too-many": {
+			args: []string{"fred", "blee"},
+			err:  true,
+		},
+		"bad-selector": {
+			args: []string{`spec.nodeName=\q`},
+			err:  true,
+		},
+		"missing-labels": {
+			args: []string{"-l"},
+			err:  true,
+		},
+		"bad-labels": {
+			args: []string{"-l", "app=(web"},
+			err:  true,
+		},
+		"labels-and-filter": {
+			args: []string{"-l", "app=web", "/web"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, err := cmdArgs(u.args)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, a)
+		})
+	}
+}
+
+func TestCmdArgsMerge(t *testing.T) {
+	defs := commandArgs{ns: "prod", labelSel: "app=web"}
+
+	uu := map[string]struct {
+		args   commandArgs
+		e      commandArgs
+		filter string
+	}{
+		"defaults": {
+			e:      defs,
+			filter: "-l app=web",
+		},
+		"ns": {
+			args:   commandArgs{ns: "staging"},
+			e:      commandArgs{ns: "staging", labelSel: "app=web"},
+			filter: "-l app=web",
+		},
+		"filter": {
+			args:   commandArgs{rx: "fred"},
+			e:      commandArgs{ns: "prod", rx: "fred"},
+			filter: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := u.args.merge(defs)
+			assert.Equal(t, u.e, a)
+			assert.Equal(t, u.filter, a.filter())
+		})
+	}
+}
+
+func TestCmdArgsValidate(t *testing.T) {
+	uu := map[string]struct {
+		args commandArgs
+		err  bool
+	}{
+		"none":      {},
+		"setLabels": {args: commandArgs{labelSel: "app in (a, b)"}},
+		"spaces":    {args: commandArgs{rx: "out of memory"}},
+		"both":      {args: commandArgs{labelSel: "app=web", rx: "fred"}, err: true},
+		"badLabels": {args: commandArgs{labelSel: "app in (a"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, u.args.validate() != nil)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// ConfigCheck represents a K9s configuration files diagnostics view.
+type ConfigCheck struct {
+	ResourceViewer
+}
+
+// NewConfigCheck returns a new viewer.
+func NewConfigCheck(gvr client.GVR) ResourceViewer {
+	c := ConfigCheck{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(c.editFile)
+	c.GetTable().SetColorerFn(render.ConfigCheck{}.ColorerFunc())
+	c.GetTable().SetSortCol(0, 0, true)
+
+	return &c
+}
+
+func (c *ConfigCheck) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftF: ui.NewKeyAction("Sort File", c.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Line", c.GetTable().SortColCmd(1, true), false),
+	})
+}
+
+func (c *ConfigCheck) editFile(app *App, _ ui.Tabular, _, id string) {
+	path := configCheckFile(id)
+	if !edit(true, app, path) {
+		app.Flash().Errf("Unable to edit %s", path)
+		return
+	}
+	c.Start()
+}
+
+// configCheckFile extracts a config file path from a file:line:index row id.
+func configCheckFile(id string) string {
+	for i := 0; i < 2; i++ {
+		if idx := strings.LastIndex(id, ":"); idx >= 0 {
+			id = id[:idx]
+		}
+	}
+
+	return id
+}
+
+// checkConfig reports configuration files problems on startup.
+func (a *App) checkConfig() {
+	dd := config.Diagnose(dao.KnownGVR)
+	if len(dd) == 0 {
+		return
+	}
+	for _, d := range dd {
+		log.Warn().Msgf("Config %s", d)
+	}
+	a.Flash().Warnf("Found %d config problem(s). Check :config", len(dd))
+}
@@ -0,0 +1,23 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigCheckFile(t *testing.T) {
+	uu := map[string]struct {
+		id, e string
+	}{
+		"plain":   {id: "/k9s/plugin.yml:3:0", e: "/k9s/plugin.yml"},
+		"windows": {id: `C:\k9s\plugin.yml:0:2`, e: `C:\k9s\plugin.yml`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, configCheckFile(u.id))
+		})
+	}
+}
@@ -1,18 +1,19 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
-	"k8s.io/client-go/tools/portforward"
 )
 
 const (
@@ -42,9 +43,31 @@ func (c *Container) Name() string { return containerTitle }
 
 func (c *Container) bindKeys(aa ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	ns, _ := client.Namespaced(c.GetTable().Path)
+	canFwd := userCan(c.App(), ns, client.NewGVR("v1/pods:portforward"), client.CreateVerb)
+	canExec := userCan(c.App(), ns, client.NewGVR("v1/pods:exec"), client.CreateVerb)
+	if canFwd {
+		aa[ui.KeyShiftF] = ui.NewKeyAction("PortForward", c.portFwdCmd, true)
+	} else {
+		aa.Delete(ui.KeyShiftF)
+	}
+	// Probes exec commands and port-forward to the container.
+	if canFwd && canExec {
+		aa[ui.KeyR] = ui.NewKeyAction("Run Probes", c.probesCmd, true)
+	} else {
+		aa.Delete(ui.KeyR)
+	}
+	if canExec {
+		aa.Add(ui.KeyActions{
+			ui.KeyS: ui.NewKeyAction("Shell", c.shellCmd, true),
+			ui.KeyX: ui.NewKeyAction("Exec", c.execCmd, true),
+			ui.KeyT: ui.NewKeyAction("Signal", c.signalCmd, true),
+		})
+	} else {
+		aa.Delete(ui.KeyS, ui.KeyX, ui.KeyT)
+	}
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftF:   ui.NewKeyAction("PortForward", c.portFwdCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", c.shellCmd, true),
+		ui.KeyN:        ui.NewKeyAction("Env", c.envCmd, true),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(6, false), false),
 		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(7, false), false),
 		ui.KeyShiftX:   ui.NewKeyAction("Sort %CPU (REQ)", c.GetTable().SortColCmd(8, false), false),
@@ -92,6 +115,119 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) envCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewContainerEnv(c.App(), c.GetTable().Path, co)); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Container) probesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	if !c.isRunning(co) {
+		return nil
+	}
+	runProbes(c.App(), c.GetTable().Path, co)
+
+	return nil
+}
+
+func (c *Container) execCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	if !c.isRunning(co) {
+		return nil
+	}
+
+	dialog.ShowExec(c.App().Content.Pages, co, func(cmd string) {
+		args, err := config.ShellWords(cmd, true)
+		if err != nil {
+			c.App().Flash().Errf("Invalid command %q: %s", cmd, err)
+			return
+		}
+		c.execIn(co, strings.Join(args, " "), args...)
+	})
+
+	return nil
+}
+
+func (c *Container) signalCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	if !c.isRunning(co) {
+		return nil
+	}
+
+	dialog.ShowSignal(c.App().Content.Pages, co, func(sig string) {
+		msg := fmt.Sprintf("Send SIG%s to container %s main process?", sig, co)
+		dialog.ShowConfirm(c.App().Content.Pages, "Signal", msg, func() {
+			c.execIn(co, "kill -"+sig+" 1", "kill", "-"+sig, "1")
+		}, func() {})
+	})
+
+	return nil
+}
+
+// ExecIn runs a one-off command in a container, bypassing any shell, and streams its output.
+func (c *Container) execIn(co, title string, cmd ...string) {
+	if !preflight(c.App(), c.GetTable().Path, "exec", client.CreateVerb) {
+		return
+	}
+	ctx, done := c.App().tasks.Track(context.Background(), dao.TaskExec, c.GetTable().Path+":"+co, title)
+	details := NewDetails(c.App(), "Exec", co+" "+title)
+	details.SetCancelFn(done)
+	if err := c.App().inject(details); err != nil {
+		done()
+		c.App().Flash().Err(err)
+		return
+	}
+
+	go func() {
+		defer done()
+		opts := dao.ExecOptions{
+			Path:      c.GetTable().Path,
+			Container: co,
+			Command:   cmd,
+		}
+		err := execStream(ctx, c.App().Conn(), opts, func(l string) {
+			c.App().QueueUpdateDraw(func() {
+				details.Append(l)
+			})
+		})
+		if err != nil && ctx.Err() == nil {
+			msg := fmt.Sprintf("\n<command exited: %s>\n", err)
+			if missingBinary(err) {
+				msg = fmt.Sprintf("\n<%s is not available in container %s. The image ships no shell or %s binary>\n", cmd[0], co, cmd[0])
+			}
+			c.App().QueueUpdateDraw(func() {
+				details.Append(msg)
+			})
+		}
+	}()
+}
+
+func (c *Container) isRunning(co string) bool {
+	if c.GetTable().GetSelectedCell(3) != "Running" {
+		c.App().Flash().Err(fmt.Errorf("Container %s is not running?", co))
+		return false
+	}
+
+	return true
+}
+
 func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -104,7 +240,7 @@ func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	ports, ok := c.isForwardable(path)
-	if !ok {
+	if !ok || !preflight(c.App(), c.GetTable().Path, "portforward", client.CreateVerb) {
 		return nil
 	}
 
@@ -152,33 +288,22 @@ func (c *Container) preparePort(pp []string) string {
 }
 
 func (c *Container) portForward(address, lport, cport string) {
-	co := c.GetTable().GetSelectedCell(0)
-	pf := dao.NewPortForwarder(c.App().Conn())
+	app, path := c.App(), c.GetTable().Path
+	ep := dao.ForwardEndpoint{Path: path, Container: c.GetTable().GetSelectedCell(0), Port: cport}
+	pf := dao.NewPortForwarder(app.Conn())
 	ports := []string{lport + ":" + cport}
-	fw, err := pf.Start(c.GetTable().Path, co, address, ports)
+	fw, err := pf.Start(ep.Path, ep.Container, address, ports)
 	if err != nil {
-		c.App().Flash().Err(err)
+		app.Flash().Err(err)
 		return
 	}
-
-	log.Debug().Msgf(">>> Starting port forward %q %v", c.GetTable().Path, ports)
-	go c.runForward(pf, fw)
-}
-
-func (c *Container) runForward(pf *dao.PortForwarder, f *portforward.PortForwarder) {
-	c.App().QueueUpdateDraw(func() {
-		c.App().factory.AddForwarder(pf)
-		c.App().Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
-		dialog.DismissPortForward(c.App().Content.Pages)
-	})
-
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		c.App().Flash().Err(err)
-		return
+	ownerGVR, ownerPath, err := dao.PodOwner(app.factory, path)
+	if err != nil {
+		log.Debug().Err(err).Msgf("No owner for %s. Port forward will only reconnect to the same pod", path)
 	}
-	c.App().QueueUpdateDraw(func() {
-		c.App().factory.DeleteForwarder(pf.FQN())
-		pf.SetActive(false)
+
+	log.Debug().Msgf(">>> Starting port forward %q %v", path, ports)
+	go superviseForward(app, path, address, pf, fw, ep, func(lost dao.ForwardEndpoint) (dao.ForwardEndpoint, error) {
+		return dao.ReconnectEndpoint(app.factory, lost, ownerGVR, ownerPath)
 	})
 }
@@ -0,0 +1,125 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	containerEnvTitle = "Env"
+	envSecretMask     = "********"
+)
+
+// ContainerEnv presents a container environment as the container sees it.
+type ContainerEnv struct {
+	*Details
+
+	path, co string
+	reveal   bool
+	vars     []dao.EnvVar
+}
+
+// NewContainerEnv returns a new container environment viewer.
+func NewContainerEnv(app *App, path, co string) *ContainerEnv {
+	return &ContainerEnv{
+		Details: NewDetails(app, containerEnvTitle, path+":"+co),
+		path:    path,
+		co:      co,
+	}
+}
+
+// Init initializes the viewer.
+func (c *ContainerEnv) Init(ctx context.Context) error {
+	if err := c.Details.Init(ctx); err != nil {
+		return err
+	}
+	c.Actions().Add(ui.KeyActions{
+		ui.KeyX:        ui.NewKeyAction("Reveal", c.revealCmd, true),
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", c.refreshCmd, true),
+	})
+
+	return c.refresh()
+}
+
+func (c *ContainerEnv) refresh() error {
+	vars, err := dao.ResolveEnv(c.app.factory, c.path, c.co)
+	if err != nil {
+		return err
+	}
+	c.vars = vars
+	c.render()
+	if n := missingEnv(vars); n > 0 {
+		c.app.Flash().Warnf("%d environment variables could not be resolved", n)
+	}
+
+	return nil
+}
+
+func (c *ContainerEnv) render() {
+	raw, err := envYAML(c.vars, c.reveal)
+	if err != nil {
+		c.app.Flash().Err(err)
+		return
+	}
+	row, col := c.GetScrollOffset()
+	c.Update(raw)
+	c.ScrollTo(row, col)
+}
+
+func (c *ContainerEnv) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	c.reveal = !c.reveal
+	c.render()
+	if c.reveal {
+		c.app.Flash().Info("Secret values revealed")
+	} else {
+		c.app.Flash().Info("Secret values masked")
+	}
+
+	return nil
+}
+
+func (c *ContainerEnv) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := c.refresh(); err != nil {
+		c.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func envYAML(vars []dao.EnvVar, reveal bool) (string, error) {
+	if len(vars) == 0 {
+		return "# No environment variables", nil
+	}
+	vv := make([]dao.EnvVar, len(vars))
+	copy(vv, vars)
+	for i := range vv {
+		if vv[i].Secret && !reveal && vv[i].Missing == "" {
+			vv[i].Value = envSecretMask
+		}
+	}
+	raw, err := yaml.Marshal(vv)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(raw)), nil
+}
+
+func missingEnv(vars []dao.EnvVar) int {
+	var n int
+	for _, v := range vars {
+		if v.Missing != "" {
+			n++
+		}
+	}
+
+	return n
+}
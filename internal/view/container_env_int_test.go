@@ -0,0 +1,46 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvYAML(t *testing.T) {
+	vars := []dao.EnvVar{
+		{Name: "A", Value: "a"},
+		{Name: "PWD", Value: "s3cr3t", From: "secret s1[pwd]", Secret: true},
+		{Name: "GONE", From: "configMap cm1[zorg]", Missing: "not found"},
+	}
+
+	uu := map[string]struct {
+		vars   []dao.EnvVar
+		reveal bool
+		e      string
+	}{
+		"empty": {
+			e: "# No environment variables",
+		},
+		"masked": {
+			vars: vars,
+			e:    "- name: A\n  value: a\n- from: secret s1[pwd]\n  name: PWD\n  value: '********'\n- from: configMap cm1[zorg]\n  missing: not found\n  name: GONE\n  value: \"\"",
+		},
+		"revealed": {
+			vars:   vars,
+			reveal: true,
+			e:      "- name: A\n  value: a\n- from: secret s1[pwd]\n  name: PWD\n  value: s3cr3t\n- from: configMap cm1[zorg]\n  missing: not found\n  name: GONE\n  value: \"\"",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := envYAML(u.vars, u.reveal)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, raw)
+		})
+	}
+	assert.Equal(t, "s3cr3t", vars[1].Value)
+	assert.Equal(t, 1, missingEnv(vars))
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// ControlPlane represents a control plane health view.
+type ControlPlane struct {
+	ResourceViewer
+}
+
+// NewControlPlane returns a new viewer.
+func NewControlPlane(gvr client.GVR) ResourceViewer {
+	c := ControlPlane{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(blankEnterFn)
+	c.GetTable().SetColorerFn(render.ControlPlane{}.ColorerFunc())
+
+	return &c
+}
+
+func (c *ControlPlane) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Source", c.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(2, true), false),
+	})
+}
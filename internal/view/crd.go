@@ -0,0 +1,105 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// CRD represents a custom resource definition viewer.
+type CRD struct {
+	ResourceViewer
+}
+
+// NewCRD returns a new viewer.
+func NewCRD(gvr client.GVR) ResourceViewer {
+	c := CRD{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(showCRD)
+
+	return &c
+}
+
+func (c *CRD) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Schema", c.schemaCmd, true),
+		ui.KeyN: ui.NewKeyAction("New Resource", c.skeletonCmd, true),
+	})
+}
+
+func (c *CRD) schemaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	crd, err := c.crdAccessor()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	root, err := crd.Schema(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if err := c.App().inject(NewCRDSchema(path, root)); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *CRD) skeletonCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	crd, err := c.crdAccessor()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := crd.Skeleton(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	c.Stop()
+	defer c.Start()
+	var created string
+	applied, err := editManifest(c.App(), raw, func(m []byte) error {
+		p, err := dao.Create(c.App().Conn(), m)
+		created = p
+		return err
+	})
+	switch {
+	case err != nil:
+		c.App().Flash().Errf("Create failed %s", err)
+	case applied:
+		c.App().Flash().Infof("%s created", created)
+	default:
+		c.App().Flash().Info("Create canceled, no changes made")
+	}
+
+	return nil
+}
+
+func (c *CRD) crdAccessor() (*dao.CustomResourceDefinition, error) {
+	res, err := dao.AccessorFor(c.App().factory, client.NewGVR(c.GVR()))
+	if err != nil {
+		return nil, err
+	}
+	crd, ok := res.(*dao.CustomResourceDefinition)
+	if !ok {
+		return nil, fmt.Errorf("expecting a crd accessor for %q but got %T", c.GVR(), res)
+	}
+
+	return crd, nil
+}
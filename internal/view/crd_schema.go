@@ -0,0 +1,149 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"sigs.k8s.io/yaml"
+)
+
+const crdSchemaTitle = "Schema"
+
+// CRDSchema represents a CRD openAPI schema tree viewer.
+type CRDSchema struct {
+	*ui.Tree
+
+	app  *App
+	path string
+	root *dao.SchemaNode
+}
+
+// NewCRDSchema returns a new schema viewer.
+func NewCRDSchema(path string, root *dao.SchemaNode) *CRDSchema {
+	return &CRDSchema{
+		Tree: ui.NewTree(),
+		path: path,
+		root: root,
+	}
+}
+
+// Init initializes the view.
+func (s *CRDSchema) Init(ctx context.Context) error {
+	if err := s.Tree.Init(ctx); err != nil {
+		return err
+	}
+
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	s.bindKeys()
+	s.SetBackgroundColor(config.AsColor(s.app.Styles.Xray().BgColor))
+	s.SetBorderColor(config.AsColor(s.app.Styles.Xray().FgColor))
+	s.SetBorderFocusColor(config.AsColor(s.app.Styles.Frame().Border.FocusColor))
+	s.SetGraphicsColor(config.AsColor(s.app.Styles.Xray().GraphicColor))
+	s.SetTitle(fmt.Sprintf(" %s(%s) ", crdSchemaTitle, s.path))
+
+	root := toSchemaTreeNode(s.root)
+	s.SetRoot(root)
+	s.SetCurrentNode(root)
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *CRDSchema) Name() string { return crdSchemaTitle }
+
+// Start starts the view.
+func (s *CRDSchema) Start() {}
+
+// Stop terminates the view.
+func (s *CRDSchema) Stop() {}
+
+func (s *CRDSchema) bindKeys() {
+	s.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Details", s.detailsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, false),
+	})
+}
+
+func (s *CRDSchema) detailsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	node := s.GetCurrentNode()
+	if node == nil {
+		return evt
+	}
+	sn, ok := node.GetReference().(*dao.SchemaNode)
+	if !ok {
+		return nil
+	}
+
+	details := NewDetails(s.app, "Field", sn.Name).Update(schemaDetails(sn))
+	if err := s.app.inject(details); err != nil {
+		s.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toSchemaTreeNode(n *dao.SchemaNode) *tview.TreeNode {
+	node := tview.NewTreeNode(schemaLabel(n))
+	node.SetReference(n)
+	node.SetSelectable(true)
+	for _, c := range n.Children {
+		node.AddChild(toSchemaTreeNode(c))
+	}
+
+	return node
+}
+
+func schemaLabel(n *dao.SchemaNode) string {
+	label := n.Name
+	if n.Type != "" {
+		label += " [gray::]<" + n.Type + ">[-::]"
+	}
+	if n.Required {
+		label += " [red::]*[-::]"
+	}
+	if n.Default != nil {
+		label += fmt.Sprintf(" [green::]=%v[-::]", n.Default)
+	}
+
+	return label
+}
+
+func schemaDetails(n *dao.SchemaNode) string {
+	m := map[string]interface{}{
+		"name":     n.Name,
+		"required": n.Required,
+	}
+	if n.Type != "" {
+		m["type"] = n.Type
+	}
+	if n.Format != "" {
+		m["format"] = n.Format
+	}
+	if n.Default != nil {
+		m["default"] = n.Default
+	}
+	if len(n.Enum) > 0 {
+		m["enum"] = n.Enum
+	}
+	if n.Description != "" {
+		m["description"] = strings.TrimSpace(n.Description)
+	}
+	raw, err := yaml.Marshal(m)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(raw)
+}
@@ -62,9 +62,12 @@ func jobCtx(path, uid string) ContextFunc {
 }
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
-	})
+	ns := c.GetTable().GetModel().GetNamespace()
+	if userCan(c.App(), ns, client.NewGVR("batch/v1/jobs"), client.CreateVerb) {
+		aa[tcell.KeyCtrlT] = ui.NewKeyAction("Trigger", c.trigger, true)
+	} else {
+		aa.Delete(tcell.KeyCtrlT)
+	}
 }
 
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Deprecation represents a deprecated api versions report view.
+type Deprecation struct {
+	ResourceViewer
+}
+
+// NewDeprecation returns a new viewer.
+func NewDeprecation(gvr client.GVR) ResourceViewer {
+	d := Deprecation{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.SetBindKeysFn(d.bindKeys)
+	d.GetTable().SetEnterFn(d.gotoResource)
+	d.GetTable().SetColorerFn(render.Deprecation{}.ColorerFunc())
+
+	return &d
+}
+
+func (d *Deprecation) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", d.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Removed", d.GetTable().SortColCmd(6, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd(7, true), false),
+	})
+}
+
+func (d *Deprecation) gotoResource(app *App, _ ui.Tabular, _, path string) {
+	gvr, fqn := splitGVRPath(path)
+	if err := app.viewResource(client.NewGVR(gvr).R(), fqn, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
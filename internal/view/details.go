@@ -2,14 +2,21 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 )
 
 const detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
@@ -22,6 +29,9 @@ type Details struct {
 	app            *App
 	title, subject string
 	buff           string
+	cancelFn       context.CancelFunc
+	wrap           bool
+	ansiWriter     io.Writer
 }
 
 // NewDetails returns a details viewer.
@@ -77,6 +87,50 @@ func (d *Details) Update(buff string) *Details {
 	return d
 }
 
+// Stream renders YAML content in chunks off the ui goroutine, reporting
+// progress as lines get rendered.
+func (d *Details) Stream(ctx context.Context, buff string, progress func(done, total int)) error {
+	lines := strings.Split(colorizeYAML(d.app.Styles.Views().Yaml, buff), "\n")
+	for i := 0; i < len(lines); i += yamlChunkLines {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		end := i + yamlChunkLines
+		if end > len(lines) {
+			end = len(lines)
+		}
+		chunk := strings.Join(lines[i:end], "\n")
+		if end < len(lines) {
+			chunk += "\n"
+		}
+		if _, err := io.WriteString(d.TextView, chunk); err != nil {
+			return err
+		}
+		progress(end, len(lines))
+	}
+	d.app.QueueUpdateDraw(func() {
+		d.buff = buff
+		d.ScrollToBeginning()
+	})
+
+	return nil
+}
+
+// Append appends raw content to the view. ANSI colors are rendered.
+func (d *Details) Append(buff string) {
+	d.buff += buff
+	if d.ansiWriter == nil {
+		d.ansiWriter = tview.ANSIWriter(d.TextView, d.app.Styles.Views().Log.FgColor, d.app.Styles.Views().Log.BgColor)
+	}
+	fmt.Fprint(d.ansiWriter, tview.Escape(buff))
+	d.ScrollToEnd()
+}
+
+// SetCancelFn registers a function to cancel any content streaming when the view stops.
+func (d *Details) SetCancelFn(f context.CancelFunc) {
+	d.cancelFn = f
+}
+
 // SetSubject updates the subject.
 func (d *Details) SetSubject(s string) {
 	d.subject = s
@@ -95,6 +149,9 @@ func (d *Details) Start() {}
 
 // Stop terminates the updater.
 func (d *Details) Stop() {
+	if d.cancelFn != nil {
+		d.cancelFn()
+	}
 	d.app.Styles.RemoveListener(d)
 }
 
@@ -113,7 +170,59 @@ func (d *Details) bindKeys() {
 		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", d.saveCmd, false),
 		ui.KeyC:         ui.NewKeyAction("Copy", d.cpCmd, true),
+		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", d.wrapCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Pager", d.pagerCmd, true),
+		ui.KeyShiftH:    ui.NewKeyAction("Scroll Left", d.scrollCmd(-1), true),
+		ui.KeyShiftL:    ui.NewKeyAction("Scroll Right", d.scrollCmd(1), true),
 	})
+	if config.IsServed() {
+		d.actions.Delete(ui.KeyP)
+	}
+}
+
+// SetWrap sets the text wrap mode. Long lines are scrolled horizontally when
+// not wrapped.
+func (d *Details) SetWrap(wrap bool) *tview.TextView {
+	d.wrap = wrap
+	return d.TextView.SetWrap(wrap)
+}
+
+// Wrap reports the current wrap mode.
+func (d *Details) Wrap() bool {
+	return d.wrap
+}
+
+// ScrolledLeft checks if the view is not scrolled horizontally.
+func (d *Details) ScrolledLeft() bool {
+	_, col := d.GetScrollOffset()
+	return col == 0
+}
+
+func (d *Details) wrapCmd(*tcell.EventKey) *tcell.EventKey {
+	d.SetWrap(!d.wrap)
+	if d.wrap {
+		row, _ := d.GetScrollOffset()
+		d.ScrollTo(row, 0)
+	}
+
+	return nil
+}
+
+// ScrollCmd scrolls long lines by half a page when not wrapped.
+func (d *Details) scrollCmd(dir int) func(*tcell.EventKey) *tcell.EventKey {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		if d.wrap {
+			return nil
+		}
+		_, _, w, _ := d.GetInnerRect()
+		row, col := d.GetScrollOffset()
+		if col += dir * w / 2; col < 0 {
+			col = 0
+		}
+		d.ScrollTo(row, col)
+
+		return nil
+	}
 }
 
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -129,7 +238,7 @@ func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveYAML(d.app.Config.K9s.CurrentCluster, d.title, d.GetText(true)); err != nil {
+	if path, err := saveYAML(d.app.Config.K9s, d.title, d.subject, d.GetText(true)); err != nil {
 		d.app.Flash().Err(err)
 	} else {
 		d.app.Flash().Infof("Log %s saved successfully!", path)
@@ -137,6 +246,48 @@ func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Details) pagerCmd(evt *tcell.EventKey) *tcell.EventKey {
+	bin, args, err := pagerCmd(d.app.Config.K9s.Pager)
+	if err != nil {
+		d.app.Flash().Errf("No pager found %s", err)
+		return nil
+	}
+	path, err := d.pagerFile()
+	if err != nil {
+		d.app.Flash().Err(err)
+		return nil
+	}
+	defer func() {
+		if err := os.Remove(path); err != nil {
+			log.Error().Err(err).Msgf("Removing pager file %s", path)
+		}
+	}()
+	if !run(true, d.app, bin, false, append(args, path)...) {
+		d.app.Flash().Err(errors.New("Failed to launch pager"))
+	}
+
+	return nil
+}
+
+// pagerFile persists the view content to a temp file named after the
+// content type so pagers ie bat can highlight it.
+func (d *Details) pagerFile() (string, error) {
+	ext := ".txt"
+	if strings.EqualFold(d.title, "yaml") {
+		ext = ".yaml"
+	}
+	f, err := ioutil.TempFile("", "k9s-*"+ext)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(d.GetText(true)); err != nil {
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
 func (d *Details) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.app.Flash().Info("Content copied to clipboard...")
 	if err := clipboard.WriteAll(d.GetText(true)); err != nil {
@@ -149,6 +300,10 @@ func (d *Details) updateTitle() {
 	if d.title == "" {
 		return
 	}
-	title := ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, d.title, d.subject), d.app.Styles.Frame())
+	subject := d.subject
+	if render.Anonymous {
+		subject = render.AnonymizePath(subject)
+	}
+	title := ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, d.title, subject), d.app.Styles.Frame())
 	d.SetTitle(title)
 }
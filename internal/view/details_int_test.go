@@ -0,0 +1,37 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetailsStream(t *testing.T) {
+	d := NewDetails(NewApp(config.NewConfig(ks{})), "YAML", "fred")
+	d.SetDynamicColors(true)
+
+	ll := make([]string, 2*yamlChunkLines+1)
+	for i := range ll {
+		ll[i] = "a: b"
+	}
+	var calls, done, total int
+	assert.Nil(t, d.Stream(context.Background(), strings.Join(ll, "\n"), func(d, t int) {
+		calls, done, total = calls+1, d, t
+	}))
+
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, len(ll), done)
+	assert.Equal(t, len(ll), total)
+	assert.Equal(t, strings.Join(ll, "\n"), d.GetText(true))
+}
+
+func TestDetailsStreamCanceled(t *testing.T) {
+	d := NewDetails(NewApp(config.NewConfig(ks{})), "YAML", "fred")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, context.Canceled, d.Stream(ctx, "a: b", func(int, int) {}))
+}
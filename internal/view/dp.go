@@ -38,6 +38,7 @@ func (d *Deploy) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(3, true), false),
 	})
+	bindTargetPortFwd(d, aa, "Container")
 }
 
 func (d *Deploy) showPods(app *App, model ui.Tabular, gvr, path string) {
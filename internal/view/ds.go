@@ -36,6 +36,7 @@ func (d *DaemonSet) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(3, true), false),
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(4, true), false),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(5, true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Rollout Skew", rolloutCmd(d), true),
 	})
 }
 
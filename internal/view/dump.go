@@ -0,0 +1,43 @@
+package view
+
+import (
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// newDump returns a dump spec for a resource path in the current context.
+func newDump(k *config.K9s, resource, path, ext string) config.Dump {
+	ns, n := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = ""
+	}
+
+	return config.Dump{
+		Context:   k.CurrentContext,
+		Cluster:   k.CurrentCluster,
+		Namespace: ns,
+		Resource:  strings.ToLower(resource),
+		Name:      n,
+		Ext:       ext,
+		Time:      time.Now(),
+	}
+}
+
+// dumpFile persists data to a dump file and returns its path.
+func dumpFile(k *config.K9s, d config.Dump, data []byte) (string, error) {
+	path, err := k.DumpPath(d)
+	if err != nil {
+		return "", err
+	}
+	log.Debug().Msgf("Dumping %s to %s", d.Resource, path)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -0,0 +1,120 @@
+package view
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+const editHeader = `# Please edit the object below. Lines beginning with a '#' will be ignored,
+# and an empty file will abort the edit. If an error occurs while saving this file will be
+# reopened with the relevant failures.
+#
+`
+
+// EditResource edits a live resource manifest and updates the resource.
+func editResource(app *App, gvr, path string) {
+	acc, err := dao.AccessorFor(app.factory, client.NewGVR(gvr))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	e, ok := acc.(dao.Editor)
+	if !ok {
+		app.Flash().Errf("Resource %s is not editable", gvr)
+		return
+	}
+	manifest, err := e.Manifest(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	applied, err := editManifest(app, manifest, func(raw []byte) error {
+		return e.Update(path, raw)
+	})
+	switch {
+	case err != nil:
+		app.Flash().Errf("Edit failed %s", err)
+	case applied:
+		app.Flash().Infof("%s edited", path)
+	default:
+		app.Flash().Info("Edit canceled, no changes made")
+	}
+}
+
+// EditManifest opens a manifest in the editor and applies it once saved.
+// Rejected manifests are reopened along with the failure until applied or
+// aborted by saving an empty or unchanged file.
+func editManifest(app *App, manifest string, apply func([]byte) error) (bool, error) {
+	if config.IsServed() {
+		return false, config.ErrServed
+	}
+	f, err := ioutil.TempFile("", "k9s-edit-*.yaml")
+	if err != nil {
+		return false, err
+	}
+	_ = f.Close()
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			log.Warn().Err(err).Msgf("Removing %s", f.Name())
+		}
+	}()
+
+	var (
+		content  = editHeader + manifest
+		last     = stripComments([]byte(manifest))
+		applyErr error
+	)
+	for {
+		if err := ioutil.WriteFile(f.Name(), []byte(content), 0600); err != nil {
+			return false, err
+		}
+		if !edit(true, app, f.Name()) {
+			return false, errors.New("editor exec failed")
+		}
+		raw, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			return false, err
+		}
+		edited := stripComments(raw)
+		if len(bytes.TrimSpace(edited)) == 0 || bytes.Equal(edited, last) {
+			return false, applyErr
+		}
+		if applyErr = apply(edited); applyErr == nil {
+			return true, nil
+		}
+		content, last = editErrorHeader(applyErr)+string(edited), edited
+	}
+}
+
+func editErrorHeader(err error) string {
+	var b strings.Builder
+	b.WriteString(editHeader)
+	for _, l := range strings.Split(err.Error(), "\n") {
+		b.WriteString("# " + l + "\n")
+	}
+	b.WriteString("#\n")
+
+	return b.String()
+}
+
+// StripComments removes comment lines, preserving indented block content.
+func stripComments(raw []byte) []byte {
+	var b bytes.Buffer
+	for _, l := range bytes.SplitAfter(raw, []byte("\n")) {
+		if bytes.HasPrefix(l, []byte("#")) {
+			continue
+		}
+		b.Write(l)
+	}
+
+	return b.Bytes()
+}
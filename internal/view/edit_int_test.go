@@ -0,0 +1,47 @@
+package view
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripComments(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"none": {
+			s: "a: 1\nb: 2\n",
+			e: "a: 1\nb: 2\n",
+		},
+		"header": {
+			s: editHeader + "a: 1\n",
+			e: "a: 1\n",
+		},
+		"indented": {
+			s: "data:\n  script: |\n    # keep me\n# drop me\n",
+			e: "data:\n  script: |\n    # keep me\n",
+		},
+		"noEOL": {
+			s: "a: 1\n# last",
+			e: "a: 1\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(stripComments([]byte(u.s))))
+		})
+	}
+}
+
+func TestEditErrorHeader(t *testing.T) {
+	h := editErrorHeader(errors.New("boom\nbang"))
+
+	assert.True(t, strings.HasPrefix(h, editHeader))
+	assert.True(t, strings.HasSuffix(h, "# boom\n# bang\n#\n"))
+	assert.Equal(t, "", string(stripComments([]byte(h))))
+}
@@ -0,0 +1,170 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	eventsPaneRows = 6
+	eventsPaneRate = 2 * time.Second
+)
+
+// EventsPane follows the most recent events of the resource selected in the
+// active view.
+type EventsPane struct {
+	*tview.TextView
+
+	app      *App
+	path     string
+	cancelFn context.CancelFunc
+}
+
+// NewEventsPane returns a new events pane.
+func NewEventsPane(app *App) *EventsPane {
+	e := EventsPane{
+		TextView: tview.NewTextView(),
+		app:      app,
+	}
+	e.SetDynamicColors(true)
+	e.SetWrap(false)
+	e.SetBorder(true)
+	e.SetBorderPadding(0, 0, 1, 1)
+
+	return &e
+}
+
+// IsOpen returns true if the pane is showing.
+func (e *EventsPane) IsOpen() bool {
+	return e.cancelFn != nil
+}
+
+// Toggle shows or hides the pane.
+func (e *EventsPane) Toggle() {
+	if e.IsOpen() {
+		e.cancelFn()
+		e.cancelFn = nil
+		e.resize(0)
+		return
+	}
+
+	var ctx context.Context
+	ctx, e.cancelFn = context.WithCancel(context.Background())
+	e.path = ""
+	e.SetBackgroundColor(e.app.Styles.BgColor())
+	e.SetTextColor(e.app.Styles.FgColor())
+	e.SetTitle(" Events ")
+	e.SetText("")
+	e.resize(eventsPaneRows + 2)
+	go e.watch(ctx)
+}
+
+func (e *EventsPane) watch(ctx context.Context) {
+	e.app.QueueUpdateDraw(e.follow)
+	ticker := time.NewTicker(eventsPaneRate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.app.QueueUpdateDraw(e.follow)
+		}
+	}
+}
+
+// Follow tracks the current selection and refreshes its events.
+func (e *EventsPane) follow() {
+	if !e.IsOpen() {
+		return
+	}
+	path := selectedPath(e.app)
+	if path != e.path {
+		e.path = path
+		title := path
+		if render.Anonymous {
+			title = render.AnonymizePath(title)
+		}
+		e.SetTitle(fmt.Sprintf(" Events(%s) ", title))
+		e.SetText("")
+	}
+	if path == "" {
+		e.SetText("[gray::-]Select a resource to follow its events")
+		return
+	}
+	go func() {
+		ee, err := dao.EventsFor(e.app.factory, path, eventsPaneRows)
+		e.app.QueueUpdateDraw(func() {
+			if path != e.path {
+				return
+			}
+			if err != nil {
+				e.SetText(fmt.Sprintf("[%s::-]%s", e.app.Styles.Frame().Status.ErrorColor, tview.Escape(err.Error())))
+				return
+			}
+			e.SetText(e.render(ee, time.Now()))
+		})
+	}()
+}
+
+func (e *EventsPane) render(ee []*v1.Event, now time.Time) string {
+	if len(ee) == 0 {
+		return "[gray::-]No events"
+	}
+	st := e.app.Styles.Frame().Status
+	ll := make([]string, 0, len(ee))
+	for _, evt := range ee {
+		color := st.NewColor
+		if evt.Type == v1.EventTypeWarning {
+			color = st.ErrorColor
+		}
+		t := dao.EventTime(evt)
+		msg := strings.TrimSpace(evt.Message)
+		if evt.Count > 1 {
+			msg += fmt.Sprintf(" (x%d)", evt.Count)
+		}
+		ll = append(ll, fmt.Sprintf("[gray::-]%-5s [%s::b]%-8s %-20s[-::-] %s",
+			duration.HumanDuration(now.Sub(t.Time)), color, evt.Type, evt.Reason, tview.Escape(msg)))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func (e *EventsPane) resize(height int) {
+	if flex, ok := e.app.Main.GetPrimitive("main").(*tview.Flex); ok {
+		flex.ResizeItem(e, height, 0)
+	}
+}
+
+func (a *App) eventsPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.eventsPane().Toggle()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SelectedPath returns the resource selected in the active view. Containers
+// track their pod.
+func selectedPath(a *App) string {
+	if topGVR(a) == "containers" {
+		if v, ok := a.Content.Top().(TableViewer); ok {
+			return v.GetTable().Path
+		}
+	}
+
+	return selectedItem(a)
+}
@@ -1,29 +1,34 @@
 package view
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"os/signal"
+	"runtime"
 	"strings"
 	"syscall"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/pty"
 	"github.com/rs/zerolog/log"
 )
 
-func runK(clear bool, app *App, args ...string) bool {
-	bin, err := exec.LookPath("kubectl")
-	if err != nil {
-		log.Error().Msgf("Unable to find kubectl command in path %v", err)
-		return false
-	}
-
-	return run(clear, app, bin, false, args...)
-}
+// MaxStreamLine caps the size of a streamed output line.
+const maxStreamLine = 1024 * 1024
 
 func run(clear bool, app *App, bin string, bg bool, args ...string) bool {
+	if config.IsServed() {
+		app.Flash().Errf("Unable to run %s: %s", bin, config.ErrServed)
+		return false
+	}
 	app.Halt()
 	defer app.Resume()
 
@@ -35,13 +40,80 @@ func run(clear bool, app *App, bin string, bg bool, args ...string) bool {
 }
 
 func edit(clear bool, app *App, args ...string) bool {
-	bin, err := exec.LookPath(os.Getenv("EDITOR"))
+	bin, flags, err := editorCmd()
 	if err != nil {
 		log.Error().Msgf("Unable to find editor command in path %v", err)
 		return false
 	}
 
-	return run(clear, app, bin, false, args...)
+	return run(clear, app, bin, false, append(flags, args...)...)
+}
+
+// EditorCmd returns the editor binary and its arguments from KUBE_EDITOR or
+// EDITOR, falling back to the platform default.
+func editorCmd() (string, []string, error) {
+	editor := "vi"
+	if runtime.GOOS == "windows" {
+		editor = "notepad"
+	}
+	for _, env := range []string{"KUBE_EDITOR", "EDITOR"} {
+		if e := strings.TrimSpace(os.Getenv(env)); e != "" {
+			editor = e
+			break
+		}
+	}
+
+	return lookupCmd(editor)
+}
+
+// PagerCmd returns the pager binary and its arguments from the K9s config or
+// PAGER, falling back to less.
+func pagerCmd(pager string) (string, []string, error) {
+	if pager = strings.TrimSpace(pager); pager == "" {
+		pager = strings.TrimSpace(os.Getenv("PAGER"))
+	}
+	if pager == "" {
+		pager = "less"
+	}
+
+	return lookupCmd(pager)
+}
+
+// LookupCmd resolves a command line binary and its arguments.
+func lookupCmd(cmd string) (string, []string, error) {
+	// Unquoted paths with spaces ie C:\Program Files\...
+	if bin, err := exec.LookPath(cmd); err == nil {
+		return bin, nil, nil
+	}
+	args := splitArgs(cmd)
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("invalid command %q", cmd)
+	}
+	bin, err := exec.LookPath(args[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return bin, args[1:], nil
+}
+
+// SplitArgs splits a command line on white spaces, honoring quotes.
+// Backslashes escape characters except on windows where they are path
+// separators.
+func splitArgs(s string) []string {
+	args, _ := config.ShellWords(s, runtime.GOOS != "windows")
+
+	return args
+}
+
+// MissingBinary checks if a container exec failed for lack of the command binary.
+func missingBinary(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+
+	return strings.Contains(msg, "executable file not found") || strings.Contains(msg, "no such file or directory")
 }
 
 func execute(clear bool, bin string, bg bool, args ...string) error {
@@ -70,8 +142,7 @@ func execute(clear bool, bin string, bg bool, args ...string) error {
 	if bg {
 		err = cmd.Start()
 	} else {
-		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
-		err = cmd.Run()
+		err = pty.Run(cmd)
 	}
 	log.Debug().Msgf("Command returned error?? %v", err)
 	select {
@@ -85,3 +156,72 @@ func execute(clear bool, bin string, bg bool, args ...string) error {
 func clearScreen() {
 	fmt.Print("\033[H\033[2J")
 }
+
+// RunKOutputContext runs a kubectl command until done or canceled and returns its output.
+func runKOutputContext(ctx context.Context, app *App, args ...string) (string, error) {
+	bin, err := exec.LookPath("kubectl")
+	if err != nil {
+		return "", err
+	}
+	args = kubectlArgs(app, args...)
+	log.Debug().Msgf("Running command > %s %s", bin, strings.Join(args, " "))
+	out, err := exec.CommandContext(ctx, bin, args...).CombinedOutput()
+
+	return string(out), err
+}
+
+// ExecStream runs a command in a container and streams its output lines.
+func execStream(ctx context.Context, c client.Connection, opts dao.ExecOptions, out func(string)) error {
+	log.Debug().Msgf("Exec in %s:%s > %s", opts.Path, opts.Container, strings.Join(opts.Command, " "))
+	r, w := io.Pipe()
+	opts.Stdout, opts.Stderr = w, w
+	go func() {
+		_ = w.CloseWithError(dao.Exec(ctx, c, opts))
+	}()
+
+	return streamLines(r, out)
+}
+
+// CmdStream runs a local command and streams its combined output lines.
+func cmdStream(ctx context.Context, bin string, args []string, out func(string)) error {
+	if config.IsServed() {
+		return config.ErrServed
+	}
+	log.Debug().Msgf("Running command > %s %s", bin, strings.Join(args, " "))
+	r, w := io.Pipe()
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout, cmd.Stderr = w, w
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() {
+		_ = w.CloseWithError(cmd.Wait())
+	}()
+
+	return streamLines(r, out)
+}
+
+// StreamLines feeds lines to out until the reader is exhausted. On scan
+// failures the reader is drained so the writer never blocks.
+func streamLines(r io.Reader, out func(string)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxStreamLine)
+	for scanner.Scan() {
+		out(scanner.Text() + "\n")
+	}
+	err := scanner.Err()
+	if err != nil {
+		_, _ = io.Copy(ioutil.Discard, r)
+	}
+
+	return err
+}
+
+func kubectlArgs(app *App, args ...string) []string {
+	args = append(args, "--context", app.Config.K9s.CurrentContext)
+	if cfg := app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
+		args = append(args, "--kubeconfig", *cfg)
+	}
+
+	return args
+}
@@ -0,0 +1,226 @@
+package view
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitArgs(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		e []string
+	}{
+		"empty": {
+			s: "  ",
+		},
+		"plain": {
+			s: "vim",
+			e: []string{"vim"},
+		},
+		"args": {
+			s: "code  --wait -n",
+			e: []string{"code", "--wait", "-n"},
+		},
+		"doubleQuotes": {
+			s: `"/opt/my editor/bin/ed" --wait`,
+			e: []string{"/opt/my editor/bin/ed", "--wait"},
+		},
+		"singleQuotes": {
+			s: `emacs -nw --eval '(setq x "y")'`,
+			e: []string{"emacs", "-nw", "--eval", `(setq x "y")`},
+		},
+		"escaped": {
+			s: `/opt/my\ editor -w`,
+			e: []string{"/opt/my editor", "-w"},
+		},
+		"emptyQuotes": {
+			s: `ed ""`,
+			e: []string{"ed", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, splitArgs(u.s))
+		})
+	}
+}
+
+func TestMissingBinary(t *testing.T) {
+	assert.False(t, missingBinary(nil))
+	assert.True(t, missingBinary(errors.New(`exec: "kill": executable file not found in $PATH`)))
+	assert.False(t, missingBinary(errors.New("command terminated with exit code 1")))
+}
+
+func TestStreamLines(t *testing.T) {
+	var ll []string
+	err := streamLines(strings.NewReader("a\nb\n"), func(l string) {
+		ll = append(ll, l)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a\n", "b\n"}, ll)
+}
+
+func TestStreamLinesTooLong(t *testing.T) {
+	r, w := io.Pipe()
+	go func() {
+		_, _ = w.Write([]byte(strings.Repeat("x", maxStreamLine+1) + "\n"))
+		_, _ = w.Write([]byte("done\n"))
+		_ = w.Close()
+	}()
+
+	err := streamLines(r, func(string) {})
+	assert.Equal(t, bufio.ErrTooLong, err)
+}
+
+func TestEditorCmd(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell found")
+	}
+
+	uu := map[string]struct {
+		kubeEditor, editor string
+		args               []string
+		err                bool
+	}{
+		"editor": {
+			editor: "sh",
+		},
+		"args": {
+			editor: "sh -c 'exit 0'",
+			args:   []string{"-c", "exit 0"},
+		},
+		"kubeEditor": {
+			kubeEditor: "sh -e",
+			editor:     "blee",
+			args:       []string{"-e"},
+		},
+		"path": {
+			editor: sh,
+		},
+		"missing": {
+			editor: "k9s-no-such-editor --wait",
+			err:    true,
+		},
+	}
+
+	defer restoreEnv("KUBE_EDITOR")()
+	defer restoreEnv("EDITOR")()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			os.Setenv("KUBE_EDITOR", u.kubeEditor)
+			os.Setenv("EDITOR", u.editor)
+			bin, args, err := editorCmd()
+
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, sh, bin)
+			assert.Equal(t, u.args, args)
+		})
+	}
+}
+
+func TestPagerCmd(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell found")
+	}
+
+	uu := map[string]struct {
+		pager, env string
+		args       []string
+		err        bool
+	}{
+		"config": {
+			pager: "sh -e",
+			env:   "blee",
+			args:  []string{"-e"},
+		},
+		"env": {
+			env: "sh",
+		},
+		"missing": {
+			pager: "k9s-no-such-pager -R",
+			err:   true,
+		},
+	}
+
+	defer restoreEnv("PAGER")()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			os.Setenv("PAGER", u.env)
+			bin, args, err := pagerCmd(u.pager)
+
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, sh, bin)
+			assert.Equal(t, u.args, args)
+		})
+	}
+}
+
+func restoreEnv(key string) func() {
+	v, ok := os.LookupEnv(key)
+	return func() {
+		if ok {
+			os.Setenv(key, v)
+			return
+		}
+		os.Unsetenv(key)
+	}
+}
+
+func TestCmdStream(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell found")
+	}
+
+	var ll []string
+	err = cmdStream(context.Background(), sh, []string{"-c", "echo fred; echo blee >&2; exit 3"}, func(l string) {
+		ll = append(ll, l)
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"fred\n", "blee\n"}, ll)
+}
+
+func TestServedSession(t *testing.T) {
+	assert.Nil(t, os.Setenv(config.RemoteUserEnv, "fred"))
+	defer os.Unsetenv(config.RemoteUserEnv)
+
+	a := NewApp(config.NewConfig(ks{}))
+	assert.False(t, run(false, a, "true", false))
+	assert.Equal(t, config.ErrServed, cmdStream(context.Background(), "true", nil, func(string) {}))
+
+	_, err := editManifest(a, "a: b", func([]byte) error { return nil })
+	assert.Equal(t, config.ErrServed, err)
+	_, err = saveYAML(a.Config.K9s, "pods", "default/fred", "a: b")
+	assert.Equal(t, config.ErrServed, err)
+	assert.Equal(t, config.ErrServed, NewCommand(a).kustomizeCmd("kz /etc"))
+
+	aa := make(ui.KeyActions)
+	pluginActions(nil, aa)
+	assert.Equal(t, 0, len(aa))
+	_, ok := NewDetails(a, "YAML", "fred").actions[ui.KeyP]
+	assert.False(t, ok)
+}
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Find presents resources matching a search pattern across kinds.
+type Find struct {
+	ResourceViewer
+
+	pattern string
+	gvrs    []string
+}
+
+// NewFind returns a new viewer.
+func NewFind(app *App, pattern string) *Find {
+	f := Find{
+		ResourceViewer: NewBrowser(client.NewGVR("find")),
+		pattern:        pattern,
+		gvrs:           app.Config.K9s.FindResources,
+	}
+	f.SetBindKeysFn(f.bindKeys)
+	f.SetContextFn(f.findCtx)
+	f.GetTable().SetEnterFn(gotoRelated)
+	f.GetTable().SetColorerFn(render.Find{}.ColorerFunc())
+
+	return &f
+}
+
+func (f *Find) findCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, f.pattern)
+	ctx = context.WithValue(ctx, internal.KeyGVRs, f.gvrs)
+
+	return context.WithValue(ctx, internal.KeyPattern, f.pattern)
+}
+
+func (f *Find) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", f.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Match", f.GetTable().SortColCmd(3, true), false),
+	})
+}
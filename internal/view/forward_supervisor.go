@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/portforward"
+)
+
+const (
+	forwardMaxReconnects = 10
+	forwardMaxBackoff    = 5 * time.Second
+)
+
+// forwardResolver returns the endpoint a forward should reconnect to once
+// the given endpoint connection got lost.
+type forwardResolver func(lost dao.ForwardEndpoint) (dao.ForwardEndpoint, error)
+
+// superviseForward keeps a port forward going, reconnecting it when the
+// forwarded pod goes away or stops being ready. The pod is watched as the
+// forward stream may outlive it. The local port stays the same across reconnects.
+// Forwards running out of retries are left behind as dead.
+func superviseForward(app *App, name, address string, pf *dao.PortForwarder, fw *portforward.PortForwarder, ep dao.ForwardEndpoint, resolve forwardResolver) {
+	ctx, done := app.tasks.Track(context.Background(), dao.TaskPortForward, name, strings.Join(pf.Ports(), ","))
+	defer done()
+
+	key := client.ContextPath(pf.Context(), pf.Path())
+	app.QueueUpdateDraw(func() {
+		app.factory.AddForwarder(pf)
+		app.Flash().Infof("PortForward activated %s", forwardLabel(name, pf))
+		dialog.DismissPortForward(app.Content.Pages)
+	})
+	go func() {
+		<-ctx.Done()
+		if pf.Status() == dao.ForwardDead {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			app.factory.DeleteForwarder(client.ContextPath(pf.Context(), pf.Path()))
+		})
+	}()
+
+	go dao.WatchForwardPod(ctx, app.factory, pf)
+
+	lport := strings.Split(pf.Ports()[0], ":")[0]
+	for {
+		pf.SetActive(true)
+		pf.SetStatus(dao.ForwardActive)
+		err := fw.ForwardPorts()
+		pf.SetActive(false)
+		if pf.Stopped() || ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = fmt.Errorf("pod %s went away", ep.Path)
+		}
+		log.Warn().Err(err).Msgf("PortForward %s lost", name)
+		pf.SetStatus(dao.ForwardReconnecting)
+		app.Flash().Warnf("PortForward %s lost. Reconnecting...", name)
+
+		for attempt := 1; ; attempt++ {
+			if attempt > forwardMaxReconnects {
+				pf.SetStatus(dao.ForwardDead)
+				app.Flash().Errf("PortForward %s died: %s", name, err)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(forwardBackoff(attempt)):
+			}
+			if pf.Stopped() {
+				return
+			}
+
+			next, e := resolve(ep)
+			if e != nil {
+				err = e
+				continue
+			}
+			fw, err = pf.Start(next.Path, next.Container, address, []string{lport + ":" + next.Port})
+			key = rekeyForward(app, pf, key)
+			if err != nil {
+				continue
+			}
+			log.Debug().Msgf(">>> Reconnected port forward %s via %q %v", name, next.Path, pf.Ports())
+			if next.Path != ep.Path {
+				app.Flash().Warnf("PortForward %s moved from %s to %s", name, ep.Path, next.Path)
+			}
+			ep = next
+			break
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ForwardBackoff returns how long to wait before a reconnect attempt. The
+// first attempt is immediate so forwards fail over to another ready pod
+// as soon as their pod goes away.
+func forwardBackoff(attempt int) time.Duration {
+	d := time.Duration(attempt-1) * forwardFailoverDelay
+	if d > forwardMaxBackoff {
+		return forwardMaxBackoff
+	}
+
+	return d
+}
+
+// RekeyForward tracks a forward under its new pod once it moved.
+func rekeyForward(app *App, pf *dao.PortForwarder, key string) string {
+	k := client.ContextPath(pf.Context(), pf.Path())
+	if k == key {
+		return key
+	}
+	app.QueueUpdateDraw(func() {
+		delete(app.factory.Forwarders(), key)
+		app.factory.AddForwarder(pf)
+	})
+
+	return k
+}
+
+func forwardLabel(name string, pf *dao.PortForwarder) string {
+	if strings.HasPrefix(pf.Path(), name+":") {
+		return name + ":" + pf.Ports()[0]
+	}
+
+	return name + " via " + pf.Path() + ":" + pf.Ports()[0]
+}
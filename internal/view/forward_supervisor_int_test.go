@@ -0,0 +1,26 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardBackoff(t *testing.T) {
+	uu := map[string]struct {
+		attempt int
+		e       time.Duration
+	}{
+		"first": {1, 0},
+		"next":  {3, 2 * forwardFailoverDelay},
+		"cap":   {20, forwardMaxBackoff},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, forwardBackoff(u.attempt))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const forwardFailoverDelay = time.Second
+
+// targetPortFwdCmd port forwards to a ready pod backing the selected service
+// or deployment.
+func targetPortFwdCmd(v ResourceViewer, kind string) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		app := v.App()
+		if !preflight(app, path, "portforward", client.CreateVerb) {
+			return nil
+		}
+		pp, err := dao.ForwardTargetPorts(app.factory, v.GVR(), path)
+		if err != nil {
+			app.Flash().Err(err)
+			return nil
+		}
+		port := "MY_TCP_PORT!"
+		if len(pp) > 0 {
+			port = pp[0]
+		} else {
+			app.Flash().Warn("No TCP port found. User will specify...")
+		}
+
+		dialog.ShowTargetPortForward(app.Content.Pages, kind, port, func(address, lport, port string) {
+			ep, err := dao.ForwardEndpointFor(app.factory, v.GVR(), path, port, "")
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			runTargetForward(app, v.GVR(), path, port, address, lport, ep)
+		})
+
+		return nil
+	}
+}
+
+// runTargetForward port forwards to a pod backing a resource, failing over to
+// another ready pod once the pod watch sees the forwarded one go away or stop
+// being ready.
+func runTargetForward(app *App, gvr, path, port, address, lport string, ep dao.ForwardEndpoint) {
+	pf := dao.NewPortForwarder(app.Conn())
+	fw, err := pf.Start(ep.Path, ep.Container, address, []string{lport + ":" + ep.Port})
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	log.Debug().Msgf(">>> Starting port forward %s via %q %v", path, ep.Path, pf.Ports())
+	go superviseForward(app, path, address, pf, fw, ep, func(lost dao.ForwardEndpoint) (dao.ForwardEndpoint, error) {
+		next, err := dao.ForwardEndpointFor(app.factory, gvr, path, port, lost.Path)
+		if err != nil {
+			return dao.ForwardEndpointFor(app.factory, gvr, path, port, "")
+		}
+		return next, nil
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func bindTargetPortFwd(v ResourceViewer, aa ui.KeyActions, kind string) {
+	ns := v.GetTable().GetModel().GetNamespace()
+	if userCan(v.App(), ns, client.NewGVR("v1/pods:portforward"), client.CreateVerb) {
+		aa[ui.KeyShiftF] = ui.NewKeyAction("PortForward", targetPortFwdCmd(v, kind), true)
+	} else {
+		aa.Delete(ui.KeyShiftF)
+	}
+}
@@ -0,0 +1,154 @@
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// Flux represents a Flux reconciled resource viewer.
+type Flux struct {
+	ResourceViewer
+}
+
+// NewFlux returns a new viewer.
+func NewFlux(gvr client.GVR) ResourceViewer {
+	f := Flux{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.SetBindKeysFn(f.bindKeys)
+	f.GetTable().SetColorerFn(render.Flux{}.ColorerFunc())
+
+	return &f
+}
+
+func (f *Flux) bindKeys(aa ui.KeyActions) {
+	ns := f.GetTable().GetModel().GetNamespace()
+	if userCan(f.App(), ns, client.NewGVR(f.GVR()), client.PatchVerb) {
+		aa.Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Reconcile", f.reconcileCmd, true),
+			ui.KeyZ: ui.NewKeyAction("Suspend", f.suspendCmd(true), true),
+			ui.KeyU: ui.NewKeyAction("Resume", f.suspendCmd(false), true),
+		})
+	} else {
+		aa.Delete(ui.KeyR, ui.KeyZ, ui.KeyU)
+	}
+	aa[ui.KeyShiftR] = ui.NewKeyAction("Sort Ready", f.GetTable().SortColCmd(1, true), false)
+}
+
+func (f *Flux) reconcileCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := f.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := reconcile(f.App(), f.GVR(), path); err != nil {
+		f.App().Flash().Err(err)
+		return nil
+	}
+	f.App().Flash().Infof("Reconciliation requested for %s", path)
+
+	return nil
+}
+
+func (f *Flux) suspendCmd(suspend bool) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := f.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		res, err := dao.AccessorFor(f.App().factory, client.NewGVR(f.GVR()))
+		if err != nil {
+			f.App().Flash().Err(err)
+			return nil
+		}
+		s, ok := res.(dao.Suspendable)
+		if !ok {
+			f.App().Flash().Err(errors.New("resource is not suspendable"))
+			return nil
+		}
+		action, done := "Resume", "resumed"
+		if suspend {
+			action, done = "Suspend", "suspended"
+		}
+		msg := action + " reconciliation for " + path + "?"
+		dialog.ShowConfirm(f.App().Content.Pages, "<Confirm "+action+">", msg, func() {
+			if err := s.Suspend(path, suspend); err != nil {
+				f.App().Flash().Err(err)
+				return
+			}
+			f.App().Flash().Infof("Reconciliation %s for %s", done, path)
+		}, func() {})
+
+		return nil
+	}
+}
+
+// Argo represents an ArgoCD application viewer.
+type Argo struct {
+	ResourceViewer
+}
+
+// NewArgo returns a new viewer.
+func NewArgo(gvr client.GVR) ResourceViewer {
+	a := Argo{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.SetBindKeysFn(a.bindKeys)
+	a.GetTable().SetColorerFn(render.Argo{}.ColorerFunc())
+
+	return &a
+}
+
+func (a *Argo) bindKeys(aa ui.KeyActions) {
+	ns := a.GetTable().GetModel().GetNamespace()
+	if userCan(a.App(), ns, client.NewGVR(a.GVR()), client.PatchVerb) {
+		aa[ui.KeyR] = ui.NewKeyAction("Sync", a.syncCmd, true)
+	} else {
+		aa.Delete(ui.KeyR)
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Sync", a.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Sort Health", a.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func (a *Argo) syncCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := a.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	msg := "Sync application " + path + "?"
+	dialog.ShowConfirm(a.App().Content.Pages, "<Confirm Sync>", msg, func() {
+		if err := reconcile(a.App(), a.GVR(), path); err != nil {
+			a.App().Flash().Err(err)
+			return
+		}
+		a.App().Flash().Infof("Sync requested for %s", path)
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func reconcile(app *App, gvr, path string) error {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR(gvr))
+	if err != nil {
+		return err
+	}
+	r, ok := res.(dao.Reconcilable)
+	if !ok {
+		return errors.New("resource is not reconcilable")
+	}
+
+	return r.Reconcile(path)
+}
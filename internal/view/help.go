@@ -29,6 +29,7 @@ type HelpFunc func() model.MenuHints
 type Help struct {
 	*Table
 
+	top                      model.Component
 	maxKey, maxDesc, maxRows int
 }
 
@@ -44,6 +45,7 @@ func (h *Help) Init(ctx context.Context) error {
 	if err := h.Table.Init(ctx); err != nil {
 		return nil
 	}
+	h.top = h.app.Content.Top()
 	h.SetSelectable(false, false)
 	h.resetTitle()
 	h.SetBorder(true)
@@ -55,15 +57,94 @@ func (h *Help) Init(ctx context.Context) error {
 	return nil
 }
 
+// Start runs the component.
+func (h *Help) Start() {
+	h.Stop()
+	h.SearchBuff().AddListener(h.app.Cmd())
+	h.SearchBuff().AddListener(h)
+}
+
+// Stop terminates the component.
+func (h *Help) Stop() {
+	h.SearchBuff().RemoveListener(h.app.Cmd())
+	h.SearchBuff().RemoveListener(h)
+}
+
+// BufferChanged indicates the buffer was changed.
+func (h *Help) BufferChanged(s string) {
+	h.build()
+}
+
+// BufferActive indicates the buff activity changed.
+func (h *Help) BufferActive(state bool, k ui.BufferKind) {
+	h.app.BufferActive(state, k)
+}
+
 func (h *Help) bindKeys() {
-	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS)
+	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace)
 	h.Actions().Set(ui.KeyActions{
-		tcell.KeyEsc:   ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEsc:   ui.NewKeyAction("Back", h.resetCmd, false),
 		ui.KeyHelp:     ui.NewKeyAction("Back", h.app.PrevCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEnter: ui.NewKeyAction("Back", h.filterCmd, false),
+		tcell.KeyCtrlS: ui.NewKeyAction("Export", h.exportCmd, false),
 	})
 }
 
+func (h *Help) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !h.SearchBuff().InCmdMode() {
+		return h.app.PrevCmd(evt)
+	}
+	h.SearchBuff().Reset()
+
+	return nil
+}
+
+func (h *Help) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !h.SearchBuff().IsActive() {
+		return h.app.PrevCmd(evt)
+	}
+	h.SearchBuff().SetActive(false)
+
+	return nil
+}
+
+func (h *Help) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k := h.app.Config.K9s
+	path, err := dumpFile(k, newDump(k, "keymap", strings.ToLower(h.top.Name()), "md"), []byte(h.cheatSheet()))
+	if err != nil {
+		h.app.Flash().Err(err)
+		return nil
+	}
+	h.app.Flash().Infof("Keymap exported to %s", path)
+
+	return nil
+}
+
+// CheatSheet renders the keymap of the underlying view as markdown.
+func (h *Help) cheatSheet() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# K9s %s Keymap\n", h.top.Name())
+
+	hints := h.top.Hints()
+	for desc, key := range h.top.ExtraHints() {
+		hints = append(hints, model.MenuHint{Mnemonic: key, Description: desc})
+	}
+	hh := []model.MenuHints{hints, h.showGeneral(), h.showNav(), h.showHelp()}
+	titles := []string{"RESOURCE", "GENERAL", "NAVIGATION", "HELP"}
+	if hk, err := h.showHotKeys(); err == nil {
+		hh, titles = append(hh, hk), append(titles, "HOTKEYS")
+	}
+	for i, title := range titles {
+		sort.Sort(hh[i])
+		fmt.Fprintf(&b, "\n## %s\n\n| Key | Action |\n|-----|--------|\n", title)
+		for _, hint := range hh[i] {
+			fmt.Fprintf(&b, "| `%s` | %s |\n", toMnemonic(hint.Mnemonic), hint.Description)
+		}
+	}
+
+	return b.String()
+}
+
 func (h *Help) computeMaxes(hh model.MenuHints) {
 	h.maxKey, h.maxDesc = 0, 0
 	for _, hint := range hh {
@@ -88,20 +169,21 @@ func (h *Help) computeExtraMaxes(ee map[string]string) {
 
 func (h *Help) build() {
 	h.Clear()
+	h.resetTitle()
 
 	sections := []string{"RESOURCE", "GENERAL", "NAVIGATION", "HELP"}
 
-	h.maxRows = len(h.showGeneral())
+	h.maxRows = len(h.filter(h.showGeneral()))
 	ff := []HelpFunc{
-		h.app.Content.Top().Hints,
+		h.top.Hints,
 		h.showGeneral,
 		h.showNav,
 		h.showHelp,
 	}
 	var col int
-	extras := h.app.Content.Top().ExtraHints()
+	extras := h.filterExtras(h.top.ExtraHints())
 	for i, section := range sections {
-		hh := ff[i]()
+		hh := h.filter(ff[i]())
 		sort.Sort(hh)
 		h.computeMaxes(hh)
 		if extras != nil {
@@ -115,11 +197,43 @@ func (h *Help) build() {
 	}
 
 	if hh, err := h.showHotKeys(); err == nil {
+		hh = h.filter(hh)
 		h.computeMaxes(hh)
 		h.addSection(col, "HOTKEYS", hh)
 	}
 }
 
+// Filter returns the hints matching the current search term.
+func (h *Help) filter(hh model.MenuHints) model.MenuHints {
+	q := strings.ToLower(h.SearchBuff().String())
+	if q == "" {
+		return hh
+	}
+	mm := make(model.MenuHints, 0, len(hh))
+	for _, hint := range hh {
+		if helpMatches(q, hint.Mnemonic, hint.Description) {
+			mm = append(mm, hint)
+		}
+	}
+
+	return mm
+}
+
+func (h *Help) filterExtras(ee map[string]string) map[string]string {
+	q := strings.ToLower(h.SearchBuff().String())
+	if q == "" || ee == nil {
+		return ee
+	}
+	mm := make(map[string]string, len(ee))
+	for desc, key := range ee {
+		if helpMatches(q, key, desc) {
+			mm[desc] = key
+		}
+	}
+
+	return mm
+}
+
 func (h *Help) addExtras(extras map[string]string, col, size int) {
 	kk := make([]string, 0, len(extras))
 	for k := range extras {
@@ -258,6 +372,10 @@ func (h *Help) showGeneral() model.MenuHints {
 }
 
 func (h *Help) resetTitle() {
+	if q := h.SearchBuff().String(); q != "" {
+		h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle) + ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, q), h.App().Styles.Frame()))
+		return
+	}
 	h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
 }
 
@@ -301,6 +419,10 @@ func (h *Help) addSection(c int, title string, hh model.MenuHints) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func helpMatches(q, key, desc string) bool {
+	return strings.Contains(strings.ToLower(key), q) || strings.Contains(strings.ToLower(desc), q)
+}
+
 func toMnemonic(s string) string {
 	if len(s) == 0 {
 		return s
@@ -21,8 +21,26 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp()
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 19, v.GetRowCount())
+	assert.Equal(t, 25, v.GetRowCount())
 	assert.Equal(t, 8, v.GetColumnCount())
-	assert.Equal(t, "<ctrl-k>", strings.TrimSpace(v.GetCell(1, 0).Text))
-	assert.Equal(t, "Kill", strings.TrimSpace(v.GetCell(1, 1).Text))
+	assert.Equal(t, "<w>", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "API Proxy", strings.TrimSpace(v.GetCell(1, 1).Text))
+}
+
+func TestHelpFilter(t *testing.T) {
+	ctx := makeCtx()
+
+	app := ctx.Value(internal.KeyApp).(*view.App)
+	po := view.NewPod(client.NewGVR("v1/pods"))
+	po.Init(ctx)
+	app.Content.Push(po)
+
+	v := view.NewHelp()
+	assert.Nil(t, v.Init(ctx))
+	v.SearchBuff().Set("stale")
+	v.BufferChanged("stale")
+
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "<ctrl-g>", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "Clean Stale", strings.TrimSpace(v.GetCell(1, 1).Text))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -172,3 +174,126 @@ func fqn(ns, n string) string {
 	}
 	return ns + "/" + n
 }
+
+// SplitGVRPath splits a gvr/ns/name row id into a gvr and a resource path.
+func splitGVRPath(id string) (string, string) {
+	ns, n := client.Namespaced(id)
+	i := strings.LastIndex(ns, "/")
+	if i < 0 {
+		return ns, n
+	}
+
+	return ns[:i], client.FQN(ns[i+1:], n)
+}
+
+// userCan checks if the user may use a verb on a resource in a given namespace.
+// Unknown access rules grant everything and leave it to the api server.
+// In read only mode only get, list and watch verbs are granted.
+func userCan(a *App, ns string, gvr client.GVR, verb string) bool {
+	if a.Config.K9s.ReadOnly && !isReadVerb(verb) {
+		return false
+	}
+	rr, ok := a.Conn().(client.RulesReviewer)
+	if !ok {
+		return true
+	}
+	rules, err := rr.RulesFor(ns)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Rules review failed in %q", ns)
+		return true
+	}
+
+	return rules.Can(gvr, verb)
+}
+
+// preflight checks the user may use a verb on a pod sub resource before dialing in,
+// detailing any missing permission.
+func preflight(a *App, path, sub, verb string) bool {
+	if a.Conn() == nil {
+		return true
+	}
+	ns, _ := client.Namespaced(path)
+	err := client.Preflight(a.Conn(), ns, client.NewGVR("v1/pods:"+sub), verb)
+	if err == nil {
+		return true
+	}
+	if e, ok := err.(*client.AccessError); ok {
+		dialog.ShowAccessDenied(a.Content.Pages, e.Error(), e.Snippet())
+		return false
+	}
+	log.Warn().Err(err).Msgf("Preflight failed for %s on %s", sub, path)
+
+	return true
+}
+
+func isReadVerb(verb string) bool {
+	switch verb {
+	case client.GetVerb, client.ListVerb, client.WatchVerb:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextNamespace returns the favorite namespace delta steps away from the
+// current one. All namespaces always leads the cycle.
+func nextNamespace(favs []string, current string, delta int) string {
+	nss := []string{client.NamespaceAll}
+	for _, ns := range favs {
+		if ns != client.NamespaceAll {
+			nss = append(nss, ns)
+		}
+	}
+	if client.IsAllNamespaces(current) {
+		current = client.NamespaceAll
+	}
+	idx := -1
+	for i, ns := range nss {
+		if ns == current {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 && delta < 0 {
+		idx = 0
+	}
+
+	return nss[((idx+delta)%len(nss)+len(nss))%len(nss)]
+}
+
+// PaletteItems lists the described actions for the palette, app actions
+// first in precedence. It returns the key bound to each item.
+func paletteItems(aa ...ui.KeyActions) ([]dialog.PaletteItem, map[dialog.PaletteItem]tcell.Key) {
+	items := make([]dialog.PaletteItem, 0, 20)
+	keys := make(map[dialog.PaletteItem]tcell.Key)
+	seen := make(map[tcell.Key]struct{})
+	for _, actions := range aa {
+		for k, a := range actions {
+			if _, ok := seen[k]; ok || a.Description == "" {
+				continue
+			}
+			seen[k] = struct{}{}
+			name, ok := tcell.KeyNames[k]
+			if !ok {
+				continue
+			}
+			it := dialog.PaletteItem{Key: name, Description: a.Description}
+			items = append(items, it)
+			keys[it] = k
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Description < items[j].Description
+	})
+
+	return items, keys
+}
+
+// PaletteEvent synthesizes the key event triggering the given action key.
+func paletteEvent(k tcell.Key) *tcell.EventKey {
+	if k >= tcell.Key(' ') && k <= tcell.Key('~') {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
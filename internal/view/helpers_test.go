@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
@@ -108,3 +111,84 @@ func TestContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitGVRPath(t *testing.T) {
+	uu := map[string]struct {
+		id, gvr, path string
+	}{
+		"namespaced": {
+			id:   "extensions/v1beta1/ingresses/default/fred",
+			gvr:  "extensions/v1beta1/ingresses",
+			path: "default/fred",
+		},
+		"cluster": {
+			id:   "apiextensions.k8s.io/v1beta1/customresourcedefinitions/-/fred",
+			gvr:  "apiextensions.k8s.io/v1beta1/customresourcedefinitions",
+			path: "-/fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, path := splitGVRPath(u.id)
+			assert.Equal(t, u.gvr, gvr)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+func TestNextNamespace(t *testing.T) {
+	favs := []string{"all", "default", "kube-system"}
+	uu := map[string]struct {
+		current string
+		delta   int
+		e       string
+	}{
+		"next":        {"default", 1, "kube-system"},
+		"wrapNext":    {"kube-system", 1, "all"},
+		"prev":        {"default", -1, "all"},
+		"wrapPrev":    {"all", -1, "kube-system"},
+		"allNS":       {"", 1, "default"},
+		"unknownNext": {"fred", 1, "all"},
+		"unknownPrev": {"fred", -1, "kube-system"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nextNamespace(favs, u.current, u.delta))
+		})
+	}
+}
+
+func TestPaletteItems(t *testing.T) {
+	noop := func(evt *tcell.EventKey) *tcell.EventKey { return nil }
+	app := ui.KeyActions{
+		ui.KeyHelp: ui.NewSharedKeyAction("Help", noop, false),
+	}
+	view := ui.KeyActions{
+		ui.KeyHelp:     ui.NewKeyAction("Shadowed", noop, false),
+		ui.KeyD:        ui.NewKeyAction("Describe", noop, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", noop, true),
+		ui.KeyL:        ui.NewKeyAction("", noop, false),
+	}
+
+	items, keys := paletteItems(app, view)
+	assert.Equal(t, []dialog.PaletteItem{
+		{Key: "Ctrl-D", Description: "Delete"},
+		{Key: "d", Description: "Describe"},
+		{Key: "?", Description: "Help"},
+	}, items)
+	assert.Equal(t, tcell.KeyCtrlD, keys[items[0]])
+	assert.Equal(t, tcell.Key(ui.KeyHelp), keys[items[2]])
+}
+
+func TestPaletteEvent(t *testing.T) {
+	evt := paletteEvent(ui.KeyD)
+	assert.Equal(t, tcell.KeyRune, evt.Key())
+	assert.Equal(t, 'd', evt.Rune())
+
+	evt = paletteEvent(tcell.KeyCtrlD)
+	assert.Equal(t, tcell.KeyCtrlD, evt.Key())
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// HPA represents a horizontal pod autoscaler viewer.
+type HPA struct {
+	ResourceViewer
+}
+
+// NewHPA returns a new viewer.
+func NewHPA(gvr client.GVR) ResourceViewer {
+	h := HPA{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetEnterFn(h.showInsight)
+
+	return &h
+}
+
+func (h *HPA) showInsight(app *App, _ ui.Tabular, gvr, path string) {
+	showDiagnosis(app, gvr, path, "Insight")
+}
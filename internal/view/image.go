@@ -0,0 +1,33 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Image presents running container image versions.
+type Image struct {
+	ResourceViewer
+}
+
+// NewImage returns a new viewer.
+func NewImage(gvr client.GVR) ResourceViewer {
+	i := Image{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.SetBindKeysFn(i.bindKeys)
+	i.GetTable().SetColorerFn(render.Image{}.ColorerFunc())
+
+	return &i
+}
+
+func (i *Image) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD, tcell.KeyEnter)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftI: ui.NewKeyAction("Sort Image", i.GetTable().SortColCmd(-2, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Versions", i.GetTable().SortColCmd(2, false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Containers", i.GetTable().SortColCmd(3, false), false),
+	})
+}
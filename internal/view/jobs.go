@@ -0,0 +1,166 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	jobsMaxRows  = 5
+	jobsBarWidth = 20
+	jobsTTL      = 5 * time.Second
+	jobsRate     = 500 * time.Millisecond
+)
+
+var jobSpinner = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// JobFunc represents a long running operation. Returning context.Canceled
+// flags the job as canceled.
+type JobFunc func(ctx context.Context, job *model.Job) error
+
+// JobsPane presents the background jobs progress on every view.
+type JobsPane struct {
+	*tview.TextView
+
+	app  *App
+	tick int
+}
+
+// NewJobsPane returns a new jobs pane.
+func NewJobsPane(app *App) *JobsPane {
+	j := JobsPane{
+		TextView: tview.NewTextView(),
+		app:      app,
+	}
+	j.SetDynamicColors(true)
+	j.SetBorderPadding(0, 0, 1, 1)
+
+	return &j
+}
+
+// JobsChanged notifies the background jobs progressed.
+func (j *JobsPane) JobsChanged(ss []model.JobState) {
+	j.tick++
+	text := j.render(ss, time.Now())
+	rows := len(ss)
+	if rows > jobsMaxRows {
+		rows = jobsMaxRows
+	}
+
+	j.app.QueueUpdateDraw(func() {
+		j.SetBackgroundColor(j.app.Styles.BgColor())
+		j.SetText(text)
+		if flex, ok := j.app.Main.GetPrimitive("main").(*tview.Flex); ok {
+			flex.ResizeItem(j, rows, 1)
+		}
+	})
+}
+
+func (j *JobsPane) render(ss []model.JobState, now time.Time) string {
+	if len(ss) > jobsMaxRows {
+		ss = ss[len(ss)-jobsMaxRows:]
+	}
+	st := j.app.Styles.Frame().Status
+	ll := make([]string, 0, len(ss))
+	for _, s := range ss {
+		var icon, color string
+		switch {
+		case s.Canceled:
+			icon, color = "⊘", st.KillColor
+		case s.Err != nil:
+			icon, color = "✗", st.ErrorColor
+		case !s.Running():
+			icon, color = "✓", st.CompletedColor
+		default:
+			icon, color = jobSpinner[j.tick%len(jobSpinner)], st.HighlightColor
+		}
+		ll = append(ll, fmt.Sprintf("[%s::b]%s [%d] %s[-::-] %s", color, icon, s.ID, s.Name, jobDetails(s, now)))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func jobDetails(s model.JobState, now time.Time) string {
+	var ss []string
+	if s.Total > 0 {
+		ss = append(ss, progressBar(s.Done, s.Total, jobsBarWidth))
+	}
+	switch {
+	case s.Canceled:
+		ss = append(ss, "canceled")
+	case s.Err != nil:
+		ss = append(ss, s.Err.Error())
+	case s.Status != "":
+		ss = append(ss, s.Status)
+	}
+	end := now
+	if !s.Running() {
+		end = s.Ended
+	}
+	ss = append(ss, end.Sub(s.Started).Truncate(time.Second).String())
+	if s.Running() && !s.Deadline.IsZero() {
+		ss = append(ss, fmt.Sprintf("(%s left)", s.Deadline.Sub(now).Truncate(time.Second)))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func progressBar(done, total, width int) string {
+	if done > total {
+		done = total
+	}
+	fill := done * width / total
+
+	return fmt.Sprintf("%s%s %d/%d", strings.Repeat("█", fill), strings.Repeat("░", width-fill), done, total)
+}
+
+// RunJob runs a long running operation in the background, tracking its
+// progress in the jobs pane.
+func (a *App) runJob(name string, timeout time.Duration, fn JobFunc) {
+	tctx, done := a.tasks.Track(context.Background(), dao.TaskJob, name, "")
+	ctx, job := a.jobs.Start(tctx, name, timeout)
+	go func() {
+		defer done()
+		err := fn(ctx, job)
+		if err == context.Canceled || ctx.Err() == context.Canceled {
+			a.jobs.Cancel(job.ID())
+			return
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		job.Finish(err)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("%s failed: %s", name, err)
+				return
+			}
+			a.Flash().Infof("%s completed", name)
+		})
+	}()
+}
+
+func (a *App) cancelJobCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s, ok := a.jobs.Latest()
+	if !ok {
+		a.Flash().Info("No jobs in progress")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Cancel job [%d] %s?", s.ID, s.Name)
+	dialog.ShowConfirm(a.Content.Pages, "<Cancel Job>", msg, func() {
+		if a.jobs.Cancel(s.ID) {
+			a.Flash().Infof("Job %s canceled", s.Name)
+		}
+	}, func() {})
+
+	return nil
+}
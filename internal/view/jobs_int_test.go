@@ -0,0 +1,49 @@
+package view
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobDetails(t *testing.T) {
+	now := time.Now()
+	start := now.Add(-90 * time.Second)
+
+	uu := map[string]struct {
+		s model.JobState
+		e string
+	}{
+		"spinner": {
+			s: model.JobState{Started: start, Status: "kubectl apply"},
+			e: "kubectl apply 1m30s",
+		},
+		"progress": {
+			s: model.JobState{Started: start, Done: 1, Total: 4, Deadline: now.Add(time.Minute)},
+			e: "█████░░░░░░░░░░░░░░░ 1/4 1m30s (1m0s left)",
+		},
+		"failed": {
+			s: model.JobState{Started: start, Ended: start.Add(time.Second), Err: errors.New("boom")},
+			e: "boom 1s",
+		},
+		"canceled": {
+			s: model.JobState{Started: start, Ended: now, Canceled: true, Status: "blee"},
+			e: "canceled 1m30s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, jobDetails(u.s, now))
+		})
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "░░░░ 0/3", progressBar(0, 3, 4))
+	assert.Equal(t, "████ 3/3", progressBar(5, 3, 4))
+}
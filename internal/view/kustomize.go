@@ -0,0 +1,149 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const kustomizeTitle = "Kustomize"
+
+// Kustomize represents a kustomization build viewer.
+type Kustomize struct {
+	*Details
+
+	dir string
+}
+
+// NewKustomize returns a new kustomization viewer.
+func NewKustomize(app *App, dir string) *Kustomize {
+	return &Kustomize{
+		Details: NewDetails(app, kustomizeTitle, dir),
+		dir:     dir,
+	}
+}
+
+// Init initializes the viewer.
+func (k *Kustomize) Init(ctx context.Context) error {
+	if err := k.Details.Init(ctx); err != nil {
+		return err
+	}
+	raw, _, err := k.build()
+	if err != nil {
+		return err
+	}
+	k.Update(raw)
+	k.actions.Add(ui.KeyActions{
+		ui.KeyD: ui.NewKeyAction("Diff", k.diffCmd, true),
+		ui.KeyM: ui.NewKeyAction("Manifests", k.manifestsCmd, true),
+	})
+	// Kustomizations span many resources, the api server checks each one on apply.
+	if !k.app.Config.K9s.ReadOnly {
+		k.actions[ui.KeyA] = ui.NewKeyAction("Apply", k.applyCmd, true)
+	}
+
+	return nil
+}
+
+// Build renders the kustomization and saves the manifests to the file diff
+// and apply run against.
+func (k *Kustomize) build() (string, string, error) {
+	raw, err := dao.KustomizeBuild(k.dir)
+	if err != nil {
+		return "", "", err
+	}
+	file, err := saveYAML(k.app.Config.K9s, "kustomize", filepath.Base(k.dir), raw)
+	if err != nil {
+		return "", "", err
+	}
+
+	return raw, file, nil
+}
+
+func (k *Kustomize) manifestsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k.app.runJob("Build "+k.dir, 0, func(ctx context.Context, job *model.Job) error {
+		job.SetStatus("kustomize build")
+		raw, _, err := k.build()
+		if err != nil {
+			return err
+		}
+		k.show("", raw)
+
+		return nil
+	})
+
+	return nil
+}
+
+func (k *Kustomize) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k.app.runJob("Diff "+k.dir, 0, func(ctx context.Context, job *model.Job) error {
+		job.SetStatus("kustomize build")
+		_, file, err := k.build()
+		if err != nil {
+			return err
+		}
+		job.SetStatus("kubectl diff")
+		out, err := runKOutputContext(ctx, k.app, "diff", "-f", file)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// kubectl diff exits with 1 when differences are found.
+		if e, ok := err.(*exec.ExitError); err != nil && (!ok || e.ExitCode() != 1) {
+			return fmt.Errorf("diff failed %s", strings.TrimSpace(out))
+		}
+		if out == "" {
+			out = "No differences found."
+		}
+		k.show(":diff", out)
+
+		return nil
+	})
+
+	return nil
+}
+
+func (k *Kustomize) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	msg := "Apply kustomization " + k.dir + "?"
+	dialog.ShowConfirm(k.app.Content.Pages, "Confirm Apply", msg, func() {
+		k.app.runJob("Apply "+k.dir, 0, func(ctx context.Context, job *model.Job) error {
+			job.SetStatus("kustomize build")
+			_, file, err := k.build()
+			if err != nil {
+				return err
+			}
+			job.SetStatus("kubectl apply")
+			out, err := runKOutputContext(ctx, k.app, "apply", "-f", file)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				if msg := strings.TrimSpace(out); msg != "" {
+					return errors.New(msg)
+				}
+				return err
+			}
+			k.show(":applied", out)
+
+			return nil
+		})
+	}, func() {})
+
+	return nil
+}
+
+func (k *Kustomize) show(suffix, text string) {
+	k.app.QueueUpdateDraw(func() {
+		k.SetSubject(k.dir + suffix)
+		k.updateTitle()
+		k.Update(text)
+	})
+}
@@ -5,18 +5,19 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -24,12 +25,20 @@ const (
 	logMessage = "Waiting for logs..."
 	logCoFmt   = " Logs([fg:bg:]%s:[hilite:bg:b]%s[-:bg:-]) "
 	logFmt     = " Logs([fg:bg:]%s) "
+	logRateFmt = "[fg:bg:]<[count:bg:b]%.1f[fg:bg:-]/s [red:bg:b]%.1f[fg:bg:-] err/s> "
 
 	// BOZO!! Canned! Need config tail line counts!
 	tailLineCount  = 1000
 	defaultTimeout = 200 * time.Millisecond
 )
 
+// A collection of log line prefixes.
+const (
+	podPrefix = iota
+	containerPrefix
+	nodePrefix
+)
+
 // Log represents a generic log viewer.
 type Log struct {
 	*tview.Flex
@@ -40,6 +49,8 @@ type Log struct {
 	ansiWriter io.Writer
 	cmdBuff    *ui.CmdBuff
 	model      *model.Log
+	meter      *model.LogMeter
+	cancelFn   context.CancelFunc
 }
 
 var _ model.Component = (*Log)(nil)
@@ -84,7 +95,26 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	l.goFullScreen()
 
 	l.model.Init(l.app.factory)
+	if cfg := l.app.Config.LogBackend(); cfg != nil {
+		h, err := dao.NewLogHistory(cfg)
+		if err != nil {
+			l.app.Flash().Err(err)
+		} else {
+			l.model.SetHistory(h)
+		}
+	}
+	rx, err := l.app.Config.K9s.LogErrorRX()
+	if err != nil {
+		l.app.Flash().Errf("Invalid log error pattern %s", err)
+	}
+	l.meter = model.NewLogMeter(rx)
+	l.model.SetMeter(l.meter)
+	l.model.SetPrefix(l.app.logPrefix)
+	l.indicator.SetPrefix(l.app.logPrefix)
 	l.model.AddListener(l)
+	if l.app.notifier != nil {
+		l.model.SetAlerter(l.app.notifier.MatchLog)
+	}
 	l.updateTitle()
 
 	l.cmdBuff.AddListener(l.app.Cmd())
@@ -106,6 +136,24 @@ func (l *Log) LogFailed(err error) {
 	l.app.Flash().Err(err)
 }
 
+// LogSourceGone offers to resume the logs elsewhere once the pod is gone.
+func (l *Log) LogSourceGone(s model.LogSuccession) {
+	if s.Successor == "" && s.OwnerPath == "" {
+		l.app.Flash().Warnf("Pod %s is gone", s.Path)
+		return
+	}
+	gvr := l.model.GVR()
+	l.app.QueueUpdateDraw(func() {
+		dialog.ShowPodGone(l.app.Content.Pages, s.Path, s.Successor, s.OwnerPath, func() {
+			l.model.Switch(gvr, s.Successor, l.model.GetContainer())
+			l.updateTitle()
+		}, func() {
+			l.model.Switch(client.NewGVR(s.OwnerGVR), s.OwnerPath, "")
+			l.updateTitle()
+		})
+	})
+}
+
 // LogChanged updates the logs.
 func (l *Log) LogChanged(lines []string) {
 	l.app.QueueUpdateDraw(func() {
@@ -145,12 +193,19 @@ func (l *Log) ExtraHints() map[string]string {
 
 // Start runs the component.
 func (l *Log) Start() {
+	var ctx context.Context
+	ctx, l.cancelFn = context.WithCancel(context.Background())
+	go l.updateRates(ctx)
 	l.model.Start()
 	l.app.SetFocus(l)
 }
 
 // Stop terminates the component.
 func (l *Log) Stop() {
+	if l.cancelFn != nil {
+		l.cancelFn()
+		l.cancelFn = nil
+	}
 	l.model.Stop()
 	l.model.RemoveListener(l)
 	l.app.Styles.RemoveListener(l)
@@ -161,6 +216,11 @@ func (l *Log) Stop() {
 // Name returns the component name.
 func (l *Log) Name() string { return logTitle }
 
+// Actions returns the log view key actions.
+func (l *Log) Actions() ui.KeyActions {
+	return l.logs.Actions()
+}
+
 func (l *Log) bindKeys() {
 	l.logs.Actions().Set(ui.KeyActions{
 		tcell.KeyEnter:      ui.NewSharedKeyAction("Filter", l.filterCmd, false),
@@ -169,6 +229,10 @@ func (l *Log) bindKeys() {
 		ui.KeyS:             ui.NewKeyAction("Toggle AutoScroll", l.ToggleAutoScrollCmd, true),
 		ui.KeyF:             ui.NewKeyAction("FullScreen", l.fullScreenCmd, true),
 		ui.KeyW:             ui.NewKeyAction("Toggle Wrap", l.textWrapCmd, true),
+		ui.KeyA:             ui.NewKeyAction("Toggle ANSI", l.ansiCmd, true),
+		ui.KeyShiftP:        ui.NewKeyAction("Toggle Pod", l.prefixCmd(podPrefix), true),
+		ui.KeyShiftC:        ui.NewKeyAction("Toggle Container", l.prefixCmd(containerPrefix), true),
+		ui.KeyShiftN:        ui.NewKeyAction("Toggle Node", l.prefixCmd(nodePrefix), true),
 		tcell.KeyCtrlS:      ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", l.activateCmd, false),
 		tcell.KeyCtrlU:      ui.NewSharedKeyAction("Clear Filter", l.clearCmd, false),
@@ -210,12 +274,20 @@ func (l *Log) Indicator() *LogIndicator {
 func (l *Log) updateTitle() {
 	var fmat string
 	path, co := l.model.GetPath(), l.model.GetContainer()
+	if render.Anonymous {
+		path = render.AnonymizePath(path)
+	}
 	if co == "" {
 		fmat = ui.SkinTitle(fmt.Sprintf(logFmt, path), l.app.Styles.Frame())
 	} else {
 		fmat = ui.SkinTitle(fmt.Sprintf(logCoFmt, path, co), l.app.Styles.Frame())
 	}
 
+	if l.meter != nil {
+		lines, errs := l.meter.Rates(time.Now())
+		fmat += ui.SkinTitle(fmt.Sprintf(logRateFmt, lines, errs), l.app.Styles.Frame())
+	}
+
 	buff := l.cmdBuff.String()
 	if buff != "" {
 		fmat += ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, buff), l.app.Styles.Frame())
@@ -223,12 +295,27 @@ func (l *Log) updateTitle() {
 	l.SetTitle(fmat)
 }
 
+// UpdateRates refreshes the log rates in the title.
+func (l *Log) updateRates(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+			l.app.QueueUpdateDraw(l.updateTitle)
+		}
+	}
+}
+
 // Logs returns the log viewer.
 func (l *Log) Logs() *Details {
 	return l.logs
 }
 
 func (l *Log) write(lines string) {
+	if l.indicator.StripANSI() {
+		lines = color.StripANSI(lines)
+	}
 	fmt.Fprintln(l.ansiWriter, tview.Escape(lines))
 }
 
@@ -236,7 +323,10 @@ func (l *Log) write(lines string) {
 func (l *Log) Flush(lines []string) {
 	l.write(strings.Join(lines, "\n"))
 	l.indicator.Refresh()
-	l.logs.ScrollToEnd()
+	// Keep long lines scrolled horizontally while new lines come in.
+	if l.logs.ScrolledLeft() {
+		l.logs.ScrollToEnd()
+	}
 }
 
 // ----------------------------------------------------------------------------
@@ -297,7 +387,7 @@ func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 // SaveCmd dumps the logs to file.
 func (l *Log) SaveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveData(l.app.Config.K9s.CurrentCluster, l.model.GetPath(), l.logs.GetText(true)); err != nil {
+	if path, err := saveData(l.app.Config.K9s, l.model.GetPath(), l.logs.GetText(true)); err != nil {
 		l.app.Flash().Err(err)
 	} else {
 		l.app.Flash().Infof("Log %s saved successfully!", path)
@@ -309,43 +399,58 @@ func ensureDir(dir string) error {
 	return os.MkdirAll(dir, 0744)
 }
 
-func saveData(cluster, name, data string) (string, error) {
-	dir := filepath.Join(config.K9sDumpDir, cluster)
-	if err := ensureDir(dir); err != nil {
-		return "", err
-	}
+func saveData(k *config.K9s, path, data string) (string, error) {
+	return dumpFile(k, newDump(k, "logs", path, "log"), []byte(data))
+}
 
-	now := time.Now().UnixNano()
-	fName := fmt.Sprintf("%s-%d.log", strings.Replace(name, "/", "-", -1), now)
+func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
+	l.app.Flash().Info("Clearing logs...")
+	l.model.Clear()
+	return nil
+}
 
-	path := filepath.Join(dir, fName)
-	mod := os.O_CREATE | os.O_WRONLY
-	file, err := os.OpenFile(path, mod, 0600)
-	if err != nil {
-		log.Error().Err(err).Msgf("LogFile create %s", path)
-		return "", nil
-	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.Error().Err(err).Msg("Closing Log file")
+// PrefixCmd toggles a log line metadata prefix. Prefixes are remembered for
+// the session.
+func (l *Log) prefixCmd(kind int) func(*tcell.EventKey) *tcell.EventKey {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		p := l.app.logPrefix
+		if p == nil {
+			p = &dao.LogPrefix{
+				Pod:       !l.model.IsPodLog(),
+				Container: l.model.GetContainer() == "",
+			}
+			l.app.logPrefix = p
+		}
+		switch kind {
+		case podPrefix:
+			p.Pod = !p.Pod
+		case containerPrefix:
+			p.Container = !p.Container
+		case nodePrefix:
+			p.Node = !p.Node
+		}
+		l.model.SetPrefix(p)
+		l.indicator.SetPrefix(p)
+		if l.indicator.AutoScroll() {
+			l.model.Restart()
 		}
-	}()
-	if _, err := file.Write([]byte(data)); err != nil {
-		return "", err
-	}
 
-	return path, nil
+		return nil
+	}
 }
 
-func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
-	l.app.Flash().Info("Clearing logs...")
-	l.model.Clear()
+func (l *Log) ansiCmd(*tcell.EventKey) *tcell.EventKey {
+	l.indicator.ToggleANSI()
+	l.model.Refresh()
 	return nil
 }
 
 func (l *Log) textWrapCmd(*tcell.EventKey) *tcell.EventKey {
 	l.indicator.ToggleTextWrap()
 	l.logs.SetWrap(l.indicator.textWrap)
+	if l.indicator.textWrap && l.indicator.AutoScroll() {
+		l.logs.ScrollToEnd()
+	}
 	return nil
 }
 
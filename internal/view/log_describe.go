@@ -0,0 +1,154 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const logDescribeTitle = "logs+describe"
+
+// LogDescribe presents a resource live logs along side its description.
+type LogDescribe struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	path     string
+	logs     *Log
+	details  *Details
+	cancelFn context.CancelFunc
+}
+
+// NewLogDescribe returns a new split logs and describe viewer.
+func NewLogDescribe(gvr client.GVR, path, co string) *LogDescribe {
+	return &LogDescribe{
+		Flex: tview.NewFlex(),
+		gvr:  gvr,
+		path: path,
+		logs: NewLog(gvr, path, co, false),
+	}
+}
+
+// Init initializes the viewer.
+func (l *LogDescribe) Init(ctx context.Context) (err error) {
+	if l.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	if err = l.logs.Init(ctx); err != nil {
+		return err
+	}
+	l.details = NewDetails(l.app, "Describe", l.path)
+	if err = l.details.Init(ctx); err != nil {
+		return err
+	}
+
+	l.SetDirection(tview.FlexColumn)
+	l.AddItem(l.logs, 0, 1, true)
+	l.AddItem(l.details, 0, 1, false)
+	switchPane := ui.NewKeyAction("Switch Pane", l.switchPaneCmd, true)
+	l.logs.Actions()[tcell.KeyTab] = switchPane
+	l.details.Actions()[tcell.KeyTab] = switchPane
+
+	return nil
+}
+
+// Name returns the component name.
+func (l *LogDescribe) Name() string { return logDescribeTitle }
+
+// Start runs the component.
+func (l *LogDescribe) Start() {
+	l.logs.Start()
+	var ctx context.Context
+	ctx, l.cancelFn = context.WithCancel(context.Background())
+	go l.refresh(ctx)
+}
+
+// Stop terminates the component.
+func (l *LogDescribe) Stop() {
+	if l.cancelFn != nil {
+		l.cancelFn()
+		l.cancelFn = nil
+	}
+	l.logs.Stop()
+	l.details.Stop()
+}
+
+// Hints returns the focused pane menu hints.
+func (l *LogDescribe) Hints() model.MenuHints {
+	return l.Actions().Hints()
+}
+
+// ExtraHints returns additional hints.
+func (l *LogDescribe) ExtraHints() map[string]string {
+	return nil
+}
+
+// Actions returns the focused pane actions.
+func (l *LogDescribe) Actions() ui.KeyActions {
+	if l.details.HasFocus() {
+		return l.details.Actions()
+	}
+
+	return l.logs.Actions()
+}
+
+func (l *LogDescribe) switchPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.details.HasFocus() {
+		l.app.SetFocus(l.logs)
+	} else {
+		l.app.SetFocus(l.details)
+	}
+	l.app.Menu().HydrateMenu(l.Hints())
+
+	return nil
+}
+
+// Refresh updates the description at the configured refresh rate.
+func (l *LogDescribe) refresh(ctx context.Context) {
+	rate := time.Duration(l.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		l.describe()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+func (l *LogDescribe) describe() {
+	desc, err := dao.Describe(l.app.Conn(), describeGVR(l.gvr), l.path)
+	if err != nil {
+		log.Error().Err(err).Msgf("Describe %s failed", l.path)
+		l.app.Flash().Errf("Describe command failed: %s", err)
+		return
+	}
+	l.app.QueueUpdateDraw(func() {
+		if desc == l.details.buff {
+			return
+		}
+		row, col := l.details.GetScrollOffset()
+		l.details.Update(desc)
+		l.details.ScrollTo(row, col)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DescribeGVR returns the resource to describe. Containers describe their pod.
+func describeGVR(gvr client.GVR) client.GVR {
+	if gvr.String() == "containers" {
+		return client.NewGVR("v1/pods")
+	}
+
+	return gvr
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeGVR(t *testing.T) {
+	uu := map[string]struct {
+		gvr, e string
+	}{
+		"pods":       {gvr: "v1/pods", e: "v1/pods"},
+		"containers": {gvr: "containers", e: "v1/pods"},
+		"deploys":    {gvr: "apps/v1/deployments", e: "apps/v1/deployments"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, describeGVR(client.NewGVR(u.gvr)).String())
+		})
+	}
+}
@@ -2,9 +2,11 @@ package view
 
 import (
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/tview"
 )
 
@@ -16,6 +18,8 @@ type LogIndicator struct {
 	scrollStatus int32
 	fullScreen   bool
 	textWrap     bool
+	stripANSI    bool
+	prefix       string
 }
 
 // NewLogIndicator returns a new indicator.
@@ -60,6 +64,38 @@ func (l *LogIndicator) ToggleTextWrap() {
 	l.Refresh()
 }
 
+// StripANSI reports if ANSI colors are stripped.
+func (l *LogIndicator) StripANSI() bool {
+	return l.stripANSI
+}
+
+// ToggleANSI toggles the ANSI colors mode.
+func (l *LogIndicator) ToggleANSI() {
+	l.stripANSI = !l.stripANSI
+	l.Refresh()
+}
+
+// SetPrefix reports the log lines metadata prefix if any.
+func (l *LogIndicator) SetPrefix(p *dao.LogPrefix) {
+	if p == nil {
+		l.prefix = ""
+		return
+	}
+	tags := make([]string, 0, 3)
+	for _, t := range []struct {
+		on  bool
+		tag string
+	}{{p.Node, "no"}, {p.Pod, "po"}, {p.Container, "co"}} {
+		if t.on {
+			tags = append(tags, t.tag)
+		}
+	}
+	l.prefix = "Off"
+	if len(tags) > 0 {
+		l.prefix = strings.Join(tags, ":")
+	}
+}
+
 // ToggleAutoScroll toggles the scroll mode.
 func (l *LogIndicator) ToggleAutoScroll() {
 	var val int32 = 1
@@ -76,6 +112,10 @@ func (l *LogIndicator) Refresh() {
 	l.update("Autoscroll: " + l.onOff(l.AutoScroll()))
 	l.update("FullScreen: " + l.onOff(l.fullScreen))
 	l.update("Wrap: " + l.onOff(l.textWrap))
+	l.update("ANSI: " + l.onOff(!l.stripANSI))
+	if l.prefix != "" {
+		l.update("Prefix: " + l.prefix)
+	}
 }
 
 func (l *LogIndicator) onOff(b bool) string {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/stretchr/testify/assert"
 )
@@ -13,5 +14,14 @@ func TestLogIndicatorRefresh(t *testing.T) {
 	v := view.NewLogIndicator(config.NewConfig(nil), defaults)
 	v.Refresh()
 
-	assert.Equal(t, "[black:orange:b] Autoscroll: On  [black:orange:b] FullScreen: Off [black:orange:b] Wrap: Off       \n", v.GetText(false))
+	assert.Equal(t, "[black:orange:b] Autoscroll: On  [black:orange:b] FullScreen: Off [black:orange:b] Wrap: Off       [black:orange:b] ANSI: On        \n", v.GetText(false))
+}
+
+func TestLogIndicatorPrefix(t *testing.T) {
+	defaults := config.NewStyles()
+	v := view.NewLogIndicator(config.NewConfig(nil), defaults)
+	v.SetPrefix(&dao.LogPrefix{Pod: true, Node: true})
+	v.Refresh()
+
+	assert.Equal(t, "[black:orange:b] Autoscroll: On  [black:orange:b] FullScreen: Off [black:orange:b] Wrap: Off       [black:orange:b] ANSI: On        [black:orange:b] Prefix: no:po   \n", v.GetText(false))
 }
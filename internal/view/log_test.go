@@ -34,10 +34,10 @@ func TestLogAutoScroll(t *testing.T) {
 	v.GetModel().Set([]string{"blee", "bozo"})
 	v.GetModel().Notify(true)
 
-	assert.Equal(t, 6, len(v.Hints()))
+	assert.Equal(t, 13, len(v.Hints()))
 
 	v.ToggleAutoScrollCmd(nil)
-	assert.Equal(t, " Autoscroll: Off  FullScreen: Off  Wrap: Off       ", v.Indicator().GetText(true))
+	assert.Equal(t, " Autoscroll: Off  FullScreen: Off  Wrap: Off        ANSI: On        ", v.Indicator().GetText(true))
 }
 
 func TestLogViewSave(t *testing.T) {
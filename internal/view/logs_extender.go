@@ -29,6 +29,7 @@ func (l *LogsExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyL:      ui.NewKeyAction("Logs", l.logsCmd(false), true),
 		ui.KeyShiftL: ui.NewKeyAction("Logs Previous", l.logsCmd(true), true),
+		ui.KeyM:      ui.NewKeyAction("Logs+Describe", l.logDescribeCmd, true),
 	})
 }
 
@@ -47,25 +48,54 @@ func (l *LogsExtender) logsCmd(prev bool) func(evt *tcell.EventKey) *tcell.Event
 	}
 }
 
+func (l *LogsExtender) logDescribeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := l.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	if !isResourcePath(path) {
+		path = l.GetTable().Path
+	}
+	if !l.canLog(path) {
+		return nil
+	}
+	if err := l.App().inject(NewLogDescribe(client.NewGVR(l.GVR()), path, l.container())); err != nil {
+		l.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func isResourcePath(p string) bool {
 	ns, n := client.Namespaced(p)
 	return ns != "" && n != ""
 }
 
 func (l *LogsExtender) showLogs(path string, prev bool) {
+	if !l.canLog(path) {
+		return
+	}
+	if err := l.App().inject(NewLog(client.NewGVR(l.GVR()), path, l.container(), prev)); err != nil {
+		l.App().Flash().Err(err)
+	}
+}
+
+func (l *LogsExtender) canLog(path string) bool {
 	// Need to load and wait for pods
 	ns, _ := client.Namespaced(path)
 	_, err := l.App().factory.CanForResource(ns, "v1/pods", client.MonitorAccess)
 	if err != nil {
 		l.App().Flash().Err(err)
-		return
+		return false
 	}
 
-	co := ""
-	if l.containerFn != nil {
-		co = l.containerFn()
-	}
-	if err := l.App().inject(NewLog(client.NewGVR(l.GVR()), path, co, prev)); err != nil {
-		l.App().Flash().Err(err)
+	return preflight(l.App(), path, "log", client.GetVerb)
+}
+
+func (l *LogsExtender) container() string {
+	if l.containerFn == nil {
+		return ""
 	}
+
+	return l.containerFn()
 }
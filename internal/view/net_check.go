@@ -0,0 +1,57 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"sigs.k8s.io/yaml"
+)
+
+const netCheckTimeout = 2 * time.Minute
+
+// showNetCheck prompts for a network check to run from a pod container.
+func showNetCheck(app *App, path, co string) {
+	dialog.ShowNetCheck(app.Content.Pages, path, dao.NetChecks, func(kind, target string, netshoot bool) {
+		runNetCheck(app, path, co, kind, target, netshoot)
+	})
+}
+
+// runNetCheck runs a network check in the background and shows its results.
+// Checks run in an injected netshoot container when the pod lacks tooling.
+func runNetCheck(app *App, path, co, kind, target string, netshoot bool) {
+	name := fmt.Sprintf("NetTest %s %s", kind, target)
+	app.runJob(name, netCheckTimeout, func(ctx context.Context, job *model.Job) error {
+		if netshoot {
+			job.SetStatus("injecting")
+			var err error
+			if co, err = dao.InjectNetshoot(ctx, app.Conn(), path, dao.NetshootImage); err != nil {
+				return err
+			}
+		}
+		job.SetStatus("checking")
+		res, err := dao.RunNetCheck(ctx, app.Conn(), path, co, kind, target)
+		if err != nil {
+			return err
+		}
+		raw, err := yaml.Marshal(res)
+		if err != nil {
+			return err
+		}
+		app.QueueUpdateDraw(func() {
+			details := NewDetails(app, "NetTest", path).Update(strings.TrimSpace(string(raw)))
+			if err := app.inject(details); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+		if !res.OK {
+			return fmt.Errorf("%s check against %s failed", kind, target)
+		}
+
+		return nil
+	})
+}
@@ -36,7 +36,9 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 }
 
 func (n *Node) showPods(app *App, _ ui.Tabular, _, path string) {
-	showPods(app, n.GetTable().GetSelectedItem(), "", "spec.nodeName="+path)
+	if err := app.inject(NewNodePods(path)); err != nil {
+		app.Flash().Err(err)
+	}
 }
 
 func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
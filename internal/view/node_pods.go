@@ -0,0 +1,58 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// NodePods presents the pods running on a node along with their kubelet stats.
+type NodePods struct {
+	ResourceViewer
+
+	node string
+}
+
+// NewNodePods returns a new viewer for the node located at path.
+func NewNodePods(path string) *NodePods {
+	n := NodePods{
+		ResourceViewer: NewBrowser(client.NewGVR("nodepods")),
+		node:           path,
+	}
+	n.SetBindKeysFn(n.bindKeys)
+	n.SetContextFn(n.nodeCtx)
+	n.GetTable().SetEnterFn(n.gotoPod)
+	n.GetTable().SetColorerFn(render.NodePods{}.ColorerFunc())
+
+	return &n
+}
+
+func (n *NodePods) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Pods View", n.podsCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(4, false), false),
+	})
+}
+
+func (n *NodePods) nodeCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, n.node)
+}
+
+func (n *NodePods) podsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	showPods(n.App(), n.node, "", "spec.nodeName="+n.node)
+
+	return nil
+}
+
+func (n *NodePods) gotoPod(app *App, _ ui.Tabular, _, path string) {
+	if path == render.NodePodTotal || path == render.NodePodAllocatable {
+		return
+	}
+	gotoRelated(app, nil, "", path)
+}
@@ -0,0 +1,43 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Ordinal presents a statefulset pods and claims per ordinal.
+type Ordinal struct {
+	ResourceViewer
+}
+
+// NewOrdinal returns a new viewer for the statefulset located at path.
+func NewOrdinal(path string) *Ordinal {
+	o := Ordinal{
+		ResourceViewer: NewBrowser(client.NewGVR("ordinals")),
+	}
+	o.SetBindKeysFn(o.bindKeys)
+	o.SetContextFn(ordinalCtx(path))
+	o.GetTable().SetEnterFn(gotoRelated)
+	o.GetTable().SetColorerFn(render.Ordinal{}.ColorerFunc())
+
+	return &o
+}
+
+func (o *Ordinal) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Ordinal", o.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", o.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func ordinalCtx(path string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	}
+}
@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	overviewTitle      = "Overview"
+	overviewMinRefresh = 5 * time.Second
+)
+
+// Overview presents a cluster landing dashboard combining cluster info,
+// problem counts, recent warnings, node pressure and pinned resources.
+type Overview struct {
+	*Details
+}
+
+// NewOverview returns a new overview viewer.
+func NewOverview(app *App) *Overview {
+	return &Overview{
+		Details: NewDetails(app, overviewTitle, app.Config.K9s.CurrentContext),
+	}
+}
+
+// Init initializes the viewer.
+func (o *Overview) Init(ctx context.Context) error {
+	if err := o.Details.Init(ctx); err != nil {
+		return err
+	}
+	o.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", o.refreshCmd, true),
+	})
+	o.Update("Loading overview...")
+
+	return nil
+}
+
+// Start starts refreshing the overview.
+func (o *Overview) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	o.SetCancelFn(cancel)
+	go o.updater(ctx)
+}
+
+func (o *Overview) updater(ctx context.Context) {
+	rate := time.Duration(o.app.Config.K9s.GetRefreshRate()) * time.Second
+	if rate < overviewMinRefresh {
+		rate = overviewMinRefresh
+	}
+	for {
+		o.refresh()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+func (o *Overview) refresh() {
+	cfg := o.app.Config.K9s.OverviewConfig()
+	ov := dao.BuildOverview(o.app.factory, cfg, o.app.pinModel.Pins())
+	if cfg.Shows(config.OverviewCluster) {
+		c := model.NewCluster(o.app.factory)
+		ov.Cluster = &dao.OverviewCluster{
+			Context: clusterName(c.ContextName()),
+			Cluster: clusterName(c.ClusterName()),
+			User:    clusterName(c.UserName()),
+			Version: c.Version(),
+		}
+	}
+	raw, err := overviewYAML(ov, cfg.Sections)
+	if err != nil {
+		o.app.Flash().Err(err)
+		return
+	}
+	o.app.QueueUpdateDraw(func() {
+		row, col := o.GetScrollOffset()
+		o.Update(raw)
+		o.ScrollTo(row, col)
+	})
+}
+
+func (o *Overview) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go o.refresh()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OverviewYAML renders the overview sections in the configured order.
+func overviewYAML(ov dao.Overview, sections []string) (string, error) {
+	var bb []string
+	for _, s := range sections {
+		var v interface{}
+		switch s {
+		case config.OverviewCluster:
+			v = ov.Cluster
+		case config.OverviewProblems:
+			v = ov.Problems
+		case config.OverviewEvents:
+			v = orNone(ov.Warnings)
+		case config.OverviewNodes:
+			v = orNone(ov.Pressure)
+		case config.OverviewPins:
+			v = orNone(ov.Pins)
+		}
+		raw, err := yaml.Marshal(map[string]interface{}{overviewSection(s): v})
+		if err != nil {
+			return "", err
+		}
+		bb = append(bb, strings.TrimSpace(string(raw)))
+	}
+
+	return strings.Join(bb, "\n\n"), nil
+}
+
+func overviewSection(s string) string {
+	switch s {
+	case config.OverviewEvents:
+		return "warnings"
+	case config.OverviewNodes:
+		return "nodePressure"
+	default:
+		return s
+	}
+}
+
+func orNone(ss []string) []string {
+	if len(ss) == 0 {
+		return []string{}
+	}
+
+	return ss
+}
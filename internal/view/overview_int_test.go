@@ -0,0 +1,44 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverviewYAML(t *testing.T) {
+	ov := dao.Overview{
+		Cluster:  &dao.OverviewCluster{Context: "ctx1", Cluster: "c1", User: "fred", Version: "v1.16.0"},
+		Problems: map[string]int{"pods": 2, "nodes": 0},
+		Warnings: []string{"2m ago Pod default/fred BackOff: boom"},
+	}
+
+	uu := map[string]struct {
+		sections []string
+		e        string
+	}{
+		"all": {
+			sections: config.OverviewSections,
+			e: "cluster:\n  cluster: c1\n  context: ctx1\n  user: fred\n  version: v1.16.0\n\n" +
+				"problems:\n  nodes: 0\n  pods: 2\n\n" +
+				"warnings:\n- '2m ago Pod default/fred BackOff: boom'\n\n" +
+				"nodePressure: []\n\n" +
+				"pins: []",
+		},
+		"ordered": {
+			sections: []string{config.OverviewEvents, config.OverviewProblems},
+			e:        "warnings:\n- '2m ago Pod default/fred BackOff: boom'\n\nproblems:\n  nodes: 0\n  pods: 2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := overviewYAML(ov, u.sections)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, raw)
+		})
+	}
+}
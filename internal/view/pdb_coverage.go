@@ -0,0 +1,41 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// PDBCoverage represents a workloads disruption budget coverage view.
+type PDBCoverage struct {
+	ResourceViewer
+}
+
+// NewPDBCoverage returns a new viewer.
+func NewPDBCoverage(gvr client.GVR) ResourceViewer {
+	p := PDBCoverage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.gotoWorkload)
+	p.GetTable().SetColorerFn(render.PDBCoverage{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PDBCoverage) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", p.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Disruptions", p.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Risk", p.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+func (p *PDBCoverage) gotoWorkload(app *App, _ ui.Tabular, _, path string) {
+	gvr, fqn := splitGVRPath(path)
+	if err := app.viewResource(client.NewGVR(gvr).R(), fqn, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Pinboard presents the pinned resources.
+type Pinboard struct {
+	ResourceViewer
+}
+
+// NewPinboard returns a new viewer.
+func NewPinboard(gvr client.GVR) ResourceViewer {
+	p := Pinboard{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.pinsCtx)
+	p.GetTable().SetEnterFn(gotoRelated)
+	p.GetTable().SetColorerFn(render.Pin{}.ColorerFunc())
+	p.GetTable().MaskRows(true)
+
+	return &p
+}
+
+func (p *Pinboard) pinsCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPins, p.App().pinModel.Pins())
+}
+
+func (p *Pinboard) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyB:      ui.NewKeyAction("Unpin", p.unpinCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", p.GetTable().SortColCmd(0, true), false),
+	})
+}
+
+func (p *Pinboard) unpinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	gvr, path := splitGVRPath(id)
+	togglePin(p.App(), gvr, path)
+	p.Refresh()
+
+	return nil
+}
+
+// togglePin pins or unpins a resource on the active cluster.
+func togglePin(app *App, gvr, path string) {
+	cl := app.Config.K9s.ActiveCluster()
+	if cl == nil {
+		app.Flash().Err(fmt.Errorf("no active cluster"))
+		return
+	}
+	r := client.NewGVR(gvr).R()
+	if cl.TogglePin(gvr, path) {
+		app.Flash().Infof("Pinned %s %s", r, path)
+	} else {
+		app.Flash().Infof("Unpinned %s %s", r, path)
+	}
+	if err := app.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+	app.pinModel.SetPins(app.Config.Pins())
+	go app.refreshPins()
+}
+
+// ----------------------------------------------------------------------------
+// Pin bar...
+
+// PinBar presents the pinned resources readiness on every view.
+type PinBar struct {
+	*tview.TextView
+
+	app     *App
+	mx      sync.Mutex
+	flipped map[string]struct{}
+}
+
+// NewPinBar returns a new pin bar.
+func NewPinBar(app *App) *PinBar {
+	p := PinBar{
+		TextView: tview.NewTextView(),
+		app:      app,
+		flipped:  make(map[string]struct{}),
+	}
+	p.SetDynamicColors(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+
+	return &p
+}
+
+// PinTransitioned notifies a pinned resource readiness flipped.
+func (p *PinBar) PinTransitioned(r render.PinRes) {
+	p.mx.Lock()
+	p.flipped[client.FQN(r.GVR, r.Path)] = struct{}{}
+	p.mx.Unlock()
+	if r.Ready {
+		p.app.Flash().Infof("📌 %s %s is ready (%s)", client.NewGVR(r.GVR).R(), r.Path, r.Status)
+		return
+	}
+	p.app.Flash().Warnf("📌 %s %s is not ready (%s)", client.NewGVR(r.GVR).R(), r.Path, r.Status)
+}
+
+// PinboardChanged notifies the pinned resources status changed.
+func (p *PinBar) PinboardChanged(rr []render.PinRes) {
+	p.mx.Lock()
+	text := p.render(rr)
+	p.flipped = make(map[string]struct{})
+	p.mx.Unlock()
+
+	p.app.QueueUpdateDraw(func() {
+		p.SetBackgroundColor(p.app.Styles.BgColor())
+		p.SetText(text)
+		if flex, ok := p.app.Main.GetPrimitive("main").(*tview.Flex); ok {
+			if len(rr) == 0 {
+				flex.ResizeItem(p, 0, 0)
+			} else {
+				flex.ResizeItem(p, 1, 1)
+			}
+		}
+	})
+}
+
+func (p *PinBar) render(rr []render.PinRes) string {
+	if len(rr) == 0 {
+		return ""
+	}
+	st := p.app.Styles.Frame().Status
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		color, attr := st.NewColor, "-"
+		if !r.Ready {
+			color = st.ErrorColor
+		}
+		if _, ok := p.flipped[client.FQN(r.GVR, r.Path)]; ok {
+			color, attr = st.HighlightColor, "b"
+		}
+		ss = append(ss, fmt.Sprintf("[%s::%s]%s %s %s[-::-]", color, attr, client.NewGVR(r.GVR).R(), r.Path, r.Status))
+	}
+
+	return "📌 " + strings.Join(ss, " │ ")
+}
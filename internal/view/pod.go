@@ -2,14 +2,18 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/pty"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -19,7 +23,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const shellCheck = "command -v bash >/dev/null && exec bash || exec sh"
+const (
+	shellCheck = "command -v bash >/dev/null && exec bash || exec sh"
+	windowsOS  = "windows"
+)
 
 // Pod represents a pod viewer.
 type Pod struct {
@@ -37,9 +44,29 @@ func NewPod(gvr client.GVR) ResourceViewer {
 }
 
 func (p *Pod) bindKeys(aa ui.KeyActions) {
+	ns := p.GetTable().GetModel().GetNamespace()
+	if userCan(p.App(), ns, client.NewGVR(p.GVR()), client.DeleteVerb) {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
+			tcell.KeyCtrlG: ui.NewKeyAction("Clean Stale", p.cleanCmd, true),
+		})
+	} else {
+		aa.Delete(tcell.KeyCtrlK, tcell.KeyCtrlG)
+	}
+	if userCan(p.App(), ns, client.NewGVR("v1/pods:eviction"), client.CreateVerb) {
+		aa[tcell.KeyCtrlE] = ui.NewKeyAction("Evict", p.evictCmd, true)
+	} else {
+		aa.Delete(tcell.KeyCtrlE)
+	}
+	if userCan(p.App(), ns, client.NewGVR("v1/pods:exec"), client.CreateVerb) {
+		aa[ui.KeyS] = ui.NewKeyAction("Shell", p.shellCmd, true)
+		aa[ui.KeyN] = ui.NewKeyAction("Net Test", p.netCheckCmd, true)
+		aa[ui.KeyX] = ui.NewKeyAction("Proxy", p.proxyCmd, true)
+	} else {
+		aa.Delete(ui.KeyS, ui.KeyN, ui.KeyX)
+	}
+	bindAPIProxy(p, aa)
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd(3, false), false),
@@ -88,7 +115,7 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	p.GetTable().ShowDeleted()
 	for _, res := range sels {
 		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, true); err != nil {
+		if err := nuker.Delete(res, dao.ForceDeleteOptions()); err != nil {
 			p.App().Flash().Errf("Delete failed with %s", err)
 		} else {
 			p.App().factory.DeleteForwarder(res)
@@ -99,6 +126,119 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) evictCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := p.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(p.App().factory, client.NewGVR(p.GVR()))
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	evictor, ok := res.(dao.Evictable)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting an evictor for %q", p.GVR()))
+		return nil
+	}
+
+	msg := fmt.Sprintf("Evict %d pod(s)?", len(sels))
+	if len(sels) == 1 {
+		msg = "Evict pod " + sels[0] + "?"
+	}
+	dialog.ShowConfirm(p.App().Content.Pages, "<Confirm Eviction>", msg, func() {
+		for _, path := range sels {
+			err := evictor.Evict(path)
+			if err == nil {
+				p.App().Flash().Infof("Evicting pod %s", path)
+				continue
+			}
+			p.App().Flash().Err(err)
+			if blocked, ok := err.(*dao.EvictionBlockedError); ok && len(blocked.PDBs) > 0 {
+				p.showBlockingPDB(blocked)
+				return
+			}
+		}
+		p.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (p *Pod) showBlockingPDB(err *dao.EvictionBlockedError) {
+	msg := fmt.Sprintf("Eviction of %s is blocked by disruption budget %s. View it?", err.Path, err.PDBs[0])
+	dialog.ShowConfirm(p.App().Content.Pages, "<Eviction Blocked>", msg, func() {
+		if err := p.App().viewResource("poddisruptionbudgets", err.PDBs[0], false); err != nil {
+			p.App().Flash().Err(err)
+		}
+	}, func() {})
+}
+
+func (p *Pod) cleanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	res, err := dao.AccessorFor(p.App().factory, client.NewGVR(p.GVR()))
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	po, ok := res.(*dao.Pod)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a pod accessor for %q", p.GVR()))
+		return nil
+	}
+
+	ns := p.GetTable().GetModel().GetNamespace()
+	stales, err := po.StalePods(ns)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	paths, counts := make([]string, 0, 10), make([]string, 0, len(stales))
+	for _, s := range []string{render.Evicted, render.Completed, render.Failed} {
+		if len(stales[s]) == 0 {
+			continue
+		}
+		paths = append(paths, stales[s]...)
+		counts = append(counts, fmt.Sprintf("%d %s", len(stales[s]), s))
+	}
+	if len(paths) == 0 {
+		p.App().Flash().Info("No evicted, completed or failed pods found")
+		return nil
+	}
+
+	scope := "namespace " + ns
+	if client.IsAllNamespaces(ns) {
+		scope = "all namespaces"
+	}
+	msg := fmt.Sprintf("Delete %s pods in %s?", strings.Join(counts, ", "), scope)
+	dialog.ShowConfirm(p.App().Content.Pages, "<Clean Stale Pods>", msg, func() {
+		p.GetTable().ShowDeleted()
+		p.App().runJob("Clean stale pods", 0, func(ctx context.Context, job *model.Job) error {
+			var failed int
+			for i, path := range paths {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				job.SetStatus("deleting " + path)
+				if err := po.Delete(path, dao.NewDeleteOptions()); err != nil {
+					log.Error().Err(err).Msgf("Delete failed for %s", path)
+					failed++
+				}
+				job.SetProgress(i+1, len(paths))
+			}
+			p.App().QueueUpdateDraw(p.Refresh)
+			if failed > 0 {
+				return fmt.Errorf("failed to delete %d of %d pods", failed, len(paths))
+			}
+			job.SetStatus(fmt.Sprintf("deleted %d stale pods", len(paths)))
+
+			return nil
+		})
+	}, func() {})
+
+	return nil
+}
+
 func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := p.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -132,6 +272,52 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (p *Pod) netCheckCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	row := p.GetTable().GetSelectedRowIndex()
+	status := ui.TrimCell(p.GetTable().SelectTable, row, p.GetTable().NameColIndex()+2)
+	if status != render.Running {
+		p.App().Flash().Errf("%s is not in a running state", sel)
+		return nil
+	}
+	cc, err := fetchContainers(p.App().factory, sel, false)
+	if err != nil {
+		p.App().Flash().Errf("Unable to retrieve containers %s", err)
+		return nil
+	}
+	if len(cc) == 0 {
+		p.App().Flash().Errf("No containers found in pod %s", sel)
+		return nil
+	}
+	showNetCheck(p.App(), sel, cc[0])
+
+	return nil
+}
+
+func (p *Pod) proxyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	cc, err := fetchContainers(p.App().factory, sel, false)
+	if err != nil {
+		p.App().Flash().Errf("Unable to retrieve containers %s", err)
+		return nil
+	}
+	if len(cc) == 0 {
+		p.App().Flash().Errf("No containers found in pod %s", sel)
+		return nil
+	}
+	showProxy(p.App(), sel, cc[0])
+
+	return nil
+}
+
 func (p *Pod) shellIn(path, co string) {
 	p.Stop()
 	shellIn(p.App(), path, co)
@@ -166,26 +352,25 @@ func fetchContainers(f *watch.Factory, path string, includeInit bool) ([]string,
 }
 
 func shellIn(a *App, path, co string) {
-	args := computeShellArgs(path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
-	log.Debug().Msgf("Shell args %v", args)
-	if !runK(true, a, args...) {
-		a.Flash().Err(errors.New("Shell exec failed"))
+	if !preflight(a, path, "exec", client.CreateVerb) {
+		return
+	}
+	if name, err := dao.PodOS(a.factory, path); err == nil && name == windowsOS {
+		dialog.ShowWindowsShell(a.Content.Pages, path, func(shell string) {
+			execShell(a, path, co, []string{shell})
+		})
+		return
 	}
+	execShell(a, path, co, []string{"sh", "-c", shellCheck})
 }
 
-func computeShellArgs(path, co, context string, kcfg *string) []string {
-	args := make([]string, 0, 15)
-	args = append(args, "exec", "-it")
-	args = append(args, "--context", context)
-	ns, po := client.Namespaced(path)
-	args = append(args, "-n", ns)
-	args = append(args, po)
-	if kcfg != nil && *kcfg != "" {
-		args = append(args, "--kubeconfig", *kcfg)
-	}
-	if co != "" {
-		args = append(args, "-c", co)
+func execShell(a *App, path, co string, cmd []string) {
+	cols, rows, _ := pty.TermSize(os.Stdin)
+	spawn := dao.ExecSpawner(a.Conn(), path, co, cmd)
+	s, err := a.sessions.Start(path, co, spawn, cols, rows)
+	if err != nil {
+		a.Flash().Errf("Shell exec failed %s", err)
+		return
 	}
-
-	return append(args, "--", "sh", "-c", shellCheck)
+	attachSession(a, s)
 }
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// PodSecurity represents a pods security posture view.
+type PodSecurity struct {
+	ResourceViewer
+
+	privileged bool
+}
+
+// NewPodSecurity returns a new viewer.
+func NewPodSecurity(gvr client.GVR) ResourceViewer {
+	p := PodSecurity{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.securityCtx)
+	p.GetTable().SetEnterFn(gotoRelated)
+	p.GetTable().SetColorerFn(render.PodSecurity{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PodSecurity) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Privileged Only", p.privilegedCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Risk", p.GetTable().SortColCmd(-1, true), false),
+	})
+}
+
+func (p *PodSecurity) securityCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPrivileged, p.privileged)
+}
+
+func (p *PodSecurity) privilegedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.privileged = !p.privileged
+	p.Start()
+	if p.privileged {
+		p.App().Flash().Info("Showing privileged pods only")
+	} else {
+		p.App().Flash().Info("Showing all pods")
+	}
+
+	return nil
+}
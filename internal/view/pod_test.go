@@ -7,7 +7,9 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/view"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,7 +18,22 @@ func TestPodNew(t *testing.T) {
 
 	assert.Nil(t, po.Init(makeCtx()))
 	assert.Equal(t, "Pods", po.Name())
-	assert.Equal(t, 18, len(po.Hints()))
+	assert.Equal(t, 24, len(po.Hints()))
+	assert.Equal(t, "Logs+Describe", po.Actions()[ui.KeyM].Description)
+}
+
+func TestPodVimVisual(t *testing.T) {
+	po := view.NewPod(client.NewGVR("v1/pods"))
+	assert.Nil(t, po.Init(makeCtx()))
+
+	vim := ui.NewVimMotions(true)
+	bound := func(k tcell.Key) bool {
+		_, ok := po.Actions()[k]
+		return ok
+	}
+	evt, _ := vim.Map(tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone), bound)
+	assert.Equal(t, ' ', evt.Rune())
+	assert.True(t, vim.IsVisual())
 }
 
 // Helpers...
@@ -34,7 +34,7 @@ func NewPortForward(gvr client.GVR) ResourceViewer {
 	p.GetTable().SetBorderFocusColor(tcell.ColorDodgerBlue)
 	p.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone)
 	p.GetTable().SetColorerFn(render.PortForward{}.ColorerFunc())
-	p.GetTable().SetSortCol(p.GetTable().NameColIndex()+6, 0, true)
+	p.GetTable().SetSortCol(p.GetTable().NameColIndex()+9, 0, true)
 	p.SetContextFn(p.portForwardContext)
 	p.SetBindKeysFn(p.bindKeys)
 
@@ -77,11 +77,12 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	r, _ := p.GetTable().GetSelection()
+	ctx, path := client.SplitContextPath(sel)
 	cfg := defaultConfig()
-	if b, ok := p.App().Bench.Benchmarks.Containers[sel]; ok {
+	if b, ok := p.benchFor(ctx).Benchmarks.Containers[path]; ok {
 		cfg = b
 	}
-	cfg.Name = sel
+	cfg.Name = path
 
 	base := ui.TrimCell(p.GetTable().SelectTable, r, 4)
 	var err error
@@ -93,13 +94,22 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	p.App().Status(ui.FlashWarn, "Benchmark in progress...")
 	log.Debug().Msg("Bench starting...")
-	go p.runBenchmark()
+	p.runBenchmark(cfg)
 
 	return nil
 }
 
-func (p *PortForward) runBenchmark() {
-	p.bench.Run(p.App().Config.K9s.CurrentCluster, func() {
+// benchFor returns the benchmarks configuration of the context owning a forward.
+func (p *PortForward) benchFor(ctx string) *config.Bench {
+	if ctx == "" || ctx == p.App().Config.K9s.CurrentContext {
+		return p.App().Bench
+	}
+
+	return dao.ContextBench(p.App().Conn(), ctx)
+}
+
+func (p *PortForward) runBenchmark(cfg config.BenchConfig) {
+	runBench(p.App(), p.bench, cfg, func() {
 		log.Debug().Msg("Bench Completed!")
 		p.App().QueueUpdate(func() {
 			if p.bench.Canceled() {
@@ -132,7 +142,7 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	showModal(p.App().Content.Pages, fmt.Sprintf("Delete PortForward `%s?", path), func() {
 		var pf dao.PortForward
 		pf.Init(p.App().factory, client.NewGVR("portforwards"))
-		if err := pf.Delete(path, true, true); err != nil {
+		if err := pf.Delete(path, dao.ForceDeleteOptions()); err != nil {
 			p.App().Flash().Err(err)
 			return
 		}
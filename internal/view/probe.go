@@ -0,0 +1,53 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"sigs.k8s.io/yaml"
+)
+
+const probeTimeout = 2 * time.Minute
+
+// runProbes executes a container probes in the background and shows their
+// results.
+func runProbes(app *App, path, co string) {
+	subject := path + ":" + co
+	app.runJob("Probes "+subject, probeTimeout, func(ctx context.Context, job *model.Job) error {
+		job.SetStatus("probing")
+		rr, err := dao.RunProbes(ctx, app.factory, path, co)
+		if err != nil {
+			return err
+		}
+		raw, err := yaml.Marshal(rr)
+		if err != nil {
+			return err
+		}
+		app.QueueUpdateDraw(func() {
+			details := NewDetails(app, "Probes", subject).Update(strings.TrimSpace(string(raw)))
+			if err := app.inject(details); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+		if failed := failedProbes(rr); len(failed) > 0 {
+			return fmt.Errorf("%s probe failed", strings.Join(failed, ", "))
+		}
+
+		return nil
+	})
+}
+
+func failedProbes(rr []dao.ProbeResult) []string {
+	var ff []string
+	for _, r := range rr {
+		if !r.OK {
+			ff = append(ff, r.Kind)
+		}
+	}
+
+	return ff
+}
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultProxyAddress = "localhost:1080"
+
+// showProxy prompts for a local proxy tunneled through a pod container.
+func showProxy(app *App, path, co string) {
+	dialog.ShowProxy(app.Content.Pages, path, defaultProxyAddress, func(address string, relay bool) {
+		go runProxy(app, path, co, address, relay)
+	})
+}
+
+// runProxy serves a local SOCKS5 and HTTP proxy until its task gets canceled.
+// Connections are relayed by the pod container or by a dedicated relay pod
+// launched in the pod namespace.
+func runProxy(app *App, path, co, address string, relay bool) {
+	ctx, done := app.tasks.Track(context.Background(), dao.TaskProxy, path, address)
+	defer done()
+
+	if relay {
+		ns, _ := client.Namespaced(path)
+		app.Flash().Infof("Launching proxy relay pod in namespace %s...", ns)
+		var err error
+		if path, err = app.relays.Start(ctx, app.Conn(), ns, dao.NetshootImage); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		co = dao.ProxyRelayContainer
+		defer func() {
+			if err := app.relays.Stop(app.Conn(), path); err != nil {
+				log.Error().Err(err).Msgf("Unable to delete relay pod %s", path)
+			}
+		}()
+	}
+
+	t := dao.NewProxyTunnel(app.Conn(), path, co)
+	if err := t.Listen(address); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.Flash().Infof("Proxy listening on %s (socks5 and http) via %s", t.Addr(), path)
+	if err := t.Serve(ctx); err != nil {
+		app.Flash().Errf("Proxy %s failed: %s", t.Addr(), err)
+		return
+	}
+	app.Flash().Infof("Proxy %s stopped", t.Addr())
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 )
 
@@ -15,6 +16,10 @@ func loadCustomViewers() MetaViewers {
 	rbacViewers(m)
 	batchViewers(m)
 	extViewers(m)
+	admissionViewers(m)
+	gitOpsViewers(m)
+	trafficViewers(m)
+	autoscalingViewers(m)
 	helmViewers(m)
 
 	return m
@@ -45,6 +50,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/secrets")] = MetaViewer{
 		viewerFn: NewSecret,
 	}
+	vv[client.NewGVR("v1/serviceaccounts")] = MetaViewer{
+		viewerFn: NewServiceAccount,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -66,6 +74,48 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("aliases")] = MetaViewer{
 		viewerFn: NewAlias,
 	}
+	vv[client.NewGVR("deprecations")] = MetaViewer{
+		viewerFn: NewDeprecation,
+	}
+	vv[client.NewGVR("upgrade")] = MetaViewer{
+		viewerFn: NewUpgrade,
+	}
+	vv[client.NewGVR("controlplane")] = MetaViewer{
+		viewerFn: NewControlPlane,
+	}
+	vv[client.NewGVR("configs")] = MetaViewer{
+		viewerFn: NewConfigCheck,
+	}
+	vv[client.NewGVR("stats")] = MetaViewer{
+		viewerFn: NewStats,
+	}
+	vv[client.NewGVR("pdbcoverage")] = MetaViewer{
+		viewerFn: NewPDBCoverage,
+	}
+	vv[client.NewGVR("podsecurity")] = MetaViewer{
+		viewerFn: NewPodSecurity,
+	}
+	vv[client.NewGVR("applications")] = MetaViewer{
+		viewerFn: NewApplication,
+	}
+	vv[client.NewGVR("images")] = MetaViewer{
+		viewerFn: NewImage,
+	}
+	vv[client.NewGVR("satokens")] = MetaViewer{
+		viewerFn: NewSAToken,
+	}
+	vv[client.NewGVR("pinboard")] = MetaViewer{
+		viewerFn: NewPinboard,
+	}
+	vv[client.NewGVR("tasks")] = MetaViewer{
+		viewerFn: NewTask,
+	}
+	vv[client.NewGVR("sessions")] = MetaViewer{
+		viewerFn: NewSession,
+	}
+	vv[client.NewGVR("audit")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
 }
 
 func appsViewers(vv MetaViewers) {
@@ -121,10 +171,62 @@ func batchViewers(vv MetaViewers) {
 
 func extViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
-		enterFn: showCRD,
+		viewerFn: NewCRD,
 	}
 	vv[client.NewGVR("apiextensions.k8s.io/v1beta1/customresourcedefinitions")] = MetaViewer{
-		enterFn: showCRD,
+		viewerFn: NewCRD,
+	}
+}
+
+func admissionViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("admissionregistration.k8s.io/"+v+"/validatingwebhookconfigurations")] = MetaViewer{
+			viewerFn: NewWebhook,
+		}
+		vv[client.NewGVR("admissionregistration.k8s.io/"+v+"/mutatingwebhookconfigurations")] = MetaViewer{
+			viewerFn: NewWebhook,
+		}
+	}
+}
+
+func gitOpsViewers(vv MetaViewers) {
+	for _, gvr := range dao.FluxGVRs {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			viewerFn: NewFlux,
+		}
+	}
+	for _, gvr := range dao.ArgoGVRs {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			viewerFn: NewArgo,
+		}
+	}
+}
+
+func trafficViewers(vv MetaViewers) {
+	for _, v := range dao.IstioVersions {
+		for _, r := range []string{"virtualservices", "destinationrules", "gateways"} {
+			vv[client.NewGVR("networking.istio.io/"+v+"/"+r)] = MetaViewer{
+				viewerFn: NewTraffic,
+			}
+		}
+	}
+	for _, v := range dao.GatewayAPIVersions {
+		vv[client.NewGVR("gateway.networking.k8s.io/"+v+"/httproutes")] = MetaViewer{
+			viewerFn: NewTraffic,
+		}
+	}
+}
+
+func autoscalingViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v2beta1", "v2beta2"} {
+		vv[client.NewGVR("autoscaling/"+v+"/horizontalpodautoscalers")] = MetaViewer{
+			viewerFn: NewHPA,
+		}
+	}
+	for _, gvr := range dao.VPAGVRs {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			viewerFn: NewVPA,
+		}
 	}
 }
 
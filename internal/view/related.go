@@ -0,0 +1,55 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Related presents resources related to a given resource.
+type Related struct {
+	ResourceViewer
+
+	targetGVR, targetPath string
+}
+
+// NewRelated returns a new viewer listing resources related to a target
+// resource, either its children or all its related resources.
+func NewRelated(gvr client.GVR, targetGVR, targetPath string) *Related {
+	r := Related{
+		ResourceViewer: NewBrowser(gvr),
+		targetGVR:      targetGVR,
+		targetPath:     targetPath,
+	}
+	r.SetBindKeysFn(r.bindKeys)
+	r.SetContextFn(r.targetCtx)
+	r.GetTable().SetEnterFn(gotoRelated)
+	r.GetTable().SetColorerFn(render.Related{}.ColorerFunc())
+
+	return &r
+}
+
+func (r *Related) targetCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyTargetGVR, r.targetGVR)
+
+	return context.WithValue(ctx, internal.KeyPath, r.targetPath)
+}
+
+func (r *Related) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", r.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Relation", r.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func gotoRelated(app *App, _ ui.Tabular, _, path string) {
+	gvr, fqn := splitGVRPath(path)
+	if err := app.viewResource(client.NewGVR(gvr).R(), fqn, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -1,13 +1,24 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	rolloutTimeout  = 5 * time.Minute
+	rolloutPollRate = 2 * time.Second
 )
 
 // RestartExtender represents a restartable resource.
@@ -18,16 +29,19 @@ type RestartExtender struct {
 // NewRestartExtender returns a new extender.
 func NewRestartExtender(v ResourceViewer) ResourceViewer {
 	r := RestartExtender{ResourceViewer: v}
-	r.bindKeys(v.Actions())
+	r.AddBindKeysFn(r.bindKeys)
 
 	return &r
 }
 
 // BindKeys creates additional menu actions.
 func (r *RestartExtender) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Restart", r.restartCmd, true),
-	})
+	ns := r.GetTable().GetModel().GetNamespace()
+	if userCan(r.App(), ns, client.NewGVR(r.GVR()), client.PatchVerb) {
+		aa[tcell.KeyCtrlT] = ui.NewKeyAction("Restart", r.restartCmd, true)
+	} else {
+		aa.Delete(tcell.KeyCtrlT)
+	}
 }
 
 func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -44,6 +58,7 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
+			trackRollout(r.App(), r.GVR(), path)
 		}
 	}, func() {})
 
@@ -63,3 +78,30 @@ func (r *RestartExtender) restartRollout(path string) error {
 
 	return s.Restart(path)
 }
+
+// TrackRollout reports a rollout progress in the jobs pane until completion.
+func trackRollout(app *App, gvr, path string) {
+	name := fmt.Sprintf("Restart %s %s", client.NewGVR(gvr).R(), path)
+	app.runJob(name, rolloutTimeout, func(ctx context.Context, job *model.Job) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(rolloutPollRate):
+			}
+			o, err := app.factory.Get(gvr, path, true, labels.Everything())
+			if err != nil {
+				return err
+			}
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			updated, desired, done := dao.RolloutProgress(u)
+			job.SetProgress(int(updated), int(desired))
+			if done {
+				return nil
+			}
+		}
+	})
+}
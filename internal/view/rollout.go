@@ -0,0 +1,64 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Rollout presents the per pod rollout state of a daemonset or statefulset.
+type Rollout struct {
+	ResourceViewer
+
+	targetGVR, targetPath string
+}
+
+// NewRollout returns a new viewer.
+func NewRollout(targetGVR, targetPath string) *Rollout {
+	r := Rollout{
+		ResourceViewer: NewBrowser(client.NewGVR("rollouts")),
+		targetGVR:      targetGVR,
+		targetPath:     targetPath,
+	}
+	r.SetBindKeysFn(r.bindKeys)
+	r.SetContextFn(r.targetCtx)
+	r.GetTable().SetEnterFn(gotoRelated)
+	r.GetTable().SetColorerFn(render.Rollout{}.ColorerFunc())
+
+	return &r
+}
+
+func (r *Rollout) targetCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyTargetGVR, r.targetGVR)
+
+	return context.WithValue(ctx, internal.KeyPath, r.targetPath)
+}
+
+func (r *Rollout) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Node", r.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Ordinal", r.GetTable().SortColCmd(3, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", r.GetTable().SortColCmd(4, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(6, true), false),
+	})
+}
+
+func rolloutCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		if err := v.App().inject(NewRollout(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollout(t *testing.T) {
+	v := view.NewRollout("apps/v1/statefulsets", "default/fred")
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Sort Ordinal", v.Actions()[ui.KeyShiftO].Description)
+	assert.Equal(t, "Sort Node", v.Actions()[ui.KeyShiftD].Description)
+}
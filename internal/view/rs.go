@@ -41,11 +41,16 @@ func NewReplicaSet(gvr client.GVR) ResourceViewer {
 
 func (r *ReplicaSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd(1, true), false),
-		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd(2, true), false),
-		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(3, true), false),
-		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(3, true), false),
 	})
+	ns := r.GetTable().GetModel().GetNamespace()
+	if userCan(r.App(), ns, client.NewGVR("apps/v1/deployments"), client.PatchVerb) {
+		aa[tcell.KeyCtrlL] = ui.NewKeyAction("Rollback", r.rollbackCmd, true)
+	} else {
+		aa.Delete(tcell.KeyCtrlL)
+	}
 }
 
 func (r *ReplicaSet) showPods(app *App, model ui.Tabular, gvr, path string) {
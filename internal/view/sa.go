@@ -0,0 +1,81 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// saTokenTTL tracks how long debug tokens remain valid.
+const saTokenTTL = time.Hour
+
+// ServiceAccount presents a service account viewer.
+type ServiceAccount struct {
+	ResourceViewer
+}
+
+// NewServiceAccount returns a new viewer.
+func NewServiceAccount(gvr client.GVR) ResourceViewer {
+	s := ServiceAccount{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.SetBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(s.showPolicies)
+
+	return &s
+}
+
+func (s *ServiceAccount) bindKeys(aa ui.KeyActions) {
+	ns := s.GetTable().GetModel().GetNamespace()
+	if userCan(s.App(), ns, client.NewGVR("v1/serviceaccounts:token"), client.CreateVerb) {
+		aa[ui.KeyShiftT] = ui.NewKeyAction("Request Token", s.tokenCmd, true)
+	} else {
+		aa.Delete(ui.KeyShiftT)
+	}
+}
+
+func (s *ServiceAccount) showPolicies(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	if err := app.inject(NewPolicy(app, "s", n)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (s *ServiceAccount) tokenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	token, expires, err := requestSAToken(s.App().factory, client.NewGVR(s.GVR()), path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(s.App(), "Token", path).Update(
+		fmt.Sprintf("# Expires %s (%s)\n%s\n", expires.Format(time.RFC3339), saTokenTTL, token),
+	)
+	if err := s.App().inject(details); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func requestSAToken(f dao.Factory, gvr client.GVR, path string) (string, time.Time, error) {
+	res, err := dao.AccessorFor(f, gvr)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	requester, ok := res.(dao.TokenRequester)
+	if !ok {
+		return "", time.Time{}, errors.New("expecting a token requester resource")
+	}
+
+	return requester.RequestToken(path, saTokenTTL)
+}
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// SAToken presents service account tokens k9s can authenticate with.
+type SAToken struct {
+	ResourceViewer
+}
+
+// NewSAToken returns a new viewer.
+func NewSAToken(gvr client.GVR) ResourceViewer {
+	s := SAToken{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.SetBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.tokenCtx)
+	s.GetTable().SetEnterFn(s.useToken)
+	s.GetTable().SetColorerFn(render.SAToken{}.ColorerFunc())
+
+	return &s
+}
+
+func (s *SAToken) tokenCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyTokens, s.App().Config.K9s.SATokens)
+}
+
+func (s *SAToken) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
+}
+
+func (s *SAToken) useToken(app *App, _ ui.Tabular, _, path string) {
+	if err := useSAToken(app, path); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	s.Refresh()
+}
+
+func useSAToken(app *App, path string) error {
+	if app.Content.Top() != nil {
+		app.Content.Top().Stop()
+	}
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("satokens"))
+	if err != nil {
+		return err
+	}
+	switcher, ok := res.(dao.Switchable)
+	if !ok {
+		return errors.New("Expecting a switchable resource")
+	}
+	if err := switcher.Switch(path); err != nil {
+		return err
+	}
+	ctx, _ := app.Conn().Config().CurrentContextName()
+	if err := app.switchCtx(ctx, false); err != nil {
+		return err
+	}
+	if t, ok := app.Conn().Config().ActiveSAToken(); ok {
+		app.Flash().Infof("Authenticating as service account %s", client.FQN(t.Namespace, t.Name))
+	}
+
+	return nil
+}
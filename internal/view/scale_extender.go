@@ -21,15 +21,18 @@ type ScaleExtender struct {
 // NewScaleExtender returns a new extender.
 func NewScaleExtender(r ResourceViewer) ResourceViewer {
 	s := ScaleExtender{ResourceViewer: r}
-	s.bindKeys(s.Actions())
+	s.AddBindKeysFn(s.bindKeys)
 
 	return &s
 }
 
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Scale", s.scaleCmd, true),
-	})
+	ns := s.GetTable().GetModel().GetNamespace()
+	if userCan(s.App(), ns, client.NewGVR(s.GVR()+":scale"), client.UpdateVerb) {
+		aa[ui.KeyS] = ui.NewKeyAction("Scale", s.scaleCmd, true)
+	} else {
+		aa.Delete(ui.KeyS)
+	}
 }
 
 func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -3,11 +3,9 @@ package view
 import (
 	"context"
 	"errors"
-	"path/filepath"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -36,8 +34,8 @@ func NewScreenDump(gvr client.GVR) ResourceViewer {
 }
 
 func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
-	dir := filepath.Join(config.K9sDumpDir, s.App().Config.K9s.CurrentCluster)
-	return context.WithValue(ctx, internal.KeyDir, dir)
+	k := s.App().Config.K9s
+	return context.WithValue(ctx, internal.KeyDir, k.DumpDirFor(k.CurrentCluster))
 }
 
 func (s *ScreenDump) edit(app *App, model ui.Tabular, gvr, path string) {
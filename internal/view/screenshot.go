@@ -0,0 +1,56 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	screenshotHTML = "html"
+	screenshotSVG  = "svg"
+)
+
+func (a *App) screenshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.saveScreenshot(screenshotHTML)
+
+	return nil
+}
+
+// SaveScreenshot captures the current screen to a html or svg dump file.
+func (a *App) saveScreenshot(format string) {
+	path, err := a.screenshot(format)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Infof("Screenshot saved to %s", path)
+}
+
+func (a *App) screenshot(format string) (string, error) {
+	shot, err := ui.CaptureScreen(a.Main, a.Styles.FgColor(), a.Styles.BgColor())
+	if err != nil {
+		return "", err
+	}
+
+	var name string
+	if top := a.Content.Top(); top != nil {
+		name = top.Name()
+	}
+	var data string
+	switch format {
+	case screenshotHTML:
+		data = shot.HTML(fmt.Sprintf("K9s %s %s", a.Config.K9s.CurrentContext, name))
+	case screenshotSVG:
+		data = shot.SVG()
+	default:
+		return "", fmt.Errorf("invalid screenshot format %q (%s|%s)", format, screenshotHTML, screenshotSVG)
+	}
+	k := a.Config.K9s
+
+	return dumpFile(k, newDump(k, "screenshot", name, format), []byte(data))
+}
@@ -0,0 +1,136 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// Session presents the background exec sessions.
+type Session struct {
+	ResourceViewer
+}
+
+// NewSession returns a new viewer.
+func NewSession(gvr client.GVR) ResourceViewer {
+	s := Session{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.SetBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.sessionsCtx)
+	s.GetTable().SetEnterFn(s.attach)
+	s.GetTable().SetColorerFn(render.Session{}.ColorerFunc())
+	s.GetTable().SetSortCol(0, 0, true)
+
+	return &s
+}
+
+func (s *Session) sessionsCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeySessions, s.App().sessions)
+}
+
+func (s *Session) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyA:        ui.NewKeyAction("Attach", s.attachCmd, true),
+		ui.KeyL:        ui.NewKeyAction("Scrollback", s.scrollbackCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Kill", s.killCmd, true),
+	})
+}
+
+func (s *Session) attach(app *App, _ ui.Tabular, _, id string) {
+	sess, err := app.sessions.Get(id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	attachSession(app, sess)
+}
+
+func (s *Session) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := s.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	s.attach(s.App(), s.GetTable().GetModel(), s.GVR(), id)
+
+	return nil
+}
+
+func (s *Session) scrollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := s.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	sess, err := s.App().sessions.Get(id)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	subject := fmt.Sprintf("%s:%s", sess.Path, sess.Container)
+	details := NewDetails(s.App(), "Scrollback", subject).Update(sess.Scrollback())
+	if err := s.App().inject(details); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *Session) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := s.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+
+	msg := "Kill session " + id + "?"
+	dialog.ShowConfirm(s.App().Content.Pages, "<Kill Session>", msg, func() {
+		if err := s.App().sessions.Kill(id); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Session %s killed", id)
+		s.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// AttachSession suspends the UI and wires the terminal to an exec session
+// until it exits or gets detached.
+func attachSession(app *App, s *dao.Session) {
+	var (
+		detached bool
+		err      error
+	)
+	if app.Config.K9s.ShellPane {
+		if err := app.shellPane().Attach(s); err != nil {
+			app.Flash().Errf("Session %d failed %s", s.ID, err)
+		}
+		return
+	}
+	app.Halt()
+	defer app.Resume()
+	app.Suspend(func() {
+		clearScreen()
+		fmt.Printf("K9s session %d on %s:%s. Type Ctrl-] to detach.\r\n", s.ID, s.Path, s.Container)
+		detached, err = s.Attach(os.Stdin, os.Stdout)
+		clearScreen()
+	})
+	switch {
+	case err != nil:
+		app.Flash().Errf("Session %d failed %s", s.ID, err)
+	case detached:
+		app.Flash().Infof("Session %d detached. Use :sessions to reattach", s.ID)
+	case s.Err() != nil:
+		app.Flash().Errf("Session %d exited %s", s.ID, s.Err())
+	}
+}
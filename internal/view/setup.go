@@ -0,0 +1,61 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// setup pops the first run setup wizard.
+func (a *App) setup() {
+	ctxs, err := a.Conn().Config().ContextNames()
+	if err != nil {
+		log.Warn().Err(err).Msg("Context names")
+	}
+	sort.Strings(ctxs)
+	defaults := config.Setup{
+		Context:     a.Config.K9s.CurrentContext,
+		Namespace:   a.Config.ActiveNamespace(),
+		Skin:        config.StockSkin,
+		ReadOnly:    a.Config.K9s.ReadOnly,
+		RefreshRate: a.Config.K9s.GetRefreshRate(),
+	}
+	dialog.ShowSetup(a.Content.Pages, defaults, ctxs, config.Skins(config.K9sSkins), a.applySetup, func() {
+		a.Flash().Info("Setup skipped. Using default configuration...")
+	})
+}
+
+// applySetup applies and saves the first run setup choices.
+func (a *App) applySetup(s config.Setup) {
+	if s.Context != "" && s.Context != a.Config.K9s.CurrentContext {
+		if err := useContext(a, s.Context); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+	}
+	if err := s.Apply(a.Config); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.switchNS(a.Config.ActiveNamespace())
+	if err := config.InstallSkin(config.K9sSkins, s.Skin, config.K9sStylesFile); err != nil {
+		log.Error().Err(err).Msgf("Skin %q install failed", s.Skin)
+	}
+	a.ReloadStyles(a.Config.K9s.CurrentContext)
+	if s.Samples {
+		if err := config.WriteSamples(config.K9sPlugins, config.K9sHotKeys); err != nil {
+			log.Error().Err(err).Msg("Samples install failed")
+		}
+	}
+	if err := a.Config.SaveCommented(config.K9sConfigFile); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if err := a.command.defaultCmd(); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Infof("Configuration saved to %s. Run :tutorial for a guided tour", config.K9sConfigFile)
+}
@@ -0,0 +1,136 @@
+package view
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// ShellPane embeds a container shell beside the current view.
+type ShellPane struct {
+	*ui.Terminal
+
+	app     *App
+	parent  *tview.Flex
+	session *dao.Session
+	release func()
+	stop    chan struct{}
+	dirty   int32
+}
+
+// NewShellPane returns a new shell pane.
+func NewShellPane(app *App) *ShellPane {
+	s := ShellPane{
+		Terminal: ui.NewTerminal(),
+		app:      app,
+	}
+	s.SetReleaseFn(s.blur)
+
+	return &s
+}
+
+// IsOpen returns true if a session is embedded in the pane.
+func (s *ShellPane) IsOpen() bool {
+	return s.session != nil
+}
+
+// Attach embeds a session in the pane, detaching the previous one.
+func (s *ShellPane) Attach(sess *dao.Session) error {
+	s.detach()
+	s.Reset(sess)
+	s.SetResizeFn(func(cols, rows uint16) {
+		if err := sess.Resize(cols, rows); err != nil {
+			log.Warn().Err(err).Msg("Shell pane resize")
+		}
+	})
+	release, err := sess.Mirror(s)
+	if err != nil {
+		return err
+	}
+	s.session, s.release, s.stop = sess, release, make(chan struct{})
+	go s.watch(sess, s.stop)
+
+	s.SetBorderFocusColor(config.AsColor(s.app.Styles.Frame().Border.FocusColor))
+	s.SetBackgroundColor(s.app.Styles.BgColor())
+	s.SetTitle(fmt.Sprintf(" [::b]Session %d %s:%s [::-]", sess.ID, sess.Path, sess.Container))
+	if s.parent != nil {
+		s.parent.ResizeItem(s, 0, 1)
+	}
+	s.focus()
+
+	return nil
+}
+
+// Close detaches the session and hides the pane. The session keeps running
+// in the background.
+func (s *ShellPane) Close() {
+	s.detach()
+	if s.parent != nil {
+		s.parent.ResizeItem(s, 0, 0)
+	}
+	s.blur()
+}
+
+func (s *ShellPane) detach() {
+	if s.session == nil {
+		return
+	}
+	close(s.stop)
+	s.release()
+	s.session, s.release = nil, nil
+	s.SetResizeFn(nil)
+}
+
+func (s *ShellPane) watch(sess *dao.Session, stop <-chan struct{}) {
+	select {
+	case <-stop:
+	case <-sess.Done():
+		s.app.QueueUpdateDraw(func() {
+			if s.session != sess {
+				return
+			}
+			s.Close()
+			if err := sess.Err(); err != nil {
+				s.app.Flash().Errf("Session %d exited %s", sess.ID, err)
+				return
+			}
+			s.app.Flash().Infof("Session %d exited", sess.ID)
+		})
+	}
+}
+
+// Write feeds the session output to the pane.
+func (s *ShellPane) Write(bb []byte) (int, error) {
+	n, err := s.Terminal.Write(bb)
+	if atomic.CompareAndSwapInt32(&s.dirty, 0, 1) {
+		go s.app.QueueUpdateDraw(func() {
+			atomic.StoreInt32(&s.dirty, 0)
+		})
+	}
+
+	return n, err
+}
+
+func (s *ShellPane) focus() {
+	s.app.SetFocus(s.Terminal)
+}
+
+func (s *ShellPane) blur() {
+	s.app.SetFocus(s.app.Content)
+}
+
+func (a *App) shellPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.shellPane().IsOpen() {
+		a.Flash().Info("No shell pane opened")
+		return nil
+	}
+	a.shellPane().focus()
+
+	return nil
+}
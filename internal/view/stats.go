@@ -0,0 +1,124 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// cpuProfileDuration tracks how long the cpu gets profiled for.
+const cpuProfileDuration = 10 * time.Second
+
+// profiles tracks the pprof profiles dumped to disk.
+var profiles = []string{"goroutine", "heap", "allocs", "block", "mutex"}
+
+// Stats represents k9s own resource usage view.
+type Stats struct {
+	ResourceViewer
+}
+
+// NewStats returns a new viewer.
+func NewStats(gvr client.GVR) ResourceViewer {
+	s := Stats{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.SetBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(blankEnterFn)
+	s.GetTable().SetColorerFn(render.Stats{}.ColorerFunc())
+	s.GetTable().SetSortCol(0, 0, true)
+
+	return &s
+}
+
+func (s *Stats) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Dump Profiles", s.dumpCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", s.GetTable().SortColCmd(2, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rate", s.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Max", s.GetTable().SortColCmd(6, false), false),
+	})
+}
+
+func (s *Stats) dumpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	dir, err := s.App().Config.K9s.DumpPath(newDump(s.App().Config.K9s, "pprof", "", ""))
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if err := dumpProfiles(dir, profiles); err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if err := startCPUProfile(dir, cpuProfileDuration, func(err error) {
+		s.App().QueueUpdateDraw(func() {
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.App().Flash().Infof("Profiles dumped in %s", dir)
+		})
+	}); err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	s.App().Flash().Infof("Profiles dumped in %s. Profiling cpu for %s...", dir, cpuProfileDuration)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func dumpProfiles(dir string, pp []string) error {
+	if err := ensureDir(dir); err != nil {
+		return err
+	}
+	for _, p := range pp {
+		prof := pprof.Lookup(p)
+		if prof == nil {
+			return fmt.Errorf("no profile named %q", p)
+		}
+		if err := writeProfile(filepath.Join(dir, p+".pprof"), prof); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeProfile(path string, p *pprof.Profile) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return p.WriteTo(f, 0)
+}
+
+func startCPUProfile(dir string, d time.Duration, done func(error)) error {
+	f, err := os.Create(filepath.Join(dir, "cpu.pprof"))
+	if err != nil {
+		return err
+	}
+	if err := pprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	go func() {
+		<-time.After(d)
+		pprof.StopCPUProfile()
+		done(f.Close())
+	}()
+
+	return nil
+}
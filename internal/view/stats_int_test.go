@@ -0,0 +1,30 @@
+package view
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-pprof")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, dumpProfiles(dir, []string{"goroutine", "heap"}))
+	for _, p := range []string{"goroutine", "heap"} {
+		_, err := os.Stat(filepath.Join(dir, p+".pprof"))
+		assert.Nil(t, err)
+	}
+	assert.NotNil(t, dumpProfiles(dir, []string{"fred"}))
+
+	done := make(chan error)
+	assert.Nil(t, startCPUProfile(dir, 10*time.Millisecond, func(err error) { done <- err }))
+	assert.Nil(t, <-done)
+	_, err = os.Stat(filepath.Join(dir, "cpu.pprof"))
+	assert.Nil(t, err)
+}
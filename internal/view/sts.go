@@ -1,9 +1,14 @@
 package view
 
 import (
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -34,7 +39,15 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 func (s *StatefulSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Rollout Skew", rolloutCmd(s), true),
+		ui.KeyShiftV: ui.NewKeyAction("Ordinals", s.ordinalsCmd, true),
 	})
+	ns := s.GetTable().GetModel().GetNamespace()
+	if userCan(s.App(), ns, client.NewGVR("v1/pods"), client.DeleteVerb) {
+		aa[ui.KeyShiftD] = ui.NewKeyAction("Delete Ordinal", s.deleteOrdinalCmd, true)
+	} else {
+		aa.Delete(ui.KeyShiftD)
+	}
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _, path string) {
@@ -47,6 +60,79 @@ func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _, path string) {
 	showPodsFromSelector(app, path, sts.Spec.Selector)
 }
 
+func (s *StatefulSet) ordinalsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := s.App().inject(NewOrdinal(path)); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *StatefulSet) deleteOrdinalCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	oo, err := dao.StatefulSetOrdinals(s.App().factory, path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	var pods, opts []string
+	for _, o := range oo {
+		if o.PodStatus == dao.OrdinalMissing || (len(pods) > 0 && pods[len(pods)-1] == o.Path) {
+			continue
+		}
+		_, n := client.Namespaced(o.Path)
+		pods, opts = append(pods, o.Path), append(opts, n+" ["+o.PodStatus+"]")
+	}
+	if len(pods) == 0 {
+		s.App().Flash().Warnf("No pods found for statefulset %s", path)
+		return nil
+	}
+
+	dialog.ShowOrdinal(s.App().Content.Pages, path, opts, func(index int, force bool) {
+		if !force {
+			s.deletePod(pods[index], false)
+			return
+		}
+		msg := fmt.Sprintf("Force delete pod %s? Its replacement may start before the kubelet releases its volumes.", pods[index])
+		dialog.ShowConfirm(s.App().Content.Pages, "<Confirm Force Delete>", msg, func() {
+			s.deletePod(pods[index], true)
+		}, func() {})
+	})
+
+	return nil
+}
+
+func (s *StatefulSet) deletePod(path string, force bool) {
+	res, err := dao.AccessorFor(s.App().factory, client.NewGVR("v1/pods"))
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		s.App().Flash().Err(fmt.Errorf("expecting a nuker for pods"))
+		return
+	}
+	opts := dao.NewDeleteOptions()
+	if force {
+		opts = dao.ForceDeleteOptions()
+	}
+	if err := nuker.Delete(path, opts); err != nil {
+		s.App().Flash().Errf("Delete failed with %s", err)
+		return
+	}
+	s.App().Flash().Infof("Pod %s deleted", path)
+}
+
 func (s *StatefulSet) sts(path string) (*appsv1.StatefulSet, error) {
 	o, err := s.App().factory.Get(s.GVR(), path, true, labels.Everything())
 	if err != nil {
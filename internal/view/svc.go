@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -43,6 +45,8 @@ func (s *Service) bindKeys(aa ui.KeyActions) {
 		tcell.KeyCtrlB: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd(1, true), false),
 	})
+	bindTargetPortFwd(s, aa, "Service")
+	bindAPIProxy(s, aa)
 }
 
 func (s *Service) showPods(app *App, _ ui.Tabular, gvr, path string) {
@@ -87,8 +91,7 @@ func (s *Service) getExternalPort(row int) (string, error) {
 }
 
 func (s *Service) reloadBenchCfg() error {
-	path := ui.BenchConfig(s.App().Config.K9s.CurrentCluster)
-	return s.App().Bench.Reload(path)
+	return s.App().Bench.Reload(s.App().BenchFile())
 }
 
 func (s *Service) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -151,7 +154,7 @@ func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
 
 	s.App().Status(ui.FlashWarn, "Benchmark in progress...")
 	log.Debug().Msg("Bench starting...")
-	go s.bench.Run(s.App().Config.K9s.CurrentCluster, s.benchDone)
+	runBench(s.App(), s.bench, cfg, s.benchDone)
 
 	return nil
 }
@@ -170,6 +173,32 @@ func (s *Service) benchDone() {
 	})
 }
 
+// RunBench runs a benchmark as a background job.
+func runBench(app *App, bench *perf.Benchmark, cfg config.BenchConfig, done func()) {
+	app.runJob("Benchmark "+cfg.Name, 0, func(ctx context.Context, job *model.Job) error {
+		job.SetStatus(fmt.Sprintf("%d requests (concurrency %d)", cfg.N, cfg.C))
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			select {
+			case <-ctx.Done():
+				bench.Cancel()
+			case <-stop:
+			}
+		}()
+		var canceled bool
+		bench.Run(app.Config.K9s.BenchDir(app.Config.K9s.CurrentCluster), func() {
+			canceled = bench.Canceled()
+			done()
+		})
+		if canceled {
+			return context.Canceled
+		}
+
+		return nil
+	})
+}
+
 func benchTimedOut(app *App) {
 	<-time.After(2 * time.Second)
 	app.QueueUpdate(func() {
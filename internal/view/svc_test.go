@@ -101,6 +101,13 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.RegisterMeta("rollouts", metav1.APIResource{
+		Name:         "rollouts",
+		SingularName: "rollout",
+		Namespaced:   true,
+		Kind:         "Rollouts",
+		Categories:   []string{"k9s"},
+	})
 	dao.RegisterMeta("apps/v1/statefulsets", metav1.APIResource{
 		Name:         "statefulsets",
 		SingularName: "statefulset",
@@ -132,5 +139,5 @@ func TestServiceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "Services", s.Name())
-	assert.Equal(t, 7, len(s.Hints()))
+	assert.Equal(t, 10, len(s.Hints()))
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -21,6 +23,7 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn BindKeysFunc
+	extKeysFns []BindKeysFunc
 }
 
 // NewTable returns a new viewer.
@@ -41,6 +44,7 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	}
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	t.Table.Init(ctx)
+	t.SetFrozenColumns(t.app.Config.K9s.FrozenColumns)
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
 
@@ -56,6 +60,9 @@ func (t *Table) GVR() string { return t.gvr.String() }
 // SetBindKeysFn adds additional key bindings.
 func (t *Table) SetBindKeysFn(f BindKeysFunc) { t.bindKeysFn = f }
 
+// AddBindKeysFn adds extender key bindings applied after the viewer ones.
+func (t *Table) AddBindKeysFn(f BindKeysFunc) { t.extKeysFns = append(t.extKeysFns, f) }
+
 // SetEnvFn sets a function to pull viewer env vars for plugins.
 func (t *Table) SetEnvFn(f EnvFunc) { t.envFn = f }
 
@@ -66,6 +73,10 @@ func (t *Table) EnvFn() EnvFunc {
 
 func (t *Table) defaultK9sEnv() K9sEnv {
 	env := defaultK9sEnv(t.app, t.GetSelectedItem(), t.GetSelectedRow())
+	env["RESOURCE"] = t.gvr.R()
+	if g := t.gvr.G(); g != "" {
+		env["RESOURCE"] += "." + g
+	}
 	env["FILTER"] = t.SearchBuff().String()
 	if env["FILTER"] == "" {
 		ns, n := client.Namespaced(t.GetSelectedItem())
@@ -85,6 +96,8 @@ func (t *Table) Start() {
 	t.Stop()
 	t.SearchBuff().AddListener(t.app.Cmd())
 	t.SearchBuff().AddListener(t)
+	t.JumpBuff().AddListener(t.app.Cmd())
+	t.JumpBuff().AddListener(t)
 	t.Styles().AddListener(t.Table)
 }
 
@@ -92,6 +105,8 @@ func (t *Table) Start() {
 func (t *Table) Stop() {
 	t.SearchBuff().RemoveListener(t.app.Cmd())
 	t.SearchBuff().RemoveListener(t)
+	t.JumpBuff().RemoveListener(t.app.Cmd())
+	t.JumpBuff().RemoveListener(t)
 	t.Styles().RemoveListener(t.Table)
 }
 
@@ -112,7 +127,7 @@ func (t *Table) BufferActive(state bool, k ui.BufferKind) {
 }
 
 func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveTable(t.app.Config.K9s.CurrentCluster, t.BaseTitle, t.Path, t.GetFilteredData()); err != nil {
+	if path, err := saveTable(t.app.Config.K9s, t.BaseTitle, t.Path, t.GetFilteredData()); err != nil {
 		t.app.Flash().Err(err)
 	} else {
 		t.app.Flash().Infof("File %s saved successfully!", path)
@@ -127,15 +142,61 @@ func (t *Table) bindKeys() {
 		tcell.KeyCtrlSpace:  ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
 		tcell.KeyCtrlS:      ui.NewSharedKeyAction("Save", t.saveCmd, false),
 		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
+		ui.KeyQuote:         ui.NewSharedKeyAction("Jump Mode", t.jumpCmd, false),
 		tcell.KeyCtrlU:      ui.NewSharedKeyAction("Clear Filter", t.clearCmd, false),
 		tcell.KeyBackspace2: ui.NewSharedKeyAction("Erase", t.eraseCmd, false),
 		tcell.KeyBackspace:  ui.NewSharedKeyAction("Erase", t.eraseCmd, false),
 		tcell.KeyDelete:     ui.NewSharedKeyAction("Erase", t.eraseCmd, false),
 		ui.KeyShiftN:        ui.NewKeyAction("Sort Name", t.SortColCmd(0, true), false),
 		ui.KeyShiftA:        ui.NewKeyAction("Sort Age", t.SortColCmd(-1, true), false),
+		tcell.KeyCtrlN:      ui.NewSharedKeyAction("Toggle Age", t.toggleAgeCmd, false),
+		tcell.KeyCtrlO:      ui.NewSharedKeyAction("Toggle Totals", t.toggleFooterCmd, false),
+		tcell.KeyCtrlP:      ui.NewSharedKeyAction("Group By", t.groupByCmd, false),
+		tcell.KeyCtrlY:      ui.NewSharedKeyAction("Histogram", t.histogramCmd, false),
 	})
 }
 
+func (t *Table) toggleAgeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	render.AbsoluteAge = !render.AbsoluteAge
+	if render.AbsoluteAge {
+		t.app.Flash().Infof("Showing ages as %s timestamps", render.AgeLocation)
+	} else {
+		t.app.Flash().Info("Showing relative ages")
+	}
+	t.Refresh()
+
+	return nil
+}
+
+func (t *Table) toggleFooterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleFooter()
+	t.Refresh()
+
+	return nil
+}
+
+func (t *Table) groupByCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if col := t.ToggleGroupBy(); col != "" {
+		t.app.Flash().Infof("Grouping by %s. <enter> on a group folds it", col)
+	} else {
+		t.app.Flash().Info("Grouping cleared")
+	}
+	t.Refresh()
+
+	return nil
+}
+
+func (t *Table) histogramCmd(evt *tcell.EventKey) *tcell.EventKey {
+	col, bb := t.Histogram()
+	if len(bb) == 0 {
+		t.app.Flash().Warn("Nothing to chart. Sort on a column first")
+		return nil
+	}
+	dialog.ShowHistogram(t.app.Content.Pages, col, ui.HistogramBars(bb, ui.HistogramBarWidth))
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -190,6 +251,16 @@ func (t *Table) eraseCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) jumpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.app.InCmdMode() {
+		return evt
+	}
+	t.app.Flash().Info("Jump mode activated.")
+	t.JumpBuff().SetActive(true)
+
+	return nil
+}
+
 func (t *Table) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if t.app.InCmdMode() {
 		return evt
@@ -2,11 +2,8 @@ package view
 
 import (
 	"encoding/csv"
-	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
-	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -19,42 +16,24 @@ func trimCellRelative(t *Table, row, col int) string {
 	return ui.TrimCell(t.SelectTable, row, t.NameColIndex()+col)
 }
 
-func computeFilename(cluster, ns, title, path string) (string, error) {
-	now := time.Now().UnixNano()
-
-	dir := filepath.Join(config.K9sDumpDir, cluster)
-	if err := ensureDir(dir); err != nil {
-		return "", err
-	}
-
-	name := title + "-" + strings.Replace(path, "/", "-", -1)
-	if path == "" {
-		name = title
-	}
-
-	var fName string
-	if ns == client.ClusterScope {
-		fName = fmt.Sprintf(ui.NoNSFmat, name, now)
-	} else {
-		fName = fmt.Sprintf(ui.FullFmat, name, ns, now)
-	}
-
-	return strings.ToLower(filepath.Join(dir, fName)), nil
-}
-
-func saveTable(cluster, title, path string, data render.TableData) (string, error) {
-	ns := data.Namespace
-	if client.IsClusterWide(ns) {
-		ns = client.NamespaceAll
-	}
-
-	fPath, err := computeFilename(cluster, ns, title, path)
+func saveTable(k *config.K9s, title, path string, data render.TableData) (string, error) {
+	d := newDump(k, title, path, "csv")
+	switch {
+	case path != "":
+		d.Name = strings.Replace(path, "/", "-", -1)
+		d.Namespace = ""
+	case client.IsClusterWide(data.Namespace):
+		d.Namespace = client.NamespaceAll
+	default:
+		d.Namespace = data.Namespace
+	}
+	fPath, err := k.DumpPath(d)
 	if err != nil {
 		return "", err
 	}
 	log.Debug().Msgf("Saving Table to %s", fPath)
 
-	mod := os.O_CREATE | os.O_WRONLY
+	mod := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
 	out, err := os.OpenFile(fPath, mod, 0600)
 	if err != nil {
 		return "", err
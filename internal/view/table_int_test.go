@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -87,7 +88,9 @@ func TestTableViewSort(t *testing.T) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-type testTableModel struct{}
+type testTableModel struct {
+	model.Grouper
+}
 
 var _ ui.Tabular = &testTableModel{}
 
@@ -102,7 +105,7 @@ func (t *testTableModel) Watch(context.Context)           {}
 func (t *testTableModel) Get(context.Context, string) (runtime.Object, error) {
 	return nil, nil
 }
-func (t *testTableModel) Delete(context.Context, string, bool, bool) error {
+func (t *testTableModel) Delete(context.Context, string, dao.DeleteOptions) error {
 	return nil
 }
 func (t *testTableModel) Describe(context.Context, string) (string, error) {
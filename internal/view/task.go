@@ -0,0 +1,59 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// Task presents the operations in flight.
+type Task struct {
+	ResourceViewer
+}
+
+// NewTask returns a new viewer.
+func NewTask(gvr client.GVR) ResourceViewer {
+	t := Task{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.SetBindKeysFn(t.bindKeys)
+	t.SetContextFn(t.tasksCtx)
+	t.GetTable().SetSortCol(0, 0, true)
+
+	return &t
+}
+
+func (t *Task) tasksCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyTasks, t.App().tasks)
+}
+
+func (t *Task) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlD: ui.NewKeyAction("Cancel", t.cancelCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", t.GetTable().SortColCmd(1, true), false),
+	})
+}
+
+func (t *Task) cancelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := t.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+
+	msg := "Cancel task " + id + "?"
+	dialog.ShowConfirm(t.App().Content.Pages, "<Cancel Task>", msg, func() {
+		if err := t.App().tasks.Cancel(id); err != nil {
+			t.App().Flash().Err(err)
+			return
+		}
+		t.App().Flash().Infof("Task %s canceled", id)
+		t.Refresh()
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Timeline presents the events, condition transitions and rollout history of
+// a resource in chronological order.
+type Timeline struct {
+	ResourceViewer
+
+	targetGVR, targetPath string
+}
+
+// NewTimeline returns a new viewer.
+func NewTimeline(targetGVR, targetPath string) *Timeline {
+	t := Timeline{
+		ResourceViewer: NewBrowser(client.NewGVR("timelines")),
+		targetGVR:      targetGVR,
+		targetPath:     targetPath,
+	}
+	t.SetBindKeysFn(t.bindKeys)
+	t.SetContextFn(t.targetCtx)
+	t.GetTable().SetSortCol(0, 0, false)
+	t.GetTable().SetEnterFn(gotoTimelineEntry)
+	t.GetTable().SetColorerFn(render.Timeline{}.ColorerFunc())
+
+	return &t
+}
+
+func (t *Timeline) targetCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyTargetGVR, t.targetGVR)
+
+	return context.WithValue(ctx, internal.KeyPath, t.targetPath)
+}
+
+func (t *Timeline) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Time", t.GetTable().SortColCmd(0, false), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Source", t.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", t.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func gotoTimelineEntry(app *App, model ui.Tabular, gvr, path string) {
+	if i := strings.LastIndex(path, "#"); i >= 0 {
+		path = path[:i]
+	}
+	gotoRelated(app, model, gvr, path)
+}
+
+func timelineCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		if err := v.App().inject(NewTimeline(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Traffic represents an Istio or Gateway API traffic resource viewer.
+type Traffic struct {
+	ResourceViewer
+}
+
+// NewTraffic returns a new viewer.
+func NewTraffic(gvr client.GVR) ResourceViewer {
+	t := Traffic{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetEnterFn(t.showChain)
+	switch gvr.R() {
+	case "virtualservices":
+		t.GetTable().SetColorerFn(render.VirtualService{}.ColorerFunc())
+	case "destinationrules":
+		t.GetTable().SetColorerFn(render.DestinationRule{}.ColorerFunc())
+	case "httproutes":
+		t.GetTable().SetColorerFn(render.HTTPRoute{}.ColorerFunc())
+	}
+
+	return &t
+}
+
+func (t *Traffic) showChain(app *App, _ ui.Tabular, gvr, path string) {
+	showDiagnosis(app, gvr, path, "Traffic")
+}
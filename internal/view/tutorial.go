@@ -0,0 +1,223 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+const (
+	tutorialHeight = 5
+	tutorialTick   = 500 * time.Millisecond
+	tutorialFooter = "[gray::-]:tutorial next skips a step, :tutorial off exits"
+)
+
+// TutorialStep represents a tutorial lesson.
+type TutorialStep struct {
+	Title string
+	Hint  string
+
+	// Done checks the lesson got completed given the selection when the
+	// step started. Steps without a check advance with :tutorial next.
+	Done func(a *App, mark string) bool
+}
+
+// TutorialSteps lists the onboarding lessons.
+var TutorialSteps = []TutorialStep{
+	{
+		Title: "Navigation",
+		Hint:  "Type [::b]:pods[::-] and press [::b]<enter>[::-] to list pods. Any resource alias works, [::b]:aliases[::-] lists them all.",
+		Done: func(a *App, _ string) bool {
+			return topGVR(a) == "v1/pods"
+		},
+	},
+	{
+		Title: "Moving Around",
+		Hint:  "Use [::b]j/k[::-] or the arrow keys to select another pod. [::b]g[::-] and [::b]Shift-g[::-] jump to the top and bottom.",
+		Done: func(a *App, mark string) bool {
+			sel := selectedItem(a)
+			return sel != "" && sel != mark
+		},
+	},
+	{
+		Title: "Filtering",
+		Hint:  "Press [::b]/[::-], type part of a pod name and [::b]<enter>[::-] to keep the filter. [::b]<esc>[::-] clears it again.",
+		Done: func(a *App, _ string) bool {
+			v, ok := a.Content.Top().(TableViewer)
+			if !ok {
+				return false
+			}
+			buff := v.GetTable().SearchBuff()
+			return !buff.IsActive() && !buff.Empty()
+		},
+	},
+	{
+		Title: "Drill Down",
+		Hint:  "Type [::b]:deploy[::-], select a deployment and press [::b]<enter>[::-] to drill down to its pods. [::b]<esc>[::-] walks back up.",
+		Done: func(a *App, _ string) bool {
+			return topGVR(a) == "v1/pods" && !a.Content.IsLast()
+		},
+	},
+	{
+		Title: "Logs",
+		Hint:  "Select a pod and press [::b]l[::-] to tail its logs. [::b]0[::-] toggles auto scroll and [::b]<esc>[::-] returns to the pods.",
+		Done: func(a *App, _ string) bool {
+			_, ok := a.Content.Top().(*Log)
+			return ok
+		},
+	},
+	{
+		Title: "Shells",
+		Hint:  "Back on a pod, press [::b]s[::-] to shell into its container and type [::b]exit[::-] to come back. [::b]?[::-] lists every key of the current view.",
+	},
+}
+
+// Tutorial walks users through K9s using hints overlaying the live views.
+type Tutorial struct {
+	*tview.TextView
+
+	app      *App
+	steps    []TutorialStep
+	current  int
+	mark     string
+	cancelFn context.CancelFunc
+}
+
+// NewTutorial returns a new tutorial pane.
+func NewTutorial(app *App) *Tutorial {
+	t := Tutorial{
+		TextView: tview.NewTextView(),
+		app:      app,
+		steps:    TutorialSteps,
+	}
+	t.SetDynamicColors(true)
+	t.SetWordWrap(true)
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+
+	return &t
+}
+
+// IsActive returns true if the tutorial is running.
+func (t *Tutorial) IsActive() bool {
+	return t.cancelFn != nil
+}
+
+// Start runs the tutorial from its first step.
+func (t *Tutorial) Start() {
+	t.Stop()
+
+	var ctx context.Context
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	t.current, t.mark = 0, selectedItem(t.app)
+	t.refresh()
+	go t.watch(ctx)
+}
+
+// Stop ends the tutorial.
+func (t *Tutorial) Stop() {
+	if t.cancelFn == nil {
+		return
+	}
+	t.cancelFn()
+	t.cancelFn = nil
+	t.resize(0)
+}
+
+// Next moves to the next step, ending the tutorial past the last one.
+func (t *Tutorial) Next() {
+	t.move(1)
+}
+
+// Prev moves back to the previous step.
+func (t *Tutorial) Prev() {
+	t.move(-1)
+}
+
+func (t *Tutorial) move(delta int) {
+	if !t.IsActive() {
+		return
+	}
+	if !t.step(delta) {
+		t.Stop()
+		t.app.Flash().Info("Tutorial completed. Run :tutorial anytime to take it again")
+		return
+	}
+	t.mark = selectedItem(t.app)
+	t.refresh()
+}
+
+// Step moves the current step by delta. It returns false once past the last
+// step.
+func (t *Tutorial) step(delta int) bool {
+	t.current += delta
+	if t.current < 0 {
+		t.current = 0
+	}
+
+	return t.current < len(t.steps)
+}
+
+func (t *Tutorial) watch(ctx context.Context) {
+	ticker := time.NewTicker(tutorialTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.app.QueueUpdateDraw(t.check)
+		}
+	}
+}
+
+func (t *Tutorial) check() {
+	if !t.IsActive() || t.current >= len(t.steps) {
+		return
+	}
+	if s := t.steps[t.current]; s.Done != nil && s.Done(t.app, t.mark) {
+		t.app.Flash().Infof("Nice! %s done", s.Title)
+		t.Next()
+	}
+}
+
+func (t *Tutorial) refresh() {
+	s := t.steps[t.current]
+	styles := t.app.Styles
+	t.SetBackgroundColor(styles.BgColor())
+	t.SetTextColor(styles.FgColor())
+	t.SetBorderColor(config.AsColor(styles.Frame().Status.HighlightColor))
+	t.SetTitle(fmt.Sprintf(" [%s::b]Tutorial %d/%d [::-]%s ", styles.Frame().Status.HighlightColor, t.current+1, len(t.steps), s.Title))
+	t.SetText(s.Hint + "\n" + tutorialFooter)
+	t.resize(tutorialHeight)
+}
+
+func (t *Tutorial) resize(height int) {
+	if flex, ok := t.app.Main.GetPrimitive("main").(*tview.Flex); ok {
+		flex.ResizeItem(t, height, 0)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func topGVR(a *App) string {
+	v, ok := a.Content.Top().(interface{ GVR() string })
+	if !ok {
+		return ""
+	}
+
+	return v.GVR()
+}
+
+func selectedItem(a *App) string {
+	v, ok := a.Content.Top().(TableViewer)
+	if !ok {
+		return ""
+	}
+
+	return v.GetTable().GetSelectedItem()
+}
@@ -0,0 +1,37 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTutorialStep(t *testing.T) {
+	uu := map[string]struct {
+		current, delta int
+		ok             bool
+		e              int
+	}{
+		"next":  {current: 0, delta: 1, ok: true, e: 1},
+		"prev":  {current: 1, delta: -1, ok: true, e: 0},
+		"first": {current: 0, delta: -1, ok: true, e: 0},
+		"done":  {current: 2, delta: 1, ok: false, e: 3},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tu := Tutorial{steps: make([]TutorialStep, 3), current: u.current}
+			assert.Equal(t, u.ok, tu.step(u.delta))
+			assert.Equal(t, u.e, tu.current)
+		})
+	}
+}
+
+func TestTutorialSteps(t *testing.T) {
+	for _, s := range TutorialSteps {
+		assert.NotEmpty(t, s.Title)
+		assert.NotEmpty(t, s.Hint)
+	}
+	assert.Nil(t, TutorialSteps[len(TutorialSteps)-1].Done)
+}
@@ -77,7 +77,19 @@ type ResourceViewer interface {
 
 	// SetBindKeys provision additional key bindings.
 	SetBindKeysFn(BindKeysFunc)
+
+	// AddBindKeysFn provision extender key bindings.
+	AddBindKeysFn(BindKeysFunc)
 	SetInstance(string)
+
+	// SetFieldSelector sets a server side field selector.
+	SetFieldSelector(string)
+
+	// SetFilter sets an initial view filter or label selector.
+	SetFilter(string)
+
+	// SwitchNamespace lists the resource in a given namespace.
+	SwitchNamespace(string) bool
 }
 
 // LogViewer represents a log viewer.
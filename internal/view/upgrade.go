@@ -0,0 +1,52 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Upgrade represents a cluster upgrade readiness view.
+type Upgrade struct {
+	ResourceViewer
+}
+
+// NewUpgrade returns a new viewer.
+func NewUpgrade(gvr client.GVR) ResourceViewer {
+	u := Upgrade{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	u.SetBindKeysFn(u.bindKeys)
+	u.GetTable().SetEnterFn(u.drill)
+	u.GetTable().SetColorerFn(render.Upgrade{}.ColorerFunc())
+
+	return &u
+}
+
+func (u *Upgrade) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Check", u.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", u.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (u *Upgrade) drill(app *App, _ ui.Tabular, _, path string) {
+	tokens := strings.SplitN(path, "/", 2)
+	if len(tokens) < 2 {
+		return
+	}
+	var err error
+	switch tokens[0] {
+	case render.CheckKubelet:
+		err = app.viewResource("nodes", tokens[1], false)
+	case render.CheckAPI:
+		err = app.gotoResource("deprecations", false)
+	}
+	if err != nil {
+		app.Flash().Err(err)
+	}
+}
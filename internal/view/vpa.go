@@ -0,0 +1,75 @@
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// VPA represents a vertical pod autoscaler viewer.
+type VPA struct {
+	ResourceViewer
+}
+
+// NewVPA returns a new viewer.
+func NewVPA(gvr client.GVR) ResourceViewer {
+	v := VPA{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.SetBindKeysFn(v.bindKeys)
+	v.GetTable().SetEnterFn(v.showRecommendations)
+	v.GetTable().SetColorerFn(render.VerticalPodAutoscaler{}.ColorerFunc())
+
+	return &v
+}
+
+// BindKeys binds apply when the user may patch deployments, the most common
+// target. Other targets are checked when the recommendation gets applied.
+func (v *VPA) bindKeys(aa ui.KeyActions) {
+	ns := v.GetTable().GetModel().GetNamespace()
+	if userCan(v.App(), ns, client.NewGVR("apps/v1/deployments"), client.PatchVerb) {
+		aa[ui.KeyA] = ui.NewKeyAction("Apply Recommendation", v.applyCmd, true)
+	} else {
+		aa.Delete(ui.KeyA)
+	}
+}
+
+func (v *VPA) showRecommendations(app *App, _ ui.Tabular, gvr, path string) {
+	showDiagnosis(app, gvr, path, "Recommendations")
+}
+
+func (v *VPA) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	msg := "Patch " + path + " target requests with the current recommendation?"
+	dialog.ShowConfirm(v.App().Content.Pages, "<Apply Recommendation>", msg, func() {
+		if err := v.applyRecommendation(path); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Infof("Recommendation applied for %s", path)
+	}, func() {})
+
+	return nil
+}
+
+func (v *VPA) applyRecommendation(path string) error {
+	res, err := dao.AccessorFor(v.App().factory, client.NewGVR(v.GVR()))
+	if err != nil {
+		return err
+	}
+	r, ok := res.(dao.Recommender)
+	if !ok {
+		return errors.New("resource does not provide recommendations")
+	}
+
+	return r.ApplyRecommendation(path)
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Webhook represents a webhook configuration viewer.
+type Webhook struct {
+	ResourceViewer
+}
+
+// NewWebhook returns a new viewer.
+func NewWebhook(gvr client.GVR) ResourceViewer {
+	w := Webhook{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.SetBindKeysFn(w.bindKeys)
+	w.GetTable().SetEnterFn(w.showDiagnosis)
+	w.GetTable().SetColorerFn(render.Webhook{}.ColorerFunc())
+
+	return &w
+}
+
+func (w *Webhook) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftF: ui.NewKeyAction("Sort Policy", w.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Unhealthy", w.GetTable().SortColCmd(4, false), false),
+	})
+}
+
+func (w *Webhook) showDiagnosis(app *App, _ ui.Tabular, gvr, path string) {
+	showDiagnosis(app, gvr, path, "Webhooks")
+}
+
+// Helpers...
+
+func showDiagnosis(app *App, gvr, path, title string) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR(gvr))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	d, ok := res.(dao.Diagnoser)
+	if !ok {
+		app.Flash().Err(fmt.Errorf("expecting a diagnoser for %q", gvr))
+		return
+	}
+	report, err := d.Diagnose(path)
+	if err != nil {
+		app.Flash().Errf("Diagnose failed %s", err)
+		return
+	}
+
+	details := NewDetails(app, title, path).Update(report)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -2,9 +2,7 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 
@@ -101,6 +99,15 @@ func (x *Xray) ExtraHints() map[string]string {
 // SetInstance sets specific resource instance.
 func (x *Xray) SetInstance(string) {}
 
+// SetFieldSelector sets a server side field selector.
+func (x *Xray) SetFieldSelector(string) {}
+
+// SwitchNamespace is not supported by xray views.
+func (x *Xray) SwitchNamespace(string) bool { return false }
+
+// SetFilter sets an initial view filter.
+func (x *Xray) SetFilter(string) {}
+
 func (x *Xray) bindKeys() {
 	x.Actions().Add(ui.KeyActions{
 		tcell.KeyEnter:      ui.NewKeyAction("Goto", x.gotoCmd, true),
@@ -263,19 +270,11 @@ func (x *Xray) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	ctx := x.defaultContext()
-	raw, err := x.model.ToYAML(ctx, ref.GVR, ref.Path)
-	if err != nil {
-		x.App().Flash().Errf("unable to get resource %q -- %s", ref.GVR, err)
-		return nil
-	}
-
-	details := NewDetails(x.app, "YAML", ref.Path).Update(raw)
-	if err := x.app.inject(details); err != nil {
-		x.app.Flash().Err(err)
-	}
+	showYAML(x.app, ref.Path, func(context.Context) (string, error) {
+		return x.model.ToYAML(ctx, ref.GVR, ref.Path)
+	})
 
 	return nil
-
 }
 
 func (x *Xray) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -335,20 +334,7 @@ func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	x.Stop()
 	defer x.Start()
-	{
-		ns, n := client.Namespaced(ref.Path)
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, client.NewGVR(ref.GVR).R())
-		args = append(args, "-n", ns)
-		args = append(args, "--context", x.app.Config.K9s.CurrentContext)
-		if cfg := x.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
-			args = append(args, "--kubeconfig", *cfg)
-		}
-		if !runK(true, x.app, append(args, n)...) {
-			x.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	editResource(x.app, ref.GVR, ref.Path)
 
 	return evt
 }
@@ -561,6 +547,9 @@ func (x *Xray) Stop() {
 // SetBindKeysFn sets up extra key bindings.
 func (x *Xray) SetBindKeysFn(BindKeysFunc) {}
 
+// AddBindKeysFn sets up extender key bindings.
+func (x *Xray) AddBindKeysFn(BindKeysFunc) {}
+
 // SetContextFn sets custom context.
 func (x *Xray) SetContextFn(ContextFunc) {}
 
@@ -612,7 +601,7 @@ func (x *Xray) styleTitle() string {
 }
 
 func (x *Xray) resourceDelete(gvr client.GVR, ref *xray.NodeSpec, msg string) {
-	dialog.ShowDelete(x.app.Content.Pages, msg, func(cascade, force bool) {
+	dialog.ShowDelete(x.app.Content.Pages, msg, func(opts dao.DeleteOptions) {
 		x.app.Flash().Infof("Delete resource %s %s", ref.GVR, ref.Path)
 		accessor, err := dao.AccessorFor(x.app.factory, gvr)
 		if err != nil {
@@ -625,7 +614,7 @@ func (x *Xray) resourceDelete(gvr client.GVR, ref *xray.NodeSpec, msg string) {
 			x.app.Flash().Errf("Invalid nuker %T", accessor)
 			return
 		}
-		if err := nuker.Delete(ref.Path, true, true); err != nil {
+		if err := nuker.Delete(ref.Path, opts); err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
 			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), ref.Path)
@@ -646,15 +635,12 @@ func fuzzyFilter(q, path string) bool {
 }
 
 func rxFilter(q, path string) bool {
-	rx := regexp.MustCompile(`(?i)` + q)
-	tokens := strings.Split(path, xray.PathSeparator)
-	for _, t := range tokens {
-		if rx.MatchString(t) {
-			return true
-		}
+	f, err := model.NewFilter(q)
+	if err != nil {
+		return true
 	}
 
-	return false
+	return f.MatchAny(strings.Split(path, xray.PathSeparator))
 }
 
 func makeTreeNode(node *xray.TreeNode, expanded bool, styles *config.Styles) *tview.TreeNode {
@@ -1,9 +1,8 @@
 package view
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -11,7 +10,9 @@ import (
 	"github.com/derailed/tview"
 
 	"github.com/derailed/k9s/internal/config"
-	"github.com/rs/zerolog/log"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var (
@@ -19,6 +20,16 @@ var (
 	keyRX    = regexp.MustCompile(`\A(\s*)([\w|\-|\.|\/|\s]+):\s*\z`)
 )
 
+const (
+	// maxInlineYAML tracks the YAML size above which saving to a file is offered.
+	maxInlineYAML = 1 << 20
+
+	// yamlChunkLines tracks how many YAML lines get rendered at once.
+	yamlChunkLines = 500
+
+	yamlTimeout = time.Minute
+)
+
 const (
 	yamlFullFmt  = "%s[key::b]%s[colon::-]: [val::]%s"
 	yamlKeyFmt   = "%s[key::b]%s[colon::-]:"
@@ -57,30 +68,59 @@ func colorizeYAML(style config.Yaml, raw string) string {
 	return strings.Join(buff, "\n")
 }
 
-func saveYAML(cluster, name, data string) (string, error) {
-	dir := filepath.Join(config.K9sDumpDir, cluster)
-	if err := ensureDir(dir); err != nil {
-		return "", err
-	}
+// YAMLFunc fetches a resource YAML.
+type YAMLFunc func(ctx context.Context) (string, error)
+
+// showYAML fetches and renders a resource YAML in the background. Large
+// manifests can be saved to a file instead.
+func showYAML(app *App, path string, fetch YAMLFunc) {
+	app.runJob("YAML "+path, yamlTimeout, func(ctx context.Context, job *model.Job) error {
+		job.SetStatus("fetching")
+		raw, err := fetch(ctx)
+		if err != nil {
+			return err
+		}
+		if len(raw) <= maxInlineYAML {
+			return streamYAML(ctx, app, path, raw, job)
+		}
 
-	now := time.Now().UnixNano()
-	fName := fmt.Sprintf("%s-%d.yml", strings.Replace(name, "/", "-", -1), now)
+		size := resource.NewQuantity(int64(len(raw)), resource.BinarySI).String()
+		job.SetStatus(size)
+		app.QueueUpdateDraw(func() {
+			dialog.ShowLargeContent(app.Content.Pages, path, size, func() {
+				if file, err := saveYAML(app.Config.K9s, "yaml", path, raw); err != nil {
+					app.Flash().Err(err)
+				} else {
+					app.Flash().Infof("YAML saved to %s", file)
+				}
+			}, func() {
+				app.runJob("Render "+path, 0, func(ctx context.Context, job *model.Job) error {
+					return streamYAML(ctx, app, path, raw, job)
+				})
+			})
+		})
+
+		return nil
+	})
+}
 
-	path := filepath.Join(dir, fName)
-	mod := os.O_CREATE | os.O_WRONLY
-	file, err := os.OpenFile(path, mod, 0600)
-	if err != nil {
-		log.Error().Err(err).Msgf("YAML create %s", path)
-		return "", nil
-	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.Error().Err(err).Msg("Closing yaml file")
-		}
-	}()
-	if _, err := file.Write([]byte(data)); err != nil {
-		return "", err
+func streamYAML(ctx context.Context, app *App, path, raw string, job *model.Job) error {
+	ctx, cancel := context.WithCancel(ctx)
+	details := NewDetails(app, "YAML", path)
+	details.SetCancelFn(cancel)
+
+	errs := make(chan error, 1)
+	app.QueueUpdateDraw(func() {
+		errs <- app.inject(details)
+	})
+	if err := <-errs; err != nil {
+		return err
 	}
+	job.SetStatus("rendering")
+
+	return details.Stream(ctx, raw, job.SetProgress)
+}
 
-	return path, nil
+func saveYAML(k *config.K9s, resource, path, data string) (string, error) {
+	return dumpFile(k, newDump(k, resource, path, "yml"), []byte(data))
 }
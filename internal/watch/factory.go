@@ -2,6 +2,7 @@ package watch
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +22,7 @@ const (
 // Factory tracks various resource informers.
 type Factory struct {
 	factories  map[string]di.DynamicSharedInformerFactory
+	informers  map[string]struct{}
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
@@ -32,6 +34,7 @@ func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]struct{}),
 		forwarders: NewForwarders(),
 	}
 }
@@ -49,7 +52,8 @@ func (f *Factory) Start(ns string) {
 	}
 }
 
-// Terminate terminates all watchers and forwards.
+// Terminate terminates all watchers. Port forwards are kept alive across
+// context switches, use Forwarders().DeleteAll to stop them.
 func (f *Factory) Terminate() {
 	f.mx.Lock()
 	defer f.mx.Unlock()
@@ -61,7 +65,9 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
-	f.forwarders.DeleteAll()
+	for k := range f.informers {
+		delete(f.informers, k)
+	}
 }
 
 // List returns a resource collection.
@@ -183,13 +189,33 @@ func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
 		return inf
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	key := gvr
+	if !client.IsClusterWide(ns) {
+		key += "@" + ns
+	}
+	f.informers[key] = struct{}{}
 	fact.Start(f.stopChan)
 
 	return inf
 }
 
+// Informers returns the resources being watched, qualified by namespace
+// unless cluster wide.
+func (f *Factory) Informers() []string {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	ii := make([]string, 0, len(f.informers))
+	for k := range f.informers {
+		ii = append(ii, k)
+	}
+	sort.Strings(ii)
+
+	return ii
+}
+
 func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
 	if client.IsClusterWide(ns) {
 		ns = client.AllNamespaces
@@ -211,13 +237,18 @@ func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
 
 // AddForwarder registers a new portforward for a given container.
 func (f *Factory) AddForwarder(pf Forwarder) {
-	f.forwarders[pf.Path()] = pf
+	f.forwarders[client.ContextPath(pf.Context(), pf.Path())] = pf
 }
 
-// DeleteForwarder deletes portforward for a given container.
+// DeleteForwarder deletes portforward for a given container. Paths not
+// scoped to a context target the current context.
 func (f *Factory) DeleteForwarder(path string) {
-	count := f.forwarders.Kill(path)
-	log.Warn().Msgf("Deleted (%d) portforward for %q", count, path)
+	ctx, p := client.SplitContextPath(path)
+	if ctx == "" {
+		ctx = f.currentContext()
+	}
+	count := f.forwarders.Kill(ctx, p)
+	log.Warn().Msgf("Deleted (%d) portforward for %q in context %q", count, p, ctx)
 }
 
 // Forwarders returns all portforwards.
@@ -225,8 +256,21 @@ func (f *Factory) Forwarders() Forwarders {
 	return f.forwarders
 }
 
-// ForwarderFor returns a portforward for a given container or nil if none exists.
+// ForwarderFor returns a portforward for a given container in the current
+// context or nil if none exists.
 func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
-	fwd, ok := f.forwarders[path]
+	fwd, ok := f.forwarders[client.ContextPath(f.currentContext(), path)]
 	return fwd, ok
 }
+
+func (f *Factory) currentContext() string {
+	if f.client == nil || f.client.Config() == nil {
+		return ""
+	}
+	ctx, err := f.client.Config().CurrentContextName()
+	if err != nil {
+		return ""
+	}
+
+	return ctx
+}
@@ -0,0 +1,44 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestFactoryInformersContextSwitch(t *testing.T) {
+	f := NewFactory(newConn())
+	f.Start("ns1")
+	defer f.Terminate()
+
+	f.ForResource("ns1", "v1/pods")
+	f.ForResource(client.AllNamespaces, "v1/nodes")
+	assert.Equal(t, []string{"v1/nodes", "v1/pods@ns1"}, f.Informers())
+
+	f.Terminate()
+	f.Start("ns2")
+	f.ForResource("ns2", "v1/services")
+	assert.Equal(t, []string{"v1/services@ns2"}, f.Informers())
+
+	f.Terminate()
+	f.Start("ns3")
+	assert.Equal(t, []string{}, f.Informers())
+}
+
+// Helpers...
+
+type conn struct {
+	client.Connection
+
+	dial dynamic.Interface
+}
+
+func newConn() *conn {
+	return &conn{dial: fake.NewSimpleDynamicClient(runtime.NewScheme())}
+}
+
+func (c *conn) DynDialOrDie() dynamic.Interface { return c.dial }
@@ -18,6 +18,9 @@ type Forwarder interface {
 	// Path returns a resource FQN.
 	Path() string
 
+	// Context returns the kube context owning the forward.
+	Context() string
+
 	// Container returns a container name.
 	Container() string
 
@@ -27,11 +30,17 @@ type Forwarder interface {
 	// Active returns forwarder current state.
 	Active() bool
 
+	// Status returns the forwarder health.
+	Status() string
+
+	// Transferred returns the number of bytes forwarded.
+	Transferred() int64
+
 	// Age returns forwarder age.
 	Age() string
 }
 
-// Forwarders tracks active port forwards.
+// Forwarders tracks active port forwards keyed by context scoped path.
 type Forwarders map[string]Forwarder
 
 // NewForwarders returns new forwarders.
@@ -48,14 +57,17 @@ func (ff Forwarders) DeleteAll() {
 	}
 }
 
-// Kill stops and delete a port-forwards associated with pod.
-func (ff Forwarders) Kill(path string) int {
+// Kill stops and delete a port-forwards associated with pod in a given context.
+func (ff Forwarders) Kill(ctx, path string) int {
 	hasContainer := strings.Contains(path, ":")
 	var stats int
 	for k, f := range ff {
-		victim := k
+		if f.Context() != ctx {
+			continue
+		}
+		victim := f.Path()
 		if !hasContainer {
-			victim = strings.Split(k, ":")[0]
+			victim = strings.Split(victim, ":")[0]
 		}
 		if victim == path {
 			stats++
@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"vbom.ml/util/sortorder"
 )
@@ -359,7 +360,7 @@ const (
 )
 
 func (t TreeNode) toTitle() (title string) {
-	_, n := client.Namespaced(t.ID)
+	n := t.displayName()
 	color, status := "white", "OK"
 	if v, ok := t.Extras[StatusKey]; ok {
 		switch v {
@@ -397,8 +398,21 @@ func (t TreeNode) toTitle() (title string) {
 
 const colorFmt = "%s [%s::b]%s[::]"
 
-func (t TreeNode) toEmojiTitle() (title string) {
+// DisplayName returns the node name, masked in anonymous mode.
+func (t TreeNode) displayName() string {
 	_, n := client.Namespaced(t.ID)
+	if !render.Anonymous {
+		return n
+	}
+	if t.GVR == "v1/namespaces" || t.GVR == "namespaces" {
+		return render.AnonymizeNamespace(n)
+	}
+
+	return render.AnonymizeName(n)
+}
+
+func (t TreeNode) toEmojiTitle() (title string) {
+	n := t.displayName()
 	color, status := "white", "OK"
 	if v, ok := t.Extras[StatusKey]; ok {
 		switch v {